@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// vtauditverify checks the integrity of the hash chains of vttablet audit logs.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/audit"
+)
+
+func init() {
+	logger := logutil.NewConsoleLogger()
+	flag.CommandLine.SetOutput(logutil.NewLoggerWriter(logger))
+	flag.Usage = func() {
+		fmt.Printf("usage of vtauditverify:\n")
+		fmt.Printf("  vtauditverify <audit log file> [<audit log file>...]\n")
+	}
+}
+
+func main() {
+	defer exit.RecoverAll()
+	defer logutil.Flush()
+
+	files := servenv.ParseFlagsWithArgs("vtauditverify")
+	failed := false
+	for _, file := range files {
+		n, err := verify(file)
+		if err != nil {
+			fmt.Printf("%s: ERROR after %d valid records: %v\n", file, n, err)
+			failed = true
+			continue
+		}
+		fmt.Printf("%s: OK, %d records\n", file, n)
+	}
+	if failed {
+		exit.Return(1)
+	}
+}
+
+func verify(file string) (int64, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return audit.Verify(f)
+}
@@ -19,16 +19,139 @@ package command
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
+// ApplyDeclarativeSchema makes a legacy ApplyDeclarativeSchema call to a vtctld.
+var ApplyDeclarativeSchema = &cobra.Command{
+	Use:   "ApplyDeclarativeSchema {--sql-file SQL_FILE | --schema-dir SCHEMA_DIR} [--ddl-strategy DDL_STRATEGY] [--request-context REQUEST_CONTEXT] [--skip-preflight] [--dry-run] keyspace",
+	Short: "Applies a desired schema, given as the full list of CREATE TABLE and CREATE VIEW statements, to a keyspace.",
+	Long: strings.TrimSpace(`
+ApplyDeclarativeSchema compares a desired schema with the schema of a keyspace, and
+submits the CREATE, ALTER and DROP statements that apply it as online DDL migrations
+sharing a single migration context. Statements are ordered so that foreign key parents
+come before their children, and views after the tables they select from. Tables and
+views that are not in the desired schema are dropped.
+
+The desired schema is read from --sql-file, or from all the *.sql files of --schema-dir.
+`),
+	DisableFlagsInUseLine: true,
+	Args:                  cobra.ExactArgs(1),
+	RunE:                  commandApplyDeclarativeSchema,
+}
+
+var applyDeclarativeSchemaOptions = struct {
+	SQLFile        string
+	SchemaDir      string
+	DDLStrategy    string
+	RequestContext string
+	SkipPreflight  bool
+	DryRun         bool
+}{}
+
+func commandApplyDeclarativeSchema(cmd *cobra.Command, args []string) error {
+	if (applyDeclarativeSchemaOptions.SQLFile == "") == (applyDeclarativeSchemaOptions.SchemaDir == "") {
+		return errors.New("must pass exactly one of --sql-file and --schema-dir")
+	}
+
+	files := []string{applyDeclarativeSchemaOptions.SQLFile}
+	if applyDeclarativeSchemaOptions.SchemaDir != "" {
+		matches, err := filepath.Glob(filepath.Join(applyDeclarativeSchemaOptions.SchemaDir, "*.sql"))
+		if err != nil {
+			return err
+		}
+		sort.Strings(matches)
+		files = matches
+	}
+
+	cli.FinishedParsing(cmd)
+
+	queries := make([]string, 0, len(files))
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		queries = append(queries, strings.TrimRight(strings.TrimSpace(string(data)), ";"))
+	}
+
+	// The schema is read here, since the legacy command reads -sql-file on the vtctld.
+	legacyArgs := []string{
+		"ApplyDeclarativeSchema",
+		"-sql", strings.Join(queries, ";\n"),
+		"-ddl_strategy", applyDeclarativeSchemaOptions.DDLStrategy,
+		"-request_context", applyDeclarativeSchemaOptions.RequestContext,
+	}
+	if applyDeclarativeSchemaOptions.SkipPreflight {
+		legacyArgs = append(legacyArgs, "-skip_preflight")
+	}
+	if applyDeclarativeSchemaOptions.DryRun {
+		legacyArgs = append(legacyArgs, "-dry_run")
+	}
+	legacyArgs = append(legacyArgs, cmd.Flags().Arg(0))
+
+	return runLegacyCommand(legacyArgs)
+}
+
+// DiffSchemaVersions makes a DiffSchemaVersions gRPC call to a vtctld.
+var DiffSchemaVersions = &cobra.Command{
+	Use:                   "DiffSchemaVersions alias from_id to_id",
+	Short:                 "Displays the tables and columns which were added, dropped or modified between two schema versions of a tablet.",
+	DisableFlagsInUseLine: true,
+	Args:                  cobra.ExactArgs(3),
+	RunE:                  commandDiffSchemaVersions,
+}
+
+func commandDiffSchemaVersions(cmd *cobra.Command, args []string) error {
+	alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	fromID, err := strconv.ParseInt(cmd.Flags().Arg(1), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid from_id: %w", err)
+	}
+
+	toID, err := strconv.ParseInt(cmd.Flags().Arg(2), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid to_id: %w", err)
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.DiffSchemaVersions(commandCtx, &vtctldatapb.DiffSchemaVersionsRequest{
+		TabletAlias: alias,
+		FromId:      fromID,
+		ToId:        toID,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 // GetSchema makes a GetSchema gRPC call to a vtctld.
 var GetSchema = &cobra.Command{
 	Use:  "GetSchema [--tables TABLES ...] [--exclude-tables EXCLUDE_TABLES ...] [{--table-names-only | --table-sizes-only}] [--include-views] alias",
@@ -90,7 +213,106 @@ func commandGetSchema(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// GetSchemaAtVersion makes a GetSchemaAtVersion gRPC call to a vtctld.
+var GetSchemaAtVersion = &cobra.Command{
+	Use:                   "GetSchemaAtVersion {--position POSITION | --time TIME} alias",
+	Short:                 "Displays the schema version, including the full schema, which was in effect on a tablet at a GTID position or time.",
+	DisableFlagsInUseLine: true,
+	Args:                  cobra.ExactArgs(1),
+	RunE:                  commandGetSchemaAtVersion,
+}
+
+var getSchemaAtVersionOptions = struct {
+	Position string
+	Time     string
+}{}
+
+func commandGetSchemaAtVersion(cmd *cobra.Command, args []string) error {
+	if (getSchemaAtVersionOptions.Position == "") == (getSchemaAtVersionOptions.Time == "") {
+		return errors.New("must pass exactly one of --position and --time")
+	}
+
+	alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	req := &vtctldatapb.GetSchemaAtVersionRequest{
+		TabletAlias: alias,
+		Position:    getSchemaAtVersionOptions.Position,
+	}
+
+	if getSchemaAtVersionOptions.Time != "" {
+		t, err := time.Parse(time.RFC3339, getSchemaAtVersionOptions.Time)
+		if err != nil {
+			return fmt.Errorf("invalid --time: %w", err)
+		}
+
+		req.Time = protoutil.TimeToProto(t)
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetSchemaAtVersion(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.SchemaVersion)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+// GetSchemaVersions makes a GetSchemaVersions gRPC call to a vtctld.
+var GetSchemaVersions = &cobra.Command{
+	Use:                   "GetSchemaVersions alias",
+	Short:                 "Lists the schema versions which a tablet tracks in _vt.schema_version, with the DDL, GTID position and time of each version.",
+	DisableFlagsInUseLine: true,
+	Args:                  cobra.ExactArgs(1),
+	RunE:                  commandGetSchemaVersions,
+}
+
+func commandGetSchemaVersions(cmd *cobra.Command, args []string) error {
+	alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetSchemaVersions(commandCtx, &vtctldatapb.GetSchemaVersionsRequest{
+		TabletAlias: alias,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 func init() {
+	ApplyDeclarativeSchema.Flags().StringVar(&applyDeclarativeSchemaOptions.SQLFile, "sql-file", "", "Path to a file with the CREATE statements of the desired schema.")
+	ApplyDeclarativeSchema.Flags().StringVar(&applyDeclarativeSchemaOptions.SchemaDir, "schema-dir", "", "Path to a directory of .sql files with the CREATE statements of the desired schema.")
+	ApplyDeclarativeSchema.Flags().StringVar(&applyDeclarativeSchemaOptions.DDLStrategy, "ddl-strategy", "online", "Online DDL strategy of the migrations.")
+	ApplyDeclarativeSchema.Flags().StringVar(&applyDeclarativeSchemaOptions.RequestContext, "request-context", "", "Migration context shared by the migrations. Auto-generated by default.")
+	ApplyDeclarativeSchema.Flags().BoolVar(&applyDeclarativeSchemaOptions.SkipPreflight, "skip-preflight", false, "Skip pre-apply schema checks.")
+	ApplyDeclarativeSchema.Flags().BoolVar(&applyDeclarativeSchemaOptions.DryRun, "dry-run", false, "Print the statements that apply the desired schema, without applying them.")
+	Root.AddCommand(ApplyDeclarativeSchema)
+
+	Root.AddCommand(DiffSchemaVersions)
+
 	GetSchema.Flags().StringSliceVar(&getSchemaOptions.Tables, "tables", nil, "TODO")
 	GetSchema.Flags().StringSliceVar(&getSchemaOptions.ExcludeTables, "exclude-tables", nil, "TODO")
 	GetSchema.Flags().BoolVar(&getSchemaOptions.IncludeViews, "include-views", false, "TODO")
@@ -98,4 +320,10 @@ func init() {
 	GetSchema.Flags().BoolVarP(&getSchemaOptions.TableSizesOnly, "table-sizes-only", "s", false, "TODO")
 
 	Root.AddCommand(GetSchema)
+
+	GetSchemaAtVersion.Flags().StringVar(&getSchemaAtVersionOptions.Position, "position", "", "GTID position at which to read the schema.")
+	GetSchemaAtVersion.Flags().StringVar(&getSchemaAtVersionOptions.Time, "time", "", "Time, in RFC3339 format, at which to read the schema.")
+	Root.AddCommand(GetSchemaAtVersion)
+
+	Root.AddCommand(GetSchemaVersions)
 }
@@ -44,6 +44,14 @@ var (
 		RunE:                  commandApplyVSchema,
 		Short:                 "Applies the VTGate routing schema to the provided keyspace. Shows the result after application.",
 	}
+	// VerifyLookupVindex makes a VerifyLookupVindex gRPC call to a vtctld.
+	VerifyLookupVindex = &cobra.Command{
+		Use:                   "VerifyLookupVindex [--repair] [--chunk-size=<rows>] <keyspace> <vindex>",
+		Args:                  cobra.ExactArgs(2),
+		DisableFlagsInUseLine: true,
+		RunE:                  commandVerifyLookupVindex,
+		Short:                 "Compares the owner table of a lookup vindex with its lookup table, and reports missing and orphaned entries for each shard of the lookup table.",
+	}
 )
 
 var applyVSchemaOptions = struct {
@@ -141,6 +149,34 @@ func commandGetVSchema(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var verifyLookupVindexOptions = struct {
+	Repair    bool
+	ChunkSize int64
+}{}
+
+func commandVerifyLookupVindex(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.VerifyLookupVindex(commandCtx, &vtctldatapb.VerifyLookupVindexRequest{
+		Keyspace:  cmd.Flags().Arg(0),
+		Vindex:    cmd.Flags().Arg(1),
+		Repair:    verifyLookupVindexOptions.Repair,
+		ChunkSize: verifyLookupVindexOptions.ChunkSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 func init() {
 	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.VSchema, "vschema", "", "VSchema")
 	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.VSchemaFile, "vschema-file", "", "VSchema File")
@@ -152,4 +188,8 @@ func init() {
 	Root.AddCommand(ApplyVSchema)
 
 	Root.AddCommand(GetVSchema)
+
+	VerifyLookupVindex.Flags().BoolVar(&verifyLookupVindexOptions.Repair, "repair", false, "Insert missing entries and delete orphaned entries of the lookup table.")
+	VerifyLookupVindex.Flags().Int64Var(&verifyLookupVindexOptions.ChunkSize, "chunk-size", 1000, "Number of rows read from a table at a time.")
+	Root.AddCommand(VerifyLookupVindex)
 }
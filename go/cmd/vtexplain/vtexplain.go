@@ -29,7 +29,7 @@ import (
 )
 
 var (
-	sqlFlag            = flag.String("sql", "", "A list of semicolon-delimited SQL commands to analyze")
+	sqlFlag            = flag.String("sql", "", "A list of semicolon-delimited SQL commands to analyze. CREATE TABLE and CREATE VIEW commands are compared with the schema, and explained as the statements that apply them")
 	sqlFileFlag        = flag.String("sql-file", "", "Identifies the file that contains the SQL commands to analyze")
 	schemaFlag         = flag.String("schema", "", "The SQL table schema")
 	schemaFileFlag     = flag.String("schema-file", "", "Identifies the file that contains the SQL table schema")
@@ -32,6 +32,9 @@ import (
 const (
 	// BaseShowPrimary is the base query for fetching primary key info.
 	BaseShowPrimary = "SELECT table_name, column_name FROM information_schema.key_column_usage WHERE table_schema=database() AND constraint_name='PRIMARY' ORDER BY table_name, ordinal_position"
+	// BaseShowUniqueKeys is the base query for fetching the columns of the unique keys
+	// other than the primary key, in key order.
+	BaseShowUniqueKeys = "SELECT table_name, constraint_name, column_name FROM information_schema.key_column_usage WHERE table_schema=database() AND constraint_name!='PRIMARY' AND referenced_table_name IS NULL ORDER BY table_name, constraint_name, ordinal_position"
 	// BaseShowTableUniqueKey returns names of colunms covered by a given unique constraint on a given table, in key order
 	BaseShowTableUniqueKey = "SELECT column_name as column_name FROM information_schema.key_column_usage WHERE table_schema=database() AND table_name=%a AND constraint_name=%a ORDER BY ordinal_position"
 	// ShowRowsRead is the query used to find the number of rows read.
@@ -109,6 +112,21 @@ order by table_name, ordinal_position`
 from _vt.schemacopy 
 where table_schema = database() 
 order by table_name, ordinal_position`
+
+	// FetchUpdatedForeignKeys queries fetches the foreign keys of updated tables
+	FetchUpdatedForeignKeys = `select kcu.table_name, kcu.constraint_name, kcu.column_name, kcu.referenced_table_name, kcu.referenced_column_name, rc.delete_rule, rc.update_rule 
+from information_schema.key_column_usage kcu 
+	join information_schema.referential_constraints rc on rc.constraint_schema = kcu.constraint_schema and rc.table_name = kcu.table_name and rc.constraint_name = kcu.constraint_name 
+where kcu.table_schema = database() and kcu.referenced_table_schema = database() and 
+	kcu.table_name in ::tableNames 
+order by kcu.table_name, kcu.constraint_name, kcu.ordinal_position`
+
+	// FetchForeignKeys queries fetches the foreign keys of all tables
+	FetchForeignKeys = `select kcu.table_name, kcu.constraint_name, kcu.column_name, kcu.referenced_table_name, kcu.referenced_column_name, rc.delete_rule, rc.update_rule 
+from information_schema.key_column_usage kcu 
+	join information_schema.referential_constraints rc on rc.constraint_schema = kcu.constraint_schema and rc.table_name = kcu.table_name and rc.constraint_name = kcu.constraint_name 
+where kcu.table_schema = database() and kcu.referenced_table_schema = database() 
+order by kcu.table_name, kcu.constraint_name, kcu.ordinal_position`
 )
 
 // VTDatabaseInit contains all the schema creation queries needed to
@@ -203,3 +221,24 @@ func ShowPrimaryRow(tableName, colName string) []sqltypes.Value {
 		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(colName)),
 	}
 }
+
+// ShowUniqueKeysFields contains the fields for a BaseShowUniqueKeys.
+var ShowUniqueKeysFields = []*querypb.Field{{
+	Name: "table_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "constraint_name",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "column_name",
+	Type: sqltypes.VarChar,
+}}
+
+// ShowUniqueKeyRow returns a row for a unique key column.
+func ShowUniqueKeyRow(tableName, keyName, colName string) []sqltypes.Value {
+	return []sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(tableName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(keyName)),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(colName)),
+	}
+}
@@ -48,6 +48,7 @@ type Result struct {
 	executing    sync.RWMutex
 	consolidator *Consolidator
 	query        string
+	waiters      int64
 	Result       interface{}
 	Err          error
 }
@@ -77,6 +78,27 @@ func (rs *Result) Broadcast() {
 	rs.executing.Unlock()
 }
 
+// AddWaiter registers a duplicate query as a waiter for the result.
+// If the result already has maxWaiters waiters, AddWaiter returns false,
+// and the duplicate query should be executed on its own. A maxWaiters
+// of zero or less means that there is no limit.
+func (rs *Result) AddWaiter(maxWaiters int64) bool {
+	for {
+		waiters := atomic.LoadInt64(&rs.waiters)
+		if maxWaiters > 0 && waiters >= maxWaiters {
+			return false
+		}
+		if atomic.CompareAndSwapInt64(&rs.waiters, waiters, waiters+1) {
+			return true
+		}
+	}
+}
+
+// Waiters returns the number of waiters registered for the result.
+func (rs *Result) Waiters() int64 {
+	return atomic.LoadInt64(&rs.waiters)
+}
+
 // Wait waits for the original query to complete execution. Wait should
 // be invoked for duplicate queries.
 func (rs *Result) Wait() {
@@ -82,3 +82,25 @@ func TestConsolidator(t *testing.T) {
 	}
 
 }
+
+func TestConsolidatorAddWaiter(t *testing.T) {
+	con := NewConsolidator()
+	orig, _ := con.Create("select * from SomeTable")
+	defer orig.Broadcast()
+
+	dup, _ := con.Create("select * from SomeTable")
+	for i := 0; i < 2; i++ {
+		if !dup.AddWaiter(2) {
+			t.Fatalf("expected waiter %d to be added", i+1)
+		}
+	}
+	if dup.AddWaiter(2) {
+		t.Fatalf("did not expect a third waiter to be added")
+	}
+	if !dup.AddWaiter(0) {
+		t.Fatalf("expected a waiter to be added without a limit")
+	}
+	if got := orig.Waiters(); got != 3 {
+		t.Fatalf("Waiters(): %d, want 3", got)
+	}
+}
@@ -131,6 +131,16 @@ func (vtctlclient *VtctlClientProcess) OnlineDDLRetryMigration(Keyspace, uuid st
 	)
 }
 
+// OnlineDDLCompleteMigration completes a given migration uuid, which was submitted with -postpone-completion
+func (vtctlclient *VtctlClientProcess) OnlineDDLCompleteMigration(Keyspace, uuid string) (result string, err error) {
+	return vtctlclient.ExecuteCommandWithOutput(
+		"OnlineDDL",
+		Keyspace,
+		"complete",
+		uuid,
+	)
+}
+
 // OnlineDDLRevertMigration reverts a given migration uuid
 func (vtctlclient *VtctlClientProcess) OnlineDDLRevertMigration(Keyspace, uuid string) (result string, err error) {
 	return vtctlclient.ExecuteCommandWithOutput(
@@ -21,12 +21,14 @@ import (
 	"encoding/hex"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/vt/concurrency"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
@@ -320,6 +322,85 @@ func DiffSchemaToArray(leftName string, left *tabletmanagerdatapb.SchemaDefiniti
 	return nil
 }
 
+// DiffMinimalSchemas compares the tables and columns of two schema versions, as tracked in _vt.schema_version,
+// and returns the differences sorted by table name.
+func DiffMinimalSchemas(from, to *binlogdatapb.MinimalSchema) (diffs []*tabletmanagerdatapb.SchemaVersionTableDiff) {
+	fromTables := map[string]*binlogdatapb.MinimalTable{}
+	for _, table := range from.GetTables() {
+		fromTables[table.Name] = table
+	}
+	toTables := map[string]*binlogdatapb.MinimalTable{}
+	for _, table := range to.GetTables() {
+		toTables[table.Name] = table
+	}
+	for name := range fromTables {
+		if _, ok := toTables[name]; !ok {
+			diffs = append(diffs, &tabletmanagerdatapb.SchemaVersionTableDiff{Table: name, Change: "dropped"})
+		}
+	}
+	for name, toTable := range toTables {
+		fromTable, ok := fromTables[name]
+		if !ok {
+			diffs = append(diffs, &tabletmanagerdatapb.SchemaVersionTableDiff{Table: name, Change: "added"})
+			continue
+		}
+		diff := &tabletmanagerdatapb.SchemaVersionTableDiff{Table: name, Change: "modified"}
+		fromFields := map[string]string{}
+		for _, field := range fromTable.Fields {
+			fromFields[field.Name] = describeField(field.Type.String(), field.ColumnType)
+		}
+		toFields := map[string]bool{}
+		for _, field := range toTable.Fields {
+			toFields[field.Name] = true
+			fromDescription, ok := fromFields[field.Name]
+			toDescription := describeField(field.Type.String(), field.ColumnType)
+			switch {
+			case !ok:
+				diff.AddedColumns = append(diff.AddedColumns, fmt.Sprintf("%s %s", field.Name, toDescription))
+			case fromDescription != toDescription:
+				diff.ModifiedColumns = append(diff.ModifiedColumns, fmt.Sprintf("%s %s -> %s", field.Name, fromDescription, toDescription))
+			}
+		}
+		for _, field := range fromTable.Fields {
+			if !toFields[field.Name] {
+				diff.DroppedColumns = append(diff.DroppedColumns, field.Name)
+			}
+		}
+		diff.PkColumnsChanged = !equalPKColumns(fromTable, toTable)
+		if len(diff.AddedColumns)+len(diff.DroppedColumns)+len(diff.ModifiedColumns) > 0 || diff.PkColumnsChanged {
+			diffs = append(diffs, diff)
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Table < diffs[j].Table
+	})
+	return diffs
+}
+
+func describeField(fieldType string, columnType string) string {
+	if columnType == "" {
+		return fieldType
+	}
+	return columnType
+}
+
+// equalPKColumns compares the names of the primary key columns of two tables
+func equalPKColumns(from, to *binlogdatapb.MinimalTable) bool {
+	if len(from.PKColumns) != len(to.PKColumns) {
+		return false
+	}
+	for i := range from.PKColumns {
+		fromIndex, toIndex := from.PKColumns[i], to.PKColumns[i]
+		if fromIndex >= int64(len(from.Fields)) || toIndex >= int64(len(to.Fields)) {
+			return false
+		}
+		if from.Fields[fromIndex].Name != to.Fields[toIndex].Name {
+			return false
+		}
+	}
+	return true
+}
+
 // SchemaChange contains all necessary information to apply a schema change.
 // It should not be sent over the wire, it's just a set of parameters.
 type SchemaChange struct {
@@ -24,6 +24,8 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
@@ -656,3 +658,73 @@ func TestFilterTables(t *testing.T) {
 		}
 	}
 }
+
+func TestDiffMinimalSchemas(t *testing.T) {
+	from := &binlogdatapb.MinimalSchema{
+		Tables: []*binlogdatapb.MinimalTable{
+			{
+				Name: "t1",
+				Fields: []*querypb.Field{
+					{Name: "id", Type: querypb.Type_INT32, ColumnType: "int"},
+					{Name: "name", Type: querypb.Type_VARCHAR, ColumnType: "varchar(32)"},
+					{Name: "legacy", Type: querypb.Type_INT32, ColumnType: "int"},
+				},
+				PKColumns: []int64{0},
+			},
+			{
+				Name:      "t2",
+				Fields:    []*querypb.Field{{Name: "id", Type: querypb.Type_INT32}},
+				PKColumns: []int64{0},
+			},
+			{
+				Name:   "unchanged",
+				Fields: []*querypb.Field{{Name: "id", Type: querypb.Type_INT32}},
+			},
+		},
+	}
+	to := &binlogdatapb.MinimalSchema{
+		Tables: []*binlogdatapb.MinimalTable{
+			{
+				Name: "t1",
+				Fields: []*querypb.Field{
+					{Name: "id", Type: querypb.Type_INT64, ColumnType: "bigint"},
+					{Name: "name", Type: querypb.Type_VARCHAR, ColumnType: "varchar(32)"},
+					{Name: "created_at", Type: querypb.Type_TIMESTAMP, ColumnType: "timestamp"},
+				},
+				PKColumns: []int64{0, 1},
+			},
+			{
+				Name:   "t3",
+				Fields: []*querypb.Field{{Name: "id", Type: querypb.Type_INT32}},
+			},
+			{
+				Name:   "unchanged",
+				Fields: []*querypb.Field{{Name: "id", Type: querypb.Type_INT32}},
+			},
+		},
+	}
+	want := []*tabletmanagerdatapb.SchemaVersionTableDiff{
+		{
+			Table:            "t1",
+			Change:           "modified",
+			AddedColumns:     []string{"created_at timestamp"},
+			DroppedColumns:   []string{"legacy"},
+			ModifiedColumns:  []string{"id int -> bigint"},
+			PkColumnsChanged: true,
+		},
+		{Table: "t2", Change: "dropped"},
+		{Table: "t3", Change: "added"},
+	}
+	got := DiffMinimalSchemas(from, to)
+	if len(got) != len(want) {
+		t.Fatalf("DiffMinimalSchemas: got %v, want %v", got, want)
+	}
+	for i := range want {
+		if !proto.Equal(got[i], want[i]) {
+			t.Errorf("DiffMinimalSchemas: got %v, want %v", got[i], want[i])
+		}
+	}
+	if diffs := DiffMinimalSchemas(from, from); len(diffs) != 0 {
+		t.Errorf("DiffMinimalSchemas of identical schemas: got %v, want none", diffs)
+	}
+}
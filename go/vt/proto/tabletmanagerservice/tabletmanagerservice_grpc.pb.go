@@ -29,6 +29,8 @@ type TabletManagerClient interface {
 	GetSchema(ctx context.Context, in *tabletmanagerdata.GetSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaResponse, error)
 	// GetPermissions asks the tablet for its permissions
 	GetPermissions(ctx context.Context, in *tabletmanagerdata.GetPermissionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetPermissionsResponse, error)
+	// GetSchemaVersions asks the tablet for the schema versions it tracks
+	GetSchemaVersions(ctx context.Context, in *tabletmanagerdata.GetSchemaVersionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaVersionsResponse, error)
 	SetReadOnly(ctx context.Context, in *tabletmanagerdata.SetReadOnlyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadOnlyResponse, error)
 	SetReadWrite(ctx context.Context, in *tabletmanagerdata.SetReadWriteRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadWriteResponse, error)
 	// ChangeType asks the remote tablet to change its type
@@ -152,6 +154,15 @@ func (c *tabletManagerClient) GetPermissions(ctx context.Context, in *tabletmana
 	return out, nil
 }
 
+func (c *tabletManagerClient) GetSchemaVersions(ctx context.Context, in *tabletmanagerdata.GetSchemaVersionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaVersionsResponse, error) {
+	out := new(tabletmanagerdata.GetSchemaVersionsResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetSchemaVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) SetReadOnly(ctx context.Context, in *tabletmanagerdata.SetReadOnlyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadOnlyResponse, error) {
 	out := new(tabletmanagerdata.SetReadOnlyResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetReadOnly", in, out, opts...)
@@ -572,6 +583,8 @@ type TabletManagerServer interface {
 	GetSchema(context.Context, *tabletmanagerdata.GetSchemaRequest) (*tabletmanagerdata.GetSchemaResponse, error)
 	// GetPermissions asks the tablet for its permissions
 	GetPermissions(context.Context, *tabletmanagerdata.GetPermissionsRequest) (*tabletmanagerdata.GetPermissionsResponse, error)
+	// GetSchemaVersions asks the tablet for the schema versions it tracks
+	GetSchemaVersions(context.Context, *tabletmanagerdata.GetSchemaVersionsRequest) (*tabletmanagerdata.GetSchemaVersionsResponse, error)
 	SetReadOnly(context.Context, *tabletmanagerdata.SetReadOnlyRequest) (*tabletmanagerdata.SetReadOnlyResponse, error)
 	SetReadWrite(context.Context, *tabletmanagerdata.SetReadWriteRequest) (*tabletmanagerdata.SetReadWriteResponse, error)
 	// ChangeType asks the remote tablet to change its type
@@ -662,6 +675,9 @@ func (UnimplementedTabletManagerServer) GetSchema(context.Context, *tabletmanage
 func (UnimplementedTabletManagerServer) GetPermissions(context.Context, *tabletmanagerdata.GetPermissionsRequest) (*tabletmanagerdata.GetPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPermissions not implemented")
 }
+func (UnimplementedTabletManagerServer) GetSchemaVersions(context.Context, *tabletmanagerdata.GetSchemaVersionsRequest) (*tabletmanagerdata.GetSchemaVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaVersions not implemented")
+}
 func (UnimplementedTabletManagerServer) SetReadOnly(context.Context, *tabletmanagerdata.SetReadOnlyRequest) (*tabletmanagerdata.SetReadOnlyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetReadOnly not implemented")
 }
@@ -885,6 +901,24 @@ func _TabletManager_GetPermissions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_GetSchemaVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetSchemaVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetSchemaVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetSchemaVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetSchemaVersions(ctx, req.(*tabletmanagerdata.GetSchemaVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_SetReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.SetReadOnlyRequest)
 	if err := dec(in); err != nil {
@@ -1638,6 +1672,10 @@ var TabletManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPermissions",
 			Handler:    _TabletManager_GetPermissions_Handler,
 		},
+		{
+			MethodName: "GetSchemaVersions",
+			Handler:    _TabletManager_GetSchemaVersions_Handler,
+		},
 		{
 			MethodName: "SetReadOnly",
 			Handler:    _TabletManager_SetReadOnly_Handler,
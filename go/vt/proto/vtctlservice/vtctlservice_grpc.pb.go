@@ -175,6 +175,8 @@ type VtctldClient interface {
 	DeleteShards(ctx context.Context, in *vtctldata.DeleteShardsRequest, opts ...grpc.CallOption) (*vtctldata.DeleteShardsResponse, error)
 	// DeleteTablets deletes one or more tablets from the topology.
 	DeleteTablets(ctx context.Context, in *vtctldata.DeleteTabletsRequest, opts ...grpc.CallOption) (*vtctldata.DeleteTabletsResponse, error)
+	// DiffSchemaVersions compares two schema versions tracked by a tablet.
+	DiffSchemaVersions(ctx context.Context, in *vtctldata.DiffSchemaVersionsRequest, opts ...grpc.CallOption) (*vtctldata.DiffSchemaVersionsResponse, error)
 	// EmergencyReparentShard reparents the shard to the new primary. It assumes
 	// the old primary is dead or otherwise not responding.
 	EmergencyReparentShard(ctx context.Context, in *vtctldata.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldata.EmergencyReparentShardResponse, error)
@@ -200,6 +202,12 @@ type VtctldClient interface {
 	// GetSchema returns the schema for a tablet, or just the schema for the
 	// specified tables in that tablet.
 	GetSchema(ctx context.Context, in *vtctldata.GetSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaResponse, error)
+	// GetSchemaAtVersion returns the schema version, including the full schema,
+	// which was in effect on a tablet at a GTID position or time.
+	GetSchemaAtVersion(ctx context.Context, in *vtctldata.GetSchemaAtVersionRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaAtVersionResponse, error)
+	// GetSchemaVersions lists the schema versions tracked by a tablet, without
+	// their schemas.
+	GetSchemaVersions(ctx context.Context, in *vtctldata.GetSchemaVersionsRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaVersionsResponse, error)
 	// GetShard returns information about a shard in the topology.
 	GetShard(ctx context.Context, in *vtctldata.GetShardRequest, opts ...grpc.CallOption) (*vtctldata.GetShardResponse, error)
 	// GetSrvKeyspaces returns the SrvKeyspaces for a keyspace in one or more
@@ -273,6 +281,9 @@ type VtctldClient interface {
 	// parameters. Empty values are ignored. If the alias does not exist, the
 	// CellsAlias will be created.
 	UpdateCellsAlias(ctx context.Context, in *vtctldata.UpdateCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.UpdateCellsAliasResponse, error)
+	// VerifyLookupVindex compares the rows of the owner table of a lookup vindex
+	// with its lookup table, and optionally repairs the lookup table.
+	VerifyLookupVindex(ctx context.Context, in *vtctldata.VerifyLookupVindexRequest, opts ...grpc.CallOption) (*vtctldata.VerifyLookupVindexResponse, error)
 }
 
 type vtctldClient struct {
@@ -391,6 +402,15 @@ func (c *vtctldClient) DeleteTablets(ctx context.Context, in *vtctldata.DeleteTa
 	return out, nil
 }
 
+func (c *vtctldClient) DiffSchemaVersions(ctx context.Context, in *vtctldata.DiffSchemaVersionsRequest, opts ...grpc.CallOption) (*vtctldata.DiffSchemaVersionsResponse, error) {
+	out := new(vtctldata.DiffSchemaVersionsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DiffSchemaVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) EmergencyReparentShard(ctx context.Context, in *vtctldata.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldata.EmergencyReparentShardResponse, error) {
 	out := new(vtctldata.EmergencyReparentShardResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/EmergencyReparentShard", in, out, opts...)
@@ -481,6 +501,24 @@ func (c *vtctldClient) GetSchema(ctx context.Context, in *vtctldata.GetSchemaReq
 	return out, nil
 }
 
+func (c *vtctldClient) GetSchemaAtVersion(ctx context.Context, in *vtctldata.GetSchemaAtVersionRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaAtVersionResponse, error) {
+	out := new(vtctldata.GetSchemaAtVersionResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetSchemaAtVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetSchemaVersions(ctx context.Context, in *vtctldata.GetSchemaVersionsRequest, opts ...grpc.CallOption) (*vtctldata.GetSchemaVersionsResponse, error) {
+	out := new(vtctldata.GetSchemaVersionsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetSchemaVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetShard(ctx context.Context, in *vtctldata.GetShardRequest, opts ...grpc.CallOption) (*vtctldata.GetShardResponse, error) {
 	out := new(vtctldata.GetShardResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetShard", in, out, opts...)
@@ -661,6 +699,15 @@ func (c *vtctldClient) UpdateCellsAlias(ctx context.Context, in *vtctldata.Updat
 	return out, nil
 }
 
+func (c *vtctldClient) VerifyLookupVindex(ctx context.Context, in *vtctldata.VerifyLookupVindexRequest, opts ...grpc.CallOption) (*vtctldata.VerifyLookupVindexResponse, error) {
+	out := new(vtctldata.VerifyLookupVindexResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/VerifyLookupVindex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VtctldServer is the server API for Vtctld service.
 // All implementations must embed UnimplementedVtctldServer
 // for forward compatibility
@@ -708,6 +755,8 @@ type VtctldServer interface {
 	DeleteShards(context.Context, *vtctldata.DeleteShardsRequest) (*vtctldata.DeleteShardsResponse, error)
 	// DeleteTablets deletes one or more tablets from the topology.
 	DeleteTablets(context.Context, *vtctldata.DeleteTabletsRequest) (*vtctldata.DeleteTabletsResponse, error)
+	// DiffSchemaVersions compares two schema versions tracked by a tablet.
+	DiffSchemaVersions(context.Context, *vtctldata.DiffSchemaVersionsRequest) (*vtctldata.DiffSchemaVersionsResponse, error)
 	// EmergencyReparentShard reparents the shard to the new primary. It assumes
 	// the old primary is dead or otherwise not responding.
 	EmergencyReparentShard(context.Context, *vtctldata.EmergencyReparentShardRequest) (*vtctldata.EmergencyReparentShardResponse, error)
@@ -733,6 +782,12 @@ type VtctldServer interface {
 	// GetSchema returns the schema for a tablet, or just the schema for the
 	// specified tables in that tablet.
 	GetSchema(context.Context, *vtctldata.GetSchemaRequest) (*vtctldata.GetSchemaResponse, error)
+	// GetSchemaAtVersion returns the schema version, including the full schema,
+	// which was in effect on a tablet at a GTID position or time.
+	GetSchemaAtVersion(context.Context, *vtctldata.GetSchemaAtVersionRequest) (*vtctldata.GetSchemaAtVersionResponse, error)
+	// GetSchemaVersions lists the schema versions tracked by a tablet, without
+	// their schemas.
+	GetSchemaVersions(context.Context, *vtctldata.GetSchemaVersionsRequest) (*vtctldata.GetSchemaVersionsResponse, error)
 	// GetShard returns information about a shard in the topology.
 	GetShard(context.Context, *vtctldata.GetShardRequest) (*vtctldata.GetShardResponse, error)
 	// GetSrvKeyspaces returns the SrvKeyspaces for a keyspace in one or more
@@ -806,6 +861,9 @@ type VtctldServer interface {
 	// parameters. Empty values are ignored. If the alias does not exist, the
 	// CellsAlias will be created.
 	UpdateCellsAlias(context.Context, *vtctldata.UpdateCellsAliasRequest) (*vtctldata.UpdateCellsAliasResponse, error)
+	// VerifyLookupVindex compares the rows of the owner table of a lookup vindex
+	// with its lookup table, and optionally repairs the lookup table.
+	VerifyLookupVindex(context.Context, *vtctldata.VerifyLookupVindexRequest) (*vtctldata.VerifyLookupVindexResponse, error)
 	mustEmbedUnimplementedVtctldServer()
 }
 
@@ -849,6 +907,9 @@ func (UnimplementedVtctldServer) DeleteShards(context.Context, *vtctldata.Delete
 func (UnimplementedVtctldServer) DeleteTablets(context.Context, *vtctldata.DeleteTabletsRequest) (*vtctldata.DeleteTabletsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTablets not implemented")
 }
+func (UnimplementedVtctldServer) DiffSchemaVersions(context.Context, *vtctldata.DiffSchemaVersionsRequest) (*vtctldata.DiffSchemaVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffSchemaVersions not implemented")
+}
 func (UnimplementedVtctldServer) EmergencyReparentShard(context.Context, *vtctldata.EmergencyReparentShardRequest) (*vtctldata.EmergencyReparentShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EmergencyReparentShard not implemented")
 }
@@ -879,6 +940,12 @@ func (UnimplementedVtctldServer) GetRoutingRules(context.Context, *vtctldata.Get
 func (UnimplementedVtctldServer) GetSchema(context.Context, *vtctldata.GetSchemaRequest) (*vtctldata.GetSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
 }
+func (UnimplementedVtctldServer) GetSchemaAtVersion(context.Context, *vtctldata.GetSchemaAtVersionRequest) (*vtctldata.GetSchemaAtVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaAtVersion not implemented")
+}
+func (UnimplementedVtctldServer) GetSchemaVersions(context.Context, *vtctldata.GetSchemaVersionsRequest) (*vtctldata.GetSchemaVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaVersions not implemented")
+}
 func (UnimplementedVtctldServer) GetShard(context.Context, *vtctldata.GetShardRequest) (*vtctldata.GetShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShard not implemented")
 }
@@ -939,6 +1006,9 @@ func (UnimplementedVtctldServer) UpdateCellInfo(context.Context, *vtctldata.Upda
 func (UnimplementedVtctldServer) UpdateCellsAlias(context.Context, *vtctldata.UpdateCellsAliasRequest) (*vtctldata.UpdateCellsAliasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCellsAlias not implemented")
 }
+func (UnimplementedVtctldServer) VerifyLookupVindex(context.Context, *vtctldata.VerifyLookupVindexRequest) (*vtctldata.VerifyLookupVindexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyLookupVindex not implemented")
+}
 func (UnimplementedVtctldServer) mustEmbedUnimplementedVtctldServer() {}
 
 // UnsafeVtctldServer may be embedded to opt out of forward compatibility for this service.
@@ -1168,6 +1238,24 @@ func _Vtctld_DeleteTablets_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_DiffSchemaVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DiffSchemaVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DiffSchemaVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DiffSchemaVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DiffSchemaVersions(ctx, req.(*vtctldata.DiffSchemaVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_EmergencyReparentShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.EmergencyReparentShardRequest)
 	if err := dec(in); err != nil {
@@ -1348,6 +1436,42 @@ func _Vtctld_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetSchemaAtVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetSchemaAtVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetSchemaAtVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetSchemaAtVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetSchemaAtVersion(ctx, req.(*vtctldata.GetSchemaAtVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetSchemaVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetSchemaVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetSchemaVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetSchemaVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetSchemaVersions(ctx, req.(*vtctldata.GetSchemaVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetShardRequest)
 	if err := dec(in); err != nil {
@@ -1708,6 +1832,24 @@ func _Vtctld_UpdateCellsAlias_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_VerifyLookupVindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.VerifyLookupVindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).VerifyLookupVindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/VerifyLookupVindex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).VerifyLookupVindex(ctx, req.(*vtctldata.VerifyLookupVindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Vtctld_ServiceDesc is the grpc.ServiceDesc for Vtctld service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1763,6 +1905,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTablets",
 			Handler:    _Vtctld_DeleteTablets_Handler,
 		},
+		{
+			MethodName: "DiffSchemaVersions",
+			Handler:    _Vtctld_DiffSchemaVersions_Handler,
+		},
 		{
 			MethodName: "EmergencyReparentShard",
 			Handler:    _Vtctld_EmergencyReparentShard_Handler,
@@ -1803,6 +1949,14 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSchema",
 			Handler:    _Vtctld_GetSchema_Handler,
 		},
+		{
+			MethodName: "GetSchemaAtVersion",
+			Handler:    _Vtctld_GetSchemaAtVersion_Handler,
+		},
+		{
+			MethodName: "GetSchemaVersions",
+			Handler:    _Vtctld_GetSchemaVersions_Handler,
+		},
 		{
 			MethodName: "GetShard",
 			Handler:    _Vtctld_GetShard_Handler,
@@ -1883,6 +2037,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCellsAlias",
 			Handler:    _Vtctld_UpdateCellsAlias_Handler,
 		},
+		{
+			MethodName: "VerifyLookupVindex",
+			Handler:    _Vtctld_VerifyLookupVindex_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vtctlservice.proto",
@@ -19,20 +19,29 @@ package schema
 import (
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/shlex"
 )
 
 var (
 	strategyParserRegexp = regexp.MustCompile(`^([\S]+)\s+(.*)$`)
+	cutOverWindowRegexp  = regexp.MustCompile(`^([0-9]{2}):([0-9]{2})-([0-9]{2}):([0-9]{2})$`)
 )
 
 const (
-	declarativeFlag       = "declarative"
-	skipTopoFlag          = "skip-topo"
-	singletonFlag         = "singleton"
-	singletonContextFlag  = "singleton-context"
-	vreplicationTestSuite = "vreplication-test-suite"
+	declarativeFlag        = "declarative"
+	skipTopoFlag           = "skip-topo"
+	singletonFlag          = "singleton"
+	singletonContextFlag   = "singleton-context"
+	vreplicationTestSuite  = "vreplication-test-suite"
+	fastOverRevertible     = "fast-over-revertible"
+	postponeCompletionFlag = "postpone-completion"
+	cutOverWindowFlag      = "cut-over-window"
+	cutOverTimeoutFlag     = "cut-over-timeout"
+	allowConcurrentFlag    = "allow-concurrent"
 )
 
 // DDLStrategy suggests how an ALTER TABLE should run (e.g. "direct", "online", "gh-ost" or "pt-osc")
@@ -90,9 +99,61 @@ func ParseDDLStrategy(strategyVariable string) (*DDLStrategySetting, error) {
 	default:
 		return nil, fmt.Errorf("Unknown online DDL strategy: '%v'", strategy)
 	}
+	if _, err := setting.CutOverWindow(); err != nil {
+		return nil, err
+	}
+	if _, err := setting.CutOverTimeout(); err != nil {
+		return nil, err
+	}
 	return setting, nil
 }
 
+// CutOverWindow is a daily time range, in UTC, within which a migration is allowed to cut-over.
+// The range may wrap around midnight, e.g. 22:00-04:00
+type CutOverWindow struct {
+	Start time.Duration // offset since midnight
+	End   time.Duration // offset since midnight
+}
+
+// ParseCutOverWindow parses a window in the form of HH:MM-HH:MM
+func ParseCutOverWindow(s string) (*CutOverWindow, error) {
+	submatch := cutOverWindowRegexp.FindStringSubmatch(s)
+	if len(submatch) == 0 {
+		return nil, fmt.Errorf("invalid cut-over window: '%s'. Expected HH:MM-HH:MM", s)
+	}
+	offset := func(hours, minutes string) (time.Duration, error) {
+		h, _ := strconv.Atoi(hours)
+		m, _ := strconv.Atoi(minutes)
+		if h > 23 || m > 59 {
+			return 0, fmt.Errorf("invalid cut-over window: '%s'. Time out of range", s)
+		}
+		return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+	}
+	start, err := offset(submatch[1], submatch[2])
+	if err != nil {
+		return nil, err
+	}
+	end, err := offset(submatch[3], submatch[4])
+	if err != nil {
+		return nil, err
+	}
+	if start == end {
+		return nil, fmt.Errorf("invalid cut-over window: '%s'. Empty range", s)
+	}
+	return &CutOverWindow{Start: start, End: end}, nil
+}
+
+// Contains returns true when the given time is within the window
+func (w *CutOverWindow) Contains(t time.Time) bool {
+	t = t.UTC()
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	if w.Start < w.End {
+		return offset >= w.Start && offset < w.End
+	}
+	// window wraps around midnight
+	return offset >= w.Start || offset < w.End
+}
+
 // isFlag return true when the given string is a CLI flag of the given name
 func isFlag(s string, name string) bool {
 	if s == fmt.Sprintf("-%s", name) {
@@ -104,6 +165,22 @@ func isFlag(s string, name string) bool {
 	return false
 }
 
+// flagValue returns the value of a flag given as -name=value or --name=value
+func flagValue(s string, name string) (value string, ok bool) {
+	for _, prefix := range []string{fmt.Sprintf("-%s=", name), fmt.Sprintf("--%s=", name)} {
+		if strings.HasPrefix(s, prefix) {
+			return strings.TrimPrefix(s, prefix), true
+		}
+	}
+	return "", false
+}
+
+// isFlagWithValue returns true when the given string is a CLI flag of the given name, with a value
+func isFlagWithValue(s string, name string) bool {
+	_, ok := flagValue(s, name)
+	return ok
+}
+
 // hasFlag returns true when Options include named flag
 func (setting *DDLStrategySetting) hasFlag(name string) bool {
 	opts, _ := shlex.Split(setting.Options)
@@ -115,6 +192,17 @@ func (setting *DDLStrategySetting) hasFlag(name string) bool {
 	return false
 }
 
+// getFlagValue returns the value of named flag in Options, if present
+func (setting *DDLStrategySetting) getFlagValue(name string) (value string, ok bool) {
+	opts, _ := shlex.Split(setting.Options)
+	for _, opt := range opts {
+		if value, ok := flagValue(opt, name); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
 // IsDeclarative checks if strategy options include -declarative
 func (setting *DDLStrategySetting) IsDeclarative() bool {
 	return setting.hasFlag(declarativeFlag)
@@ -135,6 +223,67 @@ func (setting *DDLStrategySetting) IsVreplicationTestSuite() bool {
 	return setting.hasFlag(vreplicationTestSuite)
 }
 
+// IsFastOverRevertible checks if strategy options include -fast-over-revertible
+func (setting *DDLStrategySetting) IsFastOverRevertible() bool {
+	return setting.hasFlag(fastOverRevertible)
+}
+
+// IsPostponeCompletion checks if strategy options include -postpone-completion
+func (setting *DDLStrategySetting) IsPostponeCompletion() bool {
+	return setting.hasFlag(postponeCompletionFlag)
+}
+
+// IsAllowConcurrent checks if strategy options include -allow-concurrent
+func (setting *DDLStrategySetting) IsAllowConcurrent() bool {
+	return setting.hasFlag(allowConcurrentFlag)
+}
+
+// WithoutAllowConcurrent returns a copy of the setting without -allow-concurrent. Its migrations run
+// exclusively, which makes them a barrier to the migrations that are queued after them.
+func (setting *DDLStrategySetting) WithoutAllowConcurrent() *DDLStrategySetting {
+	opts, _ := shlex.Split(setting.Options)
+	var keptOpts []string
+	for _, opt := range opts {
+		if !isFlag(opt, allowConcurrentFlag) {
+			keptOpts = append(keptOpts, opt)
+		}
+	}
+	return NewDDLStrategySetting(setting.Strategy, strings.Join(keptOpts, " "))
+}
+
+// Variable returns the setting in the form of the @@ddl_strategy variable, e.g. "online -allow-concurrent"
+func (setting *DDLStrategySetting) Variable() string {
+	if setting.Options == "" {
+		return string(setting.Strategy)
+	}
+	return fmt.Sprintf("%s %s", setting.Strategy, setting.Options)
+}
+
+// CutOverWindow returns the window given by -cut-over-window=HH:MM-HH:MM, or nil if unspecified
+func (setting *DDLStrategySetting) CutOverWindow() (*CutOverWindow, error) {
+	value, ok := setting.getFlagValue(cutOverWindowFlag)
+	if !ok {
+		return nil, nil
+	}
+	return ParseCutOverWindow(value)
+}
+
+// CutOverTimeout returns the duration given by -cut-over-timeout, or zero if unspecified
+func (setting *DDLStrategySetting) CutOverTimeout() (time.Duration, error) {
+	value, ok := setting.getFlagValue(cutOverTimeoutFlag)
+	if !ok {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cut-over timeout: '%s': %v", value, err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("invalid cut-over timeout: '%s'. Must be positive", value)
+	}
+	return timeout, nil
+}
+
 // RuntimeOptions returns the options used as runtime flags for given strategy, removing any internal hint options
 func (setting *DDLStrategySetting) RuntimeOptions() []string {
 	opts, _ := shlex.Split(setting.Options)
@@ -146,6 +295,11 @@ func (setting *DDLStrategySetting) RuntimeOptions() []string {
 		case isFlag(opt, singletonFlag):
 		case isFlag(opt, singletonContextFlag):
 		case isFlag(opt, vreplicationTestSuite):
+		case isFlag(opt, fastOverRevertible):
+		case isFlag(opt, postponeCompletionFlag):
+		case isFlag(opt, allowConcurrentFlag):
+		case isFlagWithValue(opt, cutOverWindowFlag):
+		case isFlagWithValue(opt, cutOverTimeoutFlag):
 		default:
 			validOpts = append(validOpts, opt)
 		}
@@ -19,8 +19,10 @@ package schema
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsDirect(t *testing.T) {
@@ -41,6 +43,9 @@ func TestParseDDLStrategy(t *testing.T) {
 		options          string
 		isDeclarative    bool
 		isSingleton      bool
+		fastOverRevert   bool
+		postponeComplete bool
+		allowConcurrent  bool
 		runtimeOptions   string
 		err              error
 	}{
@@ -90,6 +95,27 @@ func TestParseDDLStrategy(t *testing.T) {
 			runtimeOptions:   "",
 			isSingleton:      true,
 		},
+		{
+			strategyVariable: "online -fast-over-revertible",
+			strategy:         DDLStrategyOnline,
+			options:          "-fast-over-revertible",
+			runtimeOptions:   "",
+			fastOverRevert:   true,
+		},
+		{
+			strategyVariable: "online -postpone-completion --cut-over-window=22:00-04:00 -cut-over-timeout=30s",
+			strategy:         DDLStrategyOnline,
+			options:          "-postpone-completion --cut-over-window=22:00-04:00 -cut-over-timeout=30s",
+			runtimeOptions:   "",
+			postponeComplete: true,
+		},
+		{
+			strategyVariable: "online -allow-concurrent",
+			strategy:         DDLStrategyOnline,
+			options:          "-allow-concurrent",
+			runtimeOptions:   "",
+			allowConcurrent:  true,
+		},
 	}
 	for _, ts := range tt {
 		setting, err := ParseDDLStrategy(ts.strategyVariable)
@@ -98,6 +124,9 @@ func TestParseDDLStrategy(t *testing.T) {
 		assert.Equal(t, ts.options, setting.Options)
 		assert.Equal(t, ts.isDeclarative, setting.IsDeclarative())
 		assert.Equal(t, ts.isSingleton, setting.IsSingleton())
+		assert.Equal(t, ts.fastOverRevert, setting.IsFastOverRevertible())
+		assert.Equal(t, ts.postponeComplete, setting.IsPostponeCompletion())
+		assert.Equal(t, ts.allowConcurrent, setting.IsAllowConcurrent())
 
 		runtimeOptions := strings.Join(setting.RuntimeOptions(), " ")
 		assert.Equal(t, ts.runtimeOptions, runtimeOptions)
@@ -106,4 +135,65 @@ func TestParseDDLStrategy(t *testing.T) {
 		_, err := ParseDDLStrategy("other")
 		assert.Error(t, err)
 	}
+	{
+		_, err := ParseDDLStrategy("online -cut-over-window=25:00-04:00")
+		assert.Error(t, err)
+	}
+	{
+		_, err := ParseDDLStrategy("online -cut-over-timeout=soon")
+		assert.Error(t, err)
+	}
+}
+
+func TestWithoutAllowConcurrent(t *testing.T) {
+	setting, err := ParseDDLStrategy("online -allow-concurrent -postpone-completion")
+	require.NoError(t, err)
+	exclusive := setting.WithoutAllowConcurrent()
+	assert.False(t, exclusive.IsAllowConcurrent())
+	assert.True(t, exclusive.IsPostponeCompletion())
+	assert.Equal(t, "online -postpone-completion", exclusive.Variable())
+	assert.True(t, setting.IsAllowConcurrent())
+
+	setting, err = ParseDDLStrategy("online -allow-concurrent")
+	require.NoError(t, err)
+	assert.Equal(t, "online", setting.WithoutAllowConcurrent().Variable())
+}
+
+func TestCutOverSettings(t *testing.T) {
+	setting, err := ParseDDLStrategy("online -cut-over-window=22:00-04:00 -cut-over-timeout=30s")
+	require.NoError(t, err)
+
+	timeout, err := setting.CutOverTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, timeout)
+
+	window, err := setting.CutOverWindow()
+	require.NoError(t, err)
+	require.NotNil(t, window)
+	assert.Equal(t, 22*time.Hour, window.Start)
+	assert.Equal(t, 4*time.Hour, window.End)
+
+	day := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	assert.True(t, window.Contains(day.Add(23*time.Hour)))
+	assert.True(t, window.Contains(day.Add(3*time.Hour+59*time.Minute)))
+	assert.False(t, window.Contains(day.Add(4*time.Hour)))
+	assert.False(t, window.Contains(day.Add(12*time.Hour)))
+
+	window, err = ParseCutOverWindow("09:30-17:00")
+	require.NoError(t, err)
+	assert.True(t, window.Contains(day.Add(9*time.Hour+30*time.Minute)))
+	assert.False(t, window.Contains(day.Add(9*time.Hour)))
+	assert.False(t, window.Contains(day.Add(17*time.Hour)))
+
+	_, err = ParseCutOverWindow("09:30-09:30")
+	assert.Error(t, err)
+
+	setting, err = ParseDDLStrategy("online")
+	require.NoError(t, err)
+	window, err = setting.CutOverWindow()
+	assert.NoError(t, err)
+	assert.Nil(t, window)
+	timeout, err = setting.CutOverTimeout()
+	assert.NoError(t, err)
+	assert.Zero(t, timeout)
 }
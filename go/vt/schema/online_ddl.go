@@ -194,11 +194,11 @@ func NewOnlineDDLs(keyspace string, sql string, ddlStmt sqlparser.DDLStatement,
 		return nil
 	}
 	switch ddlStmt := ddlStmt.(type) {
-	case *sqlparser.CreateTable, *sqlparser.AlterTable:
+	case *sqlparser.CreateTable, *sqlparser.AlterTable, *sqlparser.CreateView, *sqlparser.AlterView:
 		if err := appendOnlineDDL(ddlStmt.GetTable().Name.String(), ddlStmt); err != nil {
 			return nil, err
 		}
-	case *sqlparser.DropTable:
+	case *sqlparser.DropTable, *sqlparser.DropView:
 		tables := ddlStmt.GetFromTables()
 		for _, table := range tables {
 			ddlStmt.SetFromTables([]sqlparser.TableName{table})
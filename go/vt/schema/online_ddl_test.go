@@ -259,11 +259,15 @@ func TestNewOnlineDDLs(t *testing.T) {
 		"select * from t":                               {notDDL: true},
 		"drop database t":                               {notDDL: true},
 		"truncate table t":                              {isError: true},
-		"drop view t":                                   {isError: true},
+		"drop view t":                                   {sqls: []string{"drop view t"}},
+		"drop view if exists v1, v2":                    {sqls: []string{"drop view if exists v1", "drop view if exists v2"}},
+		"create view v as select * from t":              {sqls: []string{"create view v as select * from t"}},
+		"create or replace view v as select id from t":  {sqls: []string{"create or replace view v as select id from t"}},
+		"alter view v as select id from t":              {sqls: []string{"alter view v as select id from t"}},
 		"rename table t to t1":                          {isError: true},
-		"alter table corder add FOREIGN KEY my_fk(customer_id) reference customer(customer_id)":                                                                                      {isError: true, expectErrorText: "syntax error"},
-		"alter table corder add FOREIGN KEY my_fk(customer_id) references customer(customer_id)":                                                                                     {isError: true, expectErrorText: "foreign key constraints are not supported"},
-		"alter table corder rename as something_else":                                                                                                                                {isError: true, expectErrorText: "RENAME is not supported in online DDL"},
+		"alter table corder add FOREIGN KEY my_fk(customer_id) reference customer(customer_id)":  {isError: true, expectErrorText: "syntax error"},
+		"alter table corder add FOREIGN KEY my_fk(customer_id) references customer(customer_id)": {isError: true, expectErrorText: "foreign key constraints are not supported"},
+		"alter table corder rename as something_else":                                            {isError: true, expectErrorText: "RENAME is not supported in online DDL"},
 		"CREATE TABLE if not exists t (id bigint unsigned NOT NULL AUTO_INCREMENT, ts datetime(6) DEFAULT NULL, error_column NO_SUCH_TYPE NOT NULL, PRIMARY KEY (id)) ENGINE=InnoDB": {isError: true, expectErrorText: "near"},
 	}
 	migrationContext := "354b-11eb-82cd-f875a4d24e90"
@@ -308,6 +312,9 @@ func TestOnlineDDLFromCommentedStatement(t *testing.T) {
 		`create table t (id int primary key)`,
 		`alter table t drop primary key`,
 		`drop table if exists t`,
+		`create view t as select id from t1`,
+		`alter view t as select id, name from t1`,
+		`drop view if exists t`,
 		`revert vitess_migration '4e5dcf80_354b_11eb_82cd_f875a4d24e90'`,
 	}
 	strategySetting := NewDDLStrategySetting(DDLStrategyGhost, `-singleton -declarative --max-load="Threads_running=5"`)
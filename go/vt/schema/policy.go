@@ -0,0 +1,546 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// SchemaPolicy is a keyspace's set of rules which DDL statements must satisfy. The policy is stored in the topo,
+// and is enforced by ApplySchema, by vtgate for DDL statements, and by the tablets for online DDL migrations.
+// An empty policy allows any DDL.
+type SchemaPolicy struct {
+	// RequirePrimaryKey rejects CREATE TABLE without a primary key, and ALTER TABLE which drops the primary key
+	RequirePrimaryKey bool `json:"require_primary_key,omitempty"`
+	// ForbiddenColumnTypes lists column types, e.g. "float" or "enum", which new or modified columns may not use
+	ForbiddenColumnTypes []string `json:"forbidden_column_types,omitempty"`
+	// ForbiddenCharsets lists character sets, e.g. "latin1", which tables and columns may not use
+	ForbiddenCharsets []string `json:"forbidden_charsets,omitempty"`
+	// MaxIndexes is the maximal number of indexes, including the primary key, which a table may have after CREATE TABLE,
+	// ALTER TABLE or CREATE INDEX. Zero means no limit.
+	MaxIndexes int `json:"max_indexes,omitempty"`
+	// RequireNotNullDefault requires NOT NULL columns to have a DEFAULT. Primary key, AUTO_INCREMENT and
+	// generated columns, and columns of types which do not support a default, are exempt.
+	RequireNotNullDefault bool `json:"require_not_null_default,omitempty"`
+	// ForbidDropNonEmptyTable rejects DROP TABLE of tables which have any rows
+	ForbidDropNonEmptyTable bool `json:"forbid_drop_non_empty_table,omitempty"`
+	// TableNamePattern, ColumnNamePattern and IndexNamePattern are regular expressions which the names of
+	// new or renamed tables, columns and indexes must match
+	TableNamePattern  string `json:"table_name_pattern,omitempty"`
+	ColumnNamePattern string `json:"column_name_pattern,omitempty"`
+	IndexNamePattern  string `json:"index_name_pattern,omitempty"`
+
+	tableNameRegexp  *regexp.Regexp
+	columnNameRegexp *regexp.Regexp
+	indexNameRegexp  *regexp.Regexp
+}
+
+// TableHasRowsFunc reports whether the given table has any rows. A table that does not exist has no rows.
+type TableHasRowsFunc func(tableName string) (bool, error)
+
+// TableIndexCountFunc returns the number of indexes, including the primary key, of the given table.
+// A table that does not exist has no indexes.
+type TableIndexCountFunc func(tableName string) (int, error)
+
+// policyStatement is a DDL statement evaluated against a schema policy
+type policyStatement struct {
+	stmt            sqlparser.DDLStatement
+	tableHasRows    TableHasRowsFunc
+	tableIndexCount TableIndexCountFunc
+}
+
+// schemaPolicyRule is a single rule of the policy engine. A rule returns the violations it finds in a statement,
+// and returns nothing if it is not configured by the policy.
+type schemaPolicyRule struct {
+	name  string
+	check func(policy *SchemaPolicy, s *policyStatement) []string
+}
+
+// schemaPolicyRules are all the rules that the policy engine evaluates, in order
+var schemaPolicyRules = []*schemaPolicyRule{
+	{name: "require_primary_key", check: checkRequirePrimaryKey},
+	{name: "forbidden_column_types", check: checkForbiddenColumnTypes},
+	{name: "forbidden_charsets", check: checkForbiddenCharsets},
+	{name: "max_indexes", check: checkMaxIndexes},
+	{name: "require_not_null_default", check: checkRequireNotNullDefault},
+	{name: "forbid_drop_non_empty_table", check: checkForbidDropNonEmptyTable},
+	{name: "naming", check: checkNaming},
+}
+
+// typesWithoutDefault are column types which do not support a literal DEFAULT value
+var typesWithoutDefault = map[string]bool{
+	"tinytext":           true,
+	"text":               true,
+	"mediumtext":         true,
+	"longtext":           true,
+	"tinyblob":           true,
+	"blob":               true,
+	"mediumblob":         true,
+	"longblob":           true,
+	"json":               true,
+	"geometry":           true,
+	"point":              true,
+	"linestring":         true,
+	"polygon":            true,
+	"multipoint":         true,
+	"multilinestring":    true,
+	"multipolygon":       true,
+	"geometrycollection": true,
+}
+
+// ParseSchemaPolicy parses and validates a JSON schema policy. Empty data returns an empty policy.
+func ParseSchemaPolicy(data []byte) (*SchemaPolicy, error) {
+	policy := &SchemaPolicy{}
+	if len(data) == 0 {
+		return policy, nil
+	}
+	if err := json.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("invalid schema policy: %v", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// ReadSchemaPolicy reads the schema policy of a keyspace from the topo. A keyspace without a policy gets an empty policy.
+func ReadSchemaPolicy(ctx context.Context, ts *topo.Server, keyspace string) (*SchemaPolicy, error) {
+	data, err := ts.GetSchemaPolicy(ctx, keyspace)
+	if err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return nil, err
+	}
+	return ParseSchemaPolicy(data)
+}
+
+// Validate validates the policy settings, and compiles its naming patterns
+func (policy *SchemaPolicy) Validate() (err error) {
+	if policy.MaxIndexes < 0 {
+		return fmt.Errorf("invalid max_indexes: %d", policy.MaxIndexes)
+	}
+	compile := func(name, pattern string) (*regexp.Regexp, error) {
+		if pattern == "" {
+			return nil, nil
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", name, err)
+		}
+		return re, nil
+	}
+	if policy.tableNameRegexp, err = compile("table_name_pattern", policy.TableNamePattern); err != nil {
+		return err
+	}
+	if policy.columnNameRegexp, err = compile("column_name_pattern", policy.ColumnNamePattern); err != nil {
+		return err
+	}
+	if policy.indexNameRegexp, err = compile("index_name_pattern", policy.IndexNamePattern); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsEmpty returns true when the policy has no rules
+func (policy *SchemaPolicy) IsEmpty() bool {
+	return !policy.RequirePrimaryKey &&
+		len(policy.ForbiddenColumnTypes) == 0 &&
+		len(policy.ForbiddenCharsets) == 0 &&
+		policy.MaxIndexes == 0 &&
+		!policy.RequireNotNullDefault &&
+		!policy.ForbidDropNonEmptyTable &&
+		policy.TableNamePattern == "" &&
+		policy.ColumnNamePattern == "" &&
+		policy.IndexNamePattern == ""
+}
+
+// Violations evaluates all rules of the policy on the given DDL statement, and returns the violations found, each
+// prefixed by the name of the violated rule. If tableHasRows is nil, rules that depend on table data are not evaluated.
+// If tableIndexCount is nil, rules that depend on the existing indexes of a table are not evaluated.
+func (policy *SchemaPolicy) Violations(stmt sqlparser.DDLStatement, tableHasRows TableHasRowsFunc, tableIndexCount TableIndexCountFunc) (violations []string) {
+	if policy == nil || policy.IsEmpty() {
+		return nil
+	}
+	s := &policyStatement{stmt: stmt, tableHasRows: tableHasRows, tableIndexCount: tableIndexCount}
+	for _, rule := range schemaPolicyRules {
+		for _, violation := range rule.check(policy, s) {
+			violations = append(violations, fmt.Sprintf("%s: %s", rule.name, violation))
+		}
+	}
+	return violations
+}
+
+// Check evaluates the policy on the given DDL statement, and returns a FAILED_PRECONDITION error listing all violations
+func (policy *SchemaPolicy) Check(stmt sqlparser.DDLStatement, tableHasRows TableHasRowsFunc, tableIndexCount TableIndexCountFunc) error {
+	violations := policy.Violations(stmt, tableHasRows, tableIndexCount)
+	if len(violations) == 0 {
+		return nil
+	}
+	return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "schema policy violation in %s: %s", sqlparser.String(stmt), strings.Join(violations, "; "))
+}
+
+// CheckSQL parses the given query and evaluates the policy on it, if it is a DDL statement
+func (policy *SchemaPolicy) CheckSQL(sql string, tableHasRows TableHasRowsFunc, tableIndexCount TableIndexCountFunc) error {
+	if policy == nil || policy.IsEmpty() {
+		return nil
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return err
+	}
+	ddlStmt, ok := stmt.(sqlparser.DDLStatement)
+	if !ok {
+		return nil
+	}
+	return policy.Check(ddlStmt, tableHasRows, tableIndexCount)
+}
+
+// CreateTableIndexCount returns the number of indexes, including the primary key, which the given CREATE TABLE
+// statement defines
+func CreateTableIndexCount(createTable string) (int, error) {
+	stmt, err := sqlparser.Parse(createTable)
+	if err != nil {
+		return 0, err
+	}
+	create, ok := stmt.(*sqlparser.CreateTable)
+	if !ok || create.TableSpec == nil {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "not a CREATE TABLE statement: %s", createTable)
+	}
+	return len(create.TableSpec.Indexes) + len(keyColumns(create.TableSpec.Columns)), nil
+}
+
+// keyColumns returns the columns which define an index by a column option, e.g. UNIQUE
+func keyColumns(columns []*sqlparser.ColumnDefinition) (keyColumns []*sqlparser.ColumnDefinition) {
+	for _, column := range columns {
+		if column.Type.Options != nil && column.Type.Options.KeyOpt != sqlparser.ColKeyNone {
+			keyColumns = append(keyColumns, column)
+		}
+	}
+	return keyColumns
+}
+
+// tableSpec returns the definition of a created table, or nil for CREATE TABLE ... LIKE and for other statements
+func (s *policyStatement) tableSpec() *sqlparser.TableSpec {
+	if createTable, ok := s.stmt.(*sqlparser.CreateTable); ok && createTable.OptLike == nil {
+		return createTable.TableSpec
+	}
+	return nil
+}
+
+// alterOptions returns the options of an ALTER TABLE statement
+func (s *policyStatement) alterOptions() []sqlparser.AlterOption {
+	if alterTable, ok := s.stmt.(*sqlparser.AlterTable); ok {
+		return alterTable.AlterOptions
+	}
+	return nil
+}
+
+// columns returns the columns which the statement creates or redefines
+func (s *policyStatement) columns() (columns []*sqlparser.ColumnDefinition) {
+	if spec := s.tableSpec(); spec != nil {
+		columns = append(columns, spec.Columns...)
+	}
+	for _, option := range s.alterOptions() {
+		switch option := option.(type) {
+		case *sqlparser.AddColumns:
+			columns = append(columns, option.Columns...)
+		case *sqlparser.ModifyColumn:
+			columns = append(columns, option.NewColDefinition)
+		case *sqlparser.ChangeColumn:
+			columns = append(columns, option.NewColDefinition)
+		}
+	}
+	return columns
+}
+
+// indexes returns the indexes which the statement creates
+func (s *policyStatement) indexes() (indexes []*sqlparser.IndexDefinition) {
+	if spec := s.tableSpec(); spec != nil {
+		indexes = append(indexes, spec.Indexes...)
+	}
+	for _, option := range s.alterOptions() {
+		if addIndex, ok := option.(*sqlparser.AddIndexDefinition); ok {
+			indexes = append(indexes, addIndex.IndexDefinition)
+		}
+	}
+	return indexes
+}
+
+// droppedIndexes returns the number of indexes which the statement drops
+func (s *policyStatement) droppedIndexes() (count int) {
+	for _, option := range s.alterOptions() {
+		if dropKey, ok := option.(*sqlparser.DropKey); ok && (dropKey.Type == sqlparser.PrimaryKeyType || dropKey.Type == sqlparser.NormalKeyType) {
+			count++
+		}
+	}
+	return count
+}
+
+// primaryKeyColumns returns the names of the primary key columns which the statement defines
+func (s *policyStatement) primaryKeyColumns() map[string]bool {
+	pkColumns := map[string]bool{}
+	for _, column := range s.columns() {
+		if column.Type.Options != nil && column.Type.Options.KeyOpt == sqlparser.ColKeyPrimary {
+			pkColumns[column.Name.Lowered()] = true
+		}
+	}
+	for _, index := range s.indexes() {
+		if index.Info.Primary {
+			for _, column := range index.Columns {
+				pkColumns[column.Column.Lowered()] = true
+			}
+		}
+	}
+	return pkColumns
+}
+
+// tableName returns the name of the table the statement operates on
+func (s *policyStatement) tableName() string {
+	return s.stmt.GetTable().Name.String()
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkRequirePrimaryKey(policy *SchemaPolicy, s *policyStatement) (violations []string) {
+	if !policy.RequirePrimaryKey {
+		return nil
+	}
+	if spec := s.tableSpec(); spec != nil {
+		if len(s.primaryKeyColumns()) == 0 {
+			violations = append(violations, fmt.Sprintf("table %s has no primary key", s.tableName()))
+		}
+		return violations
+	}
+	for _, option := range s.alterOptions() {
+		if dropKey, ok := option.(*sqlparser.DropKey); ok && dropKey.Type == sqlparser.PrimaryKeyType {
+			if len(s.primaryKeyColumns()) == 0 {
+				violations = append(violations, fmt.Sprintf("table %s may not drop its primary key", s.tableName()))
+			}
+		}
+	}
+	return violations
+}
+
+func checkForbiddenColumnTypes(policy *SchemaPolicy, s *policyStatement) (violations []string) {
+	if len(policy.ForbiddenColumnTypes) == 0 {
+		return nil
+	}
+	for _, column := range s.columns() {
+		if containsFold(policy.ForbiddenColumnTypes, column.Type.Type) {
+			violations = append(violations, fmt.Sprintf("column %s.%s uses forbidden type %s", s.tableName(), column.Name.String(), strings.ToLower(column.Type.Type)))
+		}
+	}
+	return violations
+}
+
+// collationCharset returns the character set of the given collation. MySQL names each collation after its
+// character set, e.g. latin1_swedish_ci, except for the binary collation of the binary character set.
+func collationCharset(collation string) string {
+	if i := strings.Index(collation, "_"); i > 0 {
+		return collation[:i]
+	}
+	return collation
+}
+
+// isForbiddenCharset checks whether the given character set is forbidden by the policy, accepting utf8mb3 as
+// the alias of utf8
+func (policy *SchemaPolicy) isForbiddenCharset(charset string) bool {
+	normalize := func(charset string) string {
+		if strings.EqualFold(charset, "utf8mb3") {
+			return "utf8"
+		}
+		return charset
+	}
+	for _, forbidden := range policy.ForbiddenCharsets {
+		if strings.EqualFold(normalize(forbidden), normalize(charset)) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkForbiddenCharsets(policy *SchemaPolicy, s *policyStatement) (violations []string) {
+	if len(policy.ForbiddenCharsets) == 0 {
+		return nil
+	}
+	// checkCharset checks a charset, or the charset implied by a collation, of the table or of one of its columns
+	checkCharset := func(what string, charset string, collation string) {
+		if charset == "" && collation != "" {
+			charset = collationCharset(collation)
+		}
+		if charset != "" && policy.isForbiddenCharset(charset) {
+			violations = append(violations, fmt.Sprintf("%s uses forbidden charset %s", what, strings.ToLower(charset)))
+		}
+	}
+	table := "table " + s.tableName()
+	checkTableOptions := func(options sqlparser.TableOptions) {
+		for _, option := range options {
+			switch {
+			case strings.EqualFold(option.Name, "charset"):
+				checkCharset(table, option.String, "")
+			case strings.EqualFold(option.Name, "collate"):
+				checkCharset(table, "", option.String)
+			}
+		}
+	}
+	if spec := s.tableSpec(); spec != nil {
+		checkTableOptions(spec.Options)
+	}
+	for _, option := range s.alterOptions() {
+		switch option := option.(type) {
+		case sqlparser.TableOptions:
+			checkTableOptions(option)
+		case *sqlparser.AlterCharset:
+			checkCharset(table, option.CharacterSet, option.Collate)
+		}
+	}
+	for _, column := range s.columns() {
+		checkCharset(fmt.Sprintf("column %s.%s", s.tableName(), column.Name.String()), column.Type.Charset, column.Type.Collate)
+	}
+	return violations
+}
+
+func checkMaxIndexes(policy *SchemaPolicy, s *policyStatement) (violations []string) {
+	if policy.MaxIndexes == 0 {
+		return nil
+	}
+	added := len(s.indexes()) + len(keyColumns(s.columns()))
+	count := added
+	if s.tableSpec() == nil {
+		// ALTER TABLE and CREATE INDEX only add to the indexes the table already has. Statements which do not
+		// add an index are accepted, even on a table which is already over the limit.
+		if added == 0 || s.tableIndexCount == nil {
+			return nil
+		}
+		existing, err := s.tableIndexCount(s.tableName())
+		if err != nil {
+			return []string{fmt.Sprintf("unable to count the indexes of table %s: %v", s.tableName(), err)}
+		}
+		count = existing + added - s.droppedIndexes()
+	}
+	if count > policy.MaxIndexes {
+		violations = append(violations, fmt.Sprintf("table %s has %d indexes, more than the maximum of %d", s.tableName(), count, policy.MaxIndexes))
+	}
+	return violations
+}
+
+func checkRequireNotNullDefault(policy *SchemaPolicy, s *policyStatement) (violations []string) {
+	if !policy.RequireNotNullDefault {
+		return nil
+	}
+	pkColumns := s.primaryKeyColumns()
+	for _, column := range s.columns() {
+		options := column.Type.Options
+		if options == nil || options.Null == nil || *options.Null {
+			// nullable column
+			continue
+		}
+		if options.Default != nil || options.Autoincrement || options.As != nil {
+			continue
+		}
+		if pkColumns[column.Name.Lowered()] || typesWithoutDefault[strings.ToLower(column.Type.Type)] {
+			continue
+		}
+		violations = append(violations, fmt.Sprintf("NOT NULL column %s.%s has no default", s.tableName(), column.Name.String()))
+	}
+	return violations
+}
+
+func checkForbidDropNonEmptyTable(policy *SchemaPolicy, s *policyStatement) (violations []string) {
+	if !policy.ForbidDropNonEmptyTable || s.tableHasRows == nil {
+		return nil
+	}
+	dropTable, ok := s.stmt.(*sqlparser.DropTable)
+	if !ok || dropTable.Temp {
+		return nil
+	}
+	for _, table := range dropTable.FromTables {
+		hasRows, err := s.tableHasRows(table.Name.String())
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("unable to verify table %s has no rows: %v", table.Name.String(), err))
+			continue
+		}
+		if hasRows {
+			violations = append(violations, fmt.Sprintf("table %s has rows", table.Name.String()))
+		}
+	}
+	return violations
+}
+
+func checkNaming(policy *SchemaPolicy, s *policyStatement) (violations []string) {
+	checkName := func(kind string, re *regexp.Regexp, name string) {
+		if re != nil && name != "" && !re.MatchString(name) {
+			violations = append(violations, fmt.Sprintf("%s name %s does not match %s", kind, name, re.String()))
+		}
+	}
+	switch stmt := s.stmt.(type) {
+	case *sqlparser.CreateTable:
+		checkName("table", policy.tableNameRegexp, stmt.Table.Name.String())
+	case *sqlparser.RenameTable:
+		for _, pair := range stmt.TablePairs {
+			checkName("table", policy.tableNameRegexp, pair.ToTable.Name.String())
+		}
+	}
+	for _, option := range s.alterOptions() {
+		switch option := option.(type) {
+		case *sqlparser.RenameTableName:
+			checkName("table", policy.tableNameRegexp, option.Table.Name.String())
+		case *sqlparser.RenameIndex:
+			checkName("index", policy.indexNameRegexp, option.NewName.String())
+		}
+	}
+	for _, column := range s.columns() {
+		checkName("column", policy.columnNameRegexp, column.Name.String())
+	}
+	for _, index := range s.indexes() {
+		if !index.Info.Primary {
+			checkName("index", policy.indexNameRegexp, indexName(index))
+		}
+	}
+	for _, column := range keyColumns(s.columns()) {
+		if column.Type.Options.KeyOpt != sqlparser.ColKeyPrimary {
+			// MySQL names the index of a column option after the column
+			checkName("index", policy.indexNameRegexp, column.Name.String())
+		}
+	}
+	return violations
+}
+
+// indexName returns the name of an index. MySQL names an unnamed index after its first column.
+func indexName(index *sqlparser.IndexDefinition) string {
+	if name := index.Info.Name.String(); name != "" {
+		return name
+	}
+	if len(index.Columns) > 0 {
+		return index.Columns[0].Column.String()
+	}
+	return ""
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestParseSchemaPolicy(t *testing.T) {
+	policy, err := ParseSchemaPolicy(nil)
+	require.NoError(t, err)
+	assert.True(t, policy.IsEmpty())
+
+	policy, err = ParseSchemaPolicy([]byte(`{}`))
+	require.NoError(t, err)
+	assert.True(t, policy.IsEmpty())
+
+	policy, err = ParseSchemaPolicy([]byte(`{"require_primary_key": true, "max_indexes": 5, "table_name_pattern": "^[a-z_]+$"}`))
+	require.NoError(t, err)
+	assert.False(t, policy.IsEmpty())
+	assert.True(t, policy.RequirePrimaryKey)
+	assert.Equal(t, 5, policy.MaxIndexes)
+	assert.NotNil(t, policy.tableNameRegexp)
+
+	_, err = ParseSchemaPolicy([]byte(`{"table_name_pattern": "("}`))
+	assert.Error(t, err)
+	_, err = ParseSchemaPolicy([]byte(`{"max_indexes": -1}`))
+	assert.Error(t, err)
+	_, err = ParseSchemaPolicy([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestSchemaPolicyViolations(t *testing.T) {
+	tt := []struct {
+		name       string
+		policy     string
+		sql        string
+		violations []string
+	}{
+		{
+			name:   "empty policy",
+			policy: `{}`,
+			sql:    "create table t (v float)",
+		},
+		{
+			name:   "primary key",
+			policy: `{"require_primary_key": true}`,
+			sql:    "create table t (id int primary key)",
+		},
+		{
+			name:   "primary key index",
+			policy: `{"require_primary_key": true}`,
+			sql:    "create table t (id int, primary key (id))",
+		},
+		{
+			name:       "no primary key",
+			policy:     `{"require_primary_key": true}`,
+			sql:        "create table t (id int, key id_idx (id))",
+			violations: []string{"require_primary_key: table t has no primary key"},
+		},
+		{
+			name:   "create like",
+			policy: `{"require_primary_key": true}`,
+			sql:    "create table t like t2",
+		},
+		{
+			name:       "drop primary key",
+			policy:     `{"require_primary_key": true}`,
+			sql:        "alter table t drop primary key",
+			violations: []string{"require_primary_key: table t may not drop its primary key"},
+		},
+		{
+			name:   "replace primary key",
+			policy: `{"require_primary_key": true}`,
+			sql:    "alter table t drop primary key, add primary key (id, v)",
+		},
+		{
+			name:   "forbidden column types",
+			policy: `{"forbidden_column_types": ["float", "ENUM"]}`,
+			sql:    "alter table t add column f float, modify column e enum('a', 'b'), change column x y double",
+			violations: []string{
+				"forbidden_column_types: column t.f uses forbidden type float",
+				"forbidden_column_types: column t.e uses forbidden type enum",
+			},
+		},
+		{
+			name:   "forbidden charsets",
+			policy: `{"forbidden_charsets": ["latin1"]}`,
+			sql:    "create table t (id int primary key, v varchar(10) character set latin1) default charset=latin1",
+			violations: []string{
+				"forbidden_charsets: table t uses forbidden charset latin1",
+				"forbidden_charsets: column t.v uses forbidden charset latin1",
+			},
+		},
+		{
+			name:       "forbidden charset conversion",
+			policy:     `{"forbidden_charsets": ["latin1"]}`,
+			sql:        "alter table t convert to character set latin1",
+			violations: []string{"forbidden_charsets: table t uses forbidden charset latin1"},
+		},
+		{
+			name:   "forbidden charsets by collation",
+			policy: `{"forbidden_charsets": ["latin1"]}`,
+			sql:    "create table t (id int primary key, v varchar(10) collate latin1_bin) collate=latin1_general_ci",
+			violations: []string{
+				"forbidden_charsets: table t uses forbidden charset latin1",
+				"forbidden_charsets: column t.v uses forbidden charset latin1",
+			},
+		},
+		{
+			name:   "forbidden charset by collation in alter",
+			policy: `{"forbidden_charsets": ["utf8"]}`,
+			sql:    "alter table t collate utf8mb3_general_ci, modify column v varchar(10) collate utf8_bin",
+			violations: []string{
+				"forbidden_charsets: table t uses forbidden charset utf8mb3",
+				"forbidden_charsets: column t.v uses forbidden charset utf8",
+			},
+		},
+		{
+			name:   "allowed charset",
+			policy: `{"forbidden_charsets": ["latin1"]}`,
+			sql:    "alter table t engine=innodb, charset=utf8mb4",
+		},
+		{
+			name:   "max indexes",
+			policy: `{"max_indexes": 3}`,
+			sql:    "create table t (id int primary key, a int, b int, c int unique key, key a_idx (a), key b_idx (b))",
+			violations: []string{
+				"max_indexes: table t has 4 indexes, more than the maximum of 3",
+			},
+		},
+		{
+			name:   "within max indexes",
+			policy: `{"max_indexes": 3}`,
+			sql:    "create table t (id int, a int, primary key (id), key a_idx (a))",
+		},
+		{
+			name:   "not null default",
+			policy: `{"require_not_null_default": true}`,
+			sql:    "create table t (id int not null auto_increment, a int not null, b int not null default 0, c int, d text not null, e int not null, primary key (id))",
+			violations: []string{
+				"require_not_null_default: NOT NULL column t.a has no default",
+				"require_not_null_default: NOT NULL column t.e has no default",
+			},
+		},
+		{
+			name:   "not null default with primary key",
+			policy: `{"require_not_null_default": true}`,
+			sql:    "create table t (id varchar(32) not null, primary key (id))",
+		},
+		{
+			name:       "not null default in alter",
+			policy:     `{"require_not_null_default": true}`,
+			sql:        "alter table t add column a int not null, add column b int not null default 1",
+			violations: []string{"require_not_null_default: NOT NULL column t.a has no default"},
+		},
+		{
+			name:   "naming",
+			policy: `{"table_name_pattern": "^[a-z_]+$", "column_name_pattern": "^[a-z_]+$", "index_name_pattern": "_idx$"}`,
+			sql:    "create table Orders (id int primary key, customerId int, key customer (customerId))",
+			violations: []string{
+				"naming: table name Orders does not match ^[a-z_]+$",
+				"naming: column name customerId does not match ^[a-z_]+$",
+				"naming: index name customer does not match _idx$",
+			},
+		},
+		{
+			name:   "naming of unnamed indexes",
+			policy: `{"index_name_pattern": "_idx$"}`,
+			sql:    "create table t (id int primary key, a int unique, b int, key (b), key b_idx (b))",
+			violations: []string{
+				"naming: index name b does not match _idx$",
+				"naming: index name a does not match _idx$",
+			},
+		},
+		{
+			name:   "naming in alter",
+			policy: `{"table_name_pattern": "^[a-z_]+$", "index_name_pattern": "_idx$"}`,
+			sql:    "alter table t rename to T2, rename index a_idx to a_index",
+			violations: []string{
+				"naming: table name T2 does not match ^[a-z_]+$",
+				"naming: index name a_index does not match _idx$",
+			},
+		},
+		{
+			name:       "naming in rename",
+			policy:     `{"table_name_pattern": "^[a-z_]+$"}`,
+			sql:        "rename table t to t_old, t2 to T",
+			violations: []string{"naming: table name T does not match ^[a-z_]+$"},
+		},
+		{
+			name:   "multiple rules",
+			policy: `{"require_primary_key": true, "forbidden_column_types": ["float"]}`,
+			sql:    "create table t (v float)",
+			violations: []string{
+				"require_primary_key: table t has no primary key",
+				"forbidden_column_types: column t.v uses forbidden type float",
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			policy, err := ParseSchemaPolicy([]byte(tc.policy))
+			require.NoError(t, err)
+			assert.Equal(t, tc.violations, policy.Violations(mustParseDDL(t, tc.sql), nil, nil))
+		})
+	}
+}
+
+func TestSchemaPolicyForbidDropNonEmptyTable(t *testing.T) {
+	policy, err := ParseSchemaPolicy([]byte(`{"forbid_drop_non_empty_table": true}`))
+	require.NoError(t, err)
+
+	tableHasRows := func(tableName string) (bool, error) {
+		switch tableName {
+		case "orders":
+			return true, nil
+		case "unknown":
+			return false, errors.New("unavailable")
+		}
+		return false, nil
+	}
+	assert.NoError(t, policy.CheckSQL("drop table archive", tableHasRows, nil))
+	// table data is not evaluated
+	assert.NoError(t, policy.CheckSQL("drop table orders", nil, nil))
+	assert.Equal(t,
+		[]string{"forbid_drop_non_empty_table: table orders has rows"},
+		policy.Violations(mustParseDDL(t, "drop table archive, orders"), tableHasRows, nil),
+	)
+	assert.Equal(t,
+		[]string{"forbid_drop_non_empty_table: unable to verify table unknown has no rows: unavailable"},
+		policy.Violations(mustParseDDL(t, "drop table unknown"), tableHasRows, nil),
+	)
+	err = policy.CheckSQL("drop table orders", tableHasRows, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "schema policy violation in drop table orders")
+	// not a DDL
+	assert.NoError(t, policy.CheckSQL("select 1 from orders", tableHasRows, nil))
+}
+
+func TestSchemaPolicyMaxIndexesOfExistingTable(t *testing.T) {
+	policy, err := ParseSchemaPolicy([]byte(`{"max_indexes": 3}`))
+	require.NoError(t, err)
+
+	tableIndexCount := func(tableName string) (int, error) {
+		switch tableName {
+		case "t":
+			return 3, nil
+		case "unknown":
+			return 0, errors.New("unavailable")
+		}
+		return 0, nil
+	}
+	tt := []struct {
+		sql        string
+		violations []string
+	}{
+		{
+			sql:        "alter table t add index b_idx (b)",
+			violations: []string{"max_indexes: table t has 4 indexes, more than the maximum of 3"},
+		},
+		{
+			sql:        "create index b_idx on t (b)",
+			violations: []string{"max_indexes: table t has 4 indexes, more than the maximum of 3"},
+		},
+		{
+			sql:        "alter table t add column c int unique",
+			violations: []string{"max_indexes: table t has 4 indexes, more than the maximum of 3"},
+		},
+		{
+			sql: "alter table t drop index a_idx, add index b_idx (b)",
+		},
+		{
+			sql: "alter table t add column c int",
+		},
+		{
+			sql: "alter table t2 add index a_idx (a), add index b_idx (b)",
+		},
+		{
+			sql:        "alter table unknown add index a_idx (a)",
+			violations: []string{"max_indexes: unable to count the indexes of table unknown: unavailable"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.sql, func(t *testing.T) {
+			assert.Equal(t, tc.violations, policy.Violations(mustParseDDL(t, tc.sql), nil, tableIndexCount))
+		})
+	}
+	// existing indexes are not evaluated
+	assert.NoError(t, policy.CheckSQL("alter table t add index b_idx (b)", nil, nil))
+}
+
+func TestCreateTableIndexCount(t *testing.T) {
+	count, err := CreateTableIndexCount("create table t (id int primary key, a int unique, b int, key (b), key b_idx (b))")
+	require.NoError(t, err)
+	assert.Equal(t, 4, count)
+
+	_, err = CreateTableIndexCount("create view v as select 1")
+	assert.Error(t, err)
+}
+
+func mustParseDDL(t *testing.T, sql string) sqlparser.DDLStatement {
+	stmt, err := sqlparser.Parse(sql)
+	require.NoError(t, err)
+	ddlStmt, ok := stmt.(sqlparser.DDLStatement)
+	require.True(t, ok)
+	return ddlStmt
+}
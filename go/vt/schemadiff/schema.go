@@ -0,0 +1,416 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// ErrDuplicateName is returned when a schema declares two entities with the same name.
+var ErrDuplicateName = errors.New("duplicate entity name")
+
+// Schema is a set of tables and views, such as the schema of a keyspace.
+type Schema struct {
+	tables map[string]*sqlparser.CreateTable
+	views  map[string]*sqlparser.CreateView
+}
+
+// NewSchemaFromQueries parses the CREATE TABLE and CREATE VIEW statements
+// of a schema.
+func NewSchemaFromQueries(queries []string) (*Schema, error) {
+	stmts := make([]sqlparser.Statement, 0, len(queries))
+	for _, query := range queries {
+		stmt, err := ParseEntity(query)
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, stmt)
+	}
+	return NewSchemaFromStatements(stmts)
+}
+
+// NewSchemaFromStatements returns the schema made of the given CREATE
+// TABLE and CREATE VIEW statements. Entities are identified by name, and
+// qualifiers are ignored.
+func NewSchemaFromStatements(stmts []sqlparser.Statement) (*Schema, error) {
+	s := &Schema{
+		tables: map[string]*sqlparser.CreateTable{},
+		views:  map[string]*sqlparser.CreateView{},
+	}
+	for _, stmt := range stmts {
+		var name string
+		switch stmt := stmt.(type) {
+		case *sqlparser.CreateTable:
+			name = stmt.Table.Name.String()
+			if s.hasEntity(name) {
+				return nil, fmt.Errorf("%w: %s", ErrDuplicateName, name)
+			}
+			s.tables[name] = stmt
+		case *sqlparser.CreateView:
+			name = stmt.ViewName.Name.String()
+			if s.hasEntity(name) {
+				return nil, fmt.Errorf("%w: %s", ErrDuplicateName, name)
+			}
+			s.views[name] = stmt
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedStatement, sqlparser.String(stmt))
+		}
+	}
+	return s, nil
+}
+
+// Declare returns a copy of the schema where the given CREATE TABLE or
+// CREATE VIEW statement declares its entity, replacing any table or view
+// of the same name. The diff from the schema to the copy is the change
+// that a declarative migration of the statement applies.
+func (s *Schema) Declare(stmt sqlparser.Statement) (*Schema, error) {
+	var name string
+	switch stmt := stmt.(type) {
+	case *sqlparser.CreateTable:
+		name = stmt.Table.Name.String()
+	case *sqlparser.CreateView:
+		name = stmt.ViewName.Name.String()
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedStatement, sqlparser.String(stmt))
+	}
+	declared := &Schema{
+		tables: make(map[string]*sqlparser.CreateTable, len(s.tables)),
+		views:  make(map[string]*sqlparser.CreateView, len(s.views)),
+	}
+	for tableName, table := range s.tables {
+		if tableName != name {
+			declared.tables[tableName] = table
+		}
+	}
+	for viewName, view := range s.views {
+		if viewName != name {
+			declared.views[viewName] = view
+		}
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.CreateTable:
+		declared.tables[name] = stmt
+	case *sqlparser.CreateView:
+		declared.views[name] = stmt
+	}
+	return declared, nil
+}
+
+func (s *Schema) hasEntity(name string) bool {
+	_, isTable := s.tables[name]
+	_, isView := s.views[name]
+	return isTable || isView
+}
+
+// Tables returns the names of the tables of the schema, sorted.
+func (s *Schema) Tables() []string {
+	names := make([]string, 0, len(s.tables))
+	for name := range s.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Views returns the names of the views of the schema, sorted.
+func (s *Schema) Views() []string {
+	names := make([]string, 0, len(s.views))
+	for name := range s.views {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Diff returns the statements that transform the schema into the other
+// schema, in an order in which they can be applied:
+// - views that are dropped go first, dependent views before the views they select from,
+// - then new tables, with foreign key parents before their children,
+// - then altered tables, again parents first,
+// - then tables that are dropped, children first,
+// - and last, new and altered views, after the tables and views they select from.
+// A table that becomes a view, or the other way around, is dropped and
+// created again.
+func (s *Schema) Diff(to *Schema, hints *DiffHints) ([]sqlparser.Statement, error) {
+	var result []sqlparser.Statement
+
+	var droppedViews []string
+	for _, name := range s.Views() {
+		if _, ok := to.views[name]; !ok {
+			droppedViews = append(droppedViews, name)
+		}
+	}
+	droppedViews = sortByDependencies(droppedViews, s.viewDependencies)
+	for i := len(droppedViews) - 1; i >= 0; i-- {
+		view := s.views[droppedViews[i]]
+		result = append(result, &sqlparser.DropView{FromTables: sqlparser.TableNames{view.ViewName}})
+	}
+
+	var createdTables, alteredTables []string
+	for _, name := range to.Tables() {
+		if _, ok := s.tables[name]; ok {
+			alteredTables = append(alteredTables, name)
+		} else {
+			createdTables = append(createdTables, name)
+		}
+	}
+	for _, name := range sortByDependencies(createdTables, to.tableDependencies) {
+		result = append(result, to.tables[name])
+	}
+	for _, name := range sortByDependencies(alteredTables, to.tableDependencies) {
+		alter, err := DiffCreateTables(s.tables[name], to.tables[name], hints)
+		if err != nil {
+			return nil, err
+		}
+		if alter != nil {
+			result = append(result, alter)
+		}
+	}
+
+	var droppedTables []string
+	for _, name := range s.Tables() {
+		if _, ok := to.tables[name]; !ok {
+			droppedTables = append(droppedTables, name)
+		}
+	}
+	droppedTables = sortByDependencies(droppedTables, s.tableDependencies)
+	for i := len(droppedTables) - 1; i >= 0; i-- {
+		table := s.tables[droppedTables[i]]
+		result = append(result, &sqlparser.DropTable{FromTables: sqlparser.TableNames{table.Table}})
+	}
+
+	for _, name := range sortByDependencies(to.Views(), to.viewDependencies) {
+		from, ok := s.views[name]
+		if !ok {
+			result = append(result, to.views[name])
+			continue
+		}
+		alter, err := diffCreateViews(from, to.views[name], s, to)
+		if err != nil {
+			return nil, err
+		}
+		if alter != nil {
+			result = append(result, alter)
+		}
+	}
+	return result, nil
+}
+
+// columns returns the names of the columns of a table or a view, or nil
+// if they are unknown. The columns of a view are known if it lists them,
+// or if its query only projects columns, aliased expressions and the '*'
+// of tables and views whose columns are known.
+func (s *Schema) columns(name string) []string {
+	return s.entityColumns(name, map[string]bool{})
+}
+
+func (s *Schema) entityColumns(name string, visited map[string]bool) []string {
+	if table, ok := s.tables[name]; ok {
+		columns := make([]string, 0, len(table.TableSpec.Columns))
+		for _, column := range table.TableSpec.Columns {
+			columns = append(columns, column.Name.String())
+		}
+		return columns
+	}
+	view, ok := s.views[name]
+	if !ok || visited[name] {
+		return nil
+	}
+	visited[name] = true
+	defer delete(visited, name)
+	columns := make([]string, 0, len(view.Columns))
+	for _, column := range view.Columns {
+		columns = append(columns, column.String())
+	}
+	if len(columns) != 0 {
+		return columns
+	}
+	sel, ok := view.Select.(*sqlparser.Select)
+	if !ok {
+		return nil
+	}
+	for _, expr := range sel.SelectExprs {
+		switch expr := expr.(type) {
+		case *sqlparser.AliasedExpr:
+			col, isCol := expr.Expr.(*sqlparser.ColName)
+			switch {
+			case !expr.As.IsEmpty():
+				columns = append(columns, expr.As.String())
+			case isCol:
+				columns = append(columns, col.Name.String())
+			default:
+				return nil
+			}
+		case *sqlparser.StarExpr:
+			for _, from := range sel.From {
+				for _, tableExpr := range fromTables(from) {
+					tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+					if !ok {
+						return nil
+					}
+					qualifier := tableName.Name
+					if !tableExpr.As.IsEmpty() {
+						qualifier = tableExpr.As
+					}
+					if !expr.TableName.IsEmpty() && expr.TableName.Name.String() != qualifier.String() {
+						continue
+					}
+					tableColumns := s.entityColumns(tableName.Name.String(), visited)
+					if tableColumns == nil {
+						return nil
+					}
+					columns = append(columns, tableColumns...)
+				}
+			}
+		default:
+			return nil
+		}
+	}
+	return columns
+}
+
+// tableDependencies returns the tables that the foreign keys of a table reference.
+func (s *Schema) tableDependencies(name string) []string {
+	var deps []string
+	for _, constraint := range s.tables[name].TableSpec.Constraints {
+		fk, ok := constraint.Details.(*sqlparser.ForeignKeyDefinition)
+		if !ok {
+			continue
+		}
+		deps = append(deps, fk.ReferenceDefinition.ReferencedTable.Name.String())
+	}
+	return deps
+}
+
+// viewDependencies returns the tables and views that a view selects from.
+func (s *Schema) viewDependencies(name string) []string {
+	var deps []string
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if tableExpr, ok := node.(*sqlparser.AliasedTableExpr); ok {
+			if tableName, ok := tableExpr.Expr.(sqlparser.TableName); ok {
+				deps = append(deps, tableName.Name.String())
+			}
+		}
+		return true, nil
+	}, s.views[name].Select)
+	return deps
+}
+
+// DependsOn returns true if a statement of a diff from the schema must be
+// applied after an earlier statement of the diff, because one of them
+// creates, alters or drops an entity that the other operates on, or
+// references through a foreign key or the query of a view. The references
+// of the entities that are dropped are those of the schema.
+func (s *Schema) DependsOn(stmt, earlier sqlparser.Statement) bool {
+	targets, refs := s.statementEntities(stmt)
+	earlierTargets, earlierRefs := s.statementEntities(earlier)
+	for name := range targets {
+		if earlierTargets[name] || earlierRefs[name] {
+			return true
+		}
+	}
+	for name := range refs {
+		if earlierTargets[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// statementEntities returns the entities that a statement of a diff from
+// the schema operates on, and the entities that it references.
+func (s *Schema) statementEntities(stmt sqlparser.Statement) (targets, refs map[string]bool) {
+	targets = map[string]bool{}
+	refs = map[string]bool{}
+	collectRefs := func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ReferenceDefinition:
+			refs[node.ReferencedTable.Name.String()] = true
+		case *sqlparser.AliasedTableExpr:
+			if tableName, ok := node.Expr.(sqlparser.TableName); ok {
+				refs[tableName.Name.String()] = true
+			}
+		}
+		return true, nil
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.CreateTable:
+		targets[stmt.Table.Name.String()] = true
+		_ = sqlparser.Walk(collectRefs, stmt.TableSpec)
+	case *sqlparser.AlterTable:
+		targets[stmt.Table.Name.String()] = true
+		for _, option := range stmt.AlterOptions {
+			_ = sqlparser.Walk(collectRefs, option)
+		}
+	case *sqlparser.DropTable:
+		for _, table := range stmt.FromTables {
+			name := table.Name.String()
+			targets[name] = true
+			if create, ok := s.tables[name]; ok {
+				_ = sqlparser.Walk(collectRefs, create.TableSpec)
+			}
+		}
+	case *sqlparser.CreateView:
+		targets[stmt.ViewName.Name.String()] = true
+		_ = sqlparser.Walk(collectRefs, stmt.Select)
+	case *sqlparser.AlterView:
+		targets[stmt.ViewName.Name.String()] = true
+		_ = sqlparser.Walk(collectRefs, stmt.Select)
+	case *sqlparser.DropView:
+		for _, view := range stmt.FromTables {
+			name := view.Name.String()
+			targets[name] = true
+			if create, ok := s.views[name]; ok {
+				_ = sqlparser.Walk(collectRefs, create.Select)
+			}
+		}
+	}
+	return targets, refs
+}
+
+// sortByDependencies sorts names so that every name comes after the names
+// it depends on. Dependencies that are not in names are ignored, and so
+// are cycles. Independent names keep their order.
+func sortByDependencies(names []string, dependencies func(name string) []string) []string {
+	included := make(map[string]bool, len(names))
+	for _, name := range names {
+		included[name] = true
+	}
+	visited := make(map[string]bool, len(names))
+	sorted := make([]string, 0, len(names))
+	var visit func(name string)
+	visit = func(name string) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+		for _, dep := range dependencies(name) {
+			if included[dep] {
+				visit(dep)
+			}
+		}
+		sorted = append(sorted, name)
+	}
+	for _, name := range names {
+		visit(name)
+	}
+	return sorted
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestSchemaDiff(t *testing.T) {
+	testcases := []struct {
+		name string
+		from []string
+		to   []string
+		diff []string
+	}{{
+		name: "identical",
+		from: []string{"create table t (id int primary key)", "create view v as select id from t"},
+		to:   []string{"create view v as select id from t", "create table t (id int primary key)"},
+	}, {
+		name: "create tables parents first",
+		to: []string{
+			"create table a (id int primary key, b_id int, foreign key (b_id) references b (id))",
+			"create table b (id int primary key, c_id int, foreign key (c_id) references c (id))",
+			"create table c (id int primary key)",
+		},
+		diff: []string{
+			"create table c (\n\tid int primary key\n)",
+			"create table b (\n\tid int primary key,\n\tc_id int,\n\tforeign key (c_id) references c (id)\n)",
+			"create table a (\n\tid int primary key,\n\tb_id int,\n\tforeign key (b_id) references b (id)\n)",
+		},
+	}, {
+		name: "drop tables children first",
+		from: []string{
+			"create table a (id int primary key)",
+			"create table b (id int primary key, a_id int, foreign key (a_id) references a (id))",
+			"create table c (id int primary key)",
+		},
+		to:   []string{"create table c (id int primary key)"},
+		diff: []string{"drop table b", "drop table a"},
+	}, {
+		name: "show create view",
+		from: []string{
+			"create table a (id int primary key, name varchar(10))",
+			"create table b (id int primary key, a_id int, name varchar(10))",
+			"create view v1 as select `a`.`id` AS `id`,`a`.`name` AS `name` from `a`",
+			"create view v2 as select `a`.`id` AS `id`,`a`.`name` AS `name`,`b`.`a_id` AS `a_id` from (`a` join `b` on((`a`.`id` = `b`.`a_id`)))",
+			"create view v3 as select `v1`.`id` AS `id`,`v1`.`name` AS `name` from `v1`",
+		},
+		to: []string{
+			"create table a (id int primary key, name varchar(10))",
+			"create table b (id int primary key, a_id int, name varchar(10))",
+			"create view v1 as select * from a",
+			"create view v2 as select a.*, a_id from a join b on a.id = a_id",
+			"create view v3 as select * from v1",
+		},
+	}, {
+		name: "star expands to new columns",
+		from: []string{
+			"create table a (id int primary key)",
+			"create view v as select `a`.`id` AS `id` from `a`",
+		},
+		to: []string{
+			"create table a (id int primary key, name varchar(10))",
+			"create view v as select * from a",
+		},
+		diff: []string{
+			"alter table a add column `name` varchar(10)",
+			"alter view v as select * from a",
+		},
+	}, {
+		name: "views after tables",
+		from: []string{
+			"create table t (id int primary key)",
+			"create view v1 as select id from t",
+			"create view v2 as select id from v1",
+		},
+		to: []string{
+			"create table t (id int primary key, name varchar(10))",
+			"create table u (id int primary key)",
+			"create view a as select name from v3",
+			"create view v3 as select id, name from t",
+		},
+		diff: []string{
+			"drop view v2",
+			"drop view v1",
+			"create table u (\n\tid int primary key\n)",
+			"alter table t add column `name` varchar(10)",
+			"create view v3 as select id, `name` from t",
+			"create view a as select `name` from v3",
+		},
+	}, {
+		name: "table becomes a view",
+		from: []string{"create table t (id int primary key)", "create table x (id int primary key)"},
+		to:   []string{"create table t (id int primary key)", "create view x as select id from t"},
+		diff: []string{"drop table x", "create view x as select id from t"},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			from, err := NewSchemaFromQueries(tcase.from)
+			require.NoError(t, err)
+			to, err := NewSchemaFromQueries(tcase.to)
+			require.NoError(t, err)
+			diff, err := from.Diff(to, nil)
+			require.NoError(t, err)
+			var result []string
+			for _, stmt := range diff {
+				result = append(result, sqlparser.String(stmt))
+			}
+			assert.Equal(t, tcase.diff, result)
+		})
+	}
+}
+
+func TestSchemaDeclare(t *testing.T) {
+	s, err := NewSchemaFromQueries([]string{
+		"create table t (id int primary key)",
+		"create view v as select id from t",
+	})
+	require.NoError(t, err)
+
+	stmt, err := ParseEntity("create table t (id int primary key, name varchar(10))")
+	require.NoError(t, err)
+	declared, err := s.Declare(stmt)
+	require.NoError(t, err)
+	diff, err := s.Diff(declared, nil)
+	require.NoError(t, err)
+	require.Len(t, diff, 1)
+	assert.Equal(t, "alter table t add column `name` varchar(10)", sqlparser.String(diff[0]))
+
+	stmt, err = ParseEntity("create table v (id int primary key)")
+	require.NoError(t, err)
+	declared, err = s.Declare(stmt)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t", "v"}, declared.Tables())
+	assert.Empty(t, declared.Views())
+	assert.Equal(t, []string{"v"}, s.Views())
+
+	_, err = s.Declare(&sqlparser.DropTable{})
+	assert.ErrorIs(t, err, ErrUnsupportedStatement)
+}
+
+func TestDependsOn(t *testing.T) {
+	s, err := NewSchemaFromQueries([]string{
+		"create table parent (id int primary key)",
+		"create table child (id int primary key, parent_id int, foreign key (parent_id) references parent (id))",
+		"create view v1 as select id from parent",
+		"create view v2 as select id from child",
+	})
+	require.NoError(t, err)
+	testcases := []struct {
+		stmt    string
+		earlier string
+		depends bool
+	}{{
+		stmt:    "create table child (id int primary key, parent_id int, foreign key (parent_id) references parent (id))",
+		earlier: "create table parent (id int primary key)",
+		depends: true,
+	}, {
+		stmt:    "alter table child add constraint fk foreign key (parent_id) references parent (id)",
+		earlier: "alter table parent add column x int",
+		depends: true,
+	}, {
+		stmt:    "create view v as select id from t join u on t.id = u.id",
+		earlier: "alter table u add column x int",
+		depends: true,
+	}, {
+		stmt:    "drop table parent",
+		earlier: "drop table child",
+		depends: true,
+	}, {
+		stmt:    "drop view v2",
+		earlier: "drop view v1",
+	}, {
+		stmt:    "drop table parent",
+		earlier: "create table child (id int primary key, parent_id int, foreign key (parent_id) references parent (id))",
+		depends: true,
+	}, {
+		stmt:    "create view x as select 1 from dual",
+		earlier: "drop table x",
+		depends: true,
+	}, {
+		stmt:    "create table a (id int primary key, p_id int, foreign key (p_id) references p (id))",
+		earlier: "create table b (id int primary key, p_id int, foreign key (p_id) references p (id))",
+	}, {
+		stmt:    "alter table t add column x int",
+		earlier: "create table u (id int primary key)",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.stmt, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tcase.stmt)
+			require.NoError(t, err)
+			earlier, err := sqlparser.Parse(tcase.earlier)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.depends, s.DependsOn(stmt, earlier))
+		})
+	}
+}
+
+func TestNewSchemaErrors(t *testing.T) {
+	_, err := NewSchemaFromQueries([]string{"create table t (id int)", "create view t as select 1 from dual"})
+	assert.ErrorIs(t, err, ErrDuplicateName)
+	_, err = NewSchemaFromQueries([]string{"drop table t"})
+	assert.ErrorIs(t, err, ErrUnsupportedStatement)
+}
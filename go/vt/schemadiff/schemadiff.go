@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schemadiff computes the DDL that transforms a CREATE TABLE or
+// CREATE VIEW statement into another. It works on the sqlparser AST and
+// doesn't need a MySQL server: statements are normalized the way MySQL
+// prints them in SHOW CREATE TABLE, so that a declared schema can be
+// compared with the schema read from a database.
+package schemadiff
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var (
+	// ErrNotFullyParsed is returned for statements that sqlparser can't fully parse.
+	ErrNotFullyParsed = errors.New("statement is not fully parsed")
+	// ErrUnsupportedStatement is returned for statements that are not a CREATE TABLE or a CREATE VIEW.
+	ErrUnsupportedStatement = errors.New("unsupported statement")
+	// ErrEntityTypeMismatch is returned when a table is compared with a view.
+	ErrEntityTypeMismatch = errors.New("cannot compare a table with a view")
+	// ErrNameMismatch is returned when entities with different names are compared.
+	ErrNameMismatch = errors.New("cannot compare entities with different names")
+)
+
+// AutoIncrementStrategy says how AUTO_INCREMENT table options are compared.
+type AutoIncrementStrategy int
+
+const (
+	// AutoIncrementIgnore ignores AUTO_INCREMENT table options, which
+	// change as rows are inserted.
+	AutoIncrementIgnore AutoIncrementStrategy = iota
+	// AutoIncrementApply applies the AUTO_INCREMENT of the target table.
+	AutoIncrementApply
+)
+
+// DiffHints control how statements are compared.
+type DiffHints struct {
+	AutoIncrementStrategy AutoIncrementStrategy
+}
+
+// ParseEntity parses a CREATE TABLE or CREATE VIEW statement.
+func ParseEntity(sql string) (sqlparser.Statement, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.CreateTable:
+		if !stmt.FullyParsed {
+			return nil, fmt.Errorf("%w: %s", ErrNotFullyParsed, sql)
+		}
+		return stmt, nil
+	case *sqlparser.CreateView:
+		return stmt, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedStatement, sql)
+}
+
+// DiffQueries parses the CREATE statements of an entity and returns the
+// statement that transforms the first into the second. An empty query
+// stands for an entity that doesn't exist. See DiffStatements.
+func DiffQueries(from, to string, hints *DiffHints) (sqlparser.Statement, error) {
+	var fromStmt, toStmt sqlparser.Statement
+	var err error
+	if from != "" {
+		if fromStmt, err = ParseEntity(from); err != nil {
+			return nil, err
+		}
+	}
+	if to != "" {
+		if toStmt, err = ParseEntity(to); err != nil {
+			return nil, err
+		}
+	}
+	return DiffStatements(fromStmt, toStmt, hints)
+}
+
+// DiffStatements returns the statement that transforms the CREATE
+// statement of an entity into another, or nil if they are equivalent.
+// A nil statement stands for an entity that doesn't exist: the diff from
+// nil is the target CREATE statement, and the diff to nil is a DROP.
+func DiffStatements(from, to sqlparser.Statement, hints *DiffHints) (sqlparser.Statement, error) {
+	if hints == nil {
+		hints = &DiffHints{}
+	}
+	switch from := from.(type) {
+	case nil:
+		switch to := to.(type) {
+		case nil:
+			return nil, nil
+		case *sqlparser.CreateTable, *sqlparser.CreateView:
+			return to, nil
+		}
+	case *sqlparser.CreateTable:
+		switch to := to.(type) {
+		case nil:
+			return &sqlparser.DropTable{FromTables: sqlparser.TableNames{from.Table}}, nil
+		case *sqlparser.CreateTable:
+			alter, err := DiffCreateTables(from, to, hints)
+			if alter == nil || err != nil {
+				return nil, err
+			}
+			return alter, nil
+		case *sqlparser.CreateView:
+			return nil, ErrEntityTypeMismatch
+		}
+	case *sqlparser.CreateView:
+		switch to := to.(type) {
+		case nil:
+			return &sqlparser.DropView{FromTables: sqlparser.TableNames{from.ViewName}}, nil
+		case *sqlparser.CreateView:
+			alter, err := DiffCreateViews(from, to)
+			if alter == nil || err != nil {
+				return nil, err
+			}
+			return alter, nil
+		case *sqlparser.CreateTable:
+			return nil, ErrEntityTypeMismatch
+		}
+	}
+	return nil, ErrUnsupportedStatement
+}
+
+// copyStatement returns a deep copy of a statement. The statement is
+// formatted and parsed again, which also drops any state that doesn't
+// survive formatting.
+func copyStatement(stmt sqlparser.Statement) (sqlparser.Statement, error) {
+	return sqlparser.Parse(sqlparser.String(stmt))
+}
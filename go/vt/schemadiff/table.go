@@ -0,0 +1,587 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// primaryKeyName is the name of the primary key in the indexes of a table.
+const primaryKeyName = "primary"
+
+// DiffCreateTables returns the ALTER TABLE statement that transforms the
+// table created by from into the table created by to, or nil if they are
+// equivalent.
+//
+// Columns are matched by name, so a renamed column is dropped and added.
+// Textual columns that don't specify a charset are modified when the
+// charset of the table changes, since MySQL doesn't convert them.
+// Table options that to doesn't specify are not changed, since their
+// value depends on the defaults of the server. Changes of the partitions
+// of a table are expressed as ADD PARTITION or DROP PARTITION when they
+// are the only changes, and as a new PARTITION BY clause otherwise.
+func DiffCreateTables(from, to *sqlparser.CreateTable, hints *DiffHints) (*sqlparser.AlterTable, error) {
+	if hints == nil {
+		hints = &DiffHints{}
+	}
+	if !strings.EqualFold(from.Table.Name.String(), to.Table.Name.String()) {
+		return nil, fmt.Errorf("%w: %s, %s", ErrNameMismatch, sqlparser.String(from.Table), sqlparser.String(to.Table))
+	}
+	fromTable, err := newTable(from)
+	if err != nil {
+		return nil, err
+	}
+	toTable, err := newTable(to)
+	if err != nil {
+		return nil, err
+	}
+	return fromTable.diff(toTable, hints), nil
+}
+
+// NormalizeCreateTable returns a copy of the statement in the form in
+// which MySQL prints it: column keys are table indexes, unnamed indexes
+// and constraints get the names MySQL gives them, the implicit index of
+// foreign keys is explicit, and defaults are omitted.
+func NormalizeCreateTable(create *sqlparser.CreateTable) (*sqlparser.CreateTable, error) {
+	t, err := newTable(create)
+	if err != nil {
+		return nil, err
+	}
+	return t.create, nil
+}
+
+// table is a normalized CREATE TABLE statement.
+type table struct {
+	create  *sqlparser.CreateTable
+	charset string
+	collate string
+}
+
+func newTable(create *sqlparser.CreateTable) (*table, error) {
+	if !create.FullyParsed {
+		return nil, fmt.Errorf("%w: %s", ErrNotFullyParsed, sqlparser.String(create))
+	}
+	if create.TableSpec == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedStatement, sqlparser.String(create))
+	}
+	stmt, err := copyStatement(create)
+	if err != nil {
+		return nil, err
+	}
+	t := &table{create: stmt.(*sqlparser.CreateTable)}
+	t.normalize()
+	return t, nil
+}
+
+func (t *table) normalize() {
+	spec := t.create.TableSpec
+	for _, option := range spec.Options {
+		option.Name = strings.ToLower(option.Name)
+		switch option.Name {
+		case "charset":
+			t.charset = strings.ToLower(option.String)
+		case "collate":
+			t.collate = strings.ToLower(option.String)
+		}
+	}
+
+	// Keys that are column attributes are indexes of the table.
+	var columnIndexes []*sqlparser.IndexDefinition
+	for _, col := range spec.Columns {
+		if col.Type.Options == nil {
+			col.Type.Options = &sqlparser.ColumnTypeOptions{}
+		}
+		info := &sqlparser.IndexInfo{}
+		switch col.Type.Options.KeyOpt {
+		case sqlparser.ColKeyNone:
+			continue
+		case sqlparser.ColKeyPrimary:
+			info.Primary = true
+		case sqlparser.ColKeyUnique, sqlparser.ColKeyUniqueKey:
+			info.Unique = true
+		case sqlparser.ColKeySpatialKey:
+			info.Spatial = true
+		case sqlparser.ColKeyFulltextKey:
+			info.Fulltext = true
+		}
+		col.Type.Options.KeyOpt = sqlparser.ColKeyNone
+		columnIndexes = append(columnIndexes, &sqlparser.IndexDefinition{
+			Info:    info,
+			Columns: []*sqlparser.IndexColumn{{Column: col.Name}},
+		})
+	}
+	// MySQL lists the keys of columns before the other indexes.
+	spec.Indexes = append(columnIndexes, spec.Indexes...)
+
+	names := make(map[string]bool)
+	primaryColumns := make(map[string]bool)
+	for _, index := range spec.Indexes {
+		normalizeIndex(index)
+		if index.Info.Primary {
+			for _, col := range index.Columns {
+				primaryColumns[col.Column.Lowered()] = true
+			}
+			continue
+		}
+		if !index.Info.Name.IsEmpty() {
+			names[index.Info.Name.Lowered()] = true
+		}
+	}
+	for _, index := range spec.Indexes {
+		if !index.Info.Primary && index.Info.Name.IsEmpty() {
+			index.Info.Name = sqlparser.NewColIdent(uniqueName(names, index.Columns[0].Column.String()))
+		}
+	}
+
+	for _, col := range spec.Columns {
+		t.normalizeColumnType(&col.Type, primaryColumns[col.Name.Lowered()])
+	}
+
+	var foreignKeys, checks int
+	for _, constraint := range spec.Constraints {
+		switch details := constraint.Details.(type) {
+		case *sqlparser.ForeignKeyDefinition:
+			foreignKeys++
+			t.normalizeForeignKey(constraint, details, names, foreignKeys)
+		case *sqlparser.CheckConstraintDefinition:
+			checks++
+			if constraint.Name.IsEmpty() {
+				constraint.Name = sqlparser.NewColIdent(fmt.Sprintf("%s_chk_%d", t.create.Table.Name.String(), checks))
+			}
+		}
+	}
+}
+
+func (t *table) normalizeColumnType(ct *sqlparser.ColumnType, primary bool) {
+	ct.Type = strings.ToLower(ct.Type)
+	switch ct.Type {
+	case "integer":
+		ct.Type = "int"
+	case "bool", "boolean":
+		ct.Type = "tinyint"
+		ct.Length = sqlparser.NewIntLiteral("1")
+	case "dec", "numeric", "fixed":
+		ct.Type = "decimal"
+	case "real":
+		ct.Type = "double"
+	}
+	switch ct.Type {
+	case "tinyint", "smallint", "mediumint", "int", "bigint":
+		// Display widths are deprecated, and MySQL 8.0 only prints
+		// them for zerofill columns and for booleans.
+		if ct.Length != nil && !ct.Zerofill && !(ct.Type == "tinyint" && ct.Length.Val == "1") {
+			ct.Length = nil
+		}
+	case "decimal":
+		if ct.Length == nil {
+			ct.Length = sqlparser.NewIntLiteral("10")
+		}
+		if ct.Scale == nil {
+			ct.Scale = sqlparser.NewIntLiteral("0")
+		}
+	}
+
+	ct.Charset = strings.ToLower(ct.Charset)
+	ct.Collate = strings.ToLower(ct.Collate)
+	if ct.Charset == t.charset {
+		ct.Charset = ""
+	}
+	if ct.Collate == t.collate {
+		ct.Collate = ""
+	}
+
+	options := ct.Options
+	if options.Null != nil && *options.Null {
+		options.Null = nil
+	}
+	if primary {
+		notNull := false
+		options.Null = &notNull
+	}
+	switch def := options.Default.(type) {
+	case *sqlparser.NullVal:
+		if options.Null == nil {
+			options.Default = nil
+		}
+	case *sqlparser.Literal:
+		// MySQL prints the literal defaults of all types as strings.
+		if def.Type == sqlparser.IntVal || def.Type == sqlparser.FloatVal {
+			options.Default = sqlparser.NewStrLiteral(def.Val)
+		}
+	case sqlparser.BoolVal:
+		if def {
+			options.Default = sqlparser.NewStrLiteral("1")
+		} else {
+			options.Default = sqlparser.NewStrLiteral("0")
+		}
+	}
+	// MySQL ignores the REFERENCES attribute of columns.
+	options.Reference = nil
+}
+
+func (t *table) normalizeForeignKey(constraint *sqlparser.ConstraintDefinition, fk *sqlparser.ForeignKeyDefinition, names map[string]bool, n int) {
+	named := !constraint.Name.IsEmpty()
+	if !named {
+		constraint.Name = sqlparser.NewColIdent(fmt.Sprintf("%s_ibfk_%d", t.create.Table.Name.String(), n))
+	}
+	ref := fk.ReferenceDefinition
+	for _, action := range []*sqlparser.ReferenceAction{&ref.OnDelete, &ref.OnUpdate} {
+		if *action == sqlparser.Restrict || *action == sqlparser.NoAction {
+			*action = sqlparser.DefaultAction
+		}
+	}
+
+	// MySQL creates an index for the foreign key if no index starts with its columns.
+	indexName := fk.IndexName
+	fk.IndexName = sqlparser.NewColIdent("")
+	for _, index := range t.create.TableSpec.Indexes {
+		if len(index.Columns) < len(fk.Source) {
+			continue
+		}
+		covers := true
+		for i, col := range fk.Source {
+			covers = covers && index.Columns[i].Column.Equal(col)
+		}
+		if covers {
+			return
+		}
+	}
+	name := indexName.String()
+	if name == "" && named {
+		name = constraint.Name.String()
+	}
+	if name == "" {
+		name = fk.Source[0].String()
+	}
+	index := &sqlparser.IndexDefinition{Info: &sqlparser.IndexInfo{Type: "key", Name: sqlparser.NewColIdent(uniqueName(names, name))}}
+	for _, col := range fk.Source {
+		index.Columns = append(index.Columns, &sqlparser.IndexColumn{Column: col})
+	}
+	t.create.TableSpec.Indexes = append(t.create.TableSpec.Indexes, index)
+}
+
+func normalizeIndex(index *sqlparser.IndexDefinition) {
+	info := index.Info
+	if info.Name.IsEmpty() && !info.ConstraintName.IsEmpty() {
+		info.Name = info.ConstraintName
+	}
+	info.ConstraintName = sqlparser.NewColIdent("")
+	switch {
+	case info.Primary:
+		info.Type = "primary key"
+		info.Name = sqlparser.NewColIdent("")
+	case info.Unique:
+		info.Type = "unique key"
+	case info.Fulltext:
+		info.Type = "fulltext key"
+	case info.Spatial:
+		info.Type = "spatial key"
+	default:
+		info.Type = "key"
+	}
+	for _, option := range index.Options {
+		option.Name = strings.ToLower(option.Name)
+	}
+}
+
+// uniqueName returns name, or name suffixed with _2, _3... like MySQL
+// does if an index named name exists, and adds it to names.
+func uniqueName(names map[string]bool, name string) string {
+	unique := name
+	for i := 2; names[strings.ToLower(unique)]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[strings.ToLower(unique)] = true
+	return unique
+}
+
+func indexKey(index *sqlparser.IndexDefinition) string {
+	if index.Info.Primary {
+		return primaryKeyName
+	}
+	return index.Info.Name.Lowered()
+}
+
+func (t *table) columns() map[string]*sqlparser.ColumnDefinition {
+	columns := make(map[string]*sqlparser.ColumnDefinition)
+	for _, col := range t.create.TableSpec.Columns {
+		columns[col.Name.Lowered()] = col
+	}
+	return columns
+}
+
+func (t *table) indexes() map[string]*sqlparser.IndexDefinition {
+	indexes := make(map[string]*sqlparser.IndexDefinition)
+	for _, index := range t.create.TableSpec.Indexes {
+		indexes[indexKey(index)] = index
+	}
+	return indexes
+}
+
+func (t *table) constraints() map[string]*sqlparser.ConstraintDefinition {
+	constraints := make(map[string]*sqlparser.ConstraintDefinition)
+	for _, constraint := range t.create.TableSpec.Constraints {
+		constraints[constraint.Name.Lowered()] = constraint
+	}
+	return constraints
+}
+
+func (t *table) diff(to *table, hints *DiffHints) *sqlparser.AlterTable {
+	var drops, changes, adds []sqlparser.AlterOption
+
+	// Constraints and indexes that change are dropped and added.
+	toConstraints := to.constraints()
+	for _, constraint := range t.create.TableSpec.Constraints {
+		toConstraint, ok := toConstraints[constraint.Name.Lowered()]
+		if ok && strings.EqualFold(sqlparser.String(constraint), sqlparser.String(toConstraint)) {
+			continue
+		}
+		drop := &sqlparser.DropKey{Type: sqlparser.CheckKeyType, Name: constraint.Name}
+		if _, ok := constraint.Details.(*sqlparser.ForeignKeyDefinition); ok {
+			drop.Type = sqlparser.ForeignKeyType
+		}
+		drops = append(drops, drop)
+	}
+	fromConstraints := t.constraints()
+	for _, constraint := range to.create.TableSpec.Constraints {
+		fromConstraint, ok := fromConstraints[constraint.Name.Lowered()]
+		if ok && strings.EqualFold(sqlparser.String(constraint), sqlparser.String(fromConstraint)) {
+			continue
+		}
+		adds = append(adds, &sqlparser.AddConstraintDefinition{ConstraintDefinition: constraint})
+	}
+
+	toIndexes := to.indexes()
+	for _, index := range t.create.TableSpec.Indexes {
+		toIndex, ok := toIndexes[indexKey(index)]
+		if ok && strings.EqualFold(sqlparser.String(index), sqlparser.String(toIndex)) {
+			continue
+		}
+		if index.Info.Primary {
+			drops = append(drops, &sqlparser.DropKey{Type: sqlparser.PrimaryKeyType})
+		} else {
+			drops = append(drops, &sqlparser.DropKey{Type: sqlparser.NormalKeyType, Name: index.Info.Name})
+		}
+	}
+	fromIndexes := t.indexes()
+	var addIndexes []sqlparser.AlterOption
+	for _, index := range to.create.TableSpec.Indexes {
+		fromIndex, ok := fromIndexes[indexKey(index)]
+		if ok && strings.EqualFold(sqlparser.String(index), sqlparser.String(fromIndex)) {
+			continue
+		}
+		addIndexes = append(addIndexes, &sqlparser.AddIndexDefinition{IndexDefinition: index})
+	}
+	adds = append(addIndexes, adds...)
+
+	drops = append(drops, t.diffColumnDrops(to)...)
+	changes = append(changes, t.diffColumns(to)...)
+	if options := t.diffOptions(to, hints); len(options) != 0 {
+		adds = append(adds, options)
+	}
+
+	alter := &sqlparser.AlterTable{
+		Table:        t.create.Table,
+		AlterOptions: append(append(drops, changes...), adds...),
+	}
+	alter.PartitionSpec, alter.PartitionOption = t.diffPartitions(to, len(alter.AlterOptions) == 0)
+	if len(alter.AlterOptions) == 0 && alter.PartitionSpec == nil && alter.PartitionOption == nil {
+		return nil
+	}
+	return alter
+}
+
+func (t *table) diffColumnDrops(to *table) (drops []sqlparser.AlterOption) {
+	toColumns := to.columns()
+	for _, col := range t.create.TableSpec.Columns {
+		if _, ok := toColumns[col.Name.Lowered()]; !ok {
+			drops = append(drops, &sqlparser.DropColumn{Name: &sqlparser.ColName{Name: col.Name}})
+		}
+	}
+	return drops
+}
+
+// diffColumns returns the columns to add and modify, with the positions
+// that give the columns of the table the order of the columns of to.
+func (t *table) diffColumns(to *table) (changes []sqlparser.AlterOption) {
+	fromColumns := t.columns()
+	toColumns := to.columns()
+	// order is the order of the columns as the changes are applied.
+	var order []string
+	for _, col := range t.create.TableSpec.Columns {
+		if _, ok := toColumns[col.Name.Lowered()]; ok {
+			order = append(order, col.Name.Lowered())
+		}
+	}
+	position := func(i int) (first, after *sqlparser.ColName) {
+		if i == 0 {
+			return &sqlparser.ColName{}, nil
+		}
+		return nil, &sqlparser.ColName{Name: to.create.TableSpec.Columns[i-1].Name}
+	}
+
+	cols := to.create.TableSpec.Columns
+	for i, col := range cols {
+		name := col.Name.Lowered()
+		fromCol, ok := fromColumns[name]
+		if !ok {
+			add := &sqlparser.AddColumns{Columns: []*sqlparser.ColumnDefinition{col}}
+			if i < len(order) {
+				add.First, add.After = position(i)
+			}
+			order = append(order[:i], append([]string{name}, order[i:]...)...)
+			changes = append(changes, add)
+			continue
+		}
+		moved := order[i] != name
+		if moved {
+			for j := i + 1; j < len(order); j++ {
+				if order[j] == name {
+					order = append(order[:j], order[j+1:]...)
+					break
+				}
+			}
+			order = append(order[:i], append([]string{name}, order[i:]...)...)
+		}
+		// The charset of a column that doesn't specify one is the
+		// charset of its table, which may change with the table.
+		fromType, toType := t.resolvedColumnType(fromCol.Type), to.resolvedColumnType(col.Type)
+		recharset := sqlparser.String(&fromType) != sqlparser.String(&toType)
+		if !moved && !recharset {
+			continue
+		}
+		modify := &sqlparser.ModifyColumn{NewColDefinition: col}
+		// The charset is explicit if the table's changes, so that it
+		// doesn't depend on the order in which MySQL applies the changes.
+		if recharset && (t.charset != to.charset || t.collate != to.collate) {
+			resolved := *col
+			resolved.Type = toType
+			modify.NewColDefinition = &resolved
+		}
+		if moved {
+			modify.First, modify.After = position(i)
+		}
+		changes = append(changes, modify)
+	}
+	return changes
+}
+
+// resolvedColumnType returns the type of a column of the table with the
+// charset and collation of the table if it's textual and doesn't specify them.
+func (t *table) resolvedColumnType(ct sqlparser.ColumnType) sqlparser.ColumnType {
+	sqlType := ct.SQLType()
+	if !sqltypes.IsText(sqlType) && sqlType != sqltypes.Enum && sqlType != sqltypes.Set {
+		return ct
+	}
+	if ct.Charset == "" && ct.Collate == "" {
+		ct.Charset = t.charset
+	}
+	if ct.Collate == "" && ct.Charset == t.charset {
+		ct.Collate = t.collate
+	}
+	return ct
+}
+
+func (t *table) diffOptions(to *table, hints *DiffHints) sqlparser.TableOptions {
+	fromOptions := make(map[string]*sqlparser.TableOption)
+	for _, option := range t.create.TableSpec.Options {
+		fromOptions[option.Name] = option
+	}
+	var options sqlparser.TableOptions
+	for _, option := range to.create.TableSpec.Options {
+		if option.Name == "auto_increment" && hints.AutoIncrementStrategy == AutoIncrementIgnore {
+			continue
+		}
+		if fromOption, ok := fromOptions[option.Name]; ok && optionValue(fromOption) == optionValue(option) {
+			continue
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+// optionValue returns the value of a table option in a form that can be
+// compared: keywords like engine names are case insensitive, strings aren't.
+func optionValue(option *sqlparser.TableOption) string {
+	switch {
+	case option.String != "":
+		return strings.ToLower(option.String)
+	case option.Value != nil:
+		return sqlparser.String(option.Value)
+	}
+	return strings.ToLower(sqlparser.String(option.Tables))
+}
+
+// diffPartitions returns the partition operation or the partitioning that
+// transforms the partitions of the table into the partitions of to. A
+// partition operation is only possible if alone is true, since MySQL
+// doesn't allow other changes with partition operations.
+func (t *table) diffPartitions(to *table, alone bool) (*sqlparser.PartitionSpec, *sqlparser.PartitionOption) {
+	from, target := t.create.TableSpec.PartitionOption, to.create.TableSpec.PartitionOption
+	switch {
+	case from == nil && target == nil:
+		return nil, nil
+	case target == nil:
+		return &sqlparser.PartitionSpec{Action: sqlparser.RemoveAction}, nil
+	case from == nil:
+		return nil, target
+	}
+	if strings.EqualFold(sqlparser.String(from), sqlparser.String(target)) {
+		return nil, nil
+	}
+	method := func(option *sqlparser.PartitionOption) string {
+		withoutDefinitions := *option
+		withoutDefinitions.Definitions = nil
+		return strings.ToLower(sqlparser.String(&withoutDefinitions))
+	}
+	if !alone || target.Type != sqlparser.RangeType || method(from) != method(target) {
+		return nil, target
+	}
+
+	// The range partitions of to are the partitions of the table that
+	// aren't dropped, followed by the added partitions.
+	toDefinitions := make(map[string]*sqlparser.PartitionDefinition)
+	for _, definition := range target.Definitions {
+		toDefinitions[definition.Name.Lowered()] = definition
+	}
+	var dropped sqlparser.Partitions
+	kept := 0
+	for _, definition := range from.Definitions {
+		toDefinition, ok := toDefinitions[definition.Name.Lowered()]
+		if !ok {
+			dropped = append(dropped, definition.Name)
+			continue
+		}
+		if kept >= len(target.Definitions) || !target.Definitions[kept].Name.Equal(definition.Name) ||
+			!strings.EqualFold(sqlparser.String(definition), sqlparser.String(toDefinition)) {
+			return nil, target
+		}
+		kept++
+	}
+	added := target.Definitions[kept:]
+	switch {
+	case len(dropped) != 0 && len(added) == 0:
+		return &sqlparser.PartitionSpec{Action: sqlparser.DropAction, Names: dropped}, nil
+	case len(dropped) == 0 && len(added) == 1:
+		return &sqlparser.PartitionSpec{Action: sqlparser.AddAction, Definitions: added}, nil
+	}
+	return nil, target
+}
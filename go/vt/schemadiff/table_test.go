@@ -0,0 +1,215 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestDiffCreateTables(t *testing.T) {
+	testcases := []struct {
+		name  string
+		from  string
+		to    string
+		diff  string
+		hints *DiffHints
+	}{{
+		name: "identical",
+		from: "create table t (id int primary key, name varchar(10))",
+		to:   "create table t (id int primary key, name varchar(10))",
+	}, {
+		name: "show create table",
+		from: "CREATE TABLE `t` (\n" +
+			"  `id` int(11) NOT NULL AUTO_INCREMENT,\n" +
+			"  `name` varchar(10) DEFAULT NULL,\n" +
+			"  `n` int(11) NOT NULL DEFAULT '0',\n" +
+			"  `price` decimal(10,0) DEFAULT NULL,\n" +
+			"  `parent_id` int(11) DEFAULT NULL,\n" +
+			"  PRIMARY KEY (`id`),\n" +
+			"  UNIQUE KEY `name` (`name`),\n" +
+			"  KEY `t_ibfk_1` (`parent_id`),\n" +
+			"  CONSTRAINT `t_ibfk_1` FOREIGN KEY (`parent_id`) REFERENCES `p` (`id`),\n" +
+			"  CONSTRAINT `t_chk_1` CHECK ((`n` >= 0))\n" +
+			") ENGINE=InnoDB AUTO_INCREMENT=10 DEFAULT CHARSET=utf8mb4",
+		to: "create table t (" +
+			"id integer not null auto_increment primary key, " +
+			"name varchar(10) null unique, " +
+			"n int not null default 0, " +
+			"price numeric, " +
+			"parent_id int, " +
+			"constraint t_ibfk_1 foreign key (parent_id) references p (id) on delete restrict, " +
+			"check (n >= 0)" +
+			") engine=innodb charset=utf8mb4",
+	}, {
+		name: "add columns",
+		from: "create table t (id int primary key, a int)",
+		to:   "create table t (x int, id int primary key, y int, a int, z int, w int)",
+		diff: "alter table t add column x int first, add column y int after id, add column z int, add column w int",
+	}, {
+		name: "drop and modify columns",
+		from: "create table t (id int primary key, a int, b varchar(10), c int)",
+		to:   "create table t (id int primary key, a bigint, c int)",
+		diff: "alter table t drop column b, modify column a bigint",
+	}, {
+		name: "reorder columns",
+		from: "create table t (id int primary key, a int, b int, c int)",
+		to:   "create table t (id int primary key, c int, a int, b int not null)",
+		diff: "alter table t modify column c int after id, modify column b int not null",
+	}, {
+		name: "move column first",
+		from: "create table t (a int, b int)",
+		to:   "create table t (b int, a int)",
+		diff: "alter table t modify column b int first",
+	}, {
+		name: "column charset",
+		from: "create table t (a varchar(10) charset latin1, b varchar(10)) charset utf8mb4",
+		to:   "create table t (a varchar(10), b varchar(10) character set utf8mb4) charset utf8mb4",
+		diff: "alter table t modify column a varchar(10)",
+	}, {
+		name: "table charset",
+		from: "create table t (id int primary key, a varchar(10), b text charset latin1, c enum('x', 'y')) charset latin1",
+		to:   "create table t (id int primary key, a varchar(10), b text charset latin1, c enum('x', 'y')) charset utf8mb4",
+		diff: "alter table t modify column a varchar(10) character set utf8mb4, modify column c enum('x', 'y') character set utf8mb4, charset utf8mb4",
+	}, {
+		name: "table collation",
+		from: "create table t (a varchar(10)) charset utf8mb4 collate utf8mb4_general_ci",
+		to:   "create table t (a varchar(10) collate utf8mb4_bin) charset utf8mb4 collate utf8mb4_general_ci",
+		diff: "alter table t modify column a varchar(10) collate utf8mb4_bin",
+	}, {
+		name: "indexes",
+		from: "create table t (id int, a int, b int, primary key (id), key a_idx (a), key b_idx (b))",
+		to:   "create table t (id int, a int, b int, primary key (id, a), key a_idx (a), key b_idx (b, a), unique key (a, b))",
+		diff: "alter table t drop primary key, drop key b_idx, modify column a int not null, add primary key (id, a), add key b_idx (b, a), add unique key a (a, b)",
+	}, {
+		name: "unnamed indexes",
+		from: "create table t (a int, b int, key a (a), key a_2 (a, b))",
+		to:   "create table t (a int, b int, key (a), key (a, b))",
+	}, {
+		name: "foreign keys",
+		from: "create table t (id int primary key, p int, constraint fk foreign key (p) references parent (id))",
+		to:   "create table t (id int primary key, p int, q int, constraint fk foreign key (p) references parent (id) on delete cascade, foreign key (q) references other (id))",
+		diff: "alter table t drop foreign key fk, add column q int, add key q (q), add constraint fk foreign key (p) references parent (id) on delete cascade, add constraint t_ibfk_2 foreign key (q) references other (id)",
+	}, {
+		name: "checks",
+		from: "create table t (id int, constraint positive check (id > 0))",
+		to:   "create table t (id int, constraint positive check (id > 1))",
+		diff: "alter table t drop check positive, add constraint positive check (id > 1)",
+	}, {
+		name: "table options",
+		from: "create table t (id int) engine=InnoDB auto_increment=5 comment='a'",
+		to:   "create table t (id int) engine=innodb auto_increment=7 comment='b' row_format=compressed",
+		diff: "alter table t comment 'b' row_format compressed",
+	}, {
+		name:  "auto increment",
+		from:  "create table t (id int) auto_increment=5",
+		to:    "create table t (id int) auto_increment=7",
+		diff:  "alter table t auto_increment 7",
+		hints: &DiffHints{AutoIncrementStrategy: AutoIncrementApply},
+	}, {
+		name: "add range partition",
+		from: "create table t (id int) partition by range (id) (partition p0 values less than (10), partition p1 values less than (20))",
+		to:   "create table t (id int) partition by range (id) (partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than (30))",
+		diff: "alter table t add partition (partition p2 values less than (30))",
+	}, {
+		name: "drop range partitions",
+		from: "create table t (id int) partition by range (id) (partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than (30))",
+		to:   "create table t (id int) partition by range (id) (partition p2 values less than (30))",
+		diff: "alter table t drop partition p0, p1",
+	}, {
+		name: "rotate range partitions",
+		from: "create table t (id int) partition by range (id) (partition p0 values less than (10), partition p1 values less than (20))",
+		to:   "create table t (id int) partition by range (id) (partition p1 values less than (20), partition p2 values less than (30))",
+		diff: "alter table t partition by range (id)\n(partition p1 values less than (20),\n partition p2 values less than (30))",
+	}, {
+		name: "partitions with other changes",
+		from: "create table t (id int) partition by range (id) (partition p0 values less than (10))",
+		to:   "create table t (id int, a int) partition by range (id) (partition p0 values less than (10), partition p1 values less than (20))",
+		diff: "alter table t add column a int partition by range (id)\n(partition p0 values less than (10),\n partition p1 values less than (20))",
+	}, {
+		name: "partition",
+		from: "create table t (id int)",
+		to:   "create table t (id int) partition by hash (id) partitions 4",
+		diff: "alter table t partition by hash (id) partitions 4",
+	}, {
+		name: "remove partitioning",
+		from: "create table t (id int) partition by key (id) partitions 4",
+		to:   "create table t (id int)",
+		diff: "alter table t remove partitioning",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			from, err := sqlparser.Parse(tcase.from)
+			require.NoError(t, err)
+			to, err := sqlparser.Parse(tcase.to)
+			require.NoError(t, err)
+			alter, err := DiffCreateTables(from.(*sqlparser.CreateTable), to.(*sqlparser.CreateTable), tcase.hints)
+			require.NoError(t, err)
+			if tcase.diff == "" {
+				if alter != nil {
+					assert.Fail(t, "unexpected diff", sqlparser.String(alter))
+				}
+				return
+			}
+			require.NotNil(t, alter)
+			assert.Equal(t, tcase.diff, sqlparser.String(alter))
+
+			// The diff must be valid SQL.
+			_, err = sqlparser.Parse(sqlparser.String(alter))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNormalizeCreateTable(t *testing.T) {
+	stmt, err := sqlparser.Parse("create table t (id int(11) primary key, a bool default true, b int references p (id), c int, foreign key (c) references p (id), key (a), unique (a))")
+	require.NoError(t, err)
+	create := stmt.(*sqlparser.CreateTable)
+	normalized, err := NormalizeCreateTable(create)
+	require.NoError(t, err)
+	assert.Equal(t, "create table t (\n"+
+		"\tid int not null,\n"+
+		"\ta tinyint(1) default '1',\n"+
+		"\tb int,\n"+
+		"\tc int,\n"+
+		"\tprimary key (id),\n"+
+		"\tkey a (a),\n"+
+		"\tunique key a_2 (a),\n"+
+		"\tkey c (c),\n"+
+		"\tconstraint t_ibfk_1 foreign key (c) references p (id)\n"+
+		")", sqlparser.String(normalized))
+	// The statement is not modified.
+	assert.Contains(t, sqlparser.String(create), "int(11) primary key")
+}
+
+func TestDiffCreateTablesErrors(t *testing.T) {
+	parse := func(sql string) *sqlparser.CreateTable {
+		stmt, err := sqlparser.Parse(sql)
+		require.NoError(t, err)
+		return stmt.(*sqlparser.CreateTable)
+	}
+	_, err := DiffCreateTables(parse("create table t (id int)"), parse("create table u (id int)"), nil)
+	assert.ErrorIs(t, err, ErrNameMismatch)
+	_, err = DiffCreateTables(parse("create table t (id int)"), parse("create table t like u"), nil)
+	assert.ErrorIs(t, err, ErrUnsupportedStatement)
+	_, err = DiffCreateTables(parse("create table t (id int)"), parse("create table t (id int) partition by list (id) (partition p0 values in (1))"), nil)
+	assert.ErrorIs(t, err, ErrNotFullyParsed)
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// DiffCreateViews returns the ALTER VIEW statement that transforms the
+// view created by from into the view created by to, or nil if they are
+// equivalent. Definers are not compared, since they depend on the account
+// that creates the view.
+func DiffCreateViews(from, to *sqlparser.CreateView) (*sqlparser.AlterView, error) {
+	return diffCreateViews(from, to, nil, nil)
+}
+
+// diffCreateViews is DiffCreateViews for views that belong to schemas,
+// which tell the columns of the tables the views select from. Views that
+// are created by the same statement are equivalent, even if the columns
+// of their tables differ: MySQL expands the '*' of a view when it creates
+// the view, and doesn't change it with the tables.
+func diffCreateViews(from, to *sqlparser.CreateView, fromSchema, toSchema *Schema) (*sqlparser.AlterView, error) {
+	if !strings.EqualFold(from.ViewName.Name.String(), to.ViewName.Name.String()) {
+		return nil, fmt.Errorf("%w: %s, %s", ErrNameMismatch, sqlparser.String(from.ViewName), sqlparser.String(to.ViewName))
+	}
+	if sqlparser.String(from) == sqlparser.String(to) {
+		return nil, nil
+	}
+	normalizedFrom, err := normalizeCreateView(from, fromSchema)
+	if err != nil {
+		return nil, err
+	}
+	normalizedTo, err := normalizeCreateView(to, toSchema)
+	if err != nil {
+		return nil, err
+	}
+	if sqlparser.String(normalizedFrom) == sqlparser.String(normalizedTo) {
+		return nil, nil
+	}
+	return &sqlparser.AlterView{
+		ViewName:    from.ViewName,
+		Algorithm:   to.Algorithm,
+		Definer:     to.Definer,
+		Security:    to.Security,
+		Columns:     to.Columns,
+		Select:      to.Select,
+		CheckOption: to.CheckOption,
+	}, nil
+}
+
+// NormalizeCreateView returns a copy of the statement without its definer
+// and default options, which undoes the rewrite of the query that MySQL
+// prints in SHOW CREATE VIEW:
+// - parenthesized joins and comma separated tables become plain joins, and
+// right joins become left joins,
+// - columns aren't aliased with their own name,
+// - columns aren't qualified, if the query selects from a single table.
+// The columns of the tables of the view are unknown, so the qualifiers of
+// joins and the '*' of the query are left as is. See Schema.Diff, which
+// also normalizes them.
+func NormalizeCreateView(create *sqlparser.CreateView) (*sqlparser.CreateView, error) {
+	return normalizeCreateView(create, nil)
+}
+
+// normalizeCreateView is NormalizeCreateView for a view of a schema. If the
+// schema knows the columns of the tables of a query, its '*' is expanded,
+// and its columns aren't qualified unless their name is ambiguous.
+func normalizeCreateView(create *sqlparser.CreateView, schema *Schema) (*sqlparser.CreateView, error) {
+	stmt, err := copyStatement(create)
+	if err != nil {
+		return nil, err
+	}
+	view := stmt.(*sqlparser.CreateView)
+	view.IsReplace = false
+	view.Definer = ""
+	view.Algorithm = strings.ToLower(view.Algorithm)
+	if view.Algorithm == "undefined" {
+		view.Algorithm = ""
+	}
+	view.Security = strings.ToLower(view.Security)
+	if view.Security == "definer" {
+		view.Security = ""
+	}
+	view.CheckOption = strings.ToLower(view.CheckOption)
+	normalizeSelectStatement(view.Select, schema)
+	return view, nil
+}
+
+func normalizeSelectStatement(stmt sqlparser.SelectStatement, schema *Schema) {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		normalizeSelect(stmt, schema)
+	case *sqlparser.Union:
+		normalizeSelectStatement(stmt.FirstStatement, schema)
+		for _, us := range stmt.UnionSelects {
+			normalizeSelectStatement(us.Statement, schema)
+		}
+	case *sqlparser.ParenSelect:
+		normalizeSelectStatement(stmt.Select, schema)
+	}
+}
+
+// viewTable is a table that a query selects from, with its columns if
+// they are known.
+type viewTable struct {
+	qualifier string
+	columns   []string
+}
+
+func (t *viewTable) hasColumn(name string) bool {
+	for _, column := range t.columns {
+		if strings.EqualFold(column, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeSelect(sel *sqlparser.Select, schema *Schema) {
+	if len(sel.From) == 0 {
+		return
+	}
+	// The tables are listed before the joins are normalized, since a '*'
+	// expands to their columns in the order of the query.
+	var exprs []*sqlparser.AliasedTableExpr
+	for _, expr := range sel.From {
+		exprs = append(exprs, fromTables(expr)...)
+	}
+	from := normalizeTableExpr(sel.From[0])
+	for _, expr := range sel.From[1:] {
+		from = &sqlparser.JoinTableExpr{LeftExpr: from, Join: sqlparser.NormalJoinType, RightExpr: normalizeTableExpr(expr)}
+	}
+	sel.From = sqlparser.TableExprs{from}
+
+	var tables []*viewTable
+	known := true
+	for _, expr := range exprs {
+		table := &viewTable{qualifier: expr.As.String()}
+		if tableName, ok := expr.Expr.(sqlparser.TableName); ok {
+			if table.qualifier == "" {
+				table.qualifier = tableName.Name.String()
+			}
+			if schema != nil && tableName.Qualifier.IsEmpty() {
+				table.columns = schema.columns(tableName.Name.String())
+			}
+		}
+		known = known && table.columns != nil
+		tables = append(tables, table)
+	}
+
+	if known {
+		var exprs sqlparser.SelectExprs
+		for _, expr := range sel.SelectExprs {
+			star, ok := expr.(*sqlparser.StarExpr)
+			if !ok {
+				exprs = append(exprs, expr)
+				continue
+			}
+			for _, table := range tables {
+				if !star.TableName.IsEmpty() && (!star.TableName.Qualifier.IsEmpty() || star.TableName.Name.String() != table.qualifier) {
+					continue
+				}
+				for _, column := range table.columns {
+					exprs = append(exprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{
+						Name:      sqlparser.NewColIdent(column),
+						Qualifier: sqlparser.TableName{Name: sqlparser.NewTableIdent(table.qualifier)},
+					}})
+				}
+			}
+		}
+		sel.SelectExprs = exprs
+	}
+
+	// unqualified returns true if a column can drop its qualifier.
+	unqualified := func(col *sqlparser.ColName) bool {
+		if !col.Qualifier.Qualifier.IsEmpty() {
+			return false
+		}
+		if len(tables) == 1 {
+			return col.Qualifier.Name.String() == tables[0].qualifier
+		}
+		if !known {
+			return false
+		}
+		matches := 0
+		qualified := false
+		for _, table := range tables {
+			if table.hasColumn(col.Name.String()) {
+				matches++
+				qualified = qualified || col.Qualifier.Name.String() == table.qualifier
+			}
+		}
+		return matches == 1 && qualified
+	}
+	// The columns of subqueries belong to their own scopes, which are left as is.
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery:
+			return false, nil
+		case *sqlparser.ColName:
+			if !node.Qualifier.IsEmpty() && unqualified(node) {
+				node.Qualifier = sqlparser.TableName{}
+			}
+		}
+		return true, nil
+	}, sel.SelectExprs, sel.From[0], sel.Where, sel.GroupBy, sel.Having, sel.OrderBy)
+	for _, expr := range sel.SelectExprs {
+		if aliased, ok := expr.(*sqlparser.AliasedExpr); ok {
+			if col, ok := aliased.Expr.(*sqlparser.ColName); ok && aliased.As.Equal(col.Name) {
+				aliased.As = sqlparser.NewColIdent("")
+			}
+		}
+	}
+}
+
+// normalizeTableExpr removes the parentheses around joins, and turns
+// right joins into left joins, the way MySQL prints them.
+func normalizeTableExpr(expr sqlparser.TableExpr) sqlparser.TableExpr {
+	switch expr := expr.(type) {
+	case *sqlparser.ParenTableExpr:
+		if len(expr.Exprs) == 1 {
+			return normalizeTableExpr(expr.Exprs[0])
+		}
+		join := normalizeTableExpr(expr.Exprs[0])
+		for _, right := range expr.Exprs[1:] {
+			join = &sqlparser.JoinTableExpr{LeftExpr: join, Join: sqlparser.NormalJoinType, RightExpr: normalizeTableExpr(right)}
+		}
+		return join
+	case *sqlparser.JoinTableExpr:
+		expr.LeftExpr = normalizeTableExpr(expr.LeftExpr)
+		expr.RightExpr = normalizeTableExpr(expr.RightExpr)
+		switch expr.Join {
+		case sqlparser.RightJoinType:
+			expr.LeftExpr, expr.RightExpr, expr.Join = expr.RightExpr, expr.LeftExpr, sqlparser.LeftJoinType
+		case sqlparser.NaturalRightJoinType:
+			expr.LeftExpr, expr.RightExpr, expr.Join = expr.RightExpr, expr.LeftExpr, sqlparser.NaturalLeftJoinType
+		}
+	}
+	return expr
+}
+
+// fromTables returns the tables of a table expression, in order.
+func fromTables(expr sqlparser.TableExpr) []*sqlparser.AliasedTableExpr {
+	switch expr := expr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		return []*sqlparser.AliasedTableExpr{expr}
+	case *sqlparser.ParenTableExpr:
+		var exprs []*sqlparser.AliasedTableExpr
+		for _, expr := range expr.Exprs {
+			exprs = append(exprs, fromTables(expr)...)
+		}
+		return exprs
+	case *sqlparser.JoinTableExpr:
+		return append(fromTables(expr.LeftExpr), fromTables(expr.RightExpr)...)
+	}
+	return nil
+}
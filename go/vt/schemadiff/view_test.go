@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestDiffCreateViews(t *testing.T) {
+	testcases := []struct {
+		name string
+		from string
+		to   string
+		diff string
+	}{{
+		name: "identical",
+		from: "create view v as select a, b from t",
+		to:   "create view v as select a, b from t",
+	}, {
+		name: "show create view",
+		from: "CREATE ALGORITHM=UNDEFINED DEFINER='root'@'localhost' SQL SECURITY DEFINER VIEW `v` AS select `t`.`a` AS `a`,`t`.`b` AS `b` from `t` where (`t`.`a` > 1)",
+		to:   "create view v as select a, b from t where a > 1",
+	}, {
+		name: "aliased table",
+		from: "create view v as select `x`.`a` AS `a` from `t` `x`",
+		to:   "create view v as select a from t as x",
+	}, {
+		name: "joins",
+		from: "create view v as select `a`.`id` AS `id`,`b`.`name` AS `name` from (`a` join `b` on((`a`.`id` = `b`.`a_id`)))",
+		to:   "create view v as select a.id, b.name from a inner join b on a.id = b.a_id",
+	}, {
+		name: "comma joins",
+		from: "create view v as select `a`.`id` AS `id` from ((`a` join `b`) join `c`)",
+		to:   "create view v as select a.id from a, b, c",
+	}, {
+		name: "right joins",
+		from: "create view v as select `a`.`id` AS `id` from (`b` left join `a` on((`a`.`id` = `b`.`id`)))",
+		to:   "create view v as select a.id from a right join b on a.id = b.id",
+	}, {
+		name: "subqueries",
+		from: "create view v as select `t`.`a` AS `a` from `t` where `t`.`a` in (select `u`.`a` from `u`)",
+		to:   "create view v as select a from t where a in (select u.a from u)",
+	}, {
+		name: "changed select",
+		from: "create view v as select a from t",
+		to:   "create view v as select a, b from t",
+		diff: "alter view v as select a, b from t",
+	}, {
+		name: "changed options",
+		from: "create view v as select a from t",
+		to:   "create algorithm = merge view v as select a from t with cascaded check option",
+		diff: "alter algorithm = merge view v as select a from t with cascaded check option",
+	}, {
+		name: "changed columns",
+		from: "create view v as select a from t",
+		to:   "create view v(x) as select a from t",
+		diff: "alter view v(x) as select a from t",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			from, err := sqlparser.Parse(tcase.from)
+			require.NoError(t, err)
+			to, err := sqlparser.Parse(tcase.to)
+			require.NoError(t, err)
+			alter, err := DiffCreateViews(from.(*sqlparser.CreateView), to.(*sqlparser.CreateView))
+			require.NoError(t, err)
+			if tcase.diff == "" {
+				if alter != nil {
+					assert.Fail(t, "unexpected diff", sqlparser.String(alter))
+				}
+				return
+			}
+			require.NotNil(t, alter)
+			assert.Equal(t, tcase.diff, sqlparser.String(alter))
+		})
+	}
+}
+
+func TestDiffStatements(t *testing.T) {
+	testcases := []struct {
+		from string
+		to   string
+		diff string
+		err  error
+	}{{
+		from: "",
+		to:   "create table t (id int)",
+		diff: "create table t (\n\tid int\n)",
+	}, {
+		from: "create table t (id int)",
+		to:   "",
+		diff: "drop table t",
+	}, {
+		from: "create view v as select 1 from dual",
+		to:   "",
+		diff: "drop view v",
+	}, {
+		from: "create table t (id int)",
+		to:   "create table t (id int)",
+	}, {
+		from: "create table t (id int)",
+		to:   "create view t as select 1 from dual",
+		err:  ErrEntityTypeMismatch,
+	}, {
+		from: "",
+		to:   "drop table t",
+		err:  ErrUnsupportedStatement,
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.from+" -> "+tcase.to, func(t *testing.T) {
+			diff, err := DiffQueries(tcase.from, tcase.to, nil)
+			if tcase.err != nil {
+				assert.ErrorIs(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			if tcase.diff == "" {
+				assert.Nil(t, diff)
+				return
+			}
+			require.NotNil(t, diff)
+			assert.Equal(t, tcase.diff, sqlparser.String(diff))
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 
 	"vitess.io/vitess/go/sync2"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -129,6 +130,10 @@ func (exec *TabletExecutor) Validate(ctx context.Context, sqls []string) error {
 		return err
 	}
 
+	if err := exec.checkSchemaPolicy(ctx, parsedDDLs); err != nil {
+		return err
+	}
+
 	bigSchemaChange, err := exec.detectBigSchemaChanges(ctx, parsedDDLs)
 	if bigSchemaChange && exec.allowBigSchemaChange {
 		exec.wr.Logger().Warningf("Processing big schema change. This may cause visible MySQL downtime.")
@@ -137,6 +142,63 @@ func (exec *TabletExecutor) Validate(ctx context.Context, sqls []string) error {
 	return err
 }
 
+// checkSchemaPolicy rejects DDL statements which violate the schema policy of the keyspace
+func (exec *TabletExecutor) checkSchemaPolicy(ctx context.Context, parsedDDLs []sqlparser.DDLStatement) error {
+	policy, err := schema.ReadSchemaPolicy(ctx, exec.wr.TopoServer(), exec.keyspace)
+	if err != nil {
+		return err
+	}
+	if policy.IsEmpty() {
+		return nil
+	}
+	// The existing tables are read once, from the first shard, when a rule first needs them
+	var existingTables map[string]*tabletmanagerdatapb.TableDefinition
+	getTable := func(tableName string) (*tabletmanagerdatapb.TableDefinition, error) {
+		if existingTables == nil {
+			dbSchema, err := exec.wr.TabletManagerClient().GetSchema(ctx, exec.tablets[0], []string{}, []string{}, false)
+			if err != nil {
+				return nil, err
+			}
+			existingTables = make(map[string]*tabletmanagerdatapb.TableDefinition, len(dbSchema.TableDefinitions))
+			for _, tableSchema := range dbSchema.TableDefinitions {
+				existingTables[tableSchema.Name] = tableSchema
+			}
+		}
+		return existingTables[tableName], nil
+	}
+	tableIndexCount := func(tableName string) (int, error) {
+		table, err := getTable(tableName)
+		if err != nil || table == nil {
+			return 0, err
+		}
+		return schema.CreateTableIndexCount(table.Schema)
+	}
+	tableHasRows := func(tableName string) (bool, error) {
+		table, err := getTable(tableName)
+		if err != nil || table == nil {
+			return false, err
+		}
+		// Row counts in the schema are estimates, so we look for an actual row, on all shards
+		query := fmt.Sprintf("select 1 from %s limit 1", sqlparser.String(sqlparser.NewTableIdent(tableName)))
+		for _, tablet := range exec.tablets {
+			qr, err := exec.wr.TabletManagerClient().ExecuteFetchAsDba(ctx, tablet, false, []byte(query), 1, false, false)
+			if err != nil {
+				return false, err
+			}
+			if len(qr.Rows) > 0 {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	for _, ddl := range parsedDDLs {
+		if err := policy.Check(ddl, tableHasRows, tableIndexCount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (exec *TabletExecutor) parseDDLs(sqls []string) ([]sqlparser.DDLStatement, []sqlparser.DBDDLStatement, [](*sqlparser.RevertMigration), error) {
 	parsedDDLs := make([]sqlparser.DDLStatement, 0)
 	parsedDBDDLs := make([]sqlparser.DBDDLStatement, 0)
@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/wrangler"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -169,6 +170,63 @@ func TestTabletExecutorValidate(t *testing.T) {
 	}
 }
 
+func TestTabletExecutorValidateSchemaPolicy(t *testing.T) {
+	fakeTmc := newFakeTabletManagerClient()
+
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema: "CREATE DATABASE `{{.DatabaseName}}` /*!40100 DEFAULT CHARACTER SET utf8 */",
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{
+				Name:   "test_table",
+				Schema: "CREATE TABLE `test_table` (`id` int NOT NULL, `name` varchar(64), PRIMARY KEY (`id`), KEY `name_idx` (`name`))",
+				Type:   tmutils.TableBaseTable,
+			},
+		},
+	})
+
+	ts := newFakeTopo(t)
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, fakeTmc)
+	executor := NewTabletExecutor("TestTabletExecutorValidateSchemaPolicy", wr, testWaitReplicasTimeout)
+	ctx := context.Background()
+
+	err := ts.SaveSchemaPolicy(ctx, "test_keyspace", []byte(`{"require_primary_key": true, "forbidden_charsets": ["latin1"], "forbid_drop_non_empty_table": true, "max_indexes": 2}`))
+	require.NoError(t, err)
+
+	executor.Open(ctx, "test_keyspace")
+	defer executor.Close()
+
+	err = executor.Validate(ctx, []string{"CREATE TABLE test_table_02 (id int, primary key (id))"})
+	assert.NoError(t, err)
+
+	err = executor.Validate(ctx, []string{"CREATE TABLE test_table_02 (id int)"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "require_primary_key")
+
+	err = executor.Validate(ctx, []string{"ALTER TABLE test_table CONVERT TO CHARACTER SET latin1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "forbidden_charsets")
+
+	err = executor.Validate(ctx, []string{"ALTER TABLE test_table MODIFY name varchar(64) COLLATE latin1_swedish_ci"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "forbidden_charsets")
+
+	// the table already has two indexes
+	err = executor.Validate(ctx, []string{"ALTER TABLE test_table ADD INDEX id_name_idx (id, name)"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "max_indexes")
+
+	err = executor.Validate(ctx, []string{"CREATE INDEX id_name_idx ON test_table (id, name)"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "max_indexes")
+
+	err = executor.Validate(ctx, []string{"ALTER TABLE test_table DROP INDEX name_idx, ADD INDEX id_name_idx (id, name)"})
+	assert.NoError(t, err)
+
+	// the table has no rows
+	err = executor.Validate(ctx, []string{"DROP TABLE test_table"})
+	assert.NoError(t, err)
+}
+
 func TestTabletExecutorDML(t *testing.T) {
 	fakeTmc := newFakeTabletManagerClient()
 
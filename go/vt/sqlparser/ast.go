@@ -432,11 +432,12 @@ type (
 
 	// AlterTable represents a ALTER TABLE statement.
 	AlterTable struct {
-		Table         TableName
-		AlterOptions  []AlterOption
-		PartitionSpec *PartitionSpec
-		Comments      Comments
-		FullyParsed   bool
+		Table           TableName
+		AlterOptions    []AlterOption
+		PartitionSpec   *PartitionSpec
+		PartitionOption *PartitionOption
+		Comments        Comments
+		FullyParsed     bool
 	}
 
 	// DropTable represents a DROP TABLE statement.
@@ -450,6 +451,7 @@ type (
 
 	// DropView represents a DROP VIEW statement.
 	DropView struct {
+		Comments   Comments
 		FromTables TableNames
 		IfExists   bool
 	}
@@ -467,6 +469,7 @@ type (
 
 	// CreateView represents a CREATE VIEW query
 	CreateView struct {
+		Comments    Comments
 		ViewName    TableName
 		Algorithm   string
 		Definer     string
@@ -479,6 +482,7 @@ type (
 
 	// AlterView represents a ALTER VIEW query
 	AlterView struct {
+		Comments    Comments
 		ViewName    TableName
 		Algorithm   string
 		Definer     string
@@ -1122,7 +1126,7 @@ func (node *CreateTable) SetComments(comments Comments) {
 
 // SetComments implements DDLStatement.
 func (node *CreateView) SetComments(comments Comments) {
-	// irrelevant
+	node.Comments = comments
 }
 
 // SetComments implements DDLStatement.
@@ -1132,12 +1136,12 @@ func (node *DropTable) SetComments(comments Comments) {
 
 // SetComments implements DDLStatement.
 func (node *DropView) SetComments(comments Comments) {
-	// irrelevant
+	node.Comments = comments
 }
 
 // SetComments implements DDLStatement.
 func (node *AlterView) SetComments(comments Comments) {
-	// irrelevant
+	node.Comments = comments
 }
 
 // SetComments for RevertMigration, does not implement DDLStatement
@@ -1169,8 +1173,7 @@ func (node *CreateTable) GetComments() Comments {
 
 // GetComments implements DDLStatement.
 func (node *CreateView) GetComments() Comments {
-	// irrelevant
-	return nil
+	return node.Comments
 }
 
 // GetComments implements DDLStatement.
@@ -1180,14 +1183,12 @@ func (node *DropTable) GetComments() Comments {
 
 // GetComments implements DDLStatement.
 func (node *DropView) GetComments() Comments {
-	// irrelevant
-	return nil
+	return node.Comments
 }
 
 // GetComments implements DDLStatement.
 func (node *AlterView) GetComments() Comments {
-	// irrelevant
-	return nil
+	return node.Comments
 }
 
 // GetToTables implements the DDLStatement interface
@@ -1462,15 +1463,29 @@ type PartitionDefinition struct {
 	Maxvalue bool
 }
 
+// PartitionOption describes the partitioning of a table in a CREATE TABLE statement
+type PartitionOption struct {
+	Linear      bool
+	Type        PartitionByType
+	Expr        Expr
+	ColList     Columns
+	Partitions  *Literal
+	Definitions []*PartitionDefinition
+}
+
+// PartitionByType is an enum for PartitionOption.Type
+type PartitionByType int8
+
 // TableOptions specifies a list of table options
 type TableOptions []*TableOption
 
 // TableSpec describes the structure of a table from a CREATE TABLE statement
 type TableSpec struct {
-	Columns     []*ColumnDefinition
-	Indexes     []*IndexDefinition
-	Constraints []*ConstraintDefinition
-	Options     TableOptions
+	Columns         []*ColumnDefinition
+	Indexes         []*IndexDefinition
+	Constraints     []*ConstraintDefinition
+	Options         TableOptions
+	PartitionOption *PartitionOption
 }
 
 // ColumnDefinition describes a column in a CREATE TABLE statement
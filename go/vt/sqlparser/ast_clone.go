@@ -205,6 +205,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfParenTableExpr(in)
 	case *PartitionDefinition:
 		return CloneRefOfPartitionDefinition(in)
+	case *PartitionOption:
+		return CloneRefOfPartitionOption(in)
 	case *PartitionSpec:
 		return CloneRefOfPartitionSpec(in)
 	case Partitions:
@@ -428,6 +430,7 @@ func CloneRefOfAlterTable(n *AlterTable) *AlterTable {
 	out.Table = CloneTableName(n.Table)
 	out.AlterOptions = CloneSliceOfAlterOption(n.AlterOptions)
 	out.PartitionSpec = CloneRefOfPartitionSpec(n.PartitionSpec)
+	out.PartitionOption = CloneRefOfPartitionOption(n.PartitionOption)
 	out.Comments = CloneComments(n.Comments)
 	return &out
 }
@@ -438,6 +441,7 @@ func CloneRefOfAlterView(n *AlterView) *AlterView {
 		return nil
 	}
 	out := *n
+	out.Comments = CloneComments(n.Comments)
 	out.ViewName = CloneTableName(n.ViewName)
 	out.Columns = CloneColumns(n.Columns)
 	out.Select = CloneSelectStatement(n.Select)
@@ -700,6 +704,7 @@ func CloneRefOfCreateView(n *CreateView) *CreateView {
 		return nil
 	}
 	out := *n
+	out.Comments = CloneComments(n.Comments)
 	out.ViewName = CloneTableName(n.ViewName)
 	out.Columns = CloneColumns(n.Columns)
 	out.Select = CloneSelectStatement(n.Select)
@@ -800,6 +805,7 @@ func CloneRefOfDropView(n *DropView) *DropView {
 		return nil
 	}
 	out := *n
+	out.Comments = CloneComments(n.Comments)
 	out.FromTables = CloneTableNames(n.FromTables)
 	return &out
 }
@@ -1210,6 +1216,19 @@ func CloneRefOfPartitionDefinition(n *PartitionDefinition) *PartitionDefinition
 	return &out
 }
 
+// CloneRefOfPartitionOption creates a deep clone of the input.
+func CloneRefOfPartitionOption(n *PartitionOption) *PartitionOption {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.ColList = CloneColumns(n.ColList)
+	out.Partitions = CloneRefOfLiteral(n.Partitions)
+	out.Definitions = CloneSliceOfRefOfPartitionDefinition(n.Definitions)
+	return &out
+}
+
 // CloneRefOfPartitionSpec creates a deep clone of the input.
 func CloneRefOfPartitionSpec(n *PartitionSpec) *PartitionSpec {
 	if n == nil {
@@ -1562,6 +1581,7 @@ func CloneRefOfTableSpec(n *TableSpec) *TableSpec {
 	out.Indexes = CloneSliceOfRefOfIndexDefinition(n.Indexes)
 	out.Constraints = CloneSliceOfRefOfConstraintDefinition(n.Constraints)
 	out.Options = CloneTableOptions(n.Options)
+	out.PartitionOption = CloneRefOfPartitionOption(n.PartitionOption)
 	return &out
 }
 
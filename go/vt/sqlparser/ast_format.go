@@ -398,6 +398,40 @@ func (node *PartitionDefinition) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node
+func (node *PartitionOption) Format(buf *TrackedBuffer) {
+	buf.WriteString("partition by ")
+	if node.Linear {
+		buf.WriteString("linear ")
+	}
+	buf.astPrintf(node, "%s", node.Type.ToString())
+	if node.Expr != nil {
+		buf.astPrintf(node, " (%v)", node.Expr)
+	} else {
+		if node.Type != KeyType {
+			buf.WriteString(" columns")
+		}
+		if len(node.ColList) == 0 {
+			buf.WriteString(" ()")
+		} else {
+			buf.astPrintf(node, " %v", node.ColList)
+		}
+	}
+	if node.Partitions != nil {
+		buf.astPrintf(node, " partitions %v", node.Partitions)
+	}
+	for i, pd := range node.Definitions {
+		if i == 0 {
+			buf.astPrintf(node, "\n(%v", pd)
+		} else {
+			buf.astPrintf(node, ",\n %v", pd)
+		}
+	}
+	if len(node.Definitions) != 0 {
+		buf.WriteByte(')')
+	}
+}
+
 // Format formats the node.
 func (ts *TableSpec) Format(buf *TrackedBuffer) {
 	buf.astPrintf(ts, "(\n")
@@ -429,6 +463,9 @@ func (ts *TableSpec) Format(buf *TrackedBuffer) {
 			buf.astPrintf(ts, " (%v)", opt.Tables)
 		}
 	}
+	if ts.PartitionOption != nil {
+		buf.astPrintf(ts, "\n%v", ts.PartitionOption)
+	}
 }
 
 // Format formats the node.
@@ -498,22 +535,22 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 	if ct.Options.Comment != nil {
 		buf.astPrintf(ct, " %s %v", keywordStrings[COMMENT_KEYWORD], ct.Options.Comment)
 	}
-	if ct.Options.KeyOpt == colKeyPrimary {
+	if ct.Options.KeyOpt == ColKeyPrimary {
 		buf.astPrintf(ct, " %s %s", keywordStrings[PRIMARY], keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKeyUnique {
+	if ct.Options.KeyOpt == ColKeyUnique {
 		buf.astPrintf(ct, " %s", keywordStrings[UNIQUE])
 	}
-	if ct.Options.KeyOpt == colKeyUniqueKey {
+	if ct.Options.KeyOpt == ColKeyUniqueKey {
 		buf.astPrintf(ct, " %s %s", keywordStrings[UNIQUE], keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKeySpatialKey {
+	if ct.Options.KeyOpt == ColKeySpatialKey {
 		buf.astPrintf(ct, " %s %s", keywordStrings[SPATIAL], keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKeyFulltextKey {
+	if ct.Options.KeyOpt == ColKeyFulltextKey {
 		buf.astPrintf(ct, " %s %s", keywordStrings[FULLTEXT], keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKey {
+	if ct.Options.KeyOpt == ColKey {
 		buf.astPrintf(ct, " %s", keywordStrings[KEY])
 	}
 	if ct.Options.Reference != nil {
@@ -1426,20 +1463,20 @@ func (node *CreateTable) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *CreateView) Format(buf *TrackedBuffer) {
-	buf.WriteString("create")
+	buf.astPrintf(node, "create %v", node.Comments)
 	if node.IsReplace {
-		buf.WriteString(" or replace")
+		buf.WriteString("or replace ")
 	}
 	if node.Algorithm != "" {
-		buf.astPrintf(node, " algorithm = %s", node.Algorithm)
+		buf.astPrintf(node, "algorithm = %s ", node.Algorithm)
 	}
 	if node.Definer != "" {
-		buf.astPrintf(node, " definer = %s", node.Definer)
+		buf.astPrintf(node, "definer = %s ", node.Definer)
 	}
 	if node.Security != "" {
-		buf.astPrintf(node, " sql security %s", node.Security)
+		buf.astPrintf(node, "sql security %s ", node.Security)
 	}
-	buf.astPrintf(node, " view %v", node.ViewName)
+	buf.astPrintf(node, "view %v", node.ViewName)
 	buf.astPrintf(node, "%v as %v", node.Columns, node.Select)
 	if node.CheckOption != "" {
 		buf.astPrintf(node, " with %s check option", node.CheckOption)
@@ -1461,17 +1498,17 @@ func (node *UnlockTables) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *AlterView) Format(buf *TrackedBuffer) {
-	buf.WriteString("alter")
+	buf.astPrintf(node, "alter %v", node.Comments)
 	if node.Algorithm != "" {
-		buf.astPrintf(node, " algorithm = %s", node.Algorithm)
+		buf.astPrintf(node, "algorithm = %s ", node.Algorithm)
 	}
 	if node.Definer != "" {
-		buf.astPrintf(node, " definer = %s", node.Definer)
+		buf.astPrintf(node, "definer = %s ", node.Definer)
 	}
 	if node.Security != "" {
-		buf.astPrintf(node, " sql security %s", node.Security)
+		buf.astPrintf(node, "sql security %s ", node.Security)
 	}
-	buf.astPrintf(node, " view %v", node.ViewName)
+	buf.astPrintf(node, "view %v", node.ViewName)
 	buf.astPrintf(node, "%v as %v", node.Columns, node.Select)
 	if node.CheckOption != "" {
 		buf.astPrintf(node, " with %s check option", node.CheckOption)
@@ -1497,7 +1534,7 @@ func (node *DropView) Format(buf *TrackedBuffer) {
 	if node.IfExists {
 		exists = " if exists"
 	}
-	buf.astPrintf(node, "drop view%s %v", exists, node.FromTables)
+	buf.astPrintf(node, "drop %vview%s %v", node.Comments, exists, node.FromTables)
 }
 
 // Format formats the AlterTable node.
@@ -1516,6 +1553,9 @@ func (node *AlterTable) Format(buf *TrackedBuffer) {
 	if node.PartitionSpec != nil {
 		buf.astPrintf(node, "%s %v", prefix, node.PartitionSpec)
 	}
+	if node.PartitionOption != nil {
+		buf.astPrintf(node, " %v", node.PartitionOption)
+	}
 }
 
 // Format formats the node.
@@ -1534,7 +1574,10 @@ func (node *AddColumns) Format(buf *TrackedBuffer) {
 	if len(node.Columns) == 1 {
 		buf.astPrintf(node, "add column %v", node.Columns[0])
 		if node.First != nil {
-			buf.astPrintf(node, " first %v", node.First)
+			buf.WriteString(" first")
+			if !node.First.Name.IsEmpty() {
+				buf.astPrintf(node, " %v", node.First)
+			}
 		}
 		if node.After != nil {
 			buf.astPrintf(node, " after %v", node.After)
@@ -1570,7 +1613,10 @@ func (node *AlterColumn) Format(buf *TrackedBuffer) {
 func (node *ChangeColumn) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "change column %v %v", node.OldColumn, node.NewColDefinition)
 	if node.First != nil {
-		buf.astPrintf(node, " first %v", node.First)
+		buf.WriteString(" first")
+		if !node.First.Name.IsEmpty() {
+			buf.astPrintf(node, " %v", node.First)
+		}
 	}
 	if node.After != nil {
 		buf.astPrintf(node, " after %v", node.After)
@@ -1581,7 +1627,10 @@ func (node *ChangeColumn) Format(buf *TrackedBuffer) {
 func (node *ModifyColumn) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "modify column %v", node.NewColDefinition)
 	if node.First != nil {
-		buf.astPrintf(node, " first %v", node.First)
+		buf.WriteString(" first")
+		if !node.First.Name.IsEmpty() {
+			buf.astPrintf(node, " %v", node.First)
+		}
 	}
 	if node.After != nil {
 		buf.astPrintf(node, " after %v", node.After)
@@ -564,6 +564,46 @@ func (node *PartitionDefinition) formatFast(buf *TrackedBuffer) {
 	}
 }
 
+// formatFast formats the node
+func (node *PartitionOption) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("partition by ")
+	if node.Linear {
+		buf.WriteString("linear ")
+	}
+	buf.WriteString(node.Type.ToString())
+	if node.Expr != nil {
+		buf.WriteString(" (")
+		node.Expr.formatFast(buf)
+		buf.WriteByte(')')
+	} else {
+		if node.Type != KeyType {
+			buf.WriteString(" columns")
+		}
+		if len(node.ColList) == 0 {
+			buf.WriteString(" ()")
+		} else {
+			buf.WriteByte(' ')
+			node.ColList.formatFast(buf)
+		}
+	}
+	if node.Partitions != nil {
+		buf.WriteString(" partitions ")
+		node.Partitions.formatFast(buf)
+	}
+	for i, pd := range node.Definitions {
+		if i == 0 {
+			buf.WriteString("\n(")
+			pd.formatFast(buf)
+		} else {
+			buf.WriteString(",\n ")
+			pd.formatFast(buf)
+		}
+	}
+	if len(node.Definitions) != 0 {
+		buf.WriteByte(')')
+	}
+}
+
 // formatFast formats the node.
 func (ts *TableSpec) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("(\n")
@@ -604,6 +644,10 @@ func (ts *TableSpec) formatFast(buf *TrackedBuffer) {
 			buf.WriteByte(')')
 		}
 	}
+	if ts.PartitionOption != nil {
+		buf.WriteByte('\n')
+		ts.PartitionOption.formatFast(buf)
+	}
 }
 
 // formatFast formats the node.
@@ -719,35 +763,35 @@ func (ct *ColumnType) formatFast(buf *TrackedBuffer) {
 		buf.WriteByte(' ')
 		ct.Options.Comment.formatFast(buf)
 	}
-	if ct.Options.KeyOpt == colKeyPrimary {
+	if ct.Options.KeyOpt == ColKeyPrimary {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[PRIMARY])
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKeyUnique {
+	if ct.Options.KeyOpt == ColKeyUnique {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[UNIQUE])
 	}
-	if ct.Options.KeyOpt == colKeyUniqueKey {
+	if ct.Options.KeyOpt == ColKeyUniqueKey {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[UNIQUE])
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKeySpatialKey {
+	if ct.Options.KeyOpt == ColKeySpatialKey {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[SPATIAL])
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKeyFulltextKey {
+	if ct.Options.KeyOpt == ColKeyFulltextKey {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[FULLTEXT])
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[KEY])
 	}
-	if ct.Options.KeyOpt == colKey {
+	if ct.Options.KeyOpt == ColKey {
 		buf.WriteByte(' ')
 		buf.WriteString(keywordStrings[KEY])
 	}
@@ -1883,23 +1927,27 @@ func (node *CreateTable) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (node *CreateView) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("create")
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
 	if node.IsReplace {
-		buf.WriteString(" or replace")
+		buf.WriteString("or replace ")
 	}
 	if node.Algorithm != "" {
-		buf.WriteString(" algorithm = ")
+		buf.WriteString("algorithm = ")
 		buf.WriteString(node.Algorithm)
+		buf.WriteByte(' ')
 	}
 	if node.Definer != "" {
-		buf.WriteString(" definer = ")
+		buf.WriteString("definer = ")
 		buf.WriteString(node.Definer)
+		buf.WriteByte(' ')
 	}
 	if node.Security != "" {
-		buf.WriteString(" sql security ")
+		buf.WriteString("sql security ")
 		buf.WriteString(node.Security)
+		buf.WriteByte(' ')
 	}
-	buf.WriteString(" view ")
+	buf.WriteString("view ")
 	node.ViewName.formatFast(buf)
 	node.Columns.formatFast(buf)
 	buf.WriteString(" as ")
@@ -1932,20 +1980,24 @@ func (node *UnlockTables) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (node *AlterView) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("alter")
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
 	if node.Algorithm != "" {
-		buf.WriteString(" algorithm = ")
+		buf.WriteString("algorithm = ")
 		buf.WriteString(node.Algorithm)
+		buf.WriteByte(' ')
 	}
 	if node.Definer != "" {
-		buf.WriteString(" definer = ")
+		buf.WriteString("definer = ")
 		buf.WriteString(node.Definer)
+		buf.WriteByte(' ')
 	}
 	if node.Security != "" {
-		buf.WriteString(" sql security ")
+		buf.WriteString("sql security ")
 		buf.WriteString(node.Security)
+		buf.WriteByte(' ')
 	}
-	buf.WriteString(" view ")
+	buf.WriteString("view ")
 	node.ViewName.formatFast(buf)
 	node.Columns.formatFast(buf)
 	buf.WriteString(" as ")
@@ -1982,7 +2034,9 @@ func (node *DropView) formatFast(buf *TrackedBuffer) {
 	if node.IfExists {
 		exists = " if exists"
 	}
-	buf.WriteString("drop view")
+	buf.WriteString("drop ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("view")
 	buf.WriteString(exists)
 	buf.WriteByte(' ')
 	node.FromTables.formatFast(buf)
@@ -2010,6 +2064,10 @@ func (node *AlterTable) formatFast(buf *TrackedBuffer) {
 		buf.WriteByte(' ')
 		node.PartitionSpec.formatFast(buf)
 	}
+	if node.PartitionOption != nil {
+		buf.WriteByte(' ')
+		node.PartitionOption.formatFast(buf)
+	}
 }
 
 // formatFast formats the node.
@@ -2031,8 +2089,11 @@ func (node *AddColumns) formatFast(buf *TrackedBuffer) {
 		buf.WriteString("add column ")
 		node.Columns[0].formatFast(buf)
 		if node.First != nil {
-			buf.WriteString(" first ")
-			node.First.formatFast(buf)
+			buf.WriteString(" first")
+			if !node.First.Name.IsEmpty() {
+				buf.WriteByte(' ')
+				node.First.formatFast(buf)
+			}
 		}
 		if node.After != nil {
 			buf.WriteString(" after ")
@@ -2080,8 +2141,11 @@ func (node *ChangeColumn) formatFast(buf *TrackedBuffer) {
 	buf.WriteByte(' ')
 	node.NewColDefinition.formatFast(buf)
 	if node.First != nil {
-		buf.WriteString(" first ")
-		node.First.formatFast(buf)
+		buf.WriteString(" first")
+		if !node.First.Name.IsEmpty() {
+			buf.WriteByte(' ')
+			node.First.formatFast(buf)
+		}
 	}
 	if node.After != nil {
 		buf.WriteString(" after ")
@@ -2094,8 +2158,11 @@ func (node *ModifyColumn) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("modify column ")
 	node.NewColDefinition.formatFast(buf)
 	if node.First != nil {
-		buf.WriteString(" first ")
-		node.First.formatFast(buf)
+		buf.WriteString(" first")
+		if !node.First.Name.IsEmpty() {
+			buf.WriteByte(' ')
+			node.First.formatFast(buf)
+		}
 	}
 	if node.After != nil {
 		buf.WriteString(" after ")
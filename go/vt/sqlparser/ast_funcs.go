@@ -92,13 +92,13 @@ type TableOption struct {
 type ColumnKeyOption int
 
 const (
-	colKeyNone ColumnKeyOption = iota
-	colKeyPrimary
-	colKeySpatialKey
-	colKeyFulltextKey
-	colKeyUnique
-	colKeyUniqueKey
-	colKey
+	ColKeyNone ColumnKeyOption = iota
+	ColKeyPrimary
+	ColKeySpatialKey
+	ColKeyFulltextKey
+	ColKeyUnique
+	ColKeyUniqueKey
+	ColKey
 )
 
 // ReferenceAction indicates the action takes by a referential constraint e.g.
@@ -1173,6 +1173,20 @@ func (ty ExplainType) ToString() string {
 	}
 }
 
+// ToString returns the type as a string
+func (partitionByType PartitionByType) ToString() string {
+	switch partitionByType {
+	case HashType:
+		return HashTypeStr
+	case KeyType:
+		return KeyTypeStr
+	case RangeType:
+		return RangeTypeStr
+	default:
+		return "Unknown PartitionByType"
+	}
+}
+
 // ToString returns the type as a string
 func (sel SelectIntoType) ToString() string {
 	switch sel {
@@ -1293,6 +1307,8 @@ func (key DropKeyType) ToString() string {
 		return ForeignKeyTypeStr
 	case NormalKeyType:
 		return NormalKeyTypeStr
+	case CheckKeyType:
+		return CheckKeyTypeStr
 	default:
 		return "Unknown DropKeyType"
 	}
@@ -1355,6 +1371,11 @@ func encodeSQLString(val string) string {
 	return sqltypes.EncodeStringSQL(val)
 }
 
+// encodeSQLIdentifier encodes the string as a backquoted SQL identifier.
+func encodeSQLIdentifier(val string) string {
+	return "`" + strings.ReplaceAll(val, "`", "``") + "`"
+}
+
 // ToString prints the list of table expressions as a string
 // To be used as an alternate for String for []TableExpr
 func ToString(exprs []TableExpr) string {
@@ -89,6 +89,11 @@ const (
 	RemoveStr            = "remove partitioning"
 	UpgradeStr           = "upgrade partitioning"
 
+	// PartitionOption.Type
+	HashTypeStr  = "hash"
+	KeyTypeStr   = "key"
+	RangeTypeStr = "range"
+
 	// JoinTableExpr.Join
 	JoinStr             = "join"
 	StraightJoinStr     = "straight_join"
@@ -246,6 +251,7 @@ const (
 	PrimaryKeyTypeStr = "primary key"
 	ForeignKeyTypeStr = "foreign key"
 	NormalKeyTypeStr  = "key"
+	CheckKeyTypeStr   = "check"
 
 	// LockOptionType strings
 	NoneTypeStr      = "none"
@@ -436,6 +442,13 @@ const (
 	UpgradeAction
 )
 
+// Constant for Enum Type - PartitionByType
+const (
+	HashType PartitionByType = iota
+	KeyType
+	RangeType
+)
+
 // Constant for Enum Type - ExplainType
 const (
 	EmptyType ExplainType = iota
@@ -508,6 +521,7 @@ const (
 	PrimaryKeyType DropKeyType = iota
 	ForeignKeyType
 	NormalKeyType
+	CheckKeyType
 )
 
 // LockOptionType constants
@@ -291,7 +291,7 @@ var keywords = []keyword{
 	{"level", LEVEL},
 	{"like", LIKE},
 	{"limit", LIMIT},
-	{"linear", UNUSED},
+	{"linear", LINEAR},
 	{"lines", LINES},
 	{"linestring", LINESTRING},
 	{"load", LOAD},
@@ -371,7 +371,7 @@ var keywords = []keyword{
 	{"processlist", PROCESSLIST},
 	{"procedure", PROCEDURE},
 	{"query", QUERY},
-	{"range", UNUSED},
+	{"range", RANGE},
 	{"rank", UNUSED},
 	{"read", READ},
 	{"reads", UNUSED},
@@ -959,6 +959,11 @@ var (
 		input: "alter table a change column s foo int default 1 after x",
 	}, {
 		input: "alter table a modify column foo int default 1 first x",
+	}, {
+		input: "alter table a modify column foo int default 1 first",
+	}, {
+		input:  "alter table a add foo int first, change column bar baz int first",
+		output: "alter table a add column foo int first, change column bar baz int first",
 	}, {
 		input:  "alter table a add foo varchar(255) generated always as (concat(bar, ' ', baz)) stored",
 		output: "alter table a add column foo varchar(255) as (concat(bar, ' ', baz)) stored",
@@ -1054,9 +1059,11 @@ var (
 		input:  "alter table t2 add primary key `zzz` (id)",
 		output: "alter table t2 add primary key (id)",
 	}, {
-		input:      "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
-		output:     "alter table a",
-		partialDDL: true,
+		input:  "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
+		output: "alter table a partition by range (id)\n(partition p0 values less than (10),\n partition p1 values less than (maxvalue))",
+	}, {
+		input:  "alter table a engine innodb partition by key (id) partitions 4",
+		output: "alter table a engine innodb partition by key (id) partitions 4",
 	}, {
 		input:      "create database a garbage values",
 		output:     "create database a",
@@ -1113,9 +1120,7 @@ var (
 	}, {
 		input: "alter table a add check (ch_1) not enforced",
 	}, {
-		input:      "alter table a drop check ch_1",
-		output:     "alter table a",
-		partialDDL: true,
+		input: "alter table a drop check ch_1",
 	}, {
 		input: "alter table a drop foreign key kx",
 	}, {
@@ -1335,6 +1340,9 @@ var (
 	}, {
 		input:  "create definer = 'sa'@b.c.d view a(b,c,d) as select * from e",
 		output: "create definer = 'sa'@b.c.d view a(b, c, d) as select * from e",
+	}, {
+		input:  "CREATE ALGORITHM=UNDEFINED DEFINER=`root`@`%` SQL SECURITY DEFINER VIEW `a` AS select `e`.`b` AS `b` from `e`",
+		output: "create algorithm = UNDEFINED definer = `root`@`%` sql security DEFINER view a as select e.b as b from e",
 	}, {
 		input: "alter view a as select * from t",
 	}, {
@@ -1348,6 +1356,12 @@ var (
 	}, {
 		input:  "drop view a,B,c",
 		output: "drop view a, b, c",
+	}, {
+		input: "drop /*vt+ strategy=online */ view if exists v",
+	}, {
+		input: "create /*vt+ strategy=online */ or replace view v as select a from t",
+	}, {
+		input: "alter /*vt+ strategy=online */ algorithm = merge view v as select a from t",
 	}, {
 		input: "drop table a",
 	}, {
@@ -3088,6 +3102,44 @@ func TestCreateTable(t *testing.T) {
 			output: `create table t1 (
 	id int(11)
 ) ENGINE FOOBAR`,
+		}, {
+			input: "create table t1 (id int) partition by range (id) (partition p0 values less than (10), partition p1 values less than maxvalue)",
+			output: `create table t1 (
+	id int
+)
+partition by range (id)
+(partition p0 values less than (10),
+ partition p1 values less than (maxvalue))`,
+		}, {
+			input: "CREATE TABLE `t1` (\n  `id` int NOT NULL,\n  `ts` date NOT NULL,\n  PRIMARY KEY (`id`,`ts`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4\n/*!50500 PARTITION BY RANGE  COLUMNS(ts)\n(PARTITION p0 VALUES LESS THAN ('2021-01-01') ENGINE = InnoDB,\n PARTITION p1 VALUES LESS THAN (MAXVALUE) ENGINE = InnoDB) */",
+			output: `create table t1 (
+	id int not null,
+	ts date not null,
+	PRIMARY KEY (id, ts)
+) ENGINE InnoDB,
+  CHARSET utf8mb4
+partition by range columns (ts)
+(partition p0 values less than ('2021-01-01'),
+ partition p1 values less than (maxvalue))`,
+		}, {
+			input: "create table t1 (id int) partition by linear hash (id) partitions 4",
+			output: `create table t1 (
+	id int
+)
+partition by linear hash (id) partitions 4`,
+		}, {
+			input: "create table t1 (id int primary key) partition by key () partitions 2",
+			output: `create table t1 (
+	id int primary key
+)
+partition by key () partitions 2`,
+		}, {
+			input: "create table t1 (id int, c varchar(10)) partition by key (id, c)",
+			output: `create table t1 (
+	id int,
+	c varchar(10)
+)
+partition by key (id, c)`,
 		},
 	}
 	for _, test := range createTableQueries {
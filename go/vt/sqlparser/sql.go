@@ -150,361 +150,364 @@ const VIRTUAL = 57447
 const STORED = 57448
 const LOWER_THAN_CHARSET = 57449
 const CHARSET = 57450
-const UNIQUE = 57451
-const KEY = 57452
-const OR = 57453
-const XOR = 57454
-const AND = 57455
-const NOT = 57456
-const BETWEEN = 57457
-const CASE = 57458
-const WHEN = 57459
-const THEN = 57460
-const ELSE = 57461
-const END = 57462
-const LE = 57463
-const GE = 57464
-const NE = 57465
-const NULL_SAFE_EQUAL = 57466
-const IS = 57467
-const LIKE = 57468
-const REGEXP = 57469
-const IN = 57470
-const SHIFT_LEFT = 57471
-const SHIFT_RIGHT = 57472
-const DIV = 57473
-const MOD = 57474
-const UNARY = 57475
-const COLLATE = 57476
-const BINARY = 57477
-const UNDERSCORE_BINARY = 57478
-const UNDERSCORE_UTF8MB4 = 57479
-const UNDERSCORE_UTF8 = 57480
-const UNDERSCORE_LATIN1 = 57481
-const INTERVAL = 57482
-const JSON_EXTRACT_OP = 57483
-const JSON_UNQUOTE_EXTRACT_OP = 57484
-const CREATE = 57485
-const ALTER = 57486
-const DROP = 57487
-const RENAME = 57488
-const ANALYZE = 57489
-const ADD = 57490
-const FLUSH = 57491
-const CHANGE = 57492
-const MODIFY = 57493
-const REVERT = 57494
-const SCHEMA = 57495
-const TABLE = 57496
-const INDEX = 57497
-const VIEW = 57498
-const TO = 57499
-const IGNORE = 57500
-const IF = 57501
-const PRIMARY = 57502
-const COLUMN = 57503
-const SPATIAL = 57504
-const FULLTEXT = 57505
-const KEY_BLOCK_SIZE = 57506
-const CHECK = 57507
-const INDEXES = 57508
-const ACTION = 57509
-const CASCADE = 57510
-const CONSTRAINT = 57511
-const FOREIGN = 57512
-const NO = 57513
-const REFERENCES = 57514
-const RESTRICT = 57515
-const SHOW = 57516
-const DESCRIBE = 57517
-const EXPLAIN = 57518
-const DATE = 57519
-const ESCAPE = 57520
-const REPAIR = 57521
-const OPTIMIZE = 57522
-const TRUNCATE = 57523
-const COALESCE = 57524
-const EXCHANGE = 57525
-const REBUILD = 57526
-const PARTITIONING = 57527
-const REMOVE = 57528
-const MAXVALUE = 57529
-const PARTITION = 57530
-const REORGANIZE = 57531
-const LESS = 57532
-const THAN = 57533
-const PROCEDURE = 57534
-const TRIGGER = 57535
-const VINDEX = 57536
-const VINDEXES = 57537
-const DIRECTORY = 57538
-const NAME = 57539
-const UPGRADE = 57540
-const STATUS = 57541
-const VARIABLES = 57542
-const WARNINGS = 57543
-const CASCADED = 57544
-const DEFINER = 57545
-const OPTION = 57546
-const SQL = 57547
-const UNDEFINED = 57548
-const SEQUENCE = 57549
-const MERGE = 57550
-const TEMPORARY = 57551
-const TEMPTABLE = 57552
-const INVOKER = 57553
-const SECURITY = 57554
-const FIRST = 57555
-const AFTER = 57556
-const LAST = 57557
-const VITESS_MIGRATION = 57558
-const CANCEL = 57559
-const RETRY = 57560
-const COMPLETE = 57561
-const BEGIN = 57562
-const START = 57563
-const TRANSACTION = 57564
-const COMMIT = 57565
-const ROLLBACK = 57566
-const SAVEPOINT = 57567
-const RELEASE = 57568
-const WORK = 57569
-const BIT = 57570
-const TINYINT = 57571
-const SMALLINT = 57572
-const MEDIUMINT = 57573
-const INT = 57574
-const INTEGER = 57575
-const BIGINT = 57576
-const INTNUM = 57577
-const REAL = 57578
-const DOUBLE = 57579
-const FLOAT_TYPE = 57580
-const DECIMAL = 57581
-const NUMERIC = 57582
-const TIME = 57583
-const TIMESTAMP = 57584
-const DATETIME = 57585
-const YEAR = 57586
-const CHAR = 57587
-const VARCHAR = 57588
-const BOOL = 57589
-const CHARACTER = 57590
-const VARBINARY = 57591
-const NCHAR = 57592
-const TEXT = 57593
-const TINYTEXT = 57594
-const MEDIUMTEXT = 57595
-const LONGTEXT = 57596
-const BLOB = 57597
-const TINYBLOB = 57598
-const MEDIUMBLOB = 57599
-const LONGBLOB = 57600
-const JSON = 57601
-const ENUM = 57602
-const GEOMETRY = 57603
-const POINT = 57604
-const LINESTRING = 57605
-const POLYGON = 57606
-const GEOMETRYCOLLECTION = 57607
-const MULTIPOINT = 57608
-const MULTILINESTRING = 57609
-const MULTIPOLYGON = 57610
-const NULLX = 57611
-const AUTO_INCREMENT = 57612
-const APPROXNUM = 57613
-const SIGNED = 57614
-const UNSIGNED = 57615
-const ZEROFILL = 57616
-const CODE = 57617
-const COLLATION = 57618
-const COLUMNS = 57619
-const DATABASES = 57620
-const ENGINES = 57621
-const EVENT = 57622
-const EXTENDED = 57623
-const FIELDS = 57624
-const FULL = 57625
-const FUNCTION = 57626
-const GTID_EXECUTED = 57627
-const KEYSPACES = 57628
-const OPEN = 57629
-const PLUGINS = 57630
-const PRIVILEGES = 57631
-const PROCESSLIST = 57632
-const SCHEMAS = 57633
-const TABLES = 57634
-const TRIGGERS = 57635
-const USER = 57636
-const VGTID_EXECUTED = 57637
-const VITESS_KEYSPACES = 57638
-const VITESS_METADATA = 57639
-const VITESS_MIGRATIONS = 57640
-const VITESS_SHARDS = 57641
-const VITESS_TABLETS = 57642
-const VSCHEMA = 57643
-const NAMES = 57644
-const GLOBAL = 57645
-const SESSION = 57646
-const ISOLATION = 57647
-const LEVEL = 57648
-const READ = 57649
-const WRITE = 57650
-const ONLY = 57651
-const REPEATABLE = 57652
-const COMMITTED = 57653
-const UNCOMMITTED = 57654
-const SERIALIZABLE = 57655
-const CURRENT_TIMESTAMP = 57656
-const DATABASE = 57657
-const CURRENT_DATE = 57658
-const CURRENT_TIME = 57659
-const LOCALTIME = 57660
-const LOCALTIMESTAMP = 57661
-const CURRENT_USER = 57662
-const UTC_DATE = 57663
-const UTC_TIME = 57664
-const UTC_TIMESTAMP = 57665
-const REPLACE = 57666
-const CONVERT = 57667
-const CAST = 57668
-const SUBSTR = 57669
-const SUBSTRING = 57670
-const GROUP_CONCAT = 57671
-const SEPARATOR = 57672
-const TIMESTAMPADD = 57673
-const TIMESTAMPDIFF = 57674
-const MATCH = 57675
-const AGAINST = 57676
-const BOOLEAN = 57677
-const LANGUAGE = 57678
-const WITH = 57679
-const QUERY = 57680
-const EXPANSION = 57681
-const WITHOUT = 57682
-const VALIDATION = 57683
-const UNUSED = 57684
-const ARRAY = 57685
-const CUME_DIST = 57686
-const DESCRIPTION = 57687
-const DENSE_RANK = 57688
-const EMPTY = 57689
-const EXCEPT = 57690
-const FIRST_VALUE = 57691
-const GROUPING = 57692
-const GROUPS = 57693
-const JSON_TABLE = 57694
-const LAG = 57695
-const LAST_VALUE = 57696
-const LATERAL = 57697
-const LEAD = 57698
-const MEMBER = 57699
-const NTH_VALUE = 57700
-const NTILE = 57701
-const OF = 57702
-const OVER = 57703
-const PERCENT_RANK = 57704
-const RANK = 57705
-const RECURSIVE = 57706
-const ROW_NUMBER = 57707
-const SYSTEM = 57708
-const WINDOW = 57709
-const ACTIVE = 57710
-const ADMIN = 57711
-const BUCKETS = 57712
-const CLONE = 57713
-const COMPONENT = 57714
-const DEFINITION = 57715
-const ENFORCED = 57716
-const EXCLUDE = 57717
-const FOLLOWING = 57718
-const GEOMCOLLECTION = 57719
-const GET_MASTER_PUBLIC_KEY = 57720
-const HISTOGRAM = 57721
-const HISTORY = 57722
-const INACTIVE = 57723
-const INVISIBLE = 57724
-const LOCKED = 57725
-const MASTER_COMPRESSION_ALGORITHMS = 57726
-const MASTER_PUBLIC_KEY_PATH = 57727
-const MASTER_TLS_CIPHERSUITES = 57728
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57729
-const NESTED = 57730
-const NETWORK_NAMESPACE = 57731
-const NOWAIT = 57732
-const NULLS = 57733
-const OJ = 57734
-const OLD = 57735
-const OPTIONAL = 57736
-const ORDINALITY = 57737
-const ORGANIZATION = 57738
-const OTHERS = 57739
-const PATH = 57740
-const PERSIST = 57741
-const PERSIST_ONLY = 57742
-const PRECEDING = 57743
-const PRIVILEGE_CHECKS_USER = 57744
-const PROCESS = 57745
-const RANDOM = 57746
-const REFERENCE = 57747
-const REQUIRE_ROW_FORMAT = 57748
-const RESOURCE = 57749
-const RESPECT = 57750
-const RESTART = 57751
-const RETAIN = 57752
-const REUSE = 57753
-const ROLE = 57754
-const SECONDARY = 57755
-const SECONDARY_ENGINE = 57756
-const SECONDARY_LOAD = 57757
-const SECONDARY_UNLOAD = 57758
-const SKIP = 57759
-const SRID = 57760
-const THREAD_PRIORITY = 57761
-const TIES = 57762
-const UNBOUNDED = 57763
-const VCPU = 57764
-const VISIBLE = 57765
-const FORMAT = 57766
-const TREE = 57767
-const VITESS = 57768
-const TRADITIONAL = 57769
-const LOCAL = 57770
-const LOW_PRIORITY = 57771
-const NO_WRITE_TO_BINLOG = 57772
-const LOGS = 57773
-const ERROR = 57774
-const GENERAL = 57775
-const HOSTS = 57776
-const OPTIMIZER_COSTS = 57777
-const USER_RESOURCES = 57778
-const SLOW = 57779
-const CHANNEL = 57780
-const RELAY = 57781
-const EXPORT = 57782
-const AVG_ROW_LENGTH = 57783
-const CONNECTION = 57784
-const CHECKSUM = 57785
-const DELAY_KEY_WRITE = 57786
-const ENCRYPTION = 57787
-const ENGINE = 57788
-const INSERT_METHOD = 57789
-const MAX_ROWS = 57790
-const MIN_ROWS = 57791
-const PACK_KEYS = 57792
-const PASSWORD = 57793
-const FIXED = 57794
-const DYNAMIC = 57795
-const COMPRESSED = 57796
-const REDUNDANT = 57797
-const COMPACT = 57798
-const ROW_FORMAT = 57799
-const STATS_AUTO_RECALC = 57800
-const STATS_PERSISTENT = 57801
-const STATS_SAMPLE_PAGES = 57802
-const STORAGE = 57803
-const MEMORY = 57804
-const DISK = 57805
+const LOWER_THAN_COLUMN_NAME = 57451
+const AFTER = 57452
+const REMOVE = 57453
+const UNIQUE = 57454
+const KEY = 57455
+const OR = 57456
+const XOR = 57457
+const AND = 57458
+const NOT = 57459
+const BETWEEN = 57460
+const CASE = 57461
+const WHEN = 57462
+const THEN = 57463
+const ELSE = 57464
+const END = 57465
+const LE = 57466
+const GE = 57467
+const NE = 57468
+const NULL_SAFE_EQUAL = 57469
+const IS = 57470
+const LIKE = 57471
+const REGEXP = 57472
+const IN = 57473
+const SHIFT_LEFT = 57474
+const SHIFT_RIGHT = 57475
+const DIV = 57476
+const MOD = 57477
+const UNARY = 57478
+const COLLATE = 57479
+const BINARY = 57480
+const UNDERSCORE_BINARY = 57481
+const UNDERSCORE_UTF8MB4 = 57482
+const UNDERSCORE_UTF8 = 57483
+const UNDERSCORE_LATIN1 = 57484
+const INTERVAL = 57485
+const JSON_EXTRACT_OP = 57486
+const JSON_UNQUOTE_EXTRACT_OP = 57487
+const CREATE = 57488
+const ALTER = 57489
+const DROP = 57490
+const RENAME = 57491
+const ANALYZE = 57492
+const ADD = 57493
+const FLUSH = 57494
+const CHANGE = 57495
+const MODIFY = 57496
+const REVERT = 57497
+const SCHEMA = 57498
+const TABLE = 57499
+const INDEX = 57500
+const VIEW = 57501
+const TO = 57502
+const IGNORE = 57503
+const IF = 57504
+const PRIMARY = 57505
+const COLUMN = 57506
+const SPATIAL = 57507
+const FULLTEXT = 57508
+const KEY_BLOCK_SIZE = 57509
+const CHECK = 57510
+const INDEXES = 57511
+const ACTION = 57512
+const CASCADE = 57513
+const CONSTRAINT = 57514
+const FOREIGN = 57515
+const NO = 57516
+const REFERENCES = 57517
+const RESTRICT = 57518
+const SHOW = 57519
+const DESCRIBE = 57520
+const EXPLAIN = 57521
+const DATE = 57522
+const ESCAPE = 57523
+const REPAIR = 57524
+const OPTIMIZE = 57525
+const TRUNCATE = 57526
+const COALESCE = 57527
+const EXCHANGE = 57528
+const REBUILD = 57529
+const PARTITIONING = 57530
+const MAXVALUE = 57531
+const PARTITION = 57532
+const REORGANIZE = 57533
+const LESS = 57534
+const THAN = 57535
+const PROCEDURE = 57536
+const TRIGGER = 57537
+const LINEAR = 57538
+const RANGE = 57539
+const VINDEX = 57540
+const VINDEXES = 57541
+const DIRECTORY = 57542
+const NAME = 57543
+const UPGRADE = 57544
+const STATUS = 57545
+const VARIABLES = 57546
+const WARNINGS = 57547
+const CASCADED = 57548
+const DEFINER = 57549
+const OPTION = 57550
+const SQL = 57551
+const UNDEFINED = 57552
+const SEQUENCE = 57553
+const MERGE = 57554
+const TEMPORARY = 57555
+const TEMPTABLE = 57556
+const INVOKER = 57557
+const SECURITY = 57558
+const FIRST = 57559
+const LAST = 57560
+const VITESS_MIGRATION = 57561
+const CANCEL = 57562
+const RETRY = 57563
+const COMPLETE = 57564
+const BEGIN = 57565
+const START = 57566
+const TRANSACTION = 57567
+const COMMIT = 57568
+const ROLLBACK = 57569
+const SAVEPOINT = 57570
+const RELEASE = 57571
+const WORK = 57572
+const BIT = 57573
+const TINYINT = 57574
+const SMALLINT = 57575
+const MEDIUMINT = 57576
+const INT = 57577
+const INTEGER = 57578
+const BIGINT = 57579
+const INTNUM = 57580
+const REAL = 57581
+const DOUBLE = 57582
+const FLOAT_TYPE = 57583
+const DECIMAL = 57584
+const NUMERIC = 57585
+const TIME = 57586
+const TIMESTAMP = 57587
+const DATETIME = 57588
+const YEAR = 57589
+const CHAR = 57590
+const VARCHAR = 57591
+const BOOL = 57592
+const CHARACTER = 57593
+const VARBINARY = 57594
+const NCHAR = 57595
+const TEXT = 57596
+const TINYTEXT = 57597
+const MEDIUMTEXT = 57598
+const LONGTEXT = 57599
+const BLOB = 57600
+const TINYBLOB = 57601
+const MEDIUMBLOB = 57602
+const LONGBLOB = 57603
+const JSON = 57604
+const ENUM = 57605
+const GEOMETRY = 57606
+const POINT = 57607
+const LINESTRING = 57608
+const POLYGON = 57609
+const GEOMETRYCOLLECTION = 57610
+const MULTIPOINT = 57611
+const MULTILINESTRING = 57612
+const MULTIPOLYGON = 57613
+const NULLX = 57614
+const AUTO_INCREMENT = 57615
+const APPROXNUM = 57616
+const SIGNED = 57617
+const UNSIGNED = 57618
+const ZEROFILL = 57619
+const CODE = 57620
+const COLLATION = 57621
+const COLUMNS = 57622
+const DATABASES = 57623
+const ENGINES = 57624
+const EVENT = 57625
+const EXTENDED = 57626
+const FIELDS = 57627
+const FULL = 57628
+const FUNCTION = 57629
+const GTID_EXECUTED = 57630
+const KEYSPACES = 57631
+const OPEN = 57632
+const PLUGINS = 57633
+const PRIVILEGES = 57634
+const PROCESSLIST = 57635
+const SCHEMAS = 57636
+const TABLES = 57637
+const TRIGGERS = 57638
+const USER = 57639
+const VGTID_EXECUTED = 57640
+const VITESS_KEYSPACES = 57641
+const VITESS_METADATA = 57642
+const VITESS_MIGRATIONS = 57643
+const VITESS_SHARDS = 57644
+const VITESS_TABLETS = 57645
+const VSCHEMA = 57646
+const NAMES = 57647
+const GLOBAL = 57648
+const SESSION = 57649
+const ISOLATION = 57650
+const LEVEL = 57651
+const READ = 57652
+const WRITE = 57653
+const ONLY = 57654
+const REPEATABLE = 57655
+const COMMITTED = 57656
+const UNCOMMITTED = 57657
+const SERIALIZABLE = 57658
+const CURRENT_TIMESTAMP = 57659
+const DATABASE = 57660
+const CURRENT_DATE = 57661
+const CURRENT_TIME = 57662
+const LOCALTIME = 57663
+const LOCALTIMESTAMP = 57664
+const CURRENT_USER = 57665
+const UTC_DATE = 57666
+const UTC_TIME = 57667
+const UTC_TIMESTAMP = 57668
+const REPLACE = 57669
+const CONVERT = 57670
+const CAST = 57671
+const SUBSTR = 57672
+const SUBSTRING = 57673
+const GROUP_CONCAT = 57674
+const SEPARATOR = 57675
+const TIMESTAMPADD = 57676
+const TIMESTAMPDIFF = 57677
+const MATCH = 57678
+const AGAINST = 57679
+const BOOLEAN = 57680
+const LANGUAGE = 57681
+const WITH = 57682
+const QUERY = 57683
+const EXPANSION = 57684
+const WITHOUT = 57685
+const VALIDATION = 57686
+const UNUSED = 57687
+const ARRAY = 57688
+const CUME_DIST = 57689
+const DESCRIPTION = 57690
+const DENSE_RANK = 57691
+const EMPTY = 57692
+const EXCEPT = 57693
+const FIRST_VALUE = 57694
+const GROUPING = 57695
+const GROUPS = 57696
+const JSON_TABLE = 57697
+const LAG = 57698
+const LAST_VALUE = 57699
+const LATERAL = 57700
+const LEAD = 57701
+const MEMBER = 57702
+const NTH_VALUE = 57703
+const NTILE = 57704
+const OF = 57705
+const OVER = 57706
+const PERCENT_RANK = 57707
+const RANK = 57708
+const RECURSIVE = 57709
+const ROW_NUMBER = 57710
+const SYSTEM = 57711
+const WINDOW = 57712
+const ACTIVE = 57713
+const ADMIN = 57714
+const BUCKETS = 57715
+const CLONE = 57716
+const COMPONENT = 57717
+const DEFINITION = 57718
+const ENFORCED = 57719
+const EXCLUDE = 57720
+const FOLLOWING = 57721
+const GEOMCOLLECTION = 57722
+const GET_MASTER_PUBLIC_KEY = 57723
+const HISTOGRAM = 57724
+const HISTORY = 57725
+const INACTIVE = 57726
+const INVISIBLE = 57727
+const LOCKED = 57728
+const MASTER_COMPRESSION_ALGORITHMS = 57729
+const MASTER_PUBLIC_KEY_PATH = 57730
+const MASTER_TLS_CIPHERSUITES = 57731
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57732
+const NESTED = 57733
+const NETWORK_NAMESPACE = 57734
+const NOWAIT = 57735
+const NULLS = 57736
+const OJ = 57737
+const OLD = 57738
+const OPTIONAL = 57739
+const ORDINALITY = 57740
+const ORGANIZATION = 57741
+const OTHERS = 57742
+const PATH = 57743
+const PERSIST = 57744
+const PERSIST_ONLY = 57745
+const PRECEDING = 57746
+const PRIVILEGE_CHECKS_USER = 57747
+const PROCESS = 57748
+const RANDOM = 57749
+const REFERENCE = 57750
+const REQUIRE_ROW_FORMAT = 57751
+const RESOURCE = 57752
+const RESPECT = 57753
+const RESTART = 57754
+const RETAIN = 57755
+const REUSE = 57756
+const ROLE = 57757
+const SECONDARY = 57758
+const SECONDARY_ENGINE = 57759
+const SECONDARY_LOAD = 57760
+const SECONDARY_UNLOAD = 57761
+const SKIP = 57762
+const SRID = 57763
+const THREAD_PRIORITY = 57764
+const TIES = 57765
+const UNBOUNDED = 57766
+const VCPU = 57767
+const VISIBLE = 57768
+const FORMAT = 57769
+const TREE = 57770
+const VITESS = 57771
+const TRADITIONAL = 57772
+const LOCAL = 57773
+const LOW_PRIORITY = 57774
+const NO_WRITE_TO_BINLOG = 57775
+const LOGS = 57776
+const ERROR = 57777
+const GENERAL = 57778
+const HOSTS = 57779
+const OPTIMIZER_COSTS = 57780
+const USER_RESOURCES = 57781
+const SLOW = 57782
+const CHANNEL = 57783
+const RELAY = 57784
+const EXPORT = 57785
+const AVG_ROW_LENGTH = 57786
+const CONNECTION = 57787
+const CHECKSUM = 57788
+const DELAY_KEY_WRITE = 57789
+const ENCRYPTION = 57790
+const ENGINE = 57791
+const INSERT_METHOD = 57792
+const MAX_ROWS = 57793
+const MIN_ROWS = 57794
+const PACK_KEYS = 57795
+const PASSWORD = 57796
+const FIXED = 57797
+const DYNAMIC = 57798
+const COMPRESSED = 57799
+const REDUNDANT = 57800
+const COMPACT = 57801
+const ROW_FORMAT = 57802
+const STATS_AUTO_RECALC = 57803
+const STATS_PERSISTENT = 57804
+const STATS_SAMPLE_PAGES = 57805
+const STORAGE = 57806
+const MEMORY = 57807
+const DISK = 57808
 
 var yyToknames = [...]string{
 	"$end",
@@ -618,6 +621,9 @@ var yyToknames = [...]string{
 	"STORED",
 	"LOWER_THAN_CHARSET",
 	"CHARSET",
+	"LOWER_THAN_COLUMN_NAME",
+	"AFTER",
+	"REMOVE",
 	"UNIQUE",
 	"KEY",
 	"OR",
@@ -709,7 +715,6 @@ var yyToknames = [...]string{
 	"EXCHANGE",
 	"REBUILD",
 	"PARTITIONING",
-	"REMOVE",
 	"MAXVALUE",
 	"PARTITION",
 	"REORGANIZE",
@@ -717,6 +722,8 @@ var yyToknames = [...]string{
 	"THAN",
 	"PROCEDURE",
 	"TRIGGER",
+	"LINEAR",
+	"RANGE",
 	"VINDEX",
 	"VINDEXES",
 	"DIRECTORY",
@@ -737,7 +744,6 @@ var yyToknames = [...]string{
 	"INVOKER",
 	"SECURITY",
 	"FIRST",
-	"AFTER",
 	"LAST",
 	"VITESS_MIGRATION",
 	"CANCEL",
@@ -1003,643 +1009,849 @@ var yyExca = [...]int{
 	-2, 0,
 	-1, 45,
 	1, 112,
-	481, 112,
+	484, 112,
 	-2, 118,
 	-1, 46,
 	111, 118,
-	150, 118,
-	265, 118,
-	-2, 341,
+	153, 118,
+	268, 118,
+	-2, 342,
 	-1, 53,
-	33, 489,
-	172, 489,
-	183, 489,
-	216, 503,
-	217, 503,
-	-2, 491,
+	33, 510,
+	175, 510,
+	186, 510,
+	220, 524,
+	221, 524,
+	-2, 512,
 	-1, 58,
-	174, 513,
-	-2, 511,
+	177, 534,
+	-2, 532,
 	-1, 84,
-	57, 581,
-	-2, 589,
+	57, 602,
+	-2, 610,
 	-1, 97,
-	171, 955,
+	174, 977,
 	-2, 91,
 	-1, 99,
 	1, 113,
-	481, 113,
+	484, 113,
 	-2, 118,
 	-1, 109,
-	112, 244,
-	177, 244,
+	115, 244,
+	180, 244,
 	-2, 335,
 	-1, 128,
 	111, 118,
-	150, 118,
-	265, 118,
-	-2, 350,
+	153, 118,
+	268, 118,
+	-2, 351,
 	-1, 569,
-	157, 976,
-	-2, 972,
+	160, 998,
+	-2, 994,
 	-1, 570,
-	157, 977,
-	-2, 973,
+	160, 999,
+	-2, 995,
 	-1, 589,
-	57, 582,
-	-2, 594,
+	57, 603,
+	-2, 615,
 	-1, 590,
-	57, 583,
-	-2, 595,
+	57, 604,
+	-2, 616,
 	-1, 611,
-	125, 1327,
+	128, 1349,
 	-2, 84,
 	-1, 612,
-	125, 1208,
+	128, 1230,
 	-2, 85,
 	-1, 618,
-	125, 1259,
-	-2, 949,
+	128, 1281,
+	-2, 971,
 	-1, 758,
-	125, 1142,
-	-2, 946,
-	-1, 794,
-	182, 38,
-	187, 38,
+	128, 1164,
+	-2, 968,
+	-1, 796,
+	185, 38,
+	190, 38,
 	-2, 255,
-	-1, 871,
-	1, 388,
-	481, 388,
-	-2, 118,
-	-1, 1112,
-	1, 285,
-	481, 285,
+	-1, 874,
+	1, 391,
+	484, 391,
 	-2, 118,
 	-1, 1115,
+	111, 118,
+	153, 118,
+	268, 118,
+	-2, 285,
+	-1, 1118,
 	23, 137,
 	-2, 139,
-	-1, 1188,
-	112, 244,
-	177, 244,
+	-1, 1192,
+	115, 244,
+	180, 244,
 	-2, 335,
-	-1, 1197,
-	182, 39,
-	187, 39,
+	-1, 1201,
+	185, 39,
+	190, 39,
 	-2, 256,
-	-1, 1406,
-	157, 981,
-	-2, 975,
-	-1, 1497,
+	-1, 1411,
+	160, 1003,
+	-2, 997,
+	-1, 1502,
 	75, 66,
 	83, 66,
 	-2, 70,
-	-1, 1518,
-	1, 286,
-	481, 286,
-	-2, 118,
-	-1, 1951,
-	5, 842,
-	18, 842,
-	20, 842,
-	31, 842,
-	84, 842,
-	-2, 621,
-	-1, 2185,
-	47, 917,
-	-2, 911,
+	-1, 1523,
+	111, 118,
+	153, 118,
+	268, 118,
+	-2, 286,
+	-1, 1968,
+	5, 863,
+	18, 863,
+	20, 863,
+	31, 863,
+	84, 863,
+	-2, 642,
+	-1, 2220,
+	47, 939,
+	-2, 933,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 29660
+const yyLast = 31370
 
 var yyAct = [...]int{
-	569, 2281, 2105, 2010, 2238, 2215, 2225, 2251, 931, 1775,
-	2162, 83, 3, 1737, 2186, 2132, 1782, 1931, 1586, 1704,
-	2102, 1783, 1013, 1443, 1932, 527, 1060, 1827, 1738, 1928,
-	512, 1551, 1807, 1067, 1871, 541, 1831, 1556, 510, 1571,
-	137, 824, 1724, 2124, 1392, 1808, 1890, 1169, 1809, 165,
-	1400, 1943, 165, 1664, 475, 165, 1584, 1094, 882, 1304,
-	491, 1494, 165, 582, 1195, 761, 1515, 81, 123, 1558,
-	165, 1617, 616, 1570, 1801, 1104, 1097, 1070, 789, 1476,
-	591, 1065, 1087, 1445, 1052, 1426, 576, 1483, 503, 911,
-	542, 34, 491, 1090, 1369, 491, 165, 491, 514, 802,
-	613, 1202, 1568, 1287, 769, 790, 1301, 949, 768, 765,
-	1547, 1459, 795, 1403, 791, 1103, 1499, 1537, 1077, 792,
-	1309, 1101, 140, 79, 106, 34, 1088, 867, 1026, 100,
-	1164, 101, 8, 7, 33, 929, 6, 78, 107, 1615,
-	1187, 498, 1851, 1850, 1029, 1878, 167, 168, 169, 1536,
-	1879, 1273, 2134, 1358, 950, 167, 168, 169, 1440, 1441,
-	1213, 1357, 1356, 1355, 598, 602, 1354, 826, 102, 829,
-	578, 450, 478, 762, 1353, 501, 777, 502, 1346, 2270,
-	840, 841, 1702, 844, 845, 846, 847, 108, 950, 850,
-	851, 852, 853, 854, 855, 856, 857, 858, 859, 860,
-	861, 862, 863, 864, 2182, 499, 1979, 2081, 2158, 617,
-	806, 2157, 467, 610, 577, 772, 828, 827, 2297, 960,
-	784, 466, 102, 2100, 84, 1654, 2101, 2248, 2296, 783,
-	805, 782, 464, 80, 2208, 2289, 837, 554, 2106, 560,
-	561, 558, 559, 1603, 557, 556, 555, 1563, 2247, 830,
-	831, 832, 1907, 960, 562, 563, 2207, 2043, 842, 1178,
-	1703, 86, 87, 88, 89, 90, 91, 1858, 1561, 97,
-	461, 1857, 162, 1957, 781, 445, 876, 877, 1768, 473,
-	1442, 1767, 1510, 1511, 1769, 1105, 102, 1106, 1500, 1958,
-	1959, 870, 1877, 1652, 1509, 575, 35, 161, 889, 72,
-	39, 40, 918, 890, 920, 901, 906, 907, 573, 956,
-	572, 888, 948, 887, 889, 902, 1791, 895, 478, 890,
-	2012, 103, 927, 1530, 1529, 2212, 479, 776, 2034, 778,
-	779, 2032, 489, 1345, 145, 493, 487, 1293, 1056, 866,
-	917, 919, 1832, 956, 1347, 1348, 1349, 167, 168, 169,
-	478, 1585, 2006, 1560, 451, 1429, 453, 468, 1854, 481,
-	2007, 480, 457, 1618, 455, 459, 469, 460, 2295, 454,
-	1288, 465, 71, 478, 456, 470, 471, 485, 484, 472,
-	1623, 463, 482, 781, 865, 781, 478, 773, 142, 843,
-	143, 903, 908, 896, 775, 774, 2013, 904, 905, 160,
-	924, 872, 909, 910, 1866, 2271, 2171, 975, 974, 984,
-	985, 977, 978, 979, 980, 981, 982, 983, 976, 926,
-	2154, 986, 1628, 1626, 1627, 785, 2095, 1630, 915, 1631,
-	1633, 1632, 916, 1624, 849, 848, 780, 1263, 2014, 585,
-	1620, 779, 921, 1622, 813, 165, 869, 165, 811, 1891,
-	165, 955, 952, 953, 954, 959, 961, 958, 804, 957,
-	1587, 1477, 822, 914, 146, 821, 951, 1787, 922, 820,
-	819, 818, 479, 151, 817, 816, 491, 491, 491, 1264,
-	1978, 1265, 1294, 1621, 815, 955, 952, 953, 954, 959,
-	961, 958, 1893, 957, 491, 491, 810, 786, 1181, 823,
-	951, 766, 2292, 766, 479, 764, 483, 942, 798, 1500,
-	885, 804, 891, 892, 893, 894, 2285, 803, 923, 1856,
-	2287, 766, 807, 797, 476, 899, 1562, 479, 797, 1302,
-	839, 868, 808, 928, 1569, 604, 1867, 2206, 1609, 477,
-	479, 1298, 814, 936, 2213, 780, 812, 780, 833, 1201,
-	809, 1986, 1853, 1916, 1895, 1915, 1899, 1914, 1894, 1653,
-	1892, 925, 804, 1176, 1175, 1897, 930, 930, 930, 1174,
-	803, 1870, 1843, 165, 1896, 1299, 797, 800, 801, 138,
-	766, 1172, 2239, 449, 794, 798, 34, 1898, 1900, 444,
-	1058, 1275, 1274, 1276, 1277, 1278, 1705, 1707, 99, 995,
-	997, 491, 1683, 793, 165, 886, 165, 165, 996, 491,
-	1057, 1873, 933, 934, 1200, 491, 1872, 613, 998, 999,
-	804, 803, 878, 1865, 1680, 2172, 1864, 2193, 945, 943,
-	1010, 73, 944, 804, 1015, 1016, 1017, 1018, 1019, 1020,
-	1021, 1022, 2063, 1025, 1027, 1030, 1030, 1030, 1027, 1030,
-	1030, 1027, 1030, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
-	1605, 875, 1956, 1729, 898, 1055, 1053, 1086, 804, 34,
-	1672, 2283, 1071, 1873, 2284, 900, 2282, 1014, 1872, 803,
-	1595, 838, 1505, 1028, 1031, 1033, 1035, 1036, 1038, 1040,
-	1041, 1292, 803, 1081, 1011, 880, 1092, 807, 797, 1706,
-	1032, 1034, 1069, 1037, 1039, 976, 1042, 808, 986, 1516,
-	986, 1764, 912, 1050, 975, 974, 984, 985, 977, 978,
-	979, 980, 981, 982, 983, 976, 617, 803, 986, 1455,
-	963, 1341, 94, 797, 800, 801, 1310, 766, 966, 1909,
-	884, 794, 798, 167, 168, 169, 966, 1394, 998, 999,
-	139, 144, 141, 147, 148, 149, 150, 152, 153, 154,
-	155, 165, 871, 965, 963, 1165, 156, 157, 158, 159,
-	998, 999, 2202, 1665, 1173, 1376, 825, 1289, 1941, 1290,
-	966, 1427, 1291, 95, 1619, 1778, 1295, 1107, 586, 1374,
-	1375, 1373, 1604, 491, 946, 1197, 1820, 979, 980, 981,
-	982, 983, 976, 1206, 1059, 986, 1427, 1210, 1690, 1395,
-	491, 491, 2137, 491, 1966, 491, 491, 1602, 491, 491,
-	491, 491, 491, 491, 1965, 1591, 1179, 1180, 1212, 1211,
-	1779, 1199, 1597, 491, 2264, 913, 1207, 165, 1246, 1600,
-	975, 974, 984, 985, 977, 978, 979, 980, 981, 982,
-	983, 976, 1781, 165, 986, 1776, 1601, 1186, 1193, 1311,
-	883, 1241, 1242, 1074, 491, 813, 165, 1205, 1785, 1786,
-	1678, 811, 2080, 1777, 2290, 1961, 2293, 1300, 1677, 2276,
-	2079, 165, 1597, 1984, 1249, 1250, 1805, 964, 965, 963,
-	1255, 1256, 167, 168, 169, 1911, 1796, 165, 1243, 1171,
-	1203, 1203, 2291, 1204, 165, 966, 1599, 2277, 1282, 964,
-	965, 963, 1184, 165, 165, 165, 165, 165, 165, 165,
-	165, 165, 491, 491, 491, 1196, 1182, 966, 1183, 1657,
-	1658, 1659, 507, 1784, 1460, 1461, 1259, 977, 978, 979,
-	980, 981, 982, 983, 976, 1787, 2294, 986, 1102, 165,
-	71, 1244, 1804, 1306, 1314, 1566, 603, 1457, 1797, 1283,
-	600, 1318, 1372, 1320, 1321, 1322, 1323, 1268, 1267, 1281,
-	1327, 1215, 1679, 1216, 1918, 1218, 1220, 1312, 1313, 1224,
-	1226, 1228, 1230, 1232, 1342, 1303, 1266, 1393, 167, 168,
-	169, 1317, 1771, 2280, 102, 1257, 1396, 1251, 1324, 1325,
-	1326, 783, 1177, 782, 1248, 1370, 1352, 167, 168, 169,
-	491, 1579, 930, 930, 930, 167, 168, 169, 1316, 1577,
-	1404, 1456, 1919, 964, 965, 963, 504, 984, 985, 977,
-	978, 979, 980, 981, 982, 983, 976, 1397, 1398, 986,
-	608, 966, 1247, 1780, 491, 491, 605, 606, 1410, 1280,
-	1415, 1418, 964, 965, 963, 165, 1428, 1337, 1338, 1339,
-	1222, 1270, 2279, 964, 965, 963, 2278, 2265, 1371, 491,
-	966, 1405, 2259, 2257, 2121, 1806, 165, 2077, 1448, 491,
-	2009, 966, 2051, 165, 1406, 165, 167, 168, 169, 1964,
-	1404, 1920, 1814, 165, 165, 1802, 1648, 1434, 1435, 80,
-	491, 1613, 1612, 491, 1495, 964, 965, 963, 613, 586,
-	1279, 613, 1449, 1307, 491, 1271, 1258, 1254, 1253, 1252,
-	2152, 1450, 1269, 966, 964, 965, 963, 1993, 2245, 1411,
-	1412, 1462, 1014, 1417, 1420, 1421, 1407, 1364, 1366, 1367,
-	2151, 1474, 966, 2104, 2231, 1993, 2200, 2229, 1993, 2195,
-	1834, 1498, 1470, 1519, 1406, 1365, 2233, 2234, 1817, 1433,
-	1785, 1786, 1436, 1437, 1524, 2230, 1993, 2194, 1531, 491,
-	1532, 1533, 1534, 1535, 1725, 1572, 1573, 1574, 586, 1520,
-	1576, 1578, 1940, 1496, 2176, 586, 1543, 1544, 1545, 1546,
-	2098, 586, 1523, 491, 35, 1553, 1758, 1472, 1725, 491,
-	1206, 1993, 2096, 1206, 1500, 1206, 2058, 1559, 1597, 586,
-	2061, 586, 1503, 1596, 1976, 1975, 1507, 617, 1929, 1522,
-	617, 1506, 1972, 1973, 2201, 1784, 1811, 1940, 1521, 82,
-	2040, 530, 529, 532, 533, 534, 535, 1787, 1972, 1971,
-	531, 1598, 536, 491, 1480, 1393, 1468, 586, 1500, 1852,
-	1393, 1393, 1168, 1836, 1829, 1830, 1480, 586, 1583, 1501,
-	1501, 962, 586, 35, 1590, 35, 570, 1593, 1940, 1594,
-	71, 1549, 1550, 1564, 1554, 1567, 1479, 1538, 1539, 1540,
-	1575, 1565, 1168, 1167, 1113, 1112, 165, 1732, 1469, 1608,
-	962, 806, 1993, 165, 1610, 1611, 1468, 1597, 165, 165,
-	1607, 1203, 165, 1592, 165, 1974, 1554, 1589, 1606, 1588,
-	165, 805, 1733, 1480, 2139, 166, 1508, 165, 166, 1695,
-	1694, 166, 1502, 1502, 1468, 579, 492, 2082, 166, 1480,
-	1504, 1500, 1237, 71, 2148, 1597, 166, 1580, 1458, 71,
-	2164, 71, 2103, 1616, 165, 491, 975, 974, 984, 985,
-	977, 978, 979, 980, 981, 982, 983, 976, 492, 1468,
-	986, 492, 166, 492, 1438, 1350, 1297, 1099, 1408, 1409,
-	788, 1643, 1644, 787, 2074, 2069, 1646, 2083, 2084, 2085,
-	870, 1170, 1238, 1239, 1240, 1647, 975, 974, 984, 985,
-	977, 978, 979, 980, 981, 982, 983, 976, 1552, 2008,
-	986, 71, 1968, 1837, 1548, 1542, 1636, 1541, 970, 1370,
-	973, 1285, 1198, 1194, 1451, 1166, 987, 988, 989, 990,
-	991, 992, 993, 96, 971, 972, 969, 975, 974, 984,
-	985, 977, 978, 979, 980, 981, 982, 983, 976, 165,
-	1810, 986, 2011, 2086, 1944, 1945, 2165, 165, 1000, 1001,
-	1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1651, 1563,
-	2261, 2226, 1991, 1990, 1989, 1947, 1234, 967, 1660, 1929,
-	165, 1821, 1371, 1637, 1674, 1343, 1751, 1711, 1489, 1490,
-	1950, 165, 165, 165, 165, 165, 1949, 1811, 1734, 1718,
-	2087, 2088, 1746, 165, 1673, 1671, 1745, 165, 578, 2273,
-	165, 165, 1749, 504, 165, 165, 165, 1750, 1756, 2246,
-	1739, 1689, 1024, 1235, 1236, 1669, 1670, 1770, 1730, 1747,
-	1921, 1714, 1068, 1701, 1748, 2187, 2189, 2062, 1996, 1723,
-	1709, 1727, 1053, 1722, 2190, 1708, 1687, 2275, 1795, 2217,
-	1717, 2250, 577, 2252, 1063, 1066, 1726, 2216, 1712, 2220,
-	2184, 1728, 1296, 571, 1789, 1527, 1713, 1815, 835, 1773,
-	1092, 1759, 834, 1740, 491, 1761, 1743, 1735, 1736, 165,
-	2021, 1092, 1092, 1092, 1092, 1092, 165, 1757, 1306, 1752,
-	1061, 1762, 491, 1810, 1765, 1876, 935, 1496, 491, 2056,
-	1092, 1062, 1206, 1206, 1092, 1845, 1844, 1559, 491, 1774,
-	1423, 103, 1813, 1794, 1840, 1798, 1799, 1800, 1741, 1742,
-	1849, 1744, 1460, 1461, 1424, 1453, 1833, 1803, 596, 592,
-	1987, 165, 165, 165, 165, 165, 1640, 1812, 1485, 1488,
-	1489, 1490, 1486, 593, 1487, 1491, 2197, 165, 165, 1818,
-	2159, 1788, 1493, 1629, 1847, 1656, 1822, 1823, 1824, 1186,
-	2258, 596, 592, 580, 581, 1405, 1838, 1839, 1072, 1073,
-	595, 1721, 594, 1846, 583, 2256, 593, 2255, 1406, 1720,
-	2221, 2219, 2055, 491, 1992, 1848, 1581, 584, 82, 1393,
-	2054, 1887, 1792, 1793, 1924, 1725, 2263, 2262, 579, 1842,
-	1684, 589, 590, 595, 1868, 594, 1681, 1082, 1075, 2263,
-	2191, 1889, 1963, 1454, 80, 85, 77, 1888, 1, 491,
-	2228, 166, 462, 166, 1439, 1880, 166, 1051, 474, 2224,
-	165, 1908, 1886, 1901, 1272, 1262, 1902, 2107, 2161, 1999,
-	491, 1557, 1874, 796, 128, 1875, 491, 491, 1887, 1517,
-	1518, 2241, 492, 492, 492, 93, 1930, 1485, 1488, 1489,
-	1490, 1486, 1667, 1487, 1491, 759, 1668, 1944, 1945, 165,
-	492, 492, 92, 1739, 799, 897, 1582, 1675, 1676, 1933,
-	2099, 1790, 1528, 1682, 1119, 1117, 1685, 1686, 1118, 1116,
-	1939, 1121, 1120, 1115, 1692, 1344, 1693, 488, 165, 1696,
-	1697, 1698, 1699, 1700, 1927, 1948, 1492, 163, 1108, 1076,
-	1952, 836, 1954, 1710, 1955, 452, 1977, 1340, 1614, 458,
-	994, 1719, 1766, 1960, 614, 607, 1985, 1935, 2214, 2183,
-	1953, 2185, 165, 2133, 1934, 2188, 34, 2181, 2274, 2249,
-	491, 2196, 1525, 1452, 1064, 2053, 1923, 1688, 491, 166,
-	1023, 1425, 1091, 513, 165, 1308, 1447, 1363, 528, 1092,
-	1754, 1755, 1982, 1983, 165, 1981, 525, 1980, 526, 1969,
-	1970, 2000, 1463, 1995, 1731, 1998, 968, 492, 165, 1917,
-	166, 165, 166, 166, 1997, 492, 1559, 511, 505, 1083,
-	2022, 492, 1484, 2003, 2002, 1482, 1481, 1638, 1095, 1946,
-	1942, 1089, 1467, 1526, 1855, 1994, 2005, 1938, 947, 588,
-	500, 1368, 2017, 2016, 1377, 1378, 1379, 1380, 1381, 1382,
-	1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391, 771,
-	1422, 2170, 1655, 1359, 1360, 1361, 1362, 2030, 2042, 587,
-	61, 38, 495, 2019, 2020, 2269, 938, 597, 32, 2027,
-	2028, 31, 2029, 30, 29, 2031, 28, 2033, 23, 22,
-	21, 20, 2025, 19, 25, 18, 17, 16, 98, 48,
-	2057, 45, 43, 1430, 105, 104, 46, 42, 873, 2066,
-	27, 1739, 26, 2065, 15, 14, 13, 2046, 1413, 1414,
-	12, 11, 10, 9, 5, 4, 2071, 165, 941, 2073,
-	165, 165, 165, 491, 491, 24, 2072, 1012, 2041, 2,
-	0, 0, 0, 0, 0, 2047, 2048, 2049, 0, 0,
-	0, 0, 2108, 491, 491, 491, 504, 0, 0, 0,
-	2093, 0, 0, 0, 0, 1884, 1885, 166, 0, 2114,
-	975, 974, 984, 985, 977, 978, 979, 980, 981, 982,
-	983, 976, 0, 0, 986, 0, 0, 0, 491, 491,
-	491, 165, 2112, 0, 0, 0, 0, 0, 0, 492,
-	0, 0, 491, 0, 491, 0, 0, 0, 1514, 2052,
-	491, 0, 2140, 0, 2130, 491, 492, 492, 2136, 492,
-	2142, 492, 492, 2138, 492, 492, 492, 492, 492, 492,
-	0, 1936, 2128, 2129, 1933, 0, 0, 2145, 1933, 492,
-	0, 0, 2147, 166, 491, 0, 2120, 491, 0, 0,
-	2153, 0, 1951, 0, 0, 2163, 0, 2156, 2155, 166,
-	0, 2076, 0, 2078, 0, 0, 0, 1555, 0, 2144,
-	492, 2160, 166, 0, 0, 2146, 0, 0, 0, 0,
-	0, 0, 0, 2149, 0, 2150, 0, 166, 0, 1934,
-	2180, 34, 0, 1934, 0, 0, 0, 2192, 0, 0,
-	0, 0, 0, 166, 0, 491, 165, 0, 0, 0,
-	166, 2199, 0, 0, 0, 1933, 0, 491, 2113, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 492, 492,
-	492, 0, 2203, 0, 491, 0, 0, 2211, 0, 0,
-	2218, 2131, 491, 491, 0, 2222, 2227, 0, 0, 2235,
-	2163, 2242, 2240, 2232, 0, 166, 0, 0, 0, 0,
-	2254, 2253, 1739, 0, 0, 0, 0, 0, 0, 0,
-	1934, 2260, 0, 0, 0, 0, 0, 0, 0, 0,
-	2266, 0, 2024, 0, 0, 2198, 2026, 0, 0, 2272,
-	34, 0, 0, 0, 0, 0, 0, 2035, 2036, 0,
-	0, 0, 0, 0, 0, 2286, 0, 0, 0, 0,
-	0, 0, 0, 2050, 540, 2288, 492, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 34, 0,
-	2059, 2060, 0, 0, 2064, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1661, 1662, 1663,
-	492, 492, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 166, 0, 164, 0, 0, 448, 2039, 0, 486,
-	0, 0, 0, 0, 0, 492, 448, 2045, 0, 161,
-	0, 0, 166, 0, 448, 492, 0, 0, 0, 166,
-	0, 166, 0, 0, 0, 2097, 0, 0, 0, 166,
-	166, 601, 601, 103, 0, 125, 492, 0, 0, 492,
-	448, 0, 0, 0, 0, 0, 145, 0, 0, 0,
-	492, 0, 0, 0, 0, 0, 0, 0, 1691, 0,
-	975, 974, 984, 985, 977, 978, 979, 980, 981, 982,
-	983, 976, 0, 2125, 986, 0, 0, 135, 0, 0,
-	0, 0, 124, 0, 0, 0, 0, 1715, 1716, 1066,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	142, 0, 143, 0, 0, 492, 0, 112, 113, 134,
-	133, 160, 0, 975, 974, 984, 985, 977, 978, 979,
-	980, 981, 982, 983, 976, 0, 0, 986, 0, 492,
-	0, 0, 0, 0, 0, 492, 0, 0, 0, 0,
-	0, 2166, 2167, 2168, 2169, 0, 2173, 0, 2174, 2175,
-	2177, 0, 0, 0, 2178, 2179, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 110, 136, 117, 109,
-	0, 130, 131, 0, 0, 0, 146, 0, 0, 492,
-	0, 0, 0, 0, 0, 151, 118, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2205, 0, 0, 0,
-	121, 119, 114, 115, 116, 120, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 0, 0, 0, 122,
-	0, 161, 166, 0, 0, 0, 0, 0, 0, 166,
-	0, 0, 0, 0, 166, 166, 0, 0, 166, 0,
-	166, 539, 0, 0, 0, 103, 166, 0, 0, 0,
-	0, 0, 0, 166, 0, 0, 0, 0, 145, 0,
-	0, 0, 2267, 2268, 0, 0, 0, 0, 1882, 1883,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	166, 492, 0, 1903, 1904, 0, 1905, 1906, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 1912, 1913, 1772,
-	0, 490, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 142, 0, 143, 0, 0, 0, 0, 0,
-	0, 1910, 0, 160, 0, 0, 0, 2038, 0, 0,
-	0, 0, 0, 615, 0, 0, 763, 0, 770, 0,
-	0, 0, 0, 0, 0, 0, 132, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1925, 0, 126, 0,
-	0, 127, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1136, 0, 0, 166, 0, 0, 0, 0,
-	0, 1962, 0, 166, 0, 0, 0, 0, 146, 448,
-	0, 448, 0, 0, 448, 0, 0, 151, 0, 0,
-	0, 0, 0, 0, 0, 0, 166, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 166, 166, 166,
-	166, 166, 0, 0, 0, 0, 0, 0, 0, 166,
-	0, 0, 0, 166, 0, 0, 166, 166, 0, 0,
-	166, 166, 166, 975, 974, 984, 985, 977, 978, 979,
-	980, 981, 982, 983, 976, 0, 0, 986, 0, 0,
-	0, 0, 139, 144, 141, 147, 148, 149, 150, 152,
-	153, 154, 155, 0, 0, 0, 0, 0, 156, 157,
-	158, 159, 0, 0, 2023, 0, 0, 0, 0, 0,
-	0, 0, 0, 1124, 0, 0, 0, 0, 0, 0,
-	492, 0, 0, 138, 0, 166, 0, 0, 0, 0,
-	0, 0, 166, 0, 0, 0, 0, 448, 492, 0,
+	569, 2322, 2136, 2197, 1450, 2274, 827, 2035, 2277, 2251,
+	2261, 2221, 2290, 2163, 1948, 1746, 1794, 934, 1713, 2133,
+	541, 1520, 1949, 1448, 2024, 2023, 83, 3, 1016, 1063,
+	527, 512, 1747, 1786, 1793, 1945, 1844, 1541, 1556, 1818,
+	1848, 2155, 510, 1070, 1733, 885, 1888, 1172, 1576, 165,
+	1820, 1819, 165, 1561, 475, 165, 1397, 1960, 1907, 1673,
+	491, 137, 165, 1218, 1097, 1593, 616, 914, 761, 1309,
+	165, 1199, 1626, 1499, 1575, 1563, 1812, 81, 791, 1405,
+	123, 1107, 786, 1100, 1090, 1481, 1488, 1073, 591, 1068,
+	1093, 582, 491, 1055, 503, 491, 165, 491, 1431, 33,
+	576, 514, 1091, 1374, 1306, 768, 1573, 1206, 1292, 613,
+	769, 1106, 1464, 792, 794, 1104, 765, 797, 793, 1080,
+	952, 1504, 79, 1314, 1552, 804, 870, 1029, 498, 8,
+	78, 7, 829, 1167, 1542, 1032, 6, 1868, 1867, 932,
+	140, 106, 100, 101, 1191, 843, 844, 107, 847, 848,
+	849, 850, 1624, 1278, 853, 854, 855, 856, 857, 858,
+	859, 860, 861, 862, 863, 864, 865, 866, 867, 2276,
+	2308, 1895, 1896, 762, 598, 602, 167, 168, 169, 577,
+	1445, 1446, 1363, 1362, 2275, 1361, 102, 2165, 450, 1360,
+	832, 108, 499, 1359, 1358, 161, 501, 1711, 502, 2311,
+	777, 772, 2217, 2106, 2193, 1351, 2192, 610, 1996, 1408,
+	84, 953, 2131, 831, 2286, 2132, 2343, 830, 2287, 103,
+	2342, 125, 1663, 2244, 2334, 807, 617, 2137, 1612, 80,
+	1924, 2068, 145, 1568, 2243, 1181, 808, 784, 1975, 1976,
+	102, 1712, 783, 782, 833, 834, 835, 86, 87, 88,
+	89, 90, 91, 1514, 1566, 97, 1515, 1516, 162, 1974,
+	1894, 445, 840, 135, 1661, 1108, 1875, 1109, 124, 71,
+	1874, 904, 930, 573, 572, 845, 781, 963, 879, 880,
+	1802, 575, 909, 910, 1777, 1505, 142, 1776, 143, 905,
+	1778, 873, 2059, 112, 113, 134, 133, 160, 892, 1535,
+	1534, 2057, 1447, 893, 102, 2206, 978, 977, 987, 988,
+	980, 981, 982, 983, 984, 985, 986, 979, 2248, 489,
+	989, 554, 892, 560, 561, 558, 559, 893, 557, 556,
+	555, 478, 898, 779, 1350, 891, 953, 890, 562, 563,
+	2037, 478, 1565, 493, 167, 168, 169, 487, 1637, 1635,
+	1636, 1059, 1268, 1434, 129, 110, 136, 117, 109, 1298,
+	130, 131, 1594, 869, 906, 146, 959, 911, 929, 951,
+	478, 1352, 1353, 1354, 151, 118, 35, 912, 1871, 72,
+	39, 40, 1627, 846, 478, 1632, 781, 868, 1293, 121,
+	119, 114, 115, 116, 120, 1269, 1270, 1639, 111, 1640,
+	2030, 1641, 963, 921, 776, 923, 778, 899, 2031, 122,
+	167, 168, 169, 467, 2341, 2038, 907, 908, 927, 913,
+	875, 1883, 466, 1642, 2312, 852, 785, 851, 1631, 2039,
+	1629, 1588, 1587, 464, 1596, 2189, 478, 585, 780, 1633,
+	920, 922, 2126, 787, 816, 165, 814, 165, 788, 872,
+	165, 1482, 71, 825, 824, 823, 822, 821, 820, 819,
+	71, 902, 781, 818, 773, 813, 1184, 826, 925, 1630,
+	461, 775, 774, 1798, 2337, 766, 491, 491, 491, 764,
+	138, 473, 1995, 2332, 1842, 507, 766, 766, 479, 1205,
+	799, 959, 800, 1307, 491, 491, 1574, 888, 479, 894,
+	895, 896, 897, 1505, 1714, 1716, 604, 1299, 958, 955,
+	956, 957, 962, 964, 961, 1567, 960, 1884, 2242, 779,
+	931, 1873, 945, 954, 2207, 1618, 1303, 479, 939, 918,
+	836, 2003, 1870, 919, 871, 132, 788, 1933, 1932, 928,
+	2249, 479, 926, 924, 817, 1860, 815, 126, 780, 1304,
+	127, 1931, 2326, 806, 1204, 451, 1179, 453, 468, 1662,
+	481, 1887, 480, 457, 917, 455, 459, 469, 460, 1178,
+	454, 1177, 465, 165, 1175, 456, 470, 471, 485, 484,
+	472, 2278, 463, 482, 2234, 449, 444, 1882, 1614, 2228,
+	1881, 2088, 99, 479, 1001, 1002, 1280, 1279, 1281, 1282,
+	1283, 491, 1692, 901, 165, 1061, 165, 165, 1890, 491,
+	1715, 999, 805, 1889, 903, 491, 936, 937, 1973, 1908,
+	1060, 1738, 1681, 1604, 780, 948, 613, 946, 1510, 1084,
+	1689, 1789, 947, 958, 955, 956, 957, 962, 964, 961,
+	889, 960, 1017, 1014, 883, 1521, 881, 878, 954, 842,
+	989, 139, 144, 141, 147, 148, 149, 150, 152, 153,
+	154, 155, 1910, 1056, 1773, 1460, 1890, 156, 157, 158,
+	159, 1889, 806, 915, 979, 94, 1790, 989, 1315, 1089,
+	1074, 1346, 1031, 1034, 1036, 1038, 1039, 1041, 1043, 1044,
+	806, 1035, 1037, 828, 1040, 1042, 966, 1045, 1792, 586,
+	1053, 1787, 969, 806, 167, 168, 169, 483, 1399, 2238,
+	2324, 1958, 969, 2325, 73, 2323, 1628, 1796, 1797, 806,
+	887, 1926, 1788, 1613, 1912, 476, 1916, 1300, 1911, 95,
+	1909, 805, 967, 968, 966, 1914, 1110, 799, 802, 803,
+	477, 766, 806, 617, 1913, 796, 800, 1432, 949, 805,
+	969, 1001, 1002, 1297, 809, 799, 874, 1915, 1917, 811,
+	1831, 165, 805, 795, 810, 1168, 2168, 1983, 799, 802,
+	803, 1982, 766, 1400, 1176, 1600, 796, 800, 805, 1001,
+	1002, 812, 1795, 809, 799, 167, 168, 169, 811, 1807,
+	1062, 1182, 1183, 810, 1798, 491, 1216, 1201, 1072, 916,
+	1432, 805, 1699, 841, 1316, 1210, 1215, 1203, 1849, 1214,
+	1611, 1609, 1217, 491, 491, 816, 491, 814, 491, 491,
+	2335, 491, 491, 491, 491, 491, 491, 980, 981, 982,
+	983, 984, 985, 986, 979, 1381, 491, 989, 2303, 1211,
+	165, 1251, 1294, 886, 1295, 968, 966, 1296, 2336, 1379,
+	1380, 1378, 1254, 1255, 1808, 2317, 165, 1978, 1260, 1261,
+	1264, 1077, 969, 1197, 2105, 1246, 1247, 491, 2104, 165,
+	2011, 1190, 1369, 1371, 1372, 1606, 1209, 1220, 2338, 1221,
+	1305, 1223, 1225, 2318, 165, 1229, 1231, 1233, 1235, 1237,
+	1465, 1466, 1791, 1370, 967, 968, 966, 71, 1174, 1610,
+	165, 603, 1928, 1105, 1248, 2034, 2001, 165, 1606, 1377,
+	2321, 1208, 969, 1666, 1667, 1668, 165, 165, 165, 165,
+	165, 165, 165, 165, 165, 491, 491, 491, 1207, 1207,
+	1200, 1188, 1608, 1816, 1187, 1287, 1285, 1186, 982, 983,
+	984, 985, 986, 979, 1319, 1815, 989, 1571, 2339, 2320,
+	1311, 1323, 165, 1325, 1326, 1327, 1328, 1688, 1317, 1318,
+	1332, 987, 988, 980, 981, 982, 983, 984, 985, 986,
+	979, 1249, 1322, 989, 1347, 1288, 967, 968, 966, 1329,
+	1330, 1331, 967, 968, 966, 1273, 1308, 1272, 1180, 1275,
+	1398, 605, 606, 1375, 969, 608, 1286, 1284, 1271, 1401,
+	969, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011,
+	1012, 1935, 1262, 491, 1256, 102, 1357, 783, 782, 1253,
+	1252, 167, 168, 169, 1321, 1780, 167, 168, 169, 1227,
+	1584, 2319, 1409, 977, 987, 988, 980, 981, 982, 983,
+	984, 985, 986, 979, 1402, 1403, 989, 491, 491, 1687,
+	1274, 967, 968, 966, 1420, 1423, 2304, 1686, 165, 1936,
+	1433, 1415, 2298, 2033, 1342, 1343, 1344, 2296, 2152, 969,
+	2102, 2076, 491, 1981, 1376, 1937, 167, 168, 169, 165,
+	1582, 1411, 491, 1825, 1410, 1813, 165, 1453, 165, 71,
+	1657, 967, 968, 966, 1622, 1621, 165, 165, 1454, 600,
+	1017, 1312, 1409, 491, 1276, 2199, 491, 1263, 1259, 969,
+	1258, 1257, 2267, 2017, 2284, 2265, 586, 491, 2017, 2236,
+	613, 2183, 1500, 613, 2269, 2270, 167, 168, 169, 1439,
+	1440, 1796, 1797, 2017, 2230, 2182, 2266, 80, 1412, 978,
+	977, 987, 988, 980, 981, 982, 983, 984, 985, 986,
+	979, 1411, 1455, 989, 1479, 530, 529, 532, 533, 534,
+	535, 1503, 1467, 1475, 531, 504, 536, 2135, 1543, 1544,
+	1545, 1536, 491, 1537, 1538, 1539, 1540, 1524, 1577, 1578,
+	1579, 2017, 2229, 1581, 1583, 1525, 2211, 586, 1851, 1548,
+	1549, 1550, 1551, 2017, 586, 1528, 1795, 491, 1674, 2129,
+	586, 1477, 1828, 491, 1210, 1558, 1529, 1210, 1798, 1210,
+	2017, 2127, 1817, 1606, 586, 1512, 586, 1605, 1511, 1564,
+	1508, 2086, 586, 1595, 1993, 1992, 1989, 1990, 1416, 1417,
+	1527, 1506, 1422, 1425, 1426, 1526, 1957, 617, 1989, 1988,
+	617, 1473, 586, 1505, 1869, 967, 968, 966, 491, 2107,
+	1398, 1171, 1853, 1846, 1847, 1398, 1398, 82, 1438, 1462,
+	1734, 1441, 1442, 969, 2083, 1592, 1485, 586, 965, 586,
+	1946, 35, 1171, 1170, 2237, 1599, 1484, 586, 1602, 1957,
+	1603, 1506, 1569, 1572, 1559, 1570, 1734, 1580, 1554, 1555,
+	1474, 165, 1116, 1115, 1507, 1741, 35, 1767, 165, 2108,
+	2109, 2110, 1509, 165, 165, 1505, 1617, 165, 1598, 165,
+	807, 1619, 1620, 1601, 1616, 165, 1615, 1559, 1597, 35,
+	1742, 808, 165, 1461, 1473, 1607, 965, 570, 2017, 1485,
+	1485, 1207, 978, 977, 987, 988, 980, 981, 982, 983,
+	984, 985, 986, 979, 1507, 2170, 989, 71, 2134, 165,
+	491, 1242, 1505, 1991, 1485, 1625, 1957, 967, 968, 966,
+	1513, 1473, 1704, 1652, 1653, 1703, 579, 1473, 1655, 1413,
+	1414, 2179, 71, 2300, 1606, 969, 166, 1656, 1589, 166,
+	1463, 1606, 166, 1443, 1355, 1302, 1102, 492, 790, 166,
+	789, 2099, 2094, 1173, 1557, 71, 2032, 166, 1985, 1375,
+	1854, 1243, 1244, 1245, 1821, 1553, 1547, 1546, 1290, 1645,
+	1490, 1493, 1494, 1495, 1491, 1456, 1492, 1496, 1202, 492,
+	1961, 1962, 492, 166, 492, 1198, 978, 977, 987, 988,
+	980, 981, 982, 983, 984, 985, 986, 979, 1169, 96,
+	989, 1822, 71, 873, 165, 2111, 2036, 1239, 1961, 1962,
+	2200, 1822, 165, 1568, 2262, 2008, 1718, 1373, 2007, 2006,
+	1382, 1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391,
+	1392, 1393, 1394, 1395, 1396, 165, 1660, 1964, 1946, 1832,
+	1376, 1646, 1669, 1348, 1967, 1720, 165, 165, 165, 165,
+	165, 1758, 2112, 2113, 1240, 1241, 1759, 1727, 165, 1756,
+	1966, 1755, 165, 1754, 1757, 165, 165, 1683, 1743, 165,
+	165, 165, 577, 1682, 2314, 1760, 1748, 1494, 1495, 1435,
+	2285, 1938, 1779, 1723, 1071, 1739, 2222, 2224, 1765, 1698,
+	2087, 2020, 1732, 1731, 1056, 2225, 2316, 1710, 1490, 1493,
+	1494, 1495, 1491, 1806, 1492, 1496, 2253, 2289, 1736, 2291,
+	1721, 2256, 2219, 1726, 2252, 1301, 571, 1800, 1722, 1532,
+	1826, 1735, 838, 1805, 1737, 1809, 1810, 1811, 837, 491,
+	1749, 2046, 1824, 1752, 165, 1803, 1804, 1311, 1768, 1821,
+	1428, 165, 1770, 1761, 1893, 1784, 491, 491, 1766, 1750,
+	1751, 491, 1753, 1771, 1429, 1774, 938, 491, 1064, 1862,
+	1861, 1210, 1210, 103, 1785, 1782, 970, 491, 2081, 1065,
+	1458, 1564, 1465, 1466, 2004, 1649, 1678, 1679, 2232, 1866,
+	596, 592, 1857, 2194, 1814, 1799, 1850, 1498, 580, 581,
+	165, 165, 165, 165, 165, 593, 1730, 1696, 1823, 1865,
+	1638, 1665, 504, 583, 1729, 1829, 165, 165, 2297, 2295,
+	2294, 1027, 1833, 1834, 1835, 2257, 2255, 1864, 2080, 2016,
+	1075, 1076, 595, 1590, 594, 596, 592, 1185, 584, 82,
+	1190, 1411, 1855, 1856, 1410, 2079, 1941, 1734, 2302, 2301,
+	593, 1863, 491, 1066, 1069, 1693, 1690, 1085, 1398, 1078,
+	2302, 2226, 1980, 1459, 579, 80, 85, 77, 1, 1904,
+	2264, 462, 1444, 1054, 474, 589, 590, 595, 2260, 594,
+	1885, 1277, 1267, 1906, 2138, 2196, 1586, 1837, 491, 1891,
+	2009, 1585, 1892, 1781, 1562, 798, 1905, 128, 1522, 165,
+	1897, 1523, 2280, 93, 759, 92, 801, 900, 1591, 491,
+	1925, 2130, 1801, 1533, 1918, 491, 491, 1919, 1122, 1120,
+	1121, 1119, 1124, 1123, 1118, 1676, 1904, 1947, 1349, 1677,
+	1903, 488, 1497, 1950, 163, 1111, 1079, 839, 165, 452,
+	1684, 1685, 166, 1748, 166, 1994, 1691, 166, 1345, 1694,
+	1695, 1934, 1623, 458, 997, 1728, 1775, 1701, 614, 1702,
+	607, 1956, 1705, 1706, 1707, 1708, 1709, 1952, 2250, 165,
+	2218, 2220, 2164, 492, 492, 492, 1719, 2223, 2216, 1955,
+	1965, 1969, 2315, 1971, 2288, 1972, 2231, 1530, 1457, 1067,
+	2078, 492, 492, 1940, 1697, 1986, 1987, 2002, 1999, 2000,
+	1026, 1944, 1430, 165, 1094, 513, 1452, 1368, 528, 525,
+	526, 1970, 1468, 1740, 971, 1977, 511, 491, 505, 1086,
+	1489, 1487, 1486, 1763, 1764, 491, 1647, 1098, 1963, 1959,
+	1092, 165, 1472, 1531, 1872, 1670, 1671, 1672, 2029, 950,
+	1997, 165, 1998, 588, 500, 771, 1427, 2205, 1664, 2067,
+	587, 2019, 61, 38, 495, 165, 2310, 941, 165, 597,
+	32, 2022, 31, 30, 29, 28, 23, 2047, 22, 21,
+	166, 20, 1564, 19, 2027, 2026, 25, 2021, 18, 17,
+	16, 98, 48, 45, 43, 2018, 105, 104, 46, 42,
+	876, 27, 26, 15, 14, 13, 2042, 12, 492, 11,
+	10, 166, 2041, 166, 166, 9, 492, 2044, 2045, 5,
+	4, 944, 492, 24, 1015, 2, 0, 0, 0, 0,
+	0, 2055, 0, 0, 0, 0, 2052, 2053, 0, 2054,
+	0, 0, 2056, 0, 2058, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2077, 0, 0, 0, 0, 0,
+	0, 0, 0, 2082, 0, 0, 0, 1313, 0, 1748,
+	2091, 0, 0, 0, 0, 0, 0, 2050, 0, 0,
+	2090, 0, 0, 0, 165, 0, 0, 165, 165, 165,
+	0, 2098, 0, 2096, 491, 2097, 491, 491, 491, 0,
+	0, 0, 0, 0, 0, 0, 2101, 0, 2103, 0,
+	0, 0, 1901, 1902, 0, 0, 2118, 2139, 491, 491,
+	491, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2121, 0, 2123, 2124, 2145, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1364, 1365, 1366, 1367, 0,
+	0, 0, 0, 491, 491, 491, 165, 0, 0, 0,
+	2143, 0, 0, 0, 0, 0, 0, 491, 166, 491,
+	0, 2144, 0, 0, 0, 491, 0, 0, 1953, 2161,
+	491, 0, 2173, 0, 1950, 0, 2169, 0, 1950, 2167,
+	0, 0, 2171, 0, 2162, 2159, 2160, 0, 0, 1968,
+	1418, 1419, 492, 2180, 0, 2181, 0, 0, 0, 0,
+	0, 2176, 0, 0, 2184, 491, 2178, 0, 491, 0,
+	492, 492, 0, 492, 2190, 492, 492, 0, 492, 492,
+	492, 492, 492, 492, 2191, 2198, 0, 0, 504, 2151,
+	1899, 1900, 0, 492, 0, 0, 0, 166, 0, 0,
+	0, 2195, 0, 0, 0, 1920, 1921, 0, 1922, 1923,
+	2215, 0, 2175, 166, 0, 2227, 0, 0, 2177, 1929,
+	1930, 1950, 0, 0, 492, 0, 166, 0, 0, 0,
+	491, 165, 0, 0, 0, 0, 0, 0, 0, 0,
+	1519, 166, 491, 0, 0, 540, 0, 0, 0, 0,
+	0, 2235, 0, 0, 0, 0, 0, 166, 0, 491,
+	2247, 0, 2254, 0, 166, 0, 2239, 0, 491, 491,
+	0, 2279, 2258, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 492, 492, 492, 2198, 2281, 2049, 1748, 2271,
+	2293, 2051, 2292, 2071, 164, 2263, 2268, 448, 0, 1560,
+	486, 2299, 2060, 2061, 0, 1979, 0, 448, 0, 166,
+	2305, 0, 0, 2309, 0, 448, 0, 0, 2075, 0,
+	0, 0, 2313, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 601, 601, 2327, 2084, 2085, 0, 0, 2089,
+	165, 448, 2328, 2329, 2331, 2330, 2333, 0, 0, 978,
+	977, 987, 988, 980, 981, 982, 983, 984, 985, 986,
+	979, 165, 0, 989, 0, 2340, 973, 0, 976, 0,
+	492, 0, 0, 0, 990, 991, 992, 993, 994, 995,
+	996, 0, 974, 975, 972, 978, 977, 987, 988, 980,
+	981, 982, 983, 984, 985, 986, 979, 2120, 2122, 989,
+	0, 0, 0, 0, 492, 492, 0, 2128, 0, 0,
+	0, 0, 0, 0, 2048, 166, 0, 0, 161, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 492,
+	0, 0, 0, 0, 0, 0, 166, 0, 0, 492,
+	0, 0, 103, 166, 2065, 166, 0, 0, 0, 161,
+	0, 0, 0, 166, 166, 145, 2156, 0, 0, 0,
+	492, 0, 0, 492, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 103, 492, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 145, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1783, 0, 0, 0,
+	0, 2100, 2185, 2186, 0, 2187, 0, 2188, 0, 142,
+	2064, 143, 0, 0, 0, 0, 0, 0, 0, 0,
+	160, 0, 0, 0, 0, 0, 0, 0, 0, 492,
+	2201, 2202, 2203, 2204, 0, 2208, 0, 2209, 2210, 2212,
+	142, 0, 143, 2213, 2214, 0, 0, 0, 0, 0,
+	2070, 160, 0, 0, 492, 0, 0, 0, 0, 0,
+	492, 0, 1700, 978, 977, 987, 988, 980, 981, 982,
+	983, 984, 985, 986, 979, 0, 0, 989, 2146, 2147,
+	2148, 2149, 2150, 0, 0, 0, 2153, 2154, 146, 2241,
+	2063, 1724, 1725, 1069, 0, 0, 0, 151, 0, 0,
+	0, 0, 0, 0, 0, 492, 978, 977, 987, 988,
+	980, 981, 982, 983, 984, 985, 986, 979, 2062, 146,
+	989, 0, 0, 0, 0, 0, 0, 0, 151, 978,
+	977, 987, 988, 980, 981, 982, 983, 984, 985, 986,
+	979, 0, 0, 989, 0, 0, 0, 0, 166, 0,
+	0, 0, 0, 0, 0, 166, 2306, 2307, 0, 0,
+	166, 166, 0, 0, 166, 0, 166, 0, 0, 0,
+	0, 0, 166, 0, 0, 0, 0, 0, 0, 166,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 0, 448, 0, 0, 448, 0, 0, 539, 0,
+	0, 0, 0, 138, 0, 0, 166, 492, 0, 978,
+	977, 987, 988, 980, 981, 982, 983, 984, 985, 986,
+	979, 0, 0, 989, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 138, 0, 0, 978, 977, 987,
+	988, 980, 981, 982, 983, 984, 985, 986, 979, 2272,
+	1898, 989, 0, 0, 0, 0, 0, 0, 490, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	978, 977, 987, 988, 980, 981, 982, 983, 984, 985,
+	986, 979, 0, 0, 989, 0, 0, 0, 0, 0,
+	615, 0, 0, 763, 0, 770, 0, 0, 0, 0,
+	0, 166, 0, 0, 0, 0, 0, 0, 0, 166,
+	0, 0, 0, 0, 0, 0, 0, 0, 448, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1927,
+	0, 0, 166, 0, 601, 0, 0, 0, 0, 0,
+	0, 0, 0, 166, 166, 166, 166, 166, 0, 448,
+	0, 448, 1101, 0, 0, 166, 0, 0, 0, 166,
+	0, 0, 166, 166, 1942, 0, 166, 166, 166, 0,
+	0, 0, 0, 0, 139, 144, 141, 147, 148, 149,
+	150, 152, 153, 154, 155, 0, 0, 0, 0, 0,
+	156, 157, 158, 159, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 144, 141, 147, 148,
+	149, 150, 152, 153, 154, 155, 0, 0, 0, 0,
+	0, 156, 157, 158, 159, 0, 492, 0, 0, 0,
+	0, 166, 0, 0, 0, 0, 0, 0, 166, 0,
+	0, 0, 0, 492, 492, 0, 0, 0, 492, 0,
+	0, 0, 1675, 0, 492, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 492, 0, 0, 0, 0, 0,
-	0, 0, 0, 601, 492, 0, 1137, 0, 0, 0,
-	0, 0, 2044, 0, 0, 0, 0, 0, 448, 0,
-	448, 1098, 0, 0, 0, 0, 0, 166, 166, 166,
-	166, 166, 0, 0, 0, 504, 0, 0, 0, 0,
-	0, 2075, 2067, 166, 166, 2068, 0, 0, 2070, 0,
-	0, 0, 0, 0, 0, 0, 0, 1150, 1153, 1154,
-	1155, 1156, 1157, 1158, 0, 1159, 1160, 1161, 1162, 1163,
-	1138, 1139, 1140, 1141, 1122, 1123, 1151, 0, 1125, 492,
-	1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135,
-	1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2115, 2116, 2117, 2118, 2119, 492, 0, 0, 2122, 2123,
-	0, 0, 0, 0, 0, 0, 166, 0, 0, 1054,
-	0, 0, 0, 0, 0, 0, 492, 0, 0, 0,
-	0, 0, 492, 492, 139, 144, 141, 147, 148, 149,
-	150, 152, 153, 154, 155, 2135, 504, 0, 0, 0,
-	156, 157, 158, 159, 1152, 166, 2037, 0, 0, 0,
-	0, 0, 0, 0, 0, 448, 0, 0, 0, 0,
-	0, 447, 0, 0, 0, 0, 0, 615, 615, 615,
-	0, 494, 0, 0, 166, 0, 0, 0, 0, 574,
-	0, 0, 0, 0, 0, 937, 939, 975, 974, 984,
-	985, 977, 978, 979, 980, 981, 982, 983, 976, 0,
-	1209, 986, 0, 0, 0, 767, 0, 0, 166, 0,
-	0, 0, 0, 0, 0, 0, 492, 0, 0, 0,
-	0, 0, 0, 0, 492, 1209, 1209, 0, 0, 0,
-	166, 448, 0, 0, 0, 0, 0, 0, 0, 0,
-	166, 0, 0, 0, 0, 0, 0, 1260, 0, 0,
-	0, 2236, 0, 0, 166, 0, 0, 166, 0, 1881,
-	448, 0, 975, 974, 984, 985, 977, 978, 979, 980,
-	981, 982, 983, 976, 0, 1305, 986, 0, 0, 975,
-	974, 984, 985, 977, 978, 979, 980, 981, 982, 983,
-	976, 448, 1079, 986, 0, 0, 1666, 0, 448, 0,
-	615, 0, 0, 0, 0, 0, 1109, 1328, 1329, 448,
-	448, 448, 448, 448, 448, 448, 975, 974, 984, 985,
-	977, 978, 979, 980, 981, 982, 983, 976, 0, 0,
-	986, 974, 984, 985, 977, 978, 979, 980, 981, 982,
-	983, 976, 0, 448, 986, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 166, 0, 0, 166, 166, 166, 492,
-	492, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2014, 978, 977, 987, 988, 980, 981, 982, 983,
+	984, 985, 986, 979, 0, 0, 989, 166, 166, 166,
+	166, 166, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 166, 166, 0, 448, 978, 977, 987,
+	988, 980, 981, 982, 983, 984, 985, 986, 979, 0,
+	0, 989, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 492,
-	492, 492, 0, 0, 0, 601, 1305, 0, 0, 0,
-	601, 601, 0, 0, 601, 601, 601, 0, 0, 0,
-	1209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 492, 492, 492, 166, 0, 0,
-	601, 601, 601, 601, 601, 0, 0, 0, 492, 1260,
-	492, 0, 0, 0, 0, 0, 492, 0, 0, 0,
-	0, 492, 0, 0, 0, 0, 0, 0, 0, 0,
-	448, 0, 0, 0, 0, 0, 1305, 448, 0, 448,
-	0, 0, 0, 0, 763, 0, 0, 448, 448, 0,
-	492, 0, 0, 492, 0, 0, 0, 1208, 0, 0,
-	0, 1214, 1214, 0, 1214, 0, 1214, 1214, 0, 1223,
-	1214, 1214, 1214, 1214, 1214, 0, 0, 0, 0, 0,
-	0, 0, 1208, 1208, 763, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1213, 0, 0, 0, 0, 2069, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 492, 0, 0, 0, 1213,
+	1213, 504, 0, 0, 0, 448, 166, 0, 2092, 0,
+	0, 2093, 0, 0, 2095, 0, 492, 0, 0, 0,
+	0, 1265, 492, 492, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 448, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 1310,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2119, 0, 0, 448, 0, 0, 0, 0,
+	0, 0, 448, 0, 0, 0, 166, 0, 0, 0,
+	0, 1333, 1334, 448, 448, 448, 448, 448, 448, 448,
+	0, 0, 0, 0, 615, 615, 615, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 940, 942, 0, 0, 0, 448, 0, 0,
+	0, 0, 0, 0, 492, 0, 0, 0, 0, 2166,
+	504, 0, 492, 0, 0, 0, 0, 0, 166, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 166, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 601,
+	1310, 0, 0, 0, 601, 601, 0, 0, 601, 601,
+	601, 0, 0, 0, 1213, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 601, 601, 601, 601, 601, 1082,
+	0, 0, 0, 1265, 0, 0, 0, 615, 0, 0,
+	0, 0, 0, 1112, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 448, 0, 0, 0, 0, 0,
+	1310, 448, 0, 448, 0, 0, 0, 0, 0, 0,
+	0, 448, 448, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 0, 166, 166, 166, 0, 0, 0,
+	0, 492, 0, 492, 492, 492, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1057, 0, 0, 0, 492, 492, 492, 0, 0,
+	0, 0, 0, 0, 542, 34, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	492, 492, 492, 166, 0, 0, 0, 0, 0, 34,
+	0, 0, 0, 447, 492, 0, 492, 0, 0, 0,
+	0, 0, 492, 494, 0, 0, 0, 492, 0, 0,
+	0, 574, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 578, 0, 0, 767, 0, 0,
+	0, 0, 492, 763, 0, 492, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1212, 0, 0, 0,
+	0, 1219, 1219, 0, 1219, 0, 1219, 1219, 0, 1228,
+	1219, 1219, 1219, 1219, 1219, 0, 0, 0, 0, 0,
+	0, 0, 1212, 1212, 763, 0, 448, 0, 0, 0,
+	0, 0, 0, 448, 0, 0, 0, 0, 448, 448,
+	0, 0, 448, 0, 1650, 0, 0, 492, 166, 0,
+	448, 0, 0, 0, 0, 1289, 0, 448, 0, 492,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 492, 166, 0, 874, 1284, 879, 0, 0, 881,
-	0, 0, 0, 492, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	492, 0, 0, 0, 0, 0, 0, 0, 492, 492,
+	0, 0, 0, 0, 0, 0, 492, 0, 0, 0,
+	0, 0, 0, 0, 448, 492, 492, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 615, 615, 615, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1826, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	103, 0, 125, 0, 0, 0, 0, 0, 0, 0,
-	448, 0, 0, 145, 0, 0, 161, 448, 0, 0,
-	0, 0, 448, 448, 0, 0, 448, 1185, 1641, 0,
-	0, 1399, 0, 615, 448, 0, 0, 0, 0, 0,
-	103, 448, 125, 1085, 135, 0, 1096, 1208, 0, 124,
-	0, 0, 0, 145, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1431, 1432, 142, 448, 143,
-	0, 0, 0, 0, 1189, 1190, 134, 133, 160, 0,
-	0, 0, 0, 0, 135, 0, 0, 0, 0, 124,
-	1464, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1079, 0, 0, 615, 0, 0, 0, 142, 0, 143,
-	0, 0, 0, 0, 1189, 1190, 134, 133, 160, 0,
-	0, 615, 0, 0, 615, 0, 601, 601, 0, 0,
-	0, 0, 129, 1191, 136, 763, 1188, 0, 130, 131,
-	0, 0, 0, 146, 0, 0, 0, 601, 0, 0,
-	0, 0, 151, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 448, 0, 0, 0, 0, 0, 0,
-	0, 1260, 129, 1191, 136, 0, 1188, 0, 130, 131,
-	0, 0, 0, 146, 0, 0, 0, 0, 0, 0,
-	770, 0, 151, 601, 448, 0, 0, 0, 0, 0,
-	1114, 0, 0, 0, 1209, 448, 448, 448, 448, 448,
-	0, 0, 0, 0, 763, 0, 0, 1753, 0, 0,
-	770, 448, 0, 0, 448, 448, 0, 0, 448, 1763,
-	1305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
-	0, 0, 0, 0, 763, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1245, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 448, 0, 0, 0, 0, 138, 0,
-	1825, 0, 0, 0, 0, 1286, 0, 0, 0, 0,
-	1209, 0, 0, 132, 0, 0, 0, 0, 0, 0,
-	1305, 0, 0, 0, 0, 126, 0, 0, 127, 0,
-	0, 0, 0, 0, 0, 0, 1315, 0, 0, 0,
-	0, 0, 0, 1319, 0, 448, 448, 448, 448, 448,
-	0, 0, 0, 132, 1330, 1331, 1332, 1333, 1334, 1335,
-	1336, 448, 448, 0, 0, 126, 1650, 0, 127, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1096, 0,
-	0, 0, 0, 0, 0, 0, 601, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
-	144, 141, 147, 148, 149, 150, 152, 153, 154, 155,
-	0, 0, 0, 0, 448, 156, 157, 158, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 1209, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
-	144, 141, 147, 148, 149, 150, 152, 153, 154, 155,
-	0, 0, 0, 448, 0, 156, 157, 158, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1208, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 448, 0, 0, 1471, 0, 0, 0, 0,
-	0, 0, 1475, 0, 1478, 0, 0, 0, 0, 0,
-	0, 0, 0, 1497, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 448, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1209, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 448, 0,
+	0, 0, 601, 601, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 0,
+	0, 0, 0, 601, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 166, 448,
+	0, 0, 0, 0, 0, 0, 0, 1265, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1404, 0, 615, 0, 0, 0, 0, 0, 601,
+	448, 0, 0, 0, 0, 0, 0, 1212, 0, 0,
+	1213, 448, 448, 448, 448, 448, 0, 0, 0, 0,
+	0, 0, 0, 1762, 0, 1436, 1437, 448, 0, 0,
+	448, 448, 0, 0, 448, 1772, 1310, 0, 0, 0,
+	0, 0, 161, 0, 0, 0, 0, 0, 0, 0,
+	1469, 0, 0, 1843, 0, 0, 0, 0, 0, 0,
+	1082, 0, 0, 615, 0, 0, 103, 0, 125, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 145,
+	0, 615, 0, 0, 615, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 763, 0, 0, 0, 448,
+	0, 0, 0, 0, 0, 0, 1836, 0, 0, 0,
+	135, 0, 0, 0, 0, 124, 877, 0, 882, 0,
+	1213, 884, 0, 0, 0, 0, 0, 0, 0, 0,
+	1310, 0, 0, 142, 0, 143, 0, 0, 0, 0,
+	1193, 1194, 134, 133, 160, 0, 0, 0, 0, 0,
+	770, 0, 0, 0, 0, 448, 448, 448, 448, 448,
+	933, 933, 933, 0, 0, 0, 0, 0, 0, 0,
+	0, 448, 448, 0, 0, 763, 0, 0, 0, 0,
+	34, 770, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 998, 1000, 0, 0, 0, 0, 0,
+	0, 129, 1195, 136, 0, 1192, 601, 130, 131, 0,
+	0, 0, 146, 0, 0, 0, 0, 0, 0, 0,
+	0, 151, 0, 0, 1013, 0, 763, 0, 1018, 1019,
+	1020, 1021, 1022, 1023, 1024, 1025, 0, 1028, 1030, 1033,
+	1033, 1033, 1030, 1033, 1033, 1030, 1033, 1046, 1047, 1048,
+	1049, 1050, 1051, 1052, 448, 0, 0, 0, 0, 1058,
+	0, 0, 0, 34, 0, 0, 0, 1213, 0, 0,
+	0, 0, 0, 0, 0, 1088, 0, 0, 1099, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1095, 0, 0, 448, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 448, 0, 0, 138, 1659, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 448, 0,
-	0, 0, 0, 0, 0, 1816, 0, 0, 0, 0,
-	0, 0, 448, 0, 0, 448, 0, 0, 0, 0,
-	0, 0, 0, 1828, 0, 0, 0, 1208, 0, 1835,
-	35, 36, 37, 72, 39, 40, 0, 615, 0, 1841,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	76, 0, 0, 0, 41, 67, 68, 0, 65, 69,
-	0, 0, 0, 0, 0, 0, 0, 66, 0, 0,
+	0, 0, 0, 0, 0, 1213, 0, 0, 0, 0,
+	0, 0, 132, 0, 0, 0, 448, 0, 0, 0,
+	0, 0, 0, 0, 126, 0, 448, 127, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 0, 0, 448, 0, 0, 0, 0, 0, 0,
+	1139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1117, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 161, 0, 0, 0, 0,
+	0, 0, 0, 1212, 0, 0, 1189, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 103,
+	0, 125, 0, 1213, 0, 0, 0, 0, 0, 0,
+	0, 0, 145, 0, 0, 0, 0, 0, 139, 144,
+	141, 147, 148, 149, 150, 152, 153, 154, 155, 0,
+	0, 1250, 0, 0, 156, 157, 158, 159, 0, 0,
+	0, 0, 0, 135, 0, 0, 0, 0, 124, 448,
+	0, 0, 448, 448, 448, 0, 0, 0, 0, 0,
+	1291, 0, 0, 0, 1127, 0, 142, 1827, 143, 0,
+	0, 0, 0, 1193, 1194, 134, 133, 160, 0, 0,
+	0, 0, 0, 0, 1838, 1839, 0, 0, 0, 1845,
+	0, 1320, 0, 1212, 0, 1852, 0, 0, 1324, 0,
+	0, 0, 0, 615, 0, 1858, 0, 1140, 0, 1335,
+	1336, 1337, 1338, 1339, 1340, 1341, 0, 0, 0, 0,
+	0, 1265, 0, 0, 0, 0, 0, 0, 0, 933,
+	933, 933, 0, 0, 129, 1195, 136, 0, 1192, 0,
+	130, 131, 0, 1099, 0, 146, 0, 0, 0, 0,
+	0, 0, 0, 0, 151, 0, 0, 0, 1153, 1156,
+	1157, 1158, 1159, 1160, 1161, 0, 1162, 1163, 1164, 1165,
+	1166, 1141, 1142, 1143, 1144, 1125, 1126, 1154, 0, 1128,
+	615, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137,
+	1138, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1219, 0, 0, 0,
+	0, 0, 0, 0, 0, 35, 36, 37, 72, 39,
+	40, 0, 0, 0, 0, 0, 0, 615, 0, 0,
+	1212, 0, 0, 1954, 1219, 76, 448, 0, 0, 41,
+	67, 68, 0, 65, 69, 0, 0, 0, 0, 0,
+	138, 0, 66, 0, 0, 1155, 0, 0, 0, 0,
+	1476, 0, 1213, 0, 0, 0, 0, 1480, 0, 1483,
+	0, 0, 0, 0, 0, 0, 0, 0, 1502, 0,
+	0, 54, 0, 0, 0, 0, 0, 0, 0, 0,
+	1501, 71, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 132, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 126, 0, 0,
+	127, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 763, 0, 0, 1212, 0,
+	0, 0, 0, 1845, 0, 1265, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1265, 0, 0, 0,
+	0, 0, 44, 47, 50, 49, 52, 0, 64, 0,
+	0, 70, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 53, 75, 74, 0, 0, 62, 63,
+	51, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 144, 141, 147, 148, 149, 150, 152, 153,
+	154, 155, 0, 0, 0, 0, 0, 156, 157, 158,
+	159, 0, 0, 0, 0, 0, 1212, 0, 0, 55,
+	56, 0, 57, 58, 59, 60, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1099, 0, 0, 0, 0, 0, 0, 1634,
+	0, 0, 0, 0, 1643, 1644, 0, 0, 1648, 0,
+	0, 0, 0, 0, 0, 0, 1651, 0, 0, 0,
+	0, 0, 1845, 1654, 1845, 1845, 2125, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2140, 2141, 2142, 0,
+	1658, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 73, 0, 0, 0, 0, 0, 0,
+	0, 2157, 2157, 2157, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2172, 0, 2174, 0, 0,
+	0, 0, 0, 1845, 0, 0, 0, 0, 1845, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1680, 0, 0, 578, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1845, 0, 0, 615, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1717, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1095,
+	0, 0, 0, 0, 0, 0, 1744, 1745, 0, 0,
+	1095, 1095, 1095, 1095, 1095, 0, 0, 1769, 1845, 0,
+	0, 0, 0, 0, 0, 0, 1501, 0, 0, 1095,
+	2245, 0, 0, 1095, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1212, 0, 2259, 0, 0,
+	0, 0, 0, 0, 0, 0, 615, 615, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1830, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1209, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 71, 0, 0, 0,
-	0, 0, 0, 0, 615, 0, 0, 0, 0, 0,
+	0, 0, 1841, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 448, 0, 0, 448, 448, 448, 0, 0, 0,
-	0, 0, 0, 0, 0, 1096, 0, 0, 0, 0,
-	1214, 0, 1625, 0, 0, 0, 0, 1634, 1635, 0,
-	0, 1639, 0, 0, 0, 0, 0, 0, 0, 1642,
-	0, 615, 0, 0, 1208, 0, 1645, 1937, 1214, 0,
-	0, 0, 0, 0, 44, 47, 50, 49, 52, 0,
-	64, 0, 0, 70, 0, 1260, 0, 0, 0, 0,
-	0, 0, 0, 1649, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 53, 75, 74, 0, 0,
-	62, 63, 51, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1859, 0, 0, 0, 0, 0, 0, 0,
+	0, 1876, 1877, 1878, 1879, 1880, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1099, 1886, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 55, 56, 0, 57, 58, 59, 60, 0, 0,
-	0, 763, 0, 0, 1208, 0, 0, 0, 0, 1828,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	448, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1209, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1939, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1760, 0, 0, 0, 0, 73, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1951, 0, 34,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1208, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1095, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1984, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1819, 0,
-	0, 0, 0, 0, 1828, 2094, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2109, 2110, 2111, 0, 0, 0,
+	0, 0, 0, 0, 2005, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2010, 0, 2012, 2013, 0, 2015, 0, 0,
+	0, 0, 2025, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2028, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2040, 0, 0, 2043,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1859, 1860, 1861, 1862, 1863, 0, 0, 0, 0, 2126,
-	2126, 2126, 0, 0, 0, 0, 1096, 1869, 0, 0,
-	0, 0, 0, 2141, 0, 2143, 0, 0, 0, 0,
-	0, 1828, 0, 0, 0, 0, 1828, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1828, 0, 0, 615, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2066,
+	0, 0, 0, 0, 0, 0, 2072, 2073, 2074, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1922,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1828, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2209, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1208, 0, 2223, 0, 0, 0, 0,
-	0, 0, 0, 615, 615, 0, 0, 1967, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2114, 0, 0, 2115, 2116,
+	2117, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1988, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2001, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2004, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2015, 0, 0,
-	2018, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1951, 0,
+	34, 0, 1951, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2089, 0, 0, 2090,
-	2091, 2092, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1951, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2240, 0, 2233, 0, 0, 0, 0, 34,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 741, 727, 393, 0, 676, 744, 647, 664, 754,
-	667, 670, 710, 626, 689, 317, 661, 0, 651, 622,
+	667, 670, 710, 626, 689, 317, 661, 34, 651, 622,
 	657, 623, 649, 678, 224, 646, 729, 692, 743, 275,
 	221, 628, 652, 331, 666, 176, 712, 369, 209, 284,
 	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
 	323, 750, 279, 699, 0, 378, 302, 0, 0, 0,
 	680, 733, 687, 723, 675, 711, 636, 698, 745, 662,
 	707, 746, 265, 207, 175, 314, 379, 239, 0, 0,
-	0, 167, 168, 169, 0, 2243, 2244, 0, 0, 0,
+	0, 167, 168, 169, 0, 2282, 2283, 0, 0, 0,
 	0, 0, 198, 0, 205, 704, 740, 659, 706, 219,
-	263, 226, 218, 395, 751, 732, 0, 191, 742, 682,
+	263, 226, 218, 395, 751, 732, 0, 191, 0, 174,
+	354, 742, 682, 709, 757, 621, 701, 0, 624, 627,
+	753, 736, 655, 229, 0, 0, 0, 0, 0, 0,
+	0, 679, 688, 720, 673, 0, 0, 0, 0, 0,
+	0, 0, 0, 653, 0, 697, 0, 0, 0, 632,
+	625, 0, 0, 0, 0, 677, 0, 0, 0, 635,
+	0, 654, 721, 0, 619, 247, 629, 303, 0, 725,
+	735, 674, 427, 739, 672, 671, 716, 633, 731, 665,
+	274, 631, 271, 171, 187, 0, 663, 313, 352, 358,
+	730, 650, 658, 210, 656, 356, 327, 412, 194, 237,
+	349, 332, 696, 714, 355, 280, 400, 344, 410, 0,
+	0, 428, 429, 217, 307, 418, 391, 424, 439, 188,
+	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
+	245, 278, 436, 186, 364, 202, 179, 386, 408, 199,
+	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
+	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
+	442, 190, 423, 183, 935, 422, 309, 399, 407, 298,
+	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
+	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
+	443, 195, 196, 197, 645, 232, 236, 242, 244, 250,
+	251, 258, 276, 320, 341, 339, 345, 726, 394, 411,
+	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
+	376, 272, 281, 718, 756, 326, 357, 200, 414, 377,
+	640, 644, 638, 639, 690, 691, 641, 747, 748, 749,
+	722, 634, 0, 642, 643, 0, 728, 737, 738, 695,
+	170, 184, 277, 752, 346, 240, 440, 421, 417, 620,
+	637, 216, 648, 0, 0, 660, 668, 669, 681, 683,
+	684, 685, 686, 694, 702, 703, 705, 713, 715, 717,
+	719, 724, 734, 755, 172, 173, 185, 193, 203, 215,
+	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
+	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
+	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
+	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
+	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
+	249, 409, 431, 0, 285, 693, 700, 287, 234, 252,
+	262, 708, 420, 382, 189, 353, 241, 178, 206, 192,
+	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
+	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
+	741, 727, 393, 0, 676, 744, 647, 664, 754, 667,
+	670, 710, 626, 689, 317, 661, 0, 651, 622, 657,
+	623, 649, 678, 224, 646, 729, 692, 743, 275, 221,
+	628, 652, 331, 666, 176, 712, 369, 209, 284, 282,
+	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
+	750, 279, 699, 0, 378, 302, 0, 0, 0, 680,
+	733, 687, 723, 675, 711, 636, 698, 745, 662, 707,
+	746, 265, 207, 175, 314, 379, 239, 0, 0, 0,
+	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
+	0, 198, 0, 205, 704, 740, 659, 706, 219, 263,
+	226, 218, 395, 751, 732, 0, 191, 0, 174, 354,
+	742, 682, 709, 757, 621, 701, 0, 624, 627, 753,
+	736, 655, 229, 0, 0, 0, 0, 0, 0, 0,
+	679, 688, 720, 673, 0, 0, 0, 0, 0, 0,
+	1943, 0, 653, 0, 697, 0, 0, 0, 632, 625,
+	0, 0, 0, 0, 677, 0, 0, 0, 635, 0,
+	654, 721, 0, 619, 247, 629, 303, 0, 725, 735,
+	674, 427, 739, 672, 671, 716, 633, 731, 665, 274,
+	631, 271, 171, 187, 0, 663, 313, 352, 358, 730,
+	650, 658, 210, 656, 356, 327, 412, 194, 237, 349,
+	332, 696, 714, 355, 280, 400, 344, 410, 0, 0,
+	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
+	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
+	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
+	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
+	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
+	190, 423, 183, 935, 422, 309, 399, 407, 298, 289,
+	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
+	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
+	195, 196, 197, 645, 232, 236, 242, 244, 250, 251,
+	258, 276, 320, 341, 339, 345, 726, 394, 411, 419,
+	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
+	272, 281, 718, 756, 326, 357, 200, 414, 377, 640,
+	644, 638, 639, 690, 691, 641, 747, 748, 749, 722,
+	634, 0, 642, 643, 0, 728, 737, 738, 695, 170,
+	184, 277, 752, 346, 240, 440, 421, 417, 620, 637,
+	216, 648, 0, 0, 660, 668, 669, 681, 683, 684,
+	685, 686, 694, 702, 703, 705, 713, 715, 717, 719,
+	724, 734, 755, 172, 173, 185, 193, 203, 215, 230,
+	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
+	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
+	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
+	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
+	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
+	409, 431, 0, 285, 693, 700, 287, 234, 252, 262,
+	708, 420, 382, 189, 353, 241, 178, 206, 192, 213,
+	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
+	350, 201, 368, 388, 389, 390, 392, 299, 220, 741,
+	727, 393, 0, 676, 744, 647, 664, 754, 667, 670,
+	710, 626, 689, 317, 661, 0, 651, 622, 657, 623,
+	649, 678, 224, 646, 729, 692, 743, 275, 221, 628,
+	652, 331, 666, 176, 712, 369, 209, 284, 282, 398,
+	235, 227, 223, 208, 259, 290, 329, 387, 323, 750,
+	279, 699, 0, 378, 302, 0, 0, 0, 680, 733,
+	687, 723, 675, 711, 636, 698, 745, 662, 707, 746,
+	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
+	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	198, 0, 205, 704, 740, 659, 706, 219, 263, 226,
+	218, 395, 751, 732, 0, 191, 0, 174, 354, 742,
+	682, 709, 757, 621, 701, 0, 624, 627, 753, 736,
+	655, 229, 0, 0, 0, 0, 0, 0, 0, 679,
+	688, 720, 673, 0, 0, 0, 0, 0, 0, 1773,
+	0, 653, 0, 697, 0, 0, 0, 632, 625, 0,
+	0, 0, 0, 677, 0, 0, 0, 635, 0, 654,
+	721, 0, 619, 247, 629, 303, 0, 725, 735, 674,
+	427, 739, 672, 671, 716, 633, 731, 665, 274, 631,
+	271, 171, 187, 0, 663, 313, 352, 358, 730, 650,
+	658, 210, 656, 356, 327, 412, 194, 237, 349, 332,
+	696, 714, 355, 280, 400, 344, 410, 0, 0, 428,
+	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
+	384, 415, 375, 300, 396, 397, 270, 374, 245, 278,
+	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
+	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
+	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
+	423, 183, 935, 422, 309, 399, 407, 298, 289, 182,
+	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
+	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
+	196, 197, 645, 232, 236, 242, 244, 250, 251, 258,
+	276, 320, 341, 339, 345, 726, 394, 411, 419, 426,
+	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
+	281, 718, 756, 326, 357, 200, 414, 377, 640, 644,
+	638, 639, 690, 691, 641, 747, 748, 749, 722, 634,
+	0, 642, 643, 0, 728, 737, 738, 695, 170, 184,
+	277, 752, 346, 240, 440, 421, 417, 620, 637, 216,
+	648, 0, 0, 660, 668, 669, 681, 683, 684, 685,
+	686, 694, 702, 703, 705, 713, 715, 717, 719, 724,
+	734, 755, 172, 173, 185, 193, 203, 215, 230, 238,
+	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
+	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
+	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
+	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
+	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
+	431, 0, 285, 693, 700, 287, 234, 252, 262, 708,
+	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
+	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
+	201, 368, 388, 389, 390, 392, 299, 220, 741, 727,
+	393, 0, 676, 744, 647, 664, 754, 667, 670, 710,
+	626, 689, 317, 661, 0, 651, 622, 657, 623, 649,
+	678, 224, 646, 729, 692, 743, 275, 221, 628, 652,
+	331, 666, 176, 712, 369, 209, 284, 282, 398, 235,
+	227, 223, 208, 259, 290, 329, 387, 323, 750, 279,
+	699, 0, 378, 302, 0, 0, 0, 680, 733, 687,
+	723, 675, 711, 636, 698, 745, 662, 707, 746, 265,
+	207, 175, 314, 379, 239, 0, 0, 0, 167, 168,
+	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
+	0, 205, 704, 740, 659, 706, 219, 263, 226, 218,
+	395, 751, 732, 0, 191, 0, 174, 354, 742, 682,
 	709, 757, 621, 701, 0, 624, 627, 753, 736, 655,
 	229, 0, 0, 0, 0, 0, 0, 0, 679, 688,
-	720, 673, 0, 0, 0, 0, 0, 0, 0, 0,
+	720, 673, 0, 0, 0, 0, 0, 0, 1478, 0,
 	653, 0, 697, 0, 0, 0, 632, 625, 0, 0,
 	0, 0, 677, 0, 0, 0, 635, 0, 654, 721,
-	0, 619, 247, 629, 303, 2204, 725, 735, 674, 427,
+	0, 619, 247, 629, 303, 0, 725, 735, 674, 427,
 	739, 672, 671, 716, 633, 731, 665, 274, 631, 271,
 	171, 187, 0, 663, 313, 352, 358, 730, 650, 658,
-	210, 656, 356, 327, 412, 194, 237, 349, 332, 354,
-	696, 714, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
+	210, 656, 356, 327, 412, 194, 237, 349, 332, 696,
+	714, 355, 280, 400, 344, 410, 0, 0, 428, 429,
+	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
+	415, 375, 300, 396, 397, 270, 374, 245, 278, 436,
 	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
 	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
 	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 932, 422, 309, 399, 407, 298, 289, 182, 405,
+	183, 935, 422, 309, 399, 407, 298, 289, 182, 405,
 	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
 	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
 	197, 645, 232, 236, 242, 244, 250, 251, 258, 276,
@@ -1668,31 +1880,175 @@ var yyAct = [...]int{
 	223, 208, 259, 290, 329, 387, 323, 750, 279, 699,
 	0, 378, 302, 0, 0, 0, 680, 733, 687, 723,
 	675, 711, 636, 698, 745, 662, 707, 746, 265, 207,
-	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
+	175, 314, 379, 239, 71, 0, 0, 167, 168, 169,
 	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
 	205, 704, 740, 659, 706, 219, 263, 226, 218, 395,
-	751, 732, 0, 191, 742, 682, 709, 757, 621, 701,
+	751, 732, 0, 191, 0, 174, 354, 742, 682, 709,
+	757, 621, 701, 0, 624, 627, 753, 736, 655, 229,
+	0, 0, 0, 0, 0, 0, 0, 679, 688, 720,
+	673, 0, 0, 0, 0, 0, 0, 0, 0, 653,
+	0, 697, 0, 0, 0, 632, 625, 0, 0, 0,
+	0, 677, 0, 0, 0, 635, 0, 654, 721, 0,
+	619, 247, 629, 303, 0, 725, 735, 674, 427, 739,
+	672, 671, 716, 633, 731, 665, 274, 631, 271, 171,
+	187, 0, 663, 313, 352, 358, 730, 650, 658, 210,
+	656, 356, 327, 412, 194, 237, 349, 332, 696, 714,
+	355, 280, 400, 344, 410, 0, 0, 428, 429, 217,
+	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
+	375, 300, 396, 397, 270, 374, 245, 278, 436, 186,
+	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
+	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
+	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
+	935, 422, 309, 399, 407, 298, 289, 182, 405, 296,
+	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
+	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
+	645, 232, 236, 242, 244, 250, 251, 258, 276, 320,
+	341, 339, 345, 726, 394, 411, 419, 426, 432, 433,
+	437, 434, 435, 438, 308, 257, 376, 272, 281, 718,
+	756, 326, 357, 200, 414, 377, 640, 644, 638, 639,
+	690, 691, 641, 747, 748, 749, 722, 634, 0, 642,
+	643, 0, 728, 737, 738, 695, 170, 184, 277, 752,
+	346, 240, 440, 421, 417, 620, 637, 216, 648, 0,
+	0, 660, 668, 669, 681, 683, 684, 685, 686, 694,
+	702, 703, 705, 713, 715, 717, 719, 724, 734, 755,
+	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
+	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
+	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
+	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
+	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
+	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
+	285, 693, 700, 287, 234, 252, 262, 708, 420, 382,
+	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
+	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
+	388, 389, 390, 392, 299, 220, 741, 727, 393, 0,
+	676, 744, 647, 664, 754, 667, 670, 710, 626, 689,
+	317, 661, 0, 651, 622, 657, 623, 649, 678, 224,
+	646, 729, 692, 743, 275, 221, 628, 652, 331, 666,
+	176, 712, 369, 209, 284, 282, 398, 235, 227, 223,
+	208, 259, 290, 329, 387, 323, 750, 279, 699, 0,
+	378, 302, 0, 0, 0, 680, 733, 687, 723, 675,
+	711, 636, 698, 745, 662, 707, 746, 265, 207, 175,
+	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
+	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
+	704, 740, 659, 706, 219, 263, 226, 218, 395, 751,
+	732, 0, 191, 0, 174, 354, 742, 682, 709, 757,
+	621, 701, 0, 624, 627, 753, 736, 655, 229, 0,
+	0, 0, 0, 0, 0, 0, 679, 688, 720, 673,
+	0, 0, 0, 0, 0, 0, 0, 0, 653, 0,
+	697, 0, 0, 0, 632, 625, 0, 0, 0, 0,
+	677, 0, 0, 0, 635, 0, 654, 721, 0, 619,
+	247, 629, 303, 0, 725, 735, 674, 427, 739, 672,
+	671, 716, 633, 731, 665, 274, 631, 271, 171, 187,
+	0, 663, 313, 352, 358, 730, 650, 658, 210, 656,
+	356, 327, 412, 194, 237, 349, 332, 696, 714, 355,
+	280, 400, 344, 410, 0, 0, 428, 429, 217, 307,
+	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
+	300, 396, 397, 270, 374, 245, 278, 436, 186, 364,
+	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
+	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
+	212, 316, 403, 404, 211, 442, 190, 423, 183, 935,
+	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
+	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
+	0, 177, 0, 380, 416, 443, 195, 196, 197, 645,
+	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
+	339, 345, 726, 394, 411, 419, 426, 432, 433, 437,
+	434, 435, 438, 308, 257, 376, 272, 281, 718, 756,
+	326, 357, 200, 414, 377, 640, 644, 638, 639, 690,
+	691, 641, 747, 748, 749, 722, 634, 0, 642, 643,
+	0, 728, 737, 738, 695, 170, 184, 277, 752, 346,
+	240, 440, 421, 417, 620, 637, 216, 648, 0, 0,
+	660, 668, 669, 681, 683, 684, 685, 686, 694, 702,
+	703, 705, 713, 715, 717, 719, 724, 734, 755, 172,
+	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
+	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
+	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
+	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
+	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
+	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
+	693, 700, 287, 234, 252, 262, 708, 420, 382, 189,
+	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
+	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
+	389, 390, 392, 299, 220, 741, 727, 393, 0, 676,
+	744, 647, 664, 754, 667, 670, 710, 626, 689, 317,
+	661, 0, 651, 622, 657, 623, 649, 678, 224, 646,
+	729, 692, 743, 275, 221, 628, 652, 331, 666, 176,
+	712, 369, 209, 284, 282, 398, 235, 227, 223, 208,
+	259, 290, 329, 387, 323, 750, 279, 699, 0, 378,
+	302, 0, 0, 0, 680, 733, 687, 723, 675, 711,
+	636, 698, 745, 662, 707, 746, 265, 207, 175, 314,
+	379, 239, 0, 0, 0, 167, 168, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 198, 0, 205, 704,
+	740, 659, 706, 219, 263, 226, 218, 395, 751, 732,
+	0, 758, 0, 174, 354, 742, 682, 709, 757, 621,
+	701, 0, 624, 627, 753, 736, 655, 229, 0, 0,
+	0, 0, 0, 0, 0, 679, 688, 720, 673, 0,
+	0, 0, 0, 0, 0, 0, 0, 653, 0, 697,
+	0, 0, 0, 632, 625, 0, 0, 0, 0, 677,
+	0, 0, 0, 635, 0, 654, 721, 0, 619, 247,
+	629, 303, 0, 725, 735, 674, 427, 739, 672, 671,
+	716, 633, 731, 665, 274, 631, 271, 171, 187, 0,
+	663, 313, 352, 358, 730, 650, 658, 210, 656, 356,
+	327, 412, 194, 237, 349, 332, 696, 714, 355, 280,
+	400, 344, 410, 0, 0, 428, 429, 217, 307, 418,
+	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
+	396, 397, 270, 374, 245, 278, 436, 186, 364, 202,
+	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
+	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
+	316, 403, 404, 211, 442, 190, 423, 183, 630, 422,
+	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
+	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
+	177, 0, 380, 416, 443, 195, 196, 197, 645, 232,
+	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
+	345, 726, 394, 411, 419, 426, 432, 433, 437, 434,
+	435, 438, 618, 612, 611, 272, 281, 718, 756, 326,
+	357, 200, 414, 377, 640, 644, 638, 639, 690, 691,
+	641, 747, 748, 749, 722, 634, 0, 642, 643, 0,
+	728, 737, 738, 695, 170, 184, 277, 752, 346, 240,
+	440, 421, 417, 620, 637, 216, 648, 0, 0, 660,
+	668, 669, 681, 683, 684, 685, 686, 694, 702, 703,
+	705, 713, 715, 717, 719, 724, 734, 755, 172, 173,
+	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
+	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
+	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
+	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
+	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
+	402, 413, 425, 430, 249, 409, 431, 0, 285, 693,
+	700, 287, 234, 252, 262, 708, 420, 382, 189, 353,
+	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
+	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
+	390, 392, 299, 220, 741, 727, 393, 0, 676, 744,
+	647, 664, 754, 667, 670, 710, 626, 689, 317, 661,
+	0, 651, 622, 657, 623, 649, 678, 224, 646, 729,
+	692, 743, 275, 221, 628, 652, 331, 666, 176, 712,
+	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
+	290, 329, 387, 323, 750, 279, 699, 0, 378, 302,
+	0, 0, 0, 680, 733, 687, 723, 675, 711, 636,
+	698, 745, 662, 707, 746, 265, 207, 175, 314, 379,
+	239, 0, 0, 0, 167, 168, 169, 0, 0, 0,
+	0, 0, 0, 0, 0, 198, 0, 205, 704, 740,
+	659, 706, 219, 263, 226, 218, 395, 751, 732, 0,
+	758, 0, 174, 354, 742, 682, 709, 757, 621, 701,
 	0, 624, 627, 753, 736, 655, 229, 0, 0, 0,
 	0, 0, 0, 0, 679, 688, 720, 673, 0, 0,
-	0, 0, 0, 0, 1926, 0, 653, 0, 697, 0,
+	0, 0, 0, 0, 0, 0, 653, 0, 697, 0,
 	0, 0, 632, 625, 0, 0, 0, 0, 677, 0,
 	0, 0, 635, 0, 654, 721, 0, 619, 247, 629,
 	303, 0, 725, 735, 674, 427, 739, 672, 671, 716,
 	633, 731, 665, 274, 631, 271, 171, 187, 0, 663,
 	313, 352, 358, 730, 650, 658, 210, 656, 356, 327,
-	412, 194, 237, 349, 332, 354, 696, 714, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
+	412, 194, 237, 349, 332, 696, 714, 355, 280, 400,
+	344, 410, 0, 0, 428, 429, 217, 307, 418, 391,
+	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
+	397, 270, 374, 245, 278, 436, 186, 364, 202, 179,
+	386, 1103, 199, 367, 0, 0, 441, 181, 406, 383,
 	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 932, 422, 309,
+	403, 404, 211, 442, 190, 423, 183, 630, 422, 309,
 	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
 	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
 	0, 380, 416, 443, 195, 196, 197, 645, 232, 236,
 	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
 	726, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 718, 756, 326, 357,
+	438, 618, 612, 611, 272, 281, 718, 756, 326, 357,
 	200, 414, 377, 640, 644, 638, 639, 690, 691, 641,
 	747, 748, 749, 722, 634, 0, 642, 643, 0, 728,
 	737, 738, 695, 170, 184, 277, 752, 346, 240, 440,
@@ -1718,1174 +2074,83 @@ var yyAct = [...]int{
 	745, 662, 707, 746, 265, 207, 175, 314, 379, 239,
 	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
 	0, 0, 0, 0, 198, 0, 205, 704, 740, 659,
-	706, 219, 263, 226, 218, 395, 751, 732, 0, 191,
-	742, 682, 709, 757, 621, 701, 0, 624, 627, 753,
-	736, 655, 229, 0, 0, 0, 0, 0, 0, 0,
-	679, 688, 720, 673, 0, 0, 0, 0, 0, 0,
-	1764, 0, 653, 0, 697, 0, 0, 0, 632, 625,
-	0, 0, 0, 0, 677, 0, 0, 0, 635, 0,
-	654, 721, 0, 619, 247, 629, 303, 0, 725, 735,
-	674, 427, 739, 672, 671, 716, 633, 731, 665, 274,
-	631, 271, 171, 187, 0, 663, 313, 352, 358, 730,
-	650, 658, 210, 656, 356, 327, 412, 194, 237, 349,
-	332, 354, 696, 714, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
+	706, 219, 263, 226, 218, 395, 751, 732, 0, 758,
+	0, 174, 354, 742, 682, 709, 757, 621, 701, 0,
+	624, 627, 753, 736, 655, 229, 0, 0, 0, 0,
+	0, 0, 0, 679, 688, 720, 673, 0, 0, 0,
+	0, 0, 0, 0, 0, 653, 0, 697, 0, 0,
+	0, 632, 625, 0, 0, 0, 0, 677, 0, 0,
+	0, 635, 0, 654, 721, 0, 619, 247, 629, 303,
+	0, 725, 735, 674, 427, 739, 672, 671, 716, 633,
+	731, 665, 274, 631, 271, 171, 187, 0, 663, 313,
+	352, 358, 730, 650, 658, 210, 656, 356, 327, 412,
+	194, 237, 349, 332, 696, 714, 355, 280, 400, 344,
+	410, 0, 0, 428, 429, 217, 307, 418, 391, 424,
+	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
+	270, 374, 245, 278, 436, 186, 364, 202, 179, 386,
+	609, 199, 367, 0, 0, 441, 181, 406, 383, 297,
+	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
+	404, 211, 442, 190, 423, 183, 630, 422, 309, 399,
+	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
+	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
+	380, 416, 443, 195, 196, 197, 645, 232, 236, 242,
+	244, 250, 251, 258, 276, 320, 341, 339, 345, 726,
+	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
+	618, 612, 611, 272, 281, 718, 756, 326, 357, 200,
+	414, 377, 640, 644, 638, 639, 690, 691, 641, 747,
+	748, 749, 722, 634, 0, 642, 643, 0, 728, 737,
+	738, 695, 170, 184, 277, 752, 346, 240, 440, 421,
+	417, 620, 637, 216, 648, 0, 0, 660, 668, 669,
+	681, 683, 684, 685, 686, 694, 702, 703, 705, 713,
+	715, 717, 719, 724, 734, 755, 172, 173, 185, 193,
+	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
+	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
+	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
+	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
+	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
+	425, 430, 249, 409, 431, 0, 285, 693, 700, 287,
+	234, 252, 262, 708, 420, 382, 189, 353, 241, 178,
+	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
+	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
+	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 0, 0, 1406, 0, 509,
+	0, 0, 0, 224, 508, 0, 0, 0, 275, 221,
+	0, 1407, 331, 0, 176, 0, 369, 209, 284, 282,
+	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
+	552, 279, 0, 0, 378, 302, 0, 0, 0, 0,
+	0, 543, 544, 0, 0, 0, 0, 0, 0, 0,
+	0, 265, 207, 175, 314, 379, 239, 71, 0, 0,
+	167, 168, 169, 530, 529, 532, 533, 534, 535, 0,
+	0, 198, 531, 205, 536, 537, 538, 0, 219, 263,
+	226, 218, 395, 0, 0, 0, 191, 0, 174, 354,
+	0, 0, 0, 0, 0, 506, 523, 0, 551, 0,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 520, 521,
+	599, 0, 0, 0, 567, 0, 522, 0, 0, 515,
+	516, 518, 517, 519, 524, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 247, 0, 303, 0, 566, 0,
+	0, 427, 0, 0, 564, 0, 0, 0, 0, 274,
+	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
+	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
+	332, 0, 0, 355, 280, 400, 344, 410, 0, 0,
+	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
+	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
 	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
 	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
 	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 932, 422, 309, 399, 407, 298, 289,
+	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
 	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
 	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 645, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 726, 394, 411, 419,
+	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
+	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
 	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 718, 756, 326, 357, 200, 414, 377, 640,
-	644, 638, 639, 690, 691, 641, 747, 748, 749, 722,
-	634, 0, 642, 643, 0, 728, 737, 738, 695, 170,
-	184, 277, 752, 346, 240, 440, 421, 417, 620, 637,
-	216, 648, 0, 0, 660, 668, 669, 681, 683, 684,
-	685, 686, 694, 702, 703, 705, 713, 715, 717, 719,
-	724, 734, 755, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 693, 700, 287, 234, 252, 262,
-	708, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 741,
-	727, 393, 0, 676, 744, 647, 664, 754, 667, 670,
-	710, 626, 689, 317, 661, 0, 651, 622, 657, 623,
-	649, 678, 224, 646, 729, 692, 743, 275, 221, 628,
-	652, 331, 666, 176, 712, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 750,
-	279, 699, 0, 378, 302, 0, 0, 0, 680, 733,
-	687, 723, 675, 711, 636, 698, 745, 662, 707, 746,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 704, 740, 659, 706, 219, 263, 226,
-	218, 395, 751, 732, 0, 191, 742, 682, 709, 757,
-	621, 701, 0, 624, 627, 753, 736, 655, 229, 0,
-	0, 0, 0, 0, 0, 0, 679, 688, 720, 673,
-	0, 0, 0, 0, 0, 0, 1473, 0, 653, 0,
-	697, 0, 0, 0, 632, 625, 0, 0, 0, 0,
-	677, 0, 0, 0, 635, 0, 654, 721, 0, 619,
-	247, 629, 303, 0, 725, 735, 674, 427, 739, 672,
-	671, 716, 633, 731, 665, 274, 631, 271, 171, 187,
-	0, 663, 313, 352, 358, 730, 650, 658, 210, 656,
-	356, 327, 412, 194, 237, 349, 332, 354, 696, 714,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 932,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 645,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 726, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 718, 756,
-	326, 357, 200, 414, 377, 640, 644, 638, 639, 690,
-	691, 641, 747, 748, 749, 722, 634, 0, 642, 643,
-	0, 728, 737, 738, 695, 170, 184, 277, 752, 346,
-	240, 440, 421, 417, 620, 637, 216, 648, 0, 0,
-	660, 668, 669, 681, 683, 684, 685, 686, 694, 702,
-	703, 705, 713, 715, 717, 719, 724, 734, 755, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	693, 700, 287, 234, 252, 262, 708, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 741, 727, 393, 0, 676,
-	744, 647, 664, 754, 667, 670, 710, 626, 689, 317,
-	661, 0, 651, 622, 657, 623, 649, 678, 224, 646,
-	729, 692, 743, 275, 221, 628, 652, 331, 666, 176,
-	712, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 750, 279, 699, 0, 378,
-	302, 0, 0, 0, 680, 733, 687, 723, 675, 711,
-	636, 698, 745, 662, 707, 746, 265, 207, 175, 314,
-	379, 239, 71, 0, 0, 167, 168, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 704,
-	740, 659, 706, 219, 263, 226, 218, 395, 751, 732,
-	0, 191, 742, 682, 709, 757, 621, 701, 0, 624,
-	627, 753, 736, 655, 229, 0, 0, 0, 0, 0,
-	0, 0, 679, 688, 720, 673, 0, 0, 0, 0,
-	0, 0, 0, 0, 653, 0, 697, 0, 0, 0,
-	632, 625, 0, 0, 0, 0, 677, 0, 0, 0,
-	635, 0, 654, 721, 0, 619, 247, 629, 303, 0,
-	725, 735, 674, 427, 739, 672, 671, 716, 633, 731,
-	665, 274, 631, 271, 171, 187, 0, 663, 313, 352,
-	358, 730, 650, 658, 210, 656, 356, 327, 412, 194,
-	237, 349, 332, 354, 696, 714, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 932, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 645, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 726, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 718, 756, 326, 357, 200, 414,
-	377, 640, 644, 638, 639, 690, 691, 641, 747, 748,
-	749, 722, 634, 0, 642, 643, 0, 728, 737, 738,
-	695, 170, 184, 277, 752, 346, 240, 440, 421, 417,
-	620, 637, 216, 648, 0, 0, 660, 668, 669, 681,
-	683, 684, 685, 686, 694, 702, 703, 705, 713, 715,
-	717, 719, 724, 734, 755, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 693, 700, 287, 234,
-	252, 262, 708, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 741, 727, 393, 0, 676, 744, 647, 664, 754,
-	667, 670, 710, 626, 689, 317, 661, 0, 651, 622,
-	657, 623, 649, 678, 224, 646, 729, 692, 743, 275,
-	221, 628, 652, 331, 666, 176, 712, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 750, 279, 699, 0, 378, 302, 0, 0, 0,
-	680, 733, 687, 723, 675, 711, 636, 698, 745, 662,
-	707, 746, 265, 207, 175, 314, 379, 239, 0, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 704, 740, 659, 706, 219,
-	263, 226, 218, 395, 751, 732, 0, 191, 742, 682,
-	709, 757, 621, 701, 0, 624, 627, 753, 736, 655,
-	229, 0, 0, 0, 0, 0, 0, 0, 679, 688,
-	720, 673, 0, 0, 0, 0, 0, 0, 0, 0,
-	653, 0, 697, 0, 0, 0, 632, 625, 0, 0,
-	0, 0, 677, 0, 0, 0, 635, 0, 654, 721,
-	0, 619, 247, 629, 303, 0, 725, 735, 674, 427,
-	739, 672, 671, 716, 633, 731, 665, 274, 631, 271,
-	171, 187, 0, 663, 313, 352, 358, 730, 650, 658,
-	210, 656, 356, 327, 412, 194, 237, 349, 332, 354,
-	696, 714, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 932, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 645, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 726, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	718, 756, 326, 357, 200, 414, 377, 640, 644, 638,
-	639, 690, 691, 641, 747, 748, 749, 722, 634, 0,
-	642, 643, 0, 728, 737, 738, 695, 170, 184, 277,
-	752, 346, 240, 440, 421, 417, 620, 637, 216, 648,
-	0, 0, 660, 668, 669, 681, 683, 684, 685, 686,
-	694, 702, 703, 705, 713, 715, 717, 719, 724, 734,
-	755, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 693, 700, 287, 234, 252, 262, 708, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 741, 727, 393,
-	0, 676, 744, 647, 664, 754, 667, 670, 710, 626,
-	689, 317, 661, 0, 651, 622, 657, 623, 649, 678,
-	224, 646, 729, 692, 743, 275, 221, 628, 652, 331,
-	666, 176, 712, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 750, 279, 699,
-	0, 378, 302, 0, 0, 0, 680, 733, 687, 723,
-	675, 711, 636, 698, 745, 662, 707, 746, 265, 207,
-	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 704, 740, 659, 706, 219, 263, 226, 218, 395,
-	751, 732, 0, 758, 742, 682, 709, 757, 621, 701,
-	0, 624, 627, 753, 736, 655, 229, 0, 0, 0,
-	0, 0, 0, 0, 679, 688, 720, 673, 0, 0,
-	0, 0, 0, 0, 0, 0, 653, 0, 697, 0,
-	0, 0, 632, 625, 0, 0, 0, 0, 677, 0,
-	0, 0, 635, 0, 654, 721, 0, 619, 247, 629,
-	303, 0, 725, 735, 674, 427, 739, 672, 671, 716,
-	633, 731, 665, 274, 631, 271, 171, 187, 0, 663,
-	313, 352, 358, 730, 650, 658, 210, 656, 356, 327,
-	412, 194, 237, 349, 332, 354, 696, 714, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 630, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 645, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	726, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 618, 612, 611, 272, 281, 718, 756, 326, 357,
-	200, 414, 377, 640, 644, 638, 639, 690, 691, 641,
-	747, 748, 749, 722, 634, 0, 642, 643, 0, 728,
-	737, 738, 695, 170, 184, 277, 752, 346, 240, 440,
-	421, 417, 620, 637, 216, 648, 0, 0, 660, 668,
-	669, 681, 683, 684, 685, 686, 694, 702, 703, 705,
-	713, 715, 717, 719, 724, 734, 755, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 693, 700,
-	287, 234, 252, 262, 708, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 741, 727, 393, 0, 676, 744, 647,
-	664, 754, 667, 670, 710, 626, 689, 317, 661, 0,
-	651, 622, 657, 623, 649, 678, 224, 646, 729, 692,
-	743, 275, 221, 628, 652, 331, 666, 176, 712, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 750, 279, 699, 0, 378, 302, 0,
-	0, 0, 680, 733, 687, 723, 675, 711, 636, 698,
-	745, 662, 707, 746, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 704, 740, 659,
-	706, 219, 263, 226, 218, 395, 751, 732, 0, 758,
-	742, 682, 709, 757, 621, 701, 0, 624, 627, 753,
-	736, 655, 229, 0, 0, 0, 0, 0, 0, 0,
-	679, 688, 720, 673, 0, 0, 0, 0, 0, 0,
-	0, 0, 653, 0, 697, 0, 0, 0, 632, 625,
-	0, 0, 0, 0, 677, 0, 0, 0, 635, 0,
-	654, 721, 0, 619, 247, 629, 303, 0, 725, 735,
-	674, 427, 739, 672, 671, 716, 633, 731, 665, 274,
-	631, 271, 171, 187, 0, 663, 313, 352, 358, 730,
-	650, 658, 210, 656, 356, 327, 412, 194, 237, 349,
-	332, 354, 696, 714, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 1100, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 630, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 645, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 726, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 618, 612, 611,
-	272, 281, 718, 756, 326, 357, 200, 414, 377, 640,
-	644, 638, 639, 690, 691, 641, 747, 748, 749, 722,
-	634, 0, 642, 643, 0, 728, 737, 738, 695, 170,
-	184, 277, 752, 346, 240, 440, 421, 417, 620, 637,
-	216, 648, 0, 0, 660, 668, 669, 681, 683, 684,
-	685, 686, 694, 702, 703, 705, 713, 715, 717, 719,
-	724, 734, 755, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 693, 700, 287, 234, 252, 262,
-	708, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 741,
-	727, 393, 0, 676, 744, 647, 664, 754, 667, 670,
-	710, 626, 689, 317, 661, 0, 651, 622, 657, 623,
-	649, 678, 224, 646, 729, 692, 743, 275, 221, 628,
-	652, 331, 666, 176, 712, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 750,
-	279, 699, 0, 378, 302, 0, 0, 0, 680, 733,
-	687, 723, 675, 711, 636, 698, 745, 662, 707, 746,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 704, 740, 659, 706, 219, 263, 226,
-	218, 395, 751, 732, 0, 758, 742, 682, 709, 757,
-	621, 701, 0, 624, 627, 753, 736, 655, 229, 0,
-	0, 0, 0, 0, 0, 0, 679, 688, 720, 673,
-	0, 0, 0, 0, 0, 0, 0, 0, 653, 0,
-	697, 0, 0, 0, 632, 625, 0, 0, 0, 0,
-	677, 0, 0, 0, 635, 0, 654, 721, 0, 619,
-	247, 629, 303, 0, 725, 735, 674, 427, 739, 672,
-	671, 716, 633, 731, 665, 274, 631, 271, 171, 187,
-	0, 663, 313, 352, 358, 730, 650, 658, 210, 656,
-	356, 327, 412, 194, 237, 349, 332, 354, 696, 714,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 609, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 630,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 645,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 726, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 618, 612, 611, 272, 281, 718, 756,
-	326, 357, 200, 414, 377, 640, 644, 638, 639, 690,
-	691, 641, 747, 748, 749, 722, 634, 0, 642, 643,
-	0, 728, 737, 738, 695, 170, 184, 277, 752, 346,
-	240, 440, 421, 417, 620, 637, 216, 648, 0, 0,
-	660, 668, 669, 681, 683, 684, 685, 686, 694, 702,
-	703, 705, 713, 715, 717, 719, 724, 734, 755, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	693, 700, 287, 234, 252, 262, 708, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	1401, 0, 509, 0, 0, 0, 224, 508, 0, 0,
-	0, 275, 221, 0, 1402, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 552, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 543, 544, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	71, 0, 0, 167, 168, 169, 530, 529, 532, 533,
-	534, 535, 0, 0, 198, 531, 205, 536, 537, 538,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 506, 523, 0, 551, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 520, 521,
-	599, 0, 0, 0, 567, 0, 522, 0, 0, 515,
-	516, 518, 517, 519, 524, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 566, 0,
-	0, 427, 0, 0, 564, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 554,
-	565, 560, 561, 558, 559, 553, 557, 556, 555, 568,
-	545, 546, 547, 548, 550, 0, 562, 563, 549, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 0, 0, 0, 509, 0, 0, 0,
-	224, 508, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 552, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 543, 544,
-	0, 0, 0, 0, 0, 0, 1512, 0, 265, 207,
-	175, 314, 379, 239, 71, 0, 0, 167, 168, 169,
-	530, 529, 532, 533, 534, 535, 0, 0, 198, 531,
-	205, 536, 537, 538, 1513, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 506,
-	523, 0, 551, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 520, 521, 0, 0, 0, 0, 567, 0,
-	522, 0, 0, 515, 516, 518, 517, 519, 524, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 566, 0, 0, 427, 0, 0, 564, 0,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 554, 565, 560, 561, 558, 559, 553,
-	557, 556, 555, 568, 545, 546, 547, 548, 550, 0,
-	562, 563, 549, 170, 184, 277, 0, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
-	509, 0, 0, 0, 224, 508, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 552, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 543, 544, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 71, 0,
-	586, 167, 168, 169, 530, 529, 532, 533, 534, 535,
-	0, 0, 198, 531, 205, 536, 537, 538, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 506, 523, 0, 551, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 520, 521, 0, 0,
-	0, 0, 567, 0, 522, 0, 0, 515, 516, 518,
-	517, 519, 524, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 566, 0, 0, 427,
-	0, 0, 564, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 554, 565, 560,
-	561, 558, 559, 553, 557, 556, 555, 568, 545, 546,
-	547, 548, 550, 0, 562, 563, 549, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 0, 509, 0, 0, 0, 224, 508,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 552, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 543, 544, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 71, 0, 0, 167, 168, 169, 530, 529,
-	532, 533, 534, 535, 0, 0, 198, 531, 205, 536,
-	537, 538, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 506, 523, 0,
-	551, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	520, 521, 599, 0, 0, 0, 567, 0, 522, 0,
-	0, 515, 516, 518, 517, 519, 524, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	566, 0, 0, 427, 0, 0, 564, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 554, 565, 560, 561, 558, 559, 553, 557, 556,
-	555, 568, 545, 546, 547, 548, 550, 0, 562, 563,
-	549, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 0, 509, 0,
-	0, 0, 224, 508, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 552,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	543, 544, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 71, 0, 0, 167,
-	168, 169, 530, 1419, 532, 533, 534, 535, 0, 0,
-	198, 531, 205, 536, 537, 538, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
-	0, 506, 523, 0, 551, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 520, 521, 599, 0, 0, 0,
-	567, 0, 522, 0, 0, 515, 516, 518, 517, 519,
-	524, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 566, 0, 0, 427, 0, 0,
-	564, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 554, 565, 560, 561, 558,
-	559, 553, 557, 556, 555, 568, 545, 546, 547, 548,
-	550, 0, 562, 563, 549, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 0, 509, 0, 0, 0, 224, 508, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 552, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 543, 544, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	71, 0, 0, 167, 168, 169, 530, 1416, 532, 533,
-	534, 535, 0, 0, 198, 531, 205, 536, 537, 538,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 506, 523, 0, 551, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 520, 521,
-	599, 0, 0, 0, 567, 0, 522, 0, 0, 515,
-	516, 518, 517, 519, 524, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 566, 0,
-	0, 427, 0, 0, 564, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 554,
-	565, 560, 561, 558, 559, 553, 557, 556, 555, 568,
-	545, 546, 547, 548, 550, 0, 562, 563, 549, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 579,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 0, 0, 0, 509, 0, 0,
-	0, 224, 508, 0, 0, 0, 275, 221, 0, 0,
-	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 552, 279,
-	0, 0, 378, 302, 0, 0, 0, 0, 0, 543,
-	544, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 71, 0, 0, 167, 168,
-	169, 530, 529, 532, 533, 534, 535, 0, 0, 198,
-	531, 205, 536, 537, 538, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
-	506, 523, 0, 551, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 520, 521, 0, 0, 0, 0, 567,
-	0, 522, 0, 0, 515, 516, 518, 517, 519, 524,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 566, 0, 0, 427, 0, 0, 564,
-	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
-	357, 200, 414, 377, 554, 565, 560, 561, 558, 559,
-	553, 557, 556, 555, 568, 545, 546, 547, 548, 550,
-	0, 562, 563, 549, 170, 184, 277, 0, 346, 240,
-	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
-	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 509, 0, 0, 0, 224, 508, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 552, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 543, 544, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 71,
-	0, 0, 167, 168, 169, 530, 529, 532, 533, 534,
-	535, 0, 0, 198, 531, 205, 536, 537, 538, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 506, 523, 0, 551, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 520, 521, 0,
-	0, 0, 0, 567, 0, 522, 0, 0, 515, 516,
-	518, 517, 519, 524, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 566, 0, 0,
-	427, 0, 0, 564, 0, 0, 0, 0, 274, 0,
-	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 554, 565,
-	560, 561, 558, 559, 553, 557, 556, 555, 568, 545,
-	546, 547, 548, 550, 0, 562, 563, 549, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 224,
-	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 552, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 543, 544, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 71, 0, 0, 167, 168, 169, 530,
-	529, 532, 533, 534, 535, 0, 0, 198, 531, 205,
-	536, 537, 538, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 523,
-	0, 551, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 520, 521, 0, 0, 0, 0, 567, 0, 522,
-	0, 0, 515, 516, 518, 517, 519, 524, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 566, 0, 0, 427, 0, 0, 564, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 2237, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 554, 565, 560, 561, 558, 559, 553, 557,
-	556, 555, 568, 545, 546, 547, 548, 550, 0, 562,
-	563, 549, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 224, 0, 0, 0, 0, 275, 221,
-	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	552, 279, 0, 0, 378, 302, 0, 0, 0, 0,
-	0, 543, 544, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 207, 175, 314, 379, 239, 71, 0, 586,
-	167, 168, 169, 530, 529, 532, 533, 534, 535, 0,
-	0, 198, 531, 205, 536, 537, 538, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 0, 523, 0, 551, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 520, 521, 0, 0, 0,
-	0, 567, 0, 522, 0, 0, 515, 516, 518, 517,
-	519, 524, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 0, 303, 0, 566, 0, 0, 427, 0,
-	0, 564, 0, 0, 0, 0, 274, 0, 271, 171,
-	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	0, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	0, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 0, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 308, 257, 376, 272, 281, 0,
-	0, 326, 357, 200, 414, 377, 554, 565, 560, 561,
-	558, 559, 553, 557, 556, 555, 568, 545, 546, 547,
-	548, 550, 0, 562, 563, 549, 170, 184, 277, 0,
-	346, 240, 440, 421, 417, 0, 0, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 0, 0, 287, 234, 252, 262, 0, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 224, 0, 0,
-	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 552, 279, 0, 0, 378, 302,
-	0, 0, 0, 0, 0, 543, 544, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 207, 175, 314, 379,
-	239, 71, 0, 0, 167, 168, 169, 530, 529, 532,
-	533, 534, 535, 0, 0, 198, 531, 205, 536, 537,
-	538, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 523, 0, 551,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 520,
-	521, 0, 0, 0, 0, 567, 0, 522, 0, 0,
-	515, 516, 518, 517, 519, 524, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 247, 0, 303, 0, 566,
-	0, 0, 427, 0, 0, 564, 0, 0, 0, 0,
-	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
-	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 0, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 0, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
-	554, 565, 560, 561, 558, 559, 553, 557, 556, 555,
-	568, 545, 546, 547, 548, 550, 0, 562, 563, 549,
-	170, 184, 277, 0, 346, 240, 440, 421, 417, 0,
-	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 0, 0, 287, 234, 252,
-	262, 0, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 224, 0, 0, 0, 0, 275, 221, 0, 0,
-	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 0, 279,
-	0, 0, 378, 302, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 0, 0, 0, 167, 168,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	0, 205, 0, 0, 0, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 975,
-	974, 984, 985, 977, 978, 979, 980, 981, 982, 983,
-	976, 0, 0, 986, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 0, 0, 0, 427, 0, 0, 0,
-	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
-	357, 200, 414, 377, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 170, 184, 277, 0, 346, 240,
-	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
-	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 224, 0, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 0, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 0,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	804, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 0, 0, 803,
-	427, 0, 0, 0, 0, 0, 800, 801, 274, 766,
-	271, 171, 187, 794, 798, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 0, 1078, 0, 0, 0, 0, 224,
-	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 0, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
-	1080, 0, 0, 0, 0, 0, 0, 198, 0, 205,
-	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 964, 965, 963, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 966, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 0, 0, 0, 427, 0, 0, 0, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 35, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 71, 0,
-	586, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
-	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 1446, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 0, 0, 0, 167, 168, 169, 0, 1261,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 1444, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 760, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
-	0, 0, 0, 0, 0, 274, 766, 271, 171, 187,
-	764, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 1446, 0, 0, 0, 0, 224, 0, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 1261, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
-	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
+	272, 281, 0, 0, 326, 357, 200, 414, 377, 554,
+	565, 560, 561, 558, 559, 553, 557, 556, 555, 568,
+	545, 546, 547, 548, 550, 0, 562, 563, 549, 170,
+	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
+	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
 	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
 	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
 	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
@@ -2896,28 +2161,76 @@ var yyAct = [...]int{
 	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
 	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
+	0, 317, 0, 0, 0, 0, 509, 0, 0, 0,
+	224, 508, 0, 0, 0, 275, 221, 0, 0, 331,
 	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
-	175, 314, 379, 239, 0, 0, 586, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
+	223, 208, 259, 290, 329, 387, 323, 552, 279, 0,
+	0, 378, 302, 0, 0, 0, 0, 0, 543, 544,
+	0, 0, 0, 0, 0, 0, 1517, 0, 265, 207,
+	175, 314, 379, 239, 71, 0, 0, 167, 168, 169,
+	530, 529, 532, 533, 534, 535, 0, 0, 198, 531,
+	205, 536, 537, 538, 1518, 219, 263, 226, 218, 395,
+	0, 0, 0, 191, 0, 174, 354, 0, 0, 0,
+	0, 0, 506, 523, 0, 551, 0, 0, 0, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 520, 521, 0, 0, 0,
+	0, 567, 0, 522, 0, 0, 515, 516, 518, 517,
+	519, 524, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 247, 0, 303, 0, 566, 0, 0, 427, 0,
+	0, 564, 0, 0, 0, 0, 274, 0, 271, 171,
+	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
+	0, 356, 327, 412, 194, 237, 349, 332, 0, 0,
+	355, 280, 400, 344, 410, 0, 0, 428, 429, 217,
+	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
+	375, 300, 396, 397, 270, 374, 245, 278, 436, 186,
+	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
+	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
+	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
+	0, 422, 309, 399, 407, 298, 289, 182, 405, 296,
+	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
+	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
+	0, 232, 236, 242, 244, 250, 251, 258, 276, 320,
+	341, 339, 345, 0, 394, 411, 419, 426, 432, 433,
+	437, 434, 435, 438, 308, 257, 376, 272, 281, 0,
+	0, 326, 357, 200, 414, 377, 554, 565, 560, 561,
+	558, 559, 553, 557, 556, 555, 568, 545, 546, 547,
+	548, 550, 0, 562, 563, 549, 170, 184, 277, 0,
+	346, 240, 440, 421, 417, 0, 0, 216, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
+	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
+	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
+	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
+	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
+	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
+	285, 0, 0, 287, 234, 252, 262, 0, 420, 382,
+	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
+	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
+	388, 389, 390, 392, 299, 220, 393, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
+	0, 0, 0, 509, 0, 0, 0, 224, 508, 0,
+	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
+	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
+	290, 329, 387, 323, 552, 279, 0, 0, 378, 302,
+	0, 0, 0, 0, 0, 543, 544, 0, 0, 0,
+	0, 0, 0, 0, 0, 265, 207, 175, 314, 379,
+	239, 71, 0, 586, 167, 168, 169, 530, 529, 532,
+	533, 534, 535, 0, 0, 198, 531, 205, 536, 537,
+	538, 0, 219, 263, 226, 218, 395, 0, 0, 0,
+	191, 0, 174, 354, 0, 0, 0, 0, 0, 506,
+	523, 0, 551, 0, 0, 0, 229, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 520, 521, 0, 0, 0, 0, 567, 0,
+	522, 0, 0, 515, 516, 518, 517, 519, 524, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 0, 0, 0, 427, 0, 0, 0, 2127,
+	303, 0, 566, 0, 0, 427, 0, 0, 564, 0,
 	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
 	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
+	412, 194, 237, 349, 332, 0, 0, 355, 280, 400,
+	344, 410, 0, 0, 428, 429, 217, 307, 418, 391,
+	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
+	397, 270, 374, 245, 278, 436, 186, 364, 202, 179,
 	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
 	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
 	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
@@ -2927,9 +2240,9 @@ var yyAct = [...]int{
 	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
 	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
 	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
+	200, 414, 377, 554, 565, 560, 561, 558, 559, 553,
+	557, 556, 555, 568, 545, 546, 547, 548, 550, 0,
+	562, 563, 549, 170, 184, 277, 0, 346, 240, 440,
 	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
@@ -2942,77 +2255,173 @@ var yyAct = [...]int{
 	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
 	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
 	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 35, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 224, 0, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 0, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 71,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
+	509, 0, 0, 0, 224, 508, 0, 0, 0, 275,
+	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
+	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
+	323, 552, 279, 0, 0, 378, 302, 0, 0, 0,
+	0, 0, 543, 544, 0, 0, 0, 0, 0, 0,
+	0, 0, 265, 207, 175, 314, 379, 239, 71, 0,
+	0, 167, 168, 169, 530, 529, 532, 533, 534, 535,
+	0, 0, 198, 531, 205, 536, 537, 538, 0, 219,
+	263, 226, 218, 395, 0, 0, 0, 191, 0, 174,
+	354, 0, 0, 0, 0, 0, 506, 523, 0, 551,
+	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 520,
+	521, 599, 0, 0, 0, 567, 0, 522, 0, 0,
+	515, 516, 518, 517, 519, 524, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 247, 0, 303, 0, 566,
+	0, 0, 427, 0, 0, 564, 0, 0, 0, 0,
+	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
+	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
+	349, 332, 0, 0, 355, 280, 400, 344, 410, 0,
+	0, 428, 429, 217, 307, 418, 391, 424, 439, 188,
+	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
+	245, 278, 436, 186, 364, 202, 179, 386, 408, 199,
+	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
+	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
+	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
+	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
+	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
+	443, 195, 196, 197, 0, 232, 236, 242, 244, 250,
+	251, 258, 276, 320, 341, 339, 345, 0, 394, 411,
+	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
+	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
+	554, 565, 560, 561, 558, 559, 553, 557, 556, 555,
+	568, 545, 546, 547, 548, 550, 0, 562, 563, 549,
+	170, 184, 277, 0, 346, 240, 440, 421, 417, 0,
+	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 0, 0, 0,
-	427, 0, 0, 0, 0, 0, 0, 0, 274, 0,
-	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
+	0, 0, 0, 0, 172, 173, 185, 193, 203, 215,
+	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
+	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
+	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
+	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
+	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
+	249, 409, 431, 0, 285, 0, 0, 287, 234, 252,
+	262, 0, 420, 382, 189, 353, 241, 178, 206, 192,
+	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
+	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
+	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 317, 0, 0, 0, 0, 509, 0, 0,
+	0, 224, 508, 0, 0, 0, 275, 221, 0, 0,
+	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
+	227, 223, 208, 259, 290, 329, 387, 323, 552, 279,
+	0, 0, 378, 302, 0, 0, 0, 0, 0, 543,
+	544, 0, 0, 0, 0, 0, 0, 0, 0, 265,
+	207, 175, 314, 379, 239, 71, 0, 0, 167, 168,
+	169, 530, 1424, 532, 533, 534, 535, 0, 0, 198,
+	531, 205, 536, 537, 538, 0, 219, 263, 226, 218,
+	395, 0, 0, 0, 191, 0, 174, 354, 0, 0,
+	0, 0, 0, 506, 523, 0, 551, 0, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 520, 521, 599, 0,
+	0, 0, 567, 0, 522, 0, 0, 515, 516, 518,
+	517, 519, 524, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 247, 0, 303, 0, 566, 0, 0, 427,
+	0, 0, 564, 0, 0, 0, 0, 274, 0, 271,
+	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
+	210, 0, 356, 327, 412, 194, 237, 349, 332, 0,
+	0, 355, 280, 400, 344, 410, 0, 0, 428, 429,
 	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	415, 375, 300, 396, 397, 270, 374, 245, 278, 436,
+	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
+	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
+	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
+	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
+	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
+	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
+	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
+	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
+	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
+	0, 0, 326, 357, 200, 414, 377, 554, 565, 560,
+	561, 558, 559, 553, 557, 556, 555, 568, 545, 546,
+	547, 548, 550, 0, 562, 563, 549, 170, 184, 277,
+	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 224,
-	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 0, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
-	0, 1465, 0, 0, 1466, 0, 0, 198, 0, 205,
-	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
+	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
+	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
+	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
+	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
+	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
+	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
+	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
+	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
+	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
+	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
+	0, 0, 0, 0, 509, 0, 0, 0, 224, 508,
+	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
+	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
+	259, 290, 329, 387, 323, 552, 279, 0, 0, 378,
+	302, 0, 0, 0, 0, 0, 543, 544, 0, 0,
+	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
+	379, 239, 71, 0, 0, 167, 168, 169, 530, 1421,
+	532, 533, 534, 535, 0, 0, 198, 531, 205, 536,
+	537, 538, 0, 219, 263, 226, 218, 395, 0, 0,
+	0, 191, 0, 174, 354, 0, 0, 0, 0, 0,
+	506, 523, 0, 551, 0, 0, 0, 229, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 520, 521, 599, 0, 0, 0, 567,
+	0, 522, 0, 0, 515, 516, 518, 517, 519, 524,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
+	0, 303, 0, 566, 0, 0, 427, 0, 0, 564,
+	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
+	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
+	327, 412, 194, 237, 349, 332, 0, 0, 355, 280,
+	400, 344, 410, 0, 0, 428, 429, 217, 307, 418,
+	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
+	396, 397, 270, 374, 245, 278, 436, 186, 364, 202,
+	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
+	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
+	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
+	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
+	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
+	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
+	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
+	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
+	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
+	357, 200, 414, 377, 554, 565, 560, 561, 558, 559,
+	553, 557, 556, 555, 568, 545, 546, 547, 548, 550,
+	0, 562, 563, 549, 170, 184, 277, 0, 346, 240,
+	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
+	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
+	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
+	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
+	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
+	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
+	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
+	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
+	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
+	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
+	390, 392, 299, 220, 579, 393, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
+	0, 0, 509, 0, 0, 0, 224, 508, 0, 0,
+	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
+	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
+	329, 387, 323, 552, 279, 0, 0, 378, 302, 0,
+	0, 0, 0, 0, 543, 544, 0, 0, 0, 0,
+	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
+	71, 0, 0, 167, 168, 169, 530, 529, 532, 533,
+	534, 535, 0, 0, 198, 531, 205, 536, 537, 538,
+	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
+	0, 174, 354, 0, 0, 0, 0, 0, 506, 523,
+	0, 551, 0, 0, 0, 229, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 520, 521, 0, 0, 0, 0, 567, 0, 522,
+	0, 0, 515, 516, 518, 517, 519, 524, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 0, 0, 0, 427, 0, 0, 0, 0, 0,
+	0, 566, 0, 0, 427, 0, 0, 564, 0, 0,
 	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
 	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
+	194, 237, 349, 332, 0, 0, 355, 280, 400, 344,
+	410, 0, 0, 428, 429, 217, 307, 418, 391, 424,
+	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
+	270, 374, 245, 278, 436, 186, 364, 202, 179, 386,
 	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
 	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
 	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
@@ -3022,9 +2431,9 @@ var yyAct = [...]int{
 	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
 	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
 	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 184, 277, 0, 346, 240, 440, 421,
+	414, 377, 554, 565, 560, 561, 558, 559, 553, 557,
+	556, 555, 568, 545, 546, 547, 548, 550, 0, 562,
+	563, 549, 170, 184, 277, 0, 346, 240, 440, 421,
 	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
@@ -3038,28 +2447,76 @@ var yyAct = [...]int{
 	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
 	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
 	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 224, 1111, 0, 0, 0, 275, 221,
+	0, 0, 0, 0, 317, 0, 0, 0, 0, 509,
+	0, 0, 0, 224, 508, 0, 0, 0, 275, 221,
 	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
 	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	0, 279, 0, 0, 378, 302, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 207, 175, 314, 379, 239, 0, 0, 0,
-	167, 168, 169, 0, 1110, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	552, 279, 0, 0, 378, 302, 0, 0, 0, 0,
+	0, 543, 544, 0, 0, 0, 0, 0, 0, 0,
+	0, 265, 207, 175, 314, 379, 239, 71, 0, 0,
+	167, 168, 169, 530, 529, 532, 533, 534, 535, 0,
+	0, 198, 531, 205, 536, 537, 538, 0, 219, 263,
+	226, 218, 395, 0, 0, 0, 191, 0, 174, 354,
+	0, 0, 0, 0, 0, 506, 523, 0, 551, 0,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 520, 521,
+	0, 0, 0, 0, 567, 0, 522, 0, 0, 515,
+	516, 518, 517, 519, 524, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 247, 0, 303, 0, 566, 0,
+	0, 427, 0, 0, 564, 0, 0, 0, 0, 274,
+	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
+	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
+	332, 0, 0, 355, 280, 400, 344, 410, 0, 0,
+	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
+	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
+	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
+	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
+	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
+	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
+	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
+	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
+	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
+	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
+	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
+	272, 281, 0, 0, 326, 357, 200, 414, 377, 554,
+	565, 560, 561, 558, 559, 553, 557, 556, 555, 568,
+	545, 546, 547, 548, 550, 0, 562, 563, 549, 170,
+	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
+	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
+	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
+	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
+	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
+	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
+	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
+	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
+	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
+	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
+	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 317, 0, 0, 0, 0, 0, 0, 0, 0,
+	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
+	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
+	223, 208, 259, 290, 329, 387, 323, 552, 279, 0,
+	0, 378, 302, 0, 0, 0, 0, 0, 543, 544,
+	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
+	175, 314, 379, 239, 71, 0, 0, 167, 168, 169,
+	530, 529, 532, 533, 534, 535, 0, 0, 198, 531,
+	205, 536, 537, 538, 0, 219, 263, 226, 218, 395,
+	0, 0, 0, 191, 0, 174, 354, 0, 0, 0,
+	0, 0, 0, 523, 0, 551, 0, 0, 0, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 0, 303, 0, 0, 0, 0, 427, 0,
-	0, 0, 0, 0, 0, 0, 274, 0, 271, 171,
+	0, 0, 0, 0, 0, 520, 521, 0, 0, 0,
+	0, 567, 0, 522, 0, 0, 515, 516, 518, 517,
+	519, 524, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 247, 0, 303, 0, 566, 0, 0, 427, 0,
+	0, 564, 0, 0, 0, 0, 274, 0, 271, 171,
 	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
+	0, 356, 327, 412, 194, 237, 349, 332, 2273, 0,
+	355, 280, 400, 344, 410, 0, 0, 428, 429, 217,
+	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
+	375, 300, 396, 397, 270, 374, 245, 278, 436, 186,
 	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
 	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
 	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
@@ -3069,9 +2526,9 @@ var yyAct = [...]int{
 	0, 232, 236, 242, 244, 250, 251, 258, 276, 320,
 	341, 339, 345, 0, 394, 411, 419, 426, 432, 433,
 	437, 434, 435, 438, 308, 257, 376, 272, 281, 0,
-	0, 326, 357, 200, 414, 377, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 170, 184, 277, 0,
+	0, 326, 357, 200, 414, 377, 554, 565, 560, 561,
+	558, 559, 553, 557, 556, 555, 568, 545, 546, 547,
+	548, 550, 0, 562, 563, 549, 170, 184, 277, 0,
 	346, 240, 440, 421, 417, 0, 0, 216, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3089,25 +2546,73 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 224, 0, 0,
 	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
 	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 0, 279, 0, 0, 378, 302,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	290, 329, 387, 323, 552, 279, 0, 0, 378, 302,
+	0, 0, 0, 0, 0, 543, 544, 0, 0, 0,
 	0, 0, 0, 0, 0, 265, 207, 175, 314, 379,
-	239, 0, 0, 0, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 0, 205, 0, 0,
-	0, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	239, 71, 0, 586, 167, 168, 169, 530, 529, 532,
+	533, 534, 535, 0, 0, 198, 531, 205, 536, 537,
+	538, 0, 219, 263, 226, 218, 395, 0, 0, 0,
+	191, 0, 174, 354, 0, 0, 0, 0, 0, 0,
+	523, 0, 551, 0, 0, 0, 229, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 520, 521, 0, 0, 0, 0, 567, 0,
+	522, 0, 0, 515, 516, 518, 517, 519, 524, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
+	303, 0, 566, 0, 0, 427, 0, 0, 564, 0,
+	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
+	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
+	412, 194, 237, 349, 332, 0, 0, 355, 280, 400,
+	344, 410, 0, 0, 428, 429, 217, 307, 418, 391,
+	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
+	397, 270, 374, 245, 278, 436, 186, 364, 202, 179,
+	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
+	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
+	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
+	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
+	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
+	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
+	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
+	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
+	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
+	200, 414, 377, 554, 565, 560, 561, 558, 559, 553,
+	557, 556, 555, 568, 545, 546, 547, 548, 550, 0,
+	562, 563, 549, 170, 184, 277, 0, 346, 240, 440,
+	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 247, 0, 303, 0, 0,
-	0, 0, 427, 0, 0, 0, 2210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
+	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
+	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
+	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
+	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
+	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
+	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
+	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
+	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
+	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
+	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
+	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
+	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
+	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
+	323, 552, 279, 0, 0, 378, 302, 0, 0, 0,
+	0, 0, 543, 544, 0, 0, 0, 0, 0, 0,
+	0, 0, 265, 207, 175, 314, 379, 239, 71, 0,
+	0, 167, 168, 169, 530, 529, 532, 533, 534, 535,
+	0, 0, 198, 531, 205, 536, 537, 538, 0, 219,
+	263, 226, 218, 395, 0, 0, 0, 191, 0, 174,
+	354, 0, 0, 0, 0, 0, 0, 523, 0, 551,
+	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 520,
+	521, 0, 0, 0, 0, 567, 0, 522, 0, 0,
+	515, 516, 518, 517, 519, 524, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 247, 0, 303, 0, 566,
+	0, 0, 427, 0, 0, 564, 0, 0, 0, 0,
 	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
 	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
+	349, 332, 0, 0, 355, 280, 400, 344, 410, 0,
+	0, 428, 429, 217, 307, 418, 391, 424, 439, 188,
+	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
+	245, 278, 436, 186, 364, 202, 179, 386, 408, 199,
 	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
 	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
 	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
@@ -3117,8 +2622,8 @@ var yyAct = [...]int{
 	251, 258, 276, 320, 341, 339, 345, 0, 394, 411,
 	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
 	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	554, 565, 560, 561, 558, 559, 553, 557, 556, 555,
+	568, 545, 546, 547, 548, 550, 0, 562, 563, 549,
 	170, 184, 277, 0, 346, 240, 440, 421, 417, 0,
 	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3142,19 +2647,67 @@ var yyAct = [...]int{
 	207, 175, 314, 379, 239, 0, 0, 0, 167, 168,
 	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
 	0, 205, 0, 0, 0, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
+	395, 0, 0, 0, 191, 0, 174, 354, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 978, 977, 987, 988, 980, 981, 982, 983,
+	984, 985, 986, 979, 0, 0, 989, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
+	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
+	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
+	210, 0, 356, 327, 412, 194, 237, 349, 332, 0,
+	0, 355, 280, 400, 344, 410, 0, 0, 428, 429,
+	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
+	415, 375, 300, 396, 397, 270, 374, 245, 278, 436,
+	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
+	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
+	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
+	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
+	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
+	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
+	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
+	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
+	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
+	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
+	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
+	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
+	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
+	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
+	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
+	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
+	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
+	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
+	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
+	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
+	0, 0, 0, 0, 0, 0, 0, 0, 224, 0,
+	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
+	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
+	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
+	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
+	379, 239, 0, 0, 0, 167, 168, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
+	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
+	0, 191, 0, 174, 354, 0, 806, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 0, 0, 0, 427, 0, 0, 0,
-	2127, 0, 0, 0, 274, 0, 271, 171, 187, 0,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
+	0, 303, 0, 0, 0, 805, 427, 0, 0, 0,
+	0, 0, 802, 803, 274, 766, 271, 171, 187, 796,
+	800, 313, 352, 358, 0, 0, 0, 210, 0, 356,
+	327, 412, 194, 237, 349, 332, 0, 0, 355, 280,
+	400, 344, 410, 0, 0, 428, 429, 217, 307, 418,
+	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
+	396, 397, 270, 374, 245, 278, 436, 186, 364, 202,
 	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
 	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
 	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
@@ -3181,110 +2734,111 @@ var yyAct = [...]int{
 	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
 	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 224, 0, 0, 0, 0,
+	1081, 0, 0, 0, 0, 224, 0, 0, 0, 0,
 	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
 	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
 	387, 323, 0, 279, 0, 0, 378, 302, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 71,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
+	0, 0, 0, 265, 207, 175, 314, 379, 239, 0,
+	0, 0, 167, 168, 169, 0, 1083, 0, 0, 0,
 	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
 	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 0, 0, 0,
-	427, 0, 0, 0, 0, 0, 0, 0, 274, 0,
-	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 224,
-	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 0, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
-	1261, 0, 0, 0, 0, 0, 0, 198, 0, 205,
-	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	174, 354, 0, 0, 967, 968, 966, 0, 0, 0,
+	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 969, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 0, 0, 0, 427, 0, 0, 0, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
+	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
+	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
+	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
+	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
+	237, 349, 332, 0, 0, 355, 280, 400, 344, 410,
+	0, 0, 428, 429, 217, 307, 418, 391, 424, 439,
 	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 0, 0, 0, 0, 0, 0, 0, 0,
+	374, 245, 278, 436, 186, 364, 202, 179, 386, 408,
+	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
+	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
+	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
+	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
+	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
+	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
+	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
+	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
+	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
+	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
+	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
+	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
+	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
+	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
+	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
+	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
+	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
+	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
+	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
+	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
+	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
+	220, 35, 393, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
 	0, 0, 0, 224, 0, 0, 0, 0, 275, 221,
 	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
 	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
 	0, 279, 0, 0, 378, 302, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 207, 175, 314, 379, 239, 0, 0, 0,
-	167, 168, 169, 0, 1080, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
+	0, 265, 207, 175, 314, 379, 239, 71, 0, 586,
+	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
+	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
+	226, 218, 395, 0, 0, 0, 191, 0, 174, 354,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
+	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
+	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
+	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
+	332, 0, 0, 355, 280, 400, 344, 410, 0, 0,
+	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
+	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
+	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
+	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
+	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
+	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
+	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
+	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
+	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
+	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
+	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
+	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
+	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
+	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
+	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
+	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
+	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
+	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
+	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
+	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
+	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
+	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
+	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 317, 0, 0, 0, 1451, 0, 0, 0, 0,
+	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
+	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
+	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
+	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
+	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
+	0, 1266, 0, 0, 0, 0, 0, 0, 198, 0,
+	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
+	0, 0, 0, 191, 0, 174, 354, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3293,10 +2847,10 @@ var yyAct = [...]int{
 	0, 247, 0, 303, 0, 0, 0, 0, 427, 0,
 	0, 0, 0, 0, 0, 0, 274, 0, 271, 171,
 	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
+	0, 356, 327, 412, 194, 237, 349, 332, 0, 1449,
+	355, 280, 400, 344, 410, 0, 0, 428, 429, 217,
+	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
+	375, 300, 396, 397, 270, 374, 245, 278, 436, 186,
 	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
 	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
 	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
@@ -3332,7 +2886,55 @@ var yyAct = [...]int{
 	239, 0, 0, 0, 167, 168, 169, 0, 0, 0,
 	0, 0, 0, 0, 0, 198, 0, 205, 0, 0,
 	0, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	191, 0, 174, 354, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 760, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
+	303, 0, 0, 0, 0, 427, 0, 0, 0, 0,
+	0, 0, 0, 274, 766, 271, 171, 187, 764, 0,
+	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
+	412, 194, 237, 349, 332, 0, 0, 355, 280, 400,
+	344, 410, 0, 0, 428, 429, 217, 307, 418, 391,
+	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
+	397, 270, 374, 245, 278, 436, 186, 364, 202, 179,
+	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
+	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
+	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
+	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
+	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
+	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
+	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
+	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
+	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
+	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
+	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
+	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
+	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
+	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
+	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
+	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
+	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
+	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
+	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
+	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
+	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 317, 0, 0, 0, 1451,
+	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
+	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
+	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
+	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 265, 207, 175, 314, 379, 239, 0, 0,
+	0, 167, 168, 169, 0, 1266, 0, 0, 0, 0,
+	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
+	263, 226, 218, 395, 0, 0, 0, 191, 0, 174,
+	354, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3341,10 +2943,10 @@ var yyAct = [...]int{
 	0, 0, 427, 0, 0, 0, 0, 0, 0, 0,
 	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
 	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
+	349, 332, 0, 0, 355, 280, 400, 344, 410, 0,
+	0, 428, 429, 217, 307, 418, 391, 424, 439, 188,
+	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
+	245, 278, 436, 186, 364, 202, 179, 386, 408, 199,
 	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
 	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
 	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
@@ -3356,7 +2958,7 @@ var yyAct = [...]int{
 	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	170, 184, 277, 1351, 346, 240, 440, 421, 417, 0,
+	170, 184, 277, 0, 346, 240, 440, 421, 417, 0,
 	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 172, 173, 185, 193, 203, 215,
@@ -3370,111 +2972,112 @@ var yyAct = [...]int{
 	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
 	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
 	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 1233, 0, 0, 0, 0, 0,
+	0, 0, 317, 0, 0, 0, 0, 0, 0, 0,
 	0, 224, 0, 0, 0, 0, 275, 221, 0, 0,
 	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
 	227, 223, 208, 259, 290, 329, 387, 323, 0, 279,
 	0, 0, 378, 302, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 0, 0, 0, 167, 168,
+	207, 175, 314, 379, 239, 0, 0, 586, 167, 168,
 	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
 	0, 205, 0, 0, 0, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 0, 0, 0, 427, 0, 0, 0,
-	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
-	357, 200, 414, 377, 0, 0, 0, 0, 0, 0,
+	395, 0, 0, 0, 191, 0, 174, 354, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 170, 184, 277, 0, 346, 240,
-	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
-	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 1231, 0,
-	0, 0, 0, 0, 0, 224, 0, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 0, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 0,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 0, 0, 0,
-	427, 0, 0, 0, 0, 0, 0, 0, 274, 0,
-	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
+	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
+	0, 0, 0, 2158, 0, 0, 0, 274, 0, 271,
+	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
+	210, 0, 356, 327, 412, 194, 237, 349, 332, 0,
+	0, 355, 280, 400, 344, 410, 0, 0, 428, 429,
 	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 0, 0,
+	415, 375, 300, 396, 397, 270, 374, 245, 278, 436,
+	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
+	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
+	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
+	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
+	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
+	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
+	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
+	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
+	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
+	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
+	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
+	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
+	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
+	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
+	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
+	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
+	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
+	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
+	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
+	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
+	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
+	368, 388, 389, 390, 392, 299, 220, 35, 393, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 1229, 0, 0, 0, 0, 0, 0, 224,
+	317, 0, 0, 0, 0, 0, 0, 0, 0, 224,
 	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
 	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
 	208, 259, 290, 329, 387, 323, 0, 279, 0, 0,
 	378, 302, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
+	314, 379, 239, 71, 0, 0, 167, 168, 169, 0,
 	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
 	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 191, 0, 174, 354, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
+	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
+	356, 327, 412, 194, 237, 349, 332, 0, 0, 355,
+	280, 400, 344, 410, 0, 0, 428, 429, 217, 307,
+	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
+	300, 396, 397, 270, 374, 245, 278, 436, 186, 364,
+	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
+	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
+	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
+	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
+	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
+	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
+	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
+	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
+	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
+	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
+	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
+	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
+	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
+	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
+	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
+	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
+	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
+	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
+	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
+	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
+	0, 0, 0, 0, 0, 0, 224, 0, 0, 0,
+	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
+	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
+	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
+	0, 0, 0, 167, 168, 169, 0, 0, 1470, 0,
+	0, 1471, 0, 0, 198, 0, 205, 0, 0, 0,
+	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
+	0, 174, 354, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3483,10 +3086,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 427, 0, 0, 0, 0, 0,
 	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
 	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
+	194, 237, 349, 332, 0, 0, 355, 280, 400, 344,
+	410, 0, 0, 428, 429, 217, 307, 418, 391, 424,
+	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
+	270, 374, 245, 278, 436, 186, 364, 202, 179, 386,
 	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
 	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
 	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
@@ -3498,30 +3101,78 @@ var yyAct = [...]int{
 	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
 	414, 377, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
+	0, 0, 170, 184, 277, 0, 346, 240, 440, 421,
+	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
+	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
+	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
+	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
+	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
+	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
+	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
+	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
+	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
+	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
+	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
+	0, 0, 0, 224, 1114, 0, 0, 0, 275, 221,
+	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
+	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
+	0, 279, 0, 0, 378, 302, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 265, 207, 175, 314, 379, 239, 0, 0, 0,
+	167, 168, 169, 0, 1113, 0, 0, 0, 0, 0,
+	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
+	226, 218, 395, 0, 0, 0, 191, 0, 174, 354,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
+	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
+	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
+	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
+	332, 0, 0, 355, 280, 400, 344, 410, 0, 0,
+	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
+	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
+	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
+	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
+	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
+	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
+	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
+	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
+	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
+	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
+	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
+	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
+	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
+	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 1227, 0, 0, 0,
-	0, 0, 0, 224, 0, 0, 0, 0, 275, 221,
-	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	0, 279, 0, 0, 378, 302, 0, 0, 0, 0,
+	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
+	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
+	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
+	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
+	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
+	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
+	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
+	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
+	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
+	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 207, 175, 314, 379, 239, 0, 0, 0,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
+	0, 317, 0, 0, 0, 0, 0, 0, 0, 0,
+	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
+	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
+	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
+	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
+	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
+	0, 1266, 0, 0, 0, 0, 0, 0, 198, 0,
+	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
+	0, 0, 0, 191, 0, 174, 354, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3530,10 +3181,10 @@ var yyAct = [...]int{
 	0, 247, 0, 303, 0, 0, 0, 0, 427, 0,
 	0, 0, 0, 0, 0, 0, 274, 0, 271, 171,
 	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
+	0, 356, 327, 412, 194, 237, 349, 332, 0, 0,
+	355, 280, 400, 344, 410, 0, 0, 428, 429, 217,
+	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
+	375, 300, 396, 397, 270, 374, 245, 278, 436, 186,
 	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
 	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
 	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
@@ -3560,7 +3211,7 @@ var yyAct = [...]int{
 	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
 	388, 389, 390, 392, 299, 220, 393, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
-	1225, 0, 0, 0, 0, 0, 0, 224, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 224, 0, 0,
 	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
 	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
 	290, 329, 387, 323, 0, 279, 0, 0, 378, 302,
@@ -3569,19 +3220,67 @@ var yyAct = [...]int{
 	239, 0, 0, 0, 167, 168, 169, 0, 0, 0,
 	0, 0, 0, 0, 0, 198, 0, 205, 0, 0,
 	0, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	191, 0, 174, 354, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
+	303, 0, 0, 0, 0, 427, 0, 0, 0, 2246,
+	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
+	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
+	412, 194, 237, 349, 332, 0, 0, 355, 280, 400,
+	344, 410, 0, 0, 428, 429, 217, 307, 418, 391,
+	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
+	397, 270, 374, 245, 278, 436, 186, 364, 202, 179,
+	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
+	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
+	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
+	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
+	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
+	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
+	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
+	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
+	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
+	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
+	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
+	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
+	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
+	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
+	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
+	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
+	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
+	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
+	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
+	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
+	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
+	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
+	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
+	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
+	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 265, 207, 175, 314, 379, 239, 0, 0,
+	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
+	263, 226, 218, 395, 0, 0, 0, 191, 0, 174,
+	354, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 247, 0, 303, 0, 0,
-	0, 0, 427, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 427, 0, 0, 0, 2158, 0, 0, 0,
 	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
 	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
+	349, 332, 0, 0, 355, 280, 400, 344, 410, 0,
+	0, 428, 429, 217, 307, 418, 391, 424, 439, 188,
+	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
+	245, 278, 436, 186, 364, 202, 179, 386, 408, 199,
 	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
 	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
 	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
@@ -3607,16 +3306,64 @@ var yyAct = [...]int{
 	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
 	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
 	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 1221, 0, 0, 0, 0, 0,
+	0, 0, 317, 0, 0, 0, 0, 0, 0, 0,
 	0, 224, 0, 0, 0, 0, 275, 221, 0, 0,
 	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
 	227, 223, 208, 259, 290, 329, 387, 323, 0, 279,
 	0, 0, 378, 302, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 0, 0, 0, 167, 168,
+	207, 175, 314, 379, 239, 0, 0, 586, 167, 168,
 	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
 	0, 205, 0, 0, 0, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
+	395, 0, 0, 0, 191, 0, 174, 354, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
+	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
+	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
+	210, 0, 356, 327, 412, 194, 237, 349, 332, 0,
+	0, 355, 280, 400, 344, 410, 0, 0, 428, 429,
+	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
+	415, 375, 300, 396, 397, 270, 374, 245, 278, 436,
+	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
+	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
+	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
+	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
+	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
+	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
+	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
+	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
+	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
+	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
+	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
+	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
+	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
+	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
+	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
+	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
+	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
+	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
+	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
+	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
+	0, 0, 0, 0, 0, 0, 0, 0, 224, 0,
+	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
+	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
+	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
+	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
+	379, 239, 71, 0, 0, 167, 168, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
+	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
+	0, 191, 0, 174, 354, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3625,10 +3372,10 @@ var yyAct = [...]int{
 	0, 303, 0, 0, 0, 0, 427, 0, 0, 0,
 	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
 	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
+	327, 412, 194, 237, 349, 332, 0, 0, 355, 280,
+	400, 344, 410, 0, 0, 428, 429, 217, 307, 418,
+	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
+	396, 397, 270, 374, 245, 278, 436, 186, 364, 202,
 	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
 	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
 	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
@@ -3654,7 +3401,7 @@ var yyAct = [...]int{
 	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
 	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
 	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 1219, 0,
+	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
 	0, 0, 0, 0, 0, 224, 0, 0, 0, 0,
 	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
 	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
@@ -3664,6 +3411,54 @@ var yyAct = [...]int{
 	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
 	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
 	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
+	174, 354, 0, 1840, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
+	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
+	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
+	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
+	237, 349, 332, 0, 0, 355, 280, 400, 344, 410,
+	0, 0, 428, 429, 217, 307, 418, 391, 424, 439,
+	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
+	374, 245, 278, 436, 186, 364, 202, 179, 386, 408,
+	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
+	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
+	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
+	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
+	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
+	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
+	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
+	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
+	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
+	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
+	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
+	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
+	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
+	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
+	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
+	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
+	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
+	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
+	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
+	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
+	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 317, 0, 0, 0, 0, 0, 0,
+	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
+	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
+	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
+	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
+	168, 169, 0, 1083, 0, 0, 0, 0, 0, 0,
+	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
+	218, 395, 0, 0, 0, 191, 0, 174, 354, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3673,9 +3468,9 @@ var yyAct = [...]int{
 	427, 0, 0, 0, 0, 0, 0, 0, 274, 0,
 	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
 	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
+	0, 0, 355, 280, 400, 344, 410, 0, 0, 428,
+	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
+	384, 415, 375, 300, 396, 397, 270, 374, 245, 278,
 	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
 	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
 	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
@@ -3702,7 +3497,7 @@ var yyAct = [...]int{
 	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
 	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 1217, 0, 0, 0, 0, 0, 0, 224,
+	317, 0, 0, 0, 0, 0, 0, 0, 0, 224,
 	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
 	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
 	208, 259, 290, 329, 387, 323, 0, 279, 0, 0,
@@ -3711,7 +3506,55 @@ var yyAct = [...]int{
 	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
 	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
 	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 191, 0, 174, 354, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
+	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
+	356, 327, 412, 194, 237, 349, 332, 0, 0, 355,
+	280, 400, 344, 410, 0, 0, 428, 429, 217, 307,
+	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
+	300, 396, 397, 270, 374, 245, 278, 436, 186, 364,
+	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
+	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
+	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
+	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
+	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
+	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
+	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
+	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
+	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
+	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 184, 277, 1356, 346,
+	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
+	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
+	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
+	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
+	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
+	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
+	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
+	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
+	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
+	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 317, 0, 1238,
+	0, 0, 0, 0, 0, 0, 224, 0, 0, 0,
+	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
+	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
+	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
+	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
+	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
+	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
+	0, 174, 354, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3720,10 +3563,10 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 427, 0, 0, 0, 0, 0,
 	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
 	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
+	194, 237, 349, 332, 0, 0, 355, 280, 400, 344,
+	410, 0, 0, 428, 429, 217, 307, 418, 391, 424,
+	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
+	270, 374, 245, 278, 436, 186, 364, 202, 179, 386,
 	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
 	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
 	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
@@ -3749,16 +3592,64 @@ var yyAct = [...]int{
 	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
 	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
 	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 0, 1236, 0, 0, 0,
 	0, 0, 0, 224, 0, 0, 0, 0, 275, 221,
 	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
 	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
 	0, 279, 0, 0, 378, 302, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 207, 175, 314, 379, 239, 1192, 0, 0,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
+	0, 265, 207, 175, 314, 379, 239, 0, 0, 0,
+	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
+	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
+	226, 218, 395, 0, 0, 0, 191, 0, 174, 354,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
+	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
+	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
+	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
+	332, 0, 0, 355, 280, 400, 344, 410, 0, 0,
+	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
+	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
+	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
+	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
+	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
+	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
+	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
+	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
+	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
+	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
+	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
+	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
+	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
+	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
+	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
+	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
+	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
+	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
+	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
+	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
+	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
+	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
+	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 317, 0, 1234, 0, 0, 0, 0, 0, 0,
+	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
+	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
+	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
+	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
+	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
+	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
+	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
+	0, 0, 0, 191, 0, 174, 354, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3767,10 +3658,10 @@ var yyAct = [...]int{
 	0, 247, 0, 303, 0, 0, 0, 0, 427, 0,
 	0, 0, 0, 0, 0, 0, 274, 0, 271, 171,
 	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
+	0, 356, 327, 412, 194, 237, 349, 332, 0, 0,
+	355, 280, 400, 344, 410, 0, 0, 428, 429, 217,
+	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
+	375, 300, 396, 397, 270, 374, 245, 278, 436, 186,
 	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
 	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
 	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
@@ -3796,8 +3687,8 @@ var yyAct = [...]int{
 	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
 	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
 	388, 389, 390, 392, 299, 220, 393, 0, 0, 0,
-	0, 1093, 0, 0, 0, 0, 0, 0, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 224, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
+	1232, 0, 0, 0, 0, 0, 0, 224, 0, 0,
 	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
 	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
 	290, 329, 387, 323, 0, 279, 0, 0, 378, 302,
@@ -3806,7 +3697,55 @@ var yyAct = [...]int{
 	239, 0, 0, 0, 167, 168, 169, 0, 0, 0,
 	0, 0, 0, 0, 0, 198, 0, 205, 0, 0,
 	0, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	191, 0, 174, 354, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
+	303, 0, 0, 0, 0, 427, 0, 0, 0, 0,
+	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
+	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
+	412, 194, 237, 349, 332, 0, 0, 355, 280, 400,
+	344, 410, 0, 0, 428, 429, 217, 307, 418, 391,
+	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
+	397, 270, 374, 245, 278, 436, 186, 364, 202, 179,
+	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
+	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
+	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
+	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
+	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
+	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
+	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
+	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
+	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
+	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
+	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
+	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
+	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
+	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
+	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
+	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
+	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
+	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
+	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
+	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
+	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 317, 0, 1230, 0, 0,
+	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
+	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
+	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
+	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 265, 207, 175, 314, 379, 239, 0, 0,
+	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
+	263, 226, 218, 395, 0, 0, 0, 191, 0, 174,
+	354, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3815,10 +3754,10 @@ var yyAct = [...]int{
 	0, 0, 427, 0, 0, 0, 0, 0, 0, 0,
 	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
 	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
+	349, 332, 0, 0, 355, 280, 400, 344, 410, 0,
+	0, 428, 429, 217, 307, 418, 391, 424, 439, 188,
+	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
+	245, 278, 436, 186, 364, 202, 179, 386, 408, 199,
 	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
 	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
 	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
@@ -3844,8 +3783,8 @@ var yyAct = [...]int{
 	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
 	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
 	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 0, 0, 0, 0, 0, 0,
-	1084, 224, 0, 0, 0, 0, 275, 221, 0, 0,
+	0, 0, 317, 0, 1226, 0, 0, 0, 0, 0,
+	0, 224, 0, 0, 0, 0, 275, 221, 0, 0,
 	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
 	227, 223, 208, 259, 290, 329, 387, 323, 0, 279,
 	0, 0, 378, 302, 0, 0, 0, 0, 0, 0,
@@ -3853,7 +3792,55 @@ var yyAct = [...]int{
 	207, 175, 314, 379, 239, 0, 0, 0, 167, 168,
 	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
 	0, 205, 0, 0, 0, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
+	395, 0, 0, 0, 191, 0, 174, 354, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
+	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
+	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
+	210, 0, 356, 327, 412, 194, 237, 349, 332, 0,
+	0, 355, 280, 400, 344, 410, 0, 0, 428, 429,
+	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
+	415, 375, 300, 396, 397, 270, 374, 245, 278, 436,
+	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
+	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
+	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
+	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
+	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
+	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
+	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
+	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
+	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
+	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
+	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
+	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
+	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
+	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
+	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
+	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
+	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
+	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
+	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
+	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
+	0, 1224, 0, 0, 0, 0, 0, 0, 224, 0,
+	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
+	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
+	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
+	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
+	379, 239, 0, 0, 0, 167, 168, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
+	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
+	0, 191, 0, 174, 354, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3862,10 +3849,10 @@ var yyAct = [...]int{
 	0, 303, 0, 0, 0, 0, 427, 0, 0, 0,
 	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
 	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
+	327, 412, 194, 237, 349, 332, 0, 0, 355, 280,
+	400, 344, 410, 0, 0, 428, 429, 217, 307, 418,
+	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
+	396, 397, 270, 374, 245, 278, 436, 186, 364, 202,
 	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
 	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
 	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
@@ -3891,16 +3878,64 @@ var yyAct = [...]int{
 	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
 	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
 	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 317, 0, 1222, 0,
 	0, 0, 0, 0, 0, 224, 0, 0, 0, 0,
 	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
 	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
 	387, 323, 0, 279, 0, 0, 378, 302, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 265, 207, 175, 314, 379, 239, 0,
-	0, 0, 167, 168, 169, 0, 940, 0, 0, 0,
+	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
 	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
 	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
+	174, 354, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
+	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
+	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
+	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
+	237, 349, 332, 0, 0, 355, 280, 400, 344, 410,
+	0, 0, 428, 429, 217, 307, 418, 391, 424, 439,
+	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
+	374, 245, 278, 436, 186, 364, 202, 179, 386, 408,
+	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
+	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
+	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
+	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
+	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
+	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
+	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
+	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
+	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
+	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
+	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
+	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
+	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
+	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
+	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
+	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
+	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
+	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
+	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
+	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
+	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 317, 0, 0, 0, 0, 0, 0,
+	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
+	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
+	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
+	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	265, 207, 175, 314, 379, 239, 1196, 0, 0, 167,
+	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
+	218, 395, 0, 0, 0, 191, 0, 174, 354, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3910,9 +3945,9 @@ var yyAct = [...]int{
 	427, 0, 0, 0, 0, 0, 0, 0, 274, 0,
 	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
 	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
+	0, 0, 355, 280, 400, 344, 410, 0, 0, 428,
+	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
+	384, 415, 375, 300, 396, 397, 270, 374, 245, 278,
 	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
 	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
 	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
@@ -3938,7 +3973,7 @@ var yyAct = [...]int{
 	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
 	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
 	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1096, 0, 0, 0, 0, 0, 0,
 	317, 0, 0, 0, 0, 0, 0, 0, 0, 224,
 	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
 	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
@@ -3948,19 +3983,67 @@ var yyAct = [...]int{
 	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
 	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
 	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 191, 0, 174, 354, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
+	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
+	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
+	356, 327, 412, 194, 237, 349, 332, 0, 0, 355,
+	280, 400, 344, 410, 0, 0, 428, 429, 217, 307,
+	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
+	300, 396, 397, 270, 374, 245, 278, 436, 186, 364,
+	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
+	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
+	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
+	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
+	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
+	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
+	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
+	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
+	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
+	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
+	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
+	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
+	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
+	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
+	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
+	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
+	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
+	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
+	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
+	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
+	0, 0, 0, 0, 0, 1087, 224, 0, 0, 0,
+	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
+	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
+	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
+	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
+	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
+	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
+	0, 174, 354, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 497, 0, 247, 0, 303,
+	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
 	0, 0, 0, 0, 427, 0, 0, 0, 0, 0,
 	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
 	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
+	194, 237, 349, 332, 0, 0, 355, 280, 400, 344,
+	410, 0, 0, 428, 429, 217, 307, 418, 391, 424,
+	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
+	270, 374, 245, 278, 436, 186, 364, 202, 179, 386,
 	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
 	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
 	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
@@ -3981,7 +4064,7 @@ var yyAct = [...]int{
 	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
 	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
 	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 496, 409, 431, 0, 285, 0, 0, 287,
+	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
 	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
 	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
 	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
@@ -3993,21 +4076,69 @@ var yyAct = [...]int{
 	0, 279, 0, 0, 378, 302, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 265, 207, 175, 314, 379, 239, 0, 0, 0,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
+	167, 168, 169, 0, 943, 0, 0, 0, 0, 0,
 	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
+	226, 218, 395, 0, 0, 0, 191, 0, 174, 354,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
+	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
+	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
+	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
+	332, 0, 0, 355, 280, 400, 344, 410, 0, 0,
+	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
+	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
+	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
+	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
+	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
+	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
+	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
+	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
+	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
+	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
+	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
+	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
+	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
+	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
+	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
+	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
+	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
+	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
+	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
+	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
+	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
+	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
+	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 317, 0, 0, 0, 0, 0, 0, 0, 0,
+	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
+	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
+	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
+	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
+	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
+	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
+	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
+	0, 0, 0, 191, 0, 174, 354, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 0, 303, 0, 0, 446, 0, 427, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 497,
+	0, 247, 0, 303, 0, 0, 0, 0, 427, 0,
 	0, 0, 0, 0, 0, 0, 274, 0, 271, 171,
 	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
+	0, 356, 327, 412, 194, 237, 349, 332, 0, 0,
+	355, 280, 400, 344, 410, 0, 0, 428, 429, 217,
+	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
+	375, 300, 396, 397, 270, 374, 245, 278, 436, 186,
 	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
 	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
 	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
@@ -4028,7 +4159,7 @@ var yyAct = [...]int{
 	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
 	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
 	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
+	385, 401, 402, 413, 425, 430, 496, 409, 431, 0,
 	285, 0, 0, 287, 234, 252, 262, 0, 420, 382,
 	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
 	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
@@ -4043,7 +4174,55 @@ var yyAct = [...]int{
 	239, 0, 0, 0, 167, 168, 169, 0, 0, 0,
 	0, 0, 0, 0, 0, 198, 0, 205, 0, 0,
 	0, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	191, 0, 174, 354, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
+	303, 0, 0, 446, 0, 427, 0, 0, 0, 0,
+	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
+	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
+	412, 194, 237, 349, 332, 0, 0, 355, 280, 400,
+	344, 410, 0, 0, 428, 429, 217, 307, 418, 391,
+	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
+	397, 270, 374, 245, 278, 436, 186, 364, 202, 179,
+	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
+	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
+	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
+	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
+	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
+	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
+	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
+	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
+	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
+	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
+	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
+	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
+	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
+	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
+	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
+	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
+	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
+	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
+	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
+	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
+	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
+	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
+	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
+	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
+	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 265, 207, 175, 314, 379, 239, 0, 0,
+	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
+	263, 226, 218, 395, 0, 0, 0, 191, 0, 174,
+	354, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4052,10 +4231,10 @@ var yyAct = [...]int{
 	0, 0, 427, 0, 0, 0, 0, 0, 0, 0,
 	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
 	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
+	349, 332, 0, 0, 355, 280, 400, 344, 410, 0,
+	0, 428, 429, 217, 307, 418, 391, 424, 439, 188,
+	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
+	245, 278, 436, 186, 364, 202, 179, 386, 408, 199,
 	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
 	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
 	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
@@ -4083,24 +4262,23 @@ var yyAct = [...]int{
 }
 
 var yyPact = [...]int{
-	4154, -1000, -344, 1699, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	4389, -1000, -354, 1690, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1662, 1188, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 650, 1341, -1000, 1572, 2334, -1000, 29179, 418,
-	-1000, 28705, 412, 61, 29179, -1000, 97, -1000, 88, 29179,
-	93, 28231, -1000, -1000, -270, 12587, 1511, 1, -1, 29179,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1319,
-	1632, 1646, 1660, 1094, 1630, -1000, 10690, 10690, 360, 360,
-	360, 8794, -1000, -1000, 16854, 29179, 29179, 215, -1000, 1572,
-	-1000, -1000, 275, -1000, 294, 1290, -1000, 1287, -1000, 398,
-	345, 291, 341, 337, 279, 270, 269, 266, 265, 264,
-	260, 257, 297, -1000, 651, 651, -141, -142, 292, 350,
-	350, 350, 374, 1529, 1525, -1000, 507, -1000, 651, 651,
-	239, 651, 651, 651, 651, 222, 221, 651, 651, 651,
-	651, 651, 651, 651, 651, 651, 651, 651, 651, 651,
-	651, 651, 213, 1572, 186, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1653, 1313, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 593, 1357, -1000, 1574, 190, -1000, 30886, 412,
+	-1000, 30409, 411, 259, 30886, -1000, 105, -1000, 72, 30886,
+	98, 29932, -1000, -1000, -252, 13235, 1514, -38, -39, 30886,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1360,
+	1607, 1625, 1651, 1132, 1644, -1000, 11326, 11326, 328, 328,
+	328, 9418, -1000, -1000, 17529, 30886, 30886, 289, -1000, 1574,
+	-1000, -1000, 273, -1000, 329, 1307, -1000, 1305, -1000, 556,
+	574, 258, 339, 337, 256, 252, 251, 250, 249, 248,
+	247, 246, 262, -1000, 565, 565, -144, -148, 2414, 309,
+	309, 309, 353, 1535, 1529, -1000, 626, -1000, 565, 565,
+	230, 565, 565, 565, 565, 210, 208, 565, 565, 565,
+	565, 565, 565, 565, 565, 565, 565, 565, 565, 565,
+	565, 565, 213, 1574, 201, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4127,27 +4305,27 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 29179, 104, 29179, -1000, 538, 29179,
-	727, 727, 4, 727, 727, 727, 727, 101, 492, -4,
-	-1000, 99, 181, 90, 187, 700, 131, 70, -1000, -1000,
-	183, 700, 110, -1000, 727, 6890, 6890, 6890, -1000, 1556,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 369, -1000,
-	-1000, -1000, -1000, 29179, 27757, 290, 669, -1000, -1000, -1000,
-	3, -1000, -1000, 1207, 1010, -1000, 12587, 1291, 1251, 1251,
-	-1000, -1000, 460, -1000, -1000, 14009, 14009, 14009, 14009, 14009,
-	14009, 14009, 14009, 14009, 14009, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1251,
-	537, -1000, 12113, 1251, 1251, 1251, 1251, 1251, 1251, 1251,
-	1251, 12587, 1251, 1251, 1251, 1251, 1251, 1251, 1251, 1251,
-	1251, 1251, 1251, 1251, 1251, 1251, 1251, 1251, 1251, -1000,
-	-1000, -1000, 29179, -1000, 1251, 105, 1662, -1000, 1188, -1000,
-	-1000, -1000, 1560, 12587, 12587, 1662, -1000, 1465, 10690, -1000,
-	-1000, 1597, -1000, -1000, -1000, -1000, -1000, 768, 1686, -1000,
-	15431, 536, 1685, 27283, -1000, 20647, 26809, 1284, 8318, -37,
-	-1000, -1000, -1000, 662, 19225, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1556, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 30886, 103, 30886, -1000, 484, 30886,
+	707, 707, 25, 707, 707, 707, 707, 112, 428, -41,
+	-1000, 69, 196, 62, 199, 661, 229, 91, -1000, -1000,
+	197, 661, 56, -1000, 707, 7502, 7502, 7502, -1000, 1566,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 351, -1000,
+	-1000, -1000, -1000, 30886, 29455, 370, 620, -1000, -1000, -1000,
+	57, -1000, -1000, 1243, 859, -1000, 13235, 2216, 1007, 1007,
+	-1000, -1000, 433, -1000, -1000, 14666, 14666, 14666, 14666, 14666,
+	14666, 14666, 14666, 14666, 14666, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1007,
+	483, -1000, 12758, 1007, 1007, 1007, 1007, 1007, 1007, 1007,
+	1007, 13235, 1007, 1007, 1007, 1007, 1007, 1007, 1007, 1007,
+	1007, 1007, 1007, 1007, 1007, 1007, 1007, 1007, 1007, -1000,
+	-1000, -1000, 30886, -1000, 1007, 115, 1653, -1000, 1313, -1000,
+	-1000, -1000, 1578, 13235, 13235, 1653, -1000, 1467, 11326, -1000,
+	-1000, 1599, -1000, -1000, -1000, -1000, -1000, 766, 1677, -1000,
+	16097, 469, 1675, 28978, -1000, 22300, 28501, 1303, 8939, -60,
+	-1000, -1000, -1000, 608, 19915, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1566, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4158,196 +4336,203 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1201,
-	29179, -1000, -1000, 2672, 1001, -1000, 1333, -1000, 1199, -1000,
-	1299, 1302, 410, 1001, 397, 392, 391, -1000, -82, -1000,
-	-1000, -1000, -1000, -1000, 651, 651, 296, 2334, 3561, -1000,
-	-1000, -1000, 26335, 1331, 1001, -1000, 1330, -1000, 718, 437,
-	449, 449, 1001, -1000, -1000, 29179, 1001, 716, 715, 29179,
-	29179, -1000, 25861, -1000, 25387, 24913, 970, 29179, 24439, 23965,
-	23491, 23017, 22543, -1000, 1437, -1000, 1303, -1000, -1000, -1000,
-	29179, 29179, 29179, 207, -1000, -1000, 29179, 1001, -1000, -1000,
-	952, 914, 651, 651, 907, 1030, 1029, 1028, 651, 651,
-	905, 1027, 21121, 249, 896, 878, 877, 1032, 1026, 122,
-	1020, 879, 869, 29179, 1329, 29179, -1000, 150, 617, 248,
-	661, 1572, 1510, 1283, 367, 404, 1001, 353, 353, -1000,
-	7366, -1000, -1000, 1024, 12587, -1000, 724, 700, 700, -1000,
-	-1000, -1000, -1000, -1000, -1000, 727, 29179, 724, -1000, -1000,
-	-1000, 700, 727, 29179, 727, 727, 727, 727, 700, 700,
-	700, 727, 29179, 29179, 29179, 29179, 29179, 29179, 29179, 29179,
-	29179, 6890, 6890, 6890, 598, 727, -1000, 1401, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 91, -1000, -1000, -1000,
-	-1000, -1000, 1699, -1000, -1000, -1000, -98, 1282, 22069, -1000,
-	-274, -282, -285, -286, -1000, -1000, -1000, -287, -295, -1000,
-	-1000, -1000, 12587, 12587, 12587, 12587, 1038, 606, 14009, 868,
-	656, 14009, 14009, 14009, 14009, 14009, 14009, 14009, 14009, 14009,
-	14009, 14009, 14009, 14009, 14009, 14009, 658, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1001, -1000, 1682, 1143, 1143,
-	560, 560, 560, 560, 560, 560, 560, 560, 560, 14483,
-	9268, 7366, 1094, 1178, 1662, 10690, 10690, 12587, 12587, 11638,
-	11164, 10690, 1578, 660, 1010, 29179, -1000, 1025, -1000, -1000,
-	13535, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 29179, 29179, 10690, 10690, 10690, 10690, 10690,
-	-1000, 1281, -1000, -166, 16380, 12587, 1023, 1646, 1094, 1597,
-	1588, 1693, 594, 938, 1255, -1000, 909, 1646, 18751, 1276,
-	-1000, 1597, -1000, -1000, -1000, 29179, -1000, -1000, 21595, -1000,
-	-1000, 6414, 29179, 256, 29179, -1000, 1246, 1564, -1000, -1000,
-	-1000, 1619, 18277, 29179, 1248, 1247, -1000, -1000, 525, 7842,
-	-37, -1000, 7842, 1233, -1000, -29, -43, 9742, 559, -1000,
-	-1000, -1000, 292, 14957, 1080, 1517, 34, -1000, -1000, -1000,
-	1299, -1000, 1299, 1299, 1299, 1299, 207, 207, 207, 207,
-	-1000, -1000, -1000, -1000, -1000, 1325, 1323, -1000, 1299, 1299,
-	1299, 1299, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1322,
-	1322, 1322, 1316, 1316, 339, -1000, 12587, 172, 29179, 1587,
-	865, 150, 358, 1384, 1001, 1001, 1001, 358, -1000, 930,
-	922, -1000, 1254, -1000, -1000, 1659, -1000, -1000, 555, 764,
-	758, 520, 29179, 121, 255, -1000, 321, -1000, 29179, 1001,
-	712, 449, 1001, -1000, 1001, -1000, -1000, -1000, -1000, 523,
-	-1000, -1000, 1001, 1252, -1000, 1214, 799, 732, 749, 710,
-	1252, -1000, -1000, -111, 1252, -1000, 1252, -1000, 1252, -1000,
-	1252, -1000, 1252, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 630, 29179, 121, 658, -1000, 364, -1000, -1000, 658,
-	658, -1000, -1000, -1000, -1000, 1013, 1012, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1209,
+	30886, -1000, -1000, 4080, 1041, -1000, 1356, -1000, 1189, -1000,
+	1311, 1365, 400, 1041, 396, 394, 381, -1000, -109, -1000,
+	-1000, -1000, -1000, -1000, 565, 565, -1000, 261, 1650, 190,
+	4140, -1000, -1000, -1000, 28024, 1343, 1041, -1000, 1336, -1000,
+	691, 374, 437, 437, 1041, -1000, -1000, 30886, 1041, 690,
+	680, 1041, 30886, 30886, -1000, 27547, -1000, 27070, 26593, 939,
+	30886, 26116, 25639, 25162, 24685, 24208, -1000, 1418, -1000, 1322,
+	-1000, -1000, -1000, 30886, 30886, 30886, 220, -1000, -1000, 30886,
+	1041, -1000, -1000, 930, 929, 565, 565, 924, 1022, 1021,
+	1019, 565, 565, 922, 1018, 20392, 161, 908, 897, 895,
+	960, 1015, 124, 907, 906, 885, 30886, 1326, 30886, -1000,
+	164, 679, 270, 599, 1574, 1513, 1302, 349, 375, 1041,
+	314, 314, -1000, 7981, -1000, -1000, 1012, 13235, -1000, 666,
+	661, 661, -1000, -1000, -1000, -1000, -1000, -1000, 707, 30886,
+	666, -1000, -1000, -1000, 661, 707, 30886, 707, 707, 707,
+	707, 661, 661, 661, 707, 30886, 30886, 30886, 30886, 30886,
+	30886, 30886, 30886, 30886, 7502, 7502, 7502, 545, 707, -1000,
+	1409, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 89,
+	-1000, -1000, -1000, -1000, -1000, 1690, -1000, -1000, -1000, -74,
+	1301, 23731, -1000, -257, -258, -262, -266, -1000, -1000, -1000,
+	-268, -269, -1000, -1000, -1000, 13235, 13235, 13235, 13235, 773,
+	567, 14666, 815, 713, 14666, 14666, 14666, 14666, 14666, 14666,
+	14666, 14666, 14666, 14666, 14666, 14666, 14666, 14666, 14666, 619,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1041, -1000,
+	1688, 1067, 1067, 497, 497, 497, 497, 497, 497, 497,
+	497, 497, 15143, 9895, 7981, 1132, 1185, 1653, 11326, 11326,
+	13235, 13235, 12280, 11803, 11326, 1558, 623, 859, 30886, -1000,
+	1032, -1000, -1000, 14189, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 30886, 30886, 11326, 11326,
+	11326, 11326, 11326, -1000, 1300, -1000, -147, 17052, 13235, 1009,
+	1625, 1132, 1599, 1583, 1683, 527, 1240, 1297, -1000, 865,
+	1625, 19438, 1278, -1000, 1599, -1000, -1000, -1000, 30886, -1000,
+	-1000, 23254, -1000, -1000, 7023, 30886, 244, 30886, -1000, 1246,
+	1474, -1000, -1000, -1000, 1604, 18961, 30886, 1269, 1219, -1000,
+	-1000, 468, 8460, -60, -1000, 8460, 1277, -1000, -73, -72,
+	10372, 492, -1000, -1000, -1000, 2414, 15620, 1122, 1521, 7,
+	-1000, -1000, -1000, 1311, -1000, 1311, 1311, 1311, 1311, 220,
+	220, 220, 220, -1000, -1000, -1000, -1000, -1000, 1325, 1324,
+	-1000, 1311, 1311, 1311, 1311, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -339, 29179, -1000, 141, 659, 229,
-	272, 209, 29179, 188, 1622, 204, 217, 29179, 29179, 353,
-	1399, 29179, 1602, 29179, -1000, -1000, -1000, -1000, 1010, 29179,
-	-1000, -1000, 727, 727, -1000, -1000, 29179, 727, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 727, -1000, -1000, -1000,
+	-1000, -1000, 1323, 1323, 1323, 1312, 1312, 301, -1000, 13235,
+	158, 30886, 1587, 857, 164, 317, 1378, 1041, 1041, 1041,
+	317, -1000, 991, 941, -1000, 218, 1295, -1000, -1000, 1646,
+	-1000, -1000, 587, 710, 708, 603, 30886, 128, 227, -1000,
+	302, -1000, 30886, 1041, 659, 437, 1041, -1000, 1041, -1000,
+	-1000, -1000, -1000, 463, -1000, -1000, 1041, -1000, 1291, -1000,
+	1298, 825, 704, 792, 703, 1291, -1000, -1000, -129, 1291,
+	-1000, 1291, -1000, 1291, -1000, 1291, -1000, 1291, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 558, 30886, 128, 619,
+	-1000, 348, -1000, -1000, 619, 619, -1000, -1000, -1000, -1000,
+	1006, 1005, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1007, -1000, 29179, 29179, -1000, -1000, -1000, -1000, -1000,
-	37, -31, 205, -1000, -1000, -1000, -1000, 1625, -1000, 1010,
-	606, 648, 614, -1000, -1000, 830, -1000, -1000, 2931, -1000,
-	-1000, -1000, -1000, 868, 14009, 14009, 14009, 578, 2931, 3060,
-	889, 3074, 560, 655, 655, 558, 558, 558, 558, 558,
-	797, 797, -1000, -1000, -1000, -1000, 1025, -1000, -1000, -1000,
-	1025, 10690, 10690, 1241, 1251, 513, -1000, 1319, -1000, -1000,
-	1646, 1163, 1163, 795, 949, 612, 1684, 1163, 590, 1678,
-	1163, 1163, 10690, -1000, -1000, 685, -1000, 12587, 1025, -1000,
-	704, 1237, 1236, 1163, 1025, 1025, 1163, 1163, 29179, -1000,
-	-263, -1000, -65, 524, 1251, -1000, 21121, 1025, 1207, -1000,
-	1560, -1000, -1000, 1508, -1000, 1462, 12587, 12587, 12587, -1000,
-	-1000, -1000, 1560, 1649, -1000, 1478, 1474, 1672, 10690, 20647,
-	1597, -1000, -1000, -1000, 506, 1672, 1257, 1251, -1000, 29179,
-	20647, 20647, 20647, 20647, 20647, -1000, 1432, 1428, -1000, 1455,
-	1438, 1412, 29179, -1000, 1173, 1094, 18277, 256, 1121, 20647,
-	29179, -1000, -1000, 20647, 29179, 5938, -1000, 1233, -37, -46,
-	-1000, -1000, -1000, -1000, 1010, -1000, 903, -1000, 2546, -1000,
-	319, -1000, -1000, -1000, -1000, 756, 1618, 1515, 25, -1000,
-	-1000, -1000, 207, 207, -1000, -1000, 559, 807, 559, 559,
-	559, 1006, 1006, -1000, -1000, -1000, -1000, -1000, 862, -1000,
-	-1000, -1000, 796, -1000, -1000, 991, 1409, 172, -1000, -1000,
-	651, 1003, 1520, 29179, -1000, -1000, 1074, 141, 29179, 679,
-	1397, -1000, 1384, 1384, 1384, 29179, -1000, -1000, -1000, -1000,
-	3521, 29179, 1171, -1000, 111, 29179, 1066, 29179, -1000, 1169,
-	1321, 1001, 1001, -1000, -1000, 7366, -1000, 29179, 1251, -1000,
-	-1000, -1000, -1000, 401, 1567, 1566, 121, 111, 559, 1001,
-	-1000, -1000, -1000, -1000, -1000, -337, 1165, 379, 129, 182,
-	29179, 29179, 29179, 29179, 29179, 461, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 190, 362, -1000, 29179, 29179, 488, -1000,
-	-1000, -1000, 700, -1000, -1000, 700, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1554, -32, -312, -1000, -305, -1000, -1000, -1000,
-	-1000, 578, 2931, 3023, -1000, 14009, 14009, -1000, -1000, 1163,
-	1163, 10690, 7366, 1662, 1560, -1000, -1000, 298, 658, 298,
-	14009, 14009, -1000, 14009, 14009, -1000, -99, 1213, 615, -1000,
-	12587, 773, -1000, -1000, 14009, 14009, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 385, 383, 381, 29179, -1000,
-	-1000, -1000, 933, 1002, 1460, 1010, 1010, -1000, -1000, 29179,
-	-1000, -1000, -1000, -1000, 1670, 12587, -1000, 1230, -1000, 5462,
-	1646, 1395, 29179, 1251, 1699, 15906, 29179, 1185, -1000, 653,
-	1564, 1370, 1391, 1683, -1000, -1000, -1000, -1000, 1422, -1000,
-	1416, -1000, -1000, -1000, -1000, -1000, 1094, 1672, 20647, 1161,
-	-1000, 1161, -1000, 505, -1000, -1000, -1000, -51, -39, -1000,
-	-1000, -1000, 292, -1000, -1000, -1000, -1000, 776, 14009, 1692,
-	-1000, 1000, -1000, -1000, 711, 701, -1000, 29179, 1320, -1000,
-	-1000, -1000, 559, 559, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1155, -1000, 1139, 1222, 1131, 89, -1000, 1148, 1552,
-	651, 651, -1000, 793, -1000, 1001, -1000, -1000, 378, -1000,
-	1596, 29179, 1390, 1389, 1388, -1000, 1657, 1209, -1000, 29179,
-	-1000, -1000, 29179, -1000, -1000, 1473, 172, 29179, -1000, -1000,
-	-1000, -1000, 255, 29179, -1000, 1143, 111, -1000, -1000, -1000,
-	-1000, -1000, -1000, 29179, 132, -1000, 1317, 994, -1000, 1367,
-	-1000, -1000, -1000, -1000, 109, 227, -1000, 29179, 426, 1409,
-	29179, -1000, -1000, -1000, 727, 727, -1000, 1539, -1000, 1001,
-	-1000, 14009, 2931, 2931, -1000, -1000, 1025, -1000, 1646, -1000,
-	1025, 1299, 1299, -1000, 1299, 1316, -1000, 1299, 81, 1299,
-	78, 1025, 1025, 3006, 2637, 2307, 1210, 1251, -90, -1000,
-	1010, 12587, 2254, 1894, 1251, 1251, 1251, 1125, 993, 207,
-	-1000, -1000, -1000, 1665, 1655, 1010, -1000, -1000, -1000, 1561,
-	1144, 1123, -1000, -1000, 10216, 1127, 1472, 485, 1125, 1662,
-	29179, 12587, -1000, -1000, 12587, 1293, -1000, 12587, -1000, -1000,
-	-1000, 1662, 1662, 1161, -1000, -1000, 569, -1000, -1000, -1000,
-	-1000, -1000, 2931, -94, -1000, -1000, -1000, 1292, 14009, -1000,
-	-1000, 207, 988, 207, 790, -1000, 782, -1000, -1000, -184,
-	-1000, -1000, 1298, 1414, -1000, -1000, 29179, -1000, -1000, 29179,
-	29179, 29179, 29179, 29179, -1000, -1000, 219, -1000, 1118, 1107,
-	-1000, -131, -1000, -1000, 1260, -1000, -1000, -1000, 1059, -1000,
-	-116, 1001, 29179, 29179, 29179, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2931, -1000, 1560, -1000, -1000, 262, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 14009, 14009, 14009,
-	14009, 14009, 1646, 985, 1010, 14009, 14009, 17802, 20173, 20173,
-	17328, 207, 20, -1000, 12587, 12587, 699, -1000, 1251, -1000,
-	1259, 29179, 1251, 29179, -1000, 1646, -1000, 1010, 1010, 29179,
-	1010, 1646, -1000, -1000, 29179, 1250, 559, -1000, 559, 1056,
-	1036, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1260,
-	-1000, -1000, -1000, 1209, -1000, 212, 278, -1000, 255, -1000,
-	-147, -150, 1617, 29179, -1000, -1000, 7366, -1000, -1000, 1258,
-	1371, -1000, -1000, -1000, -1000, 704, 704, 704, 704, 271,
-	1025, -1000, 704, 704, 1101, -1000, -1000, -1000, 1101, 1101,
-	524, -237, -1000, 1506, 1480, 1010, 1207, 1690, -1000, 1251,
-	1699, 470, 1123, -1000, -1000, 1083, -1000, 1065, -1000, -1000,
-	-1000, -1000, -1000, 1613, 1251, -1000, -1000, -1000, -1000, 1188,
-	1062, 1141, -1000, 647, 29179, 29179, -1000, -1000, -1000, -1000,
-	1025, 185, -121, -1000, -1000, -1000, 19699, -1000, -1000, -1000,
-	-1000, 20, 276, -1000, 1494, 1480, -1000, 1654, 1502, 1653,
-	-1000, 29179, 1123, 29179, -1000, 1387, 1048, 1188, 13061, 228,
-	-1000, 7366, 4986, 1044, -1000, -1000, 1449, -105, -129, -1000,
-	-1000, 1490, 1493, 1493, 1494, -1000, 1650, 1648, -1000, 984,
-	1633, 983, 1099, -1000, 1386, -1000, 1676, -1000, -1000, -1000,
-	735, 978, -1000, -1000, -1000, 228, 704, 1025, -1000, -40,
-	-1000, -1000, -1000, -1000, -1000, 1367, -1000, 1439, -1000, 1485,
-	805, -1000, -1000, -1000, -1000, 977, 973, -1000, 904, -1000,
-	-1000, 1689, 486, 486, -1000, -1000, -1000, -1000, -1000, 338,
-	-1000, -1000, -116, -119, -1000, 800, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 318, 847, -1000, 147, -1000, -127,
-	-1000, -1000, -1000, -1000, -1000, -1000, -138, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -329,
+	30886, -1000, 156, 588, 215, 254, 211, 30886, 111, 1619,
+	170, 206, 30886, 30886, 314, 1407, 30886, 1591, 30886, -1000,
+	-1000, -1000, -1000, 859, 30886, -1000, -1000, 707, 707, -1000,
+	-1000, 30886, 707, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 707, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1001, -1000, 30886, 30886,
+	-1000, -1000, -1000, -1000, -1000, 182, -63, 202, -1000, -1000,
+	-1000, -1000, 1621, -1000, 859, 567, 727, 577, -1000, -1000,
+	814, -1000, -1000, 2838, -1000, -1000, -1000, -1000, 815, 14666,
+	14666, 14666, 1000, 2838, 2803, 820, 893, 497, 793, 793,
+	524, 524, 524, 524, 524, 684, 684, -1000, -1000, -1000,
+	-1000, 1032, -1000, -1000, -1000, 1032, 11326, 11326, 1284, 1007,
+	462, -1000, 1360, -1000, -1000, 1625, 1158, 1158, 974, 934,
+	618, 1674, 1158, 590, 1673, 1158, 1158, 11326, -1000, -1000,
+	676, -1000, 13235, 1032, -1000, 1193, 1282, 1279, 1158, 1032,
+	1032, 1158, 1158, 30886, -1000, -251, -1000, -87, 432, 1007,
+	-1000, 20392, 1032, 1243, -1000, 1578, -1000, -1000, 1510, -1000,
+	1464, 13235, 13235, 13235, -1000, -1000, -1000, 1578, 1624, -1000,
+	1478, 1477, 1664, 11326, 22300, 1599, -1000, -1000, -1000, 461,
+	1664, 1265, 1007, -1000, 30886, 22300, 22300, 22300, 22300, 22300,
+	-1000, 1439, 1437, -1000, 1435, 1427, 1451, 30886, -1000, 1183,
+	1132, 18961, 244, 1222, 22300, 30886, -1000, -1000, 22300, 30886,
+	6544, -1000, 1277, -60, -43, -1000, -1000, -1000, -1000, 859,
+	-1000, 936, 241, 2383, -1000, 290, -1000, -1000, -1000, -1000,
+	602, 1602, 1518, -14, -1000, -1000, -1000, 220, 220, -1000,
+	-1000, 492, 700, 492, 492, 492, 996, 996, -1000, -1000,
+	-1000, -1000, -1000, 855, -1000, -1000, -1000, 843, -1000, -1000,
+	1128, 1373, 158, -1000, -1000, 565, 994, 1523, 30886, -1000,
+	-1000, 1118, 156, 30886, 640, 1405, -1000, 1378, 1378, 1378,
+	30886, -1000, -1000, -1000, -1000, 30886, 22777, 187, -1000, 3727,
+	30886, 1170, -1000, 695, 30886, 1104, 30886, -1000, 1168, 1318,
+	1041, 1041, -1000, -1000, 7981, -1000, 30886, 1007, -1000, -1000,
+	-1000, -1000, 371, 1571, 1570, 128, 695, 492, 1041, -1000,
+	-1000, -1000, -1000, -1000, -345, 1160, 356, 145, 181, 30886,
+	30886, 30886, 30886, 30886, 422, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 203, 340, -1000, 30886, 30886, 478, -1000, -1000,
+	-1000, 661, -1000, -1000, 661, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1553, -67, -289, -1000, -286, -1000, -1000, -1000, -1000,
+	1000, 2838, 2601, -1000, 14666, 14666, -1000, -1000, 1158, 1158,
+	11326, 7981, 1653, 1578, -1000, -1000, 465, 619, 465, 14666,
+	14666, -1000, 14666, 14666, -1000, -124, 1241, 594, -1000, 13235,
+	777, -1000, -1000, 14666, 14666, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 376, 363, 362, 30886, -1000, -1000,
+	-1000, 970, 986, 1461, 859, 859, -1000, -1000, 30886, -1000,
+	-1000, -1000, -1000, 1662, 13235, -1000, 1271, -1000, 6065, 1625,
+	1404, 30886, 1007, 1690, 16575, 30886, 1273, -1000, 583, 1474,
+	1374, 1403, 1346, -1000, -1000, -1000, -1000, 1436, -1000, 1420,
+	-1000, -1000, -1000, -1000, -1000, 1132, 1664, 22300, 1247, -1000,
+	1247, -1000, 458, -1000, -1000, -1000, -68, -93, -1000, -1000,
+	-1000, -1000, -1000, 2414, -1000, -1000, -1000, -1000, 758, 14666,
+	1682, -1000, 984, -1000, -1000, 655, 651, -1000, 30886, 1316,
+	-1000, -1000, -1000, 492, 492, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1155, -1000, 1143, 1270, 1141, 88, -1000, 1363,
+	1548, 565, 565, -1000, 816, -1000, 1041, -1000, -1000, 355,
+	-1000, 1590, 30886, 1385, 1384, 1381, -1000, 1007, 780, 1007,
+	1007, 13235, 1007, 1642, 1245, -1000, 30886, -1000, -1000, 30886,
+	-1000, -1000, 1476, 158, 30886, -1000, -1000, -1000, -1000, 227,
+	30886, -1000, 1067, 695, -1000, -1000, -1000, -1000, -1000, -1000,
+	30886, 176, -1000, 1314, 977, 819, 1371, -1000, -1000, -1000,
+	-1000, 125, 214, -1000, 30886, 420, 1373, 30886, -1000, -1000,
+	-1000, 707, 707, -1000, 1540, -1000, 1041, -1000, 14666, 2838,
+	2838, -1000, -1000, 1032, -1000, 1625, -1000, 1032, 1311, 1311,
+	-1000, 1311, 1312, -1000, 1311, 48, 1311, 39, 1032, 1032,
+	2568, 2540, 2460, 2394, 1007, -119, -1000, 859, 13235, 2437,
+	2180, 1007, 1007, 1007, 1130, 982, 220, -1000, -1000, -1000,
+	1660, 1641, 859, -1000, -1000, -1000, 1580, 1196, 1181, -1000,
+	-1000, 10849, 1138, 1475, 431, 1130, 1653, 30886, 13235, -1000,
+	-1000, 13235, 1310, -1000, 13235, -1000, -1000, -1000, 1653, 1653,
+	1247, -1000, -1000, 519, -1000, -1000, -1000, -1000, -1000, 2838,
+	-13, -1000, -1000, -1000, 1309, 14666, -1000, -1000, 220, 981,
+	220, 778, -1000, 774, -1000, -1000, -191, -1000, -1000, 1220,
+	1416, -1000, -1000, 30886, -1000, -1000, 30886, 30886, 30886, -1000,
+	227, -1000, 13235, 21823, 615, 30886, 30886, 30886, -1000, -1000,
+	233, -1000, 1127, 1116, -1000, -145, -1000, -1000, 1266, -1000,
+	-1000, -1000, 1083, -1000, -1000, -130, 1041, 30886, 30886, 30886,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2838, -1000,
+	1578, -1000, -1000, 325, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 14666, 14666, 14666, 14666, 14666, 1625, 979, 859,
+	14666, 14666, 18483, 21346, 21346, 18006, 220, 1, -1000, 13235,
+	13235, 650, -1000, 1007, -1000, 1290, 30886, 1007, 30886, -1000,
+	1625, -1000, 859, 859, 30886, 859, 1625, -1000, -1000, 30886,
+	1287, 492, -1000, 492, 1051, 1037, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1266, -1000, -1000, -1000, 1116, 615,
+	-1000, 1110, -1000, 1110, 1245, -1000, 225, 281, -1000, 227,
+	-1000, -155, -157, 1600, 30886, -1000, -1000, 7981, -1000, -1000,
+	1023, 1375, -1000, -1000, -1000, -1000, 1193, 1193, 1193, 1193,
+	167, 1032, -1000, 1193, 1193, 1103, -1000, -1000, -1000, 1103,
+	1103, 432, -242, -1000, 1508, 1481, 859, 1243, 1681, -1000,
+	1007, 1690, 429, 1181, -1000, -1000, 1098, -1000, 1050, -1000,
+	-1000, -1000, -1000, -1000, 1595, -1000, -1000, -1000, -1000, 378,
+	-1000, -1000, -1000, -1000, 1313, 1035, 1191, -1000, 581, 30886,
+	30886, -1000, -1000, -1000, -1000, 1032, 163, -135, -1000, -1000,
+	-1000, 20869, -1000, -1000, -1000, -1000, 1, 269, -1000, 1501,
+	1481, -1000, 1639, 1504, 1638, -1000, 30886, 1181, 30886, -1000,
+	1380, 1016, 1313, 13712, -297, 224, -1000, 7981, 5586, 1030,
+	-1000, -1000, 1460, -142, -141, -1000, -1000, 1496, 1499, 1499,
+	1501, -1000, 1633, 1632, -1000, 978, 1631, 973, 1153, -1000,
+	1299, -1000, 1668, -1000, -1000, -1000, 739, 967, -1000, -1000,
+	-1000, 224, 1193, 1032, -1000, -296, 565, -1000, -24, -1000,
+	-1000, -1000, -1000, -1000, 1371, -1000, 1454, -1000, 1484, 781,
+	-1000, -1000, -1000, -1000, 942, 860, -1000, 821, -1000, -1000,
+	1680, 522, 522, -1000, -1000, -1000, -297, -297, 565, 20392,
+	298, -1000, -1000, -130, -133, -1000, 746, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 287, 849, -1000, -1000, -1000,
+	20392, -1000, 189, -1000, -138, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -143, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 1999, 1997, 11, 134, 86, 1995, 1988, 1985, 1984,
-	136, 133, 132, 1983, 1982, 1981, 1980, 1976, 1975, 1974,
-	1972, 1970, 1968, 1967, 1966, 68, 140, 45, 48, 138,
-	1965, 1964, 32, 1962, 1961, 1959, 131, 129, 598, 1958,
-	122, 1957, 1956, 1955, 1954, 1953, 1951, 1950, 1949, 1948,
-	1946, 1944, 1943, 1941, 1938, 224, 1937, 1936, 4, 1935,
-	34, 1932, 1931, 1930, 1929, 1928, 107, 1922, 1921, 1920,
-	123, 1919, 1900, 53, 113, 50, 77, 1899, 1898, 80,
-	127, 1896, 71, 103, 1894, 1893, 960, 1892, 61, 82,
-	126, 1891, 51, 1890, 1889, 57, 1888, 1887, 1886, 87,
-	1885, 1882, 2989, 1879, 76, 93, 19, 42, 1878, 1877,
-	1866, 1864, 38, 932, 1862, 1858, 25, 1856, 1848, 144,
-	1847, 94, 22, 1846, 17, 35, 24, 1843, 98, 1842,
-	30, 56, 36, 1841, 85, 1840, 1837, 1836, 1835, 33,
-	1834, 81, 111, 63, 1833, 1832, 1831, 7, 15, 1829,
-	1828, 1827, 1825, 1823, 1821, 14, 1819, 5, 1818, 26,
-	1817, 27, 20, 43, 79, 160, 29, 13, 1815, 117,
-	1814, 28, 121, 75, 115, 1812, 1811, 1810, 956, 152,
-	1809, 1808, 58, 1807, 106, 102, 1806, 215, 1805, 1801,
-	72, 1266, 2571, 8, 118, 1799, 1798, 2274, 59, 83,
-	23, 1797, 89, 1796, 1787, 1785, 141, 135, 44, 948,
-	46, 1783, 1782, 1781, 1779, 1778, 1775, 1774, 47, 149,
-	66, 110, 31, 1772, 1771, 1770, 74, 65, 1766, 114,
-	105, 78, 99, 1765, 120, 101, 64, 1764, 41, 1762,
-	1755, 1745, 1741, 40, 1740, 1739, 1734, 1733, 104, 108,
-	69, 39, 1731, 37, 73, 112, 109, 1729, 18, 124,
-	10, 1728, 2, 0, 1727, 3, 130, 176, 119, 1725,
-	1724, 1, 1719, 6, 1718, 1717, 84, 1714, 1712, 1710,
-	16, 21, 9, 1708, 1706, 90, 355, 116, 1705, 128,
+	0, 1945, 1944, 26, 99, 100, 1943, 1941, 1940, 1939,
+	136, 131, 129, 1935, 1930, 1929, 1927, 1925, 1924, 1923,
+	1922, 1921, 1920, 1919, 1918, 80, 144, 51, 50, 147,
+	1917, 1916, 39, 1914, 1913, 1912, 143, 142, 592, 1911,
+	140, 1910, 1909, 1908, 1906, 1903, 1901, 1899, 1898, 1896,
+	1895, 1894, 1893, 1892, 1890, 210, 1889, 1887, 8, 1886,
+	46, 1884, 1883, 1882, 1880, 1879, 120, 1878, 1877, 1876,
+	122, 1875, 1874, 59, 209, 79, 87, 1873, 1869, 88,
+	126, 1868, 72, 108, 1864, 1863, 1099, 1862, 73, 84,
+	102, 1860, 57, 1859, 1858, 64, 1857, 1856, 1852, 86,
+	1851, 1850, 3361, 1849, 83, 90, 18, 44, 1848, 1846,
+	1844, 1843, 42, 485, 1842, 1840, 30, 1839, 1838, 135,
+	1837, 103, 28, 1836, 14, 20, 22, 1835, 101, 1834,
+	31, 65, 40, 1832, 98, 1830, 1824, 1823, 1820, 43,
+	1819, 89, 112, 91, 1818, 1817, 1816, 12, 13, 1814,
+	1812, 1808, 1807, 1802, 1801, 11, 1800, 9, 1798, 29,
+	1797, 36, 19, 41, 85, 63, 35, 15, 1790, 134,
+	1788, 32, 115, 81, 111, 1786, 1785, 1784, 901, 187,
+	1783, 1782, 45, 1778, 104, 106, 1775, 201, 1769, 1767,
+	66, 1327, 2668, 17, 119, 1766, 1765, 2215, 69, 4,
+	23, 1764, 67, 1762, 1761, 1758, 128, 139, 56, 903,
+	58, 1754, 1753, 1752, 1751, 1750, 1749, 1748, 47, 37,
+	21, 124, 38, 1743, 1742, 1741, 76, 68, 1738, 118,
+	113, 78, 125, 1737, 123, 107, 71, 1736, 6, 1735,
+	1734, 1733, 1732, 61, 1731, 1728, 1727, 1725, 110, 105,
+	75, 48, 1724, 53, 74, 117, 116, 25, 24, 141,
+	1723, 82, 1721, 1720, 1717, 1716, 3, 1715, 2, 0,
+	1714, 7, 133, 200, 114, 1712, 1711, 1, 1708, 10,
+	1704, 1703, 93, 1702, 1701, 1700, 34, 16, 33, 1698,
+	1697, 3374, 353, 121, 5, 1696, 127,
 }
 
-//line sql.y:5326
+//line sql.y:5447
 type yySymType struct {
 	union             interface{}
 	empty             struct{}
@@ -4649,6 +4834,11 @@ func (st *yySymType) partSpecsUnion() []*PartitionSpec {
 	return v
 }
 
+func (st *yySymType) partitionOptionUnion() *PartitionOption {
+	v, _ := st.union.(*PartitionOption)
+	return v
+}
+
 func (st *yySymType) partitionsUnion() Partitions {
 	v, _ := st.union.(Partitions)
 	return v
@@ -4795,22 +4985,22 @@ func (st *yySymType) whensUnion() []*When {
 }
 
 var yyR1 = [...]int{
-	0, 283, 284, 284, 1, 1, 1, 1, 1, 1,
+	0, 289, 290, 290, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 263, 263, 263, 266, 266,
+	1, 1, 1, 1, 1, 269, 269, 269, 272, 272,
 	21, 50, 3, 3, 3, 3, 2, 2, 8, 9,
 	4, 5, 5, 10, 10, 62, 62, 11, 12, 12,
-	12, 12, 287, 287, 97, 97, 95, 95, 96, 96,
+	12, 12, 293, 293, 97, 97, 95, 95, 96, 96,
 	164, 164, 13, 14, 14, 174, 174, 173, 173, 173,
 	175, 175, 175, 175, 209, 209, 15, 15, 15, 15,
-	15, 71, 71, 265, 265, 264, 262, 262, 261, 261,
-	260, 23, 24, 33, 33, 33, 33, 34, 35, 267,
-	267, 239, 39, 39, 38, 38, 38, 38, 40, 40,
+	15, 71, 71, 271, 271, 270, 268, 268, 267, 267,
+	266, 23, 24, 33, 33, 33, 33, 34, 35, 273,
+	273, 239, 39, 39, 38, 38, 38, 38, 40, 40,
 	37, 37, 36, 36, 241, 241, 228, 228, 240, 240,
 	240, 240, 240, 240, 240, 227, 227, 85, 85, 145,
-	145, 145, 145, 145, 145, 145, 145, 279, 279, 146,
-	146, 146, 146, 146, 146, 280, 280, 280, 280, 211,
+	145, 145, 145, 145, 145, 145, 145, 285, 285, 146,
+	146, 146, 146, 146, 146, 286, 286, 286, 286, 211,
 	211, 211, 211, 214, 214, 212, 212, 212, 212, 212,
 	212, 212, 212, 212, 213, 213, 213, 213, 213, 215,
 	215, 215, 215, 215, 216, 216, 216, 216, 216, 216,
@@ -4821,79 +5011,81 @@ var yyR1 = [...]int{
 	253, 252, 252, 250, 250, 250, 250, 238, 238, 247,
 	247, 247, 247, 247, 237, 237, 233, 233, 233, 234,
 	234, 235, 235, 232, 232, 236, 236, 249, 249, 248,
-	230, 230, 231, 231, 255, 281, 281, 281, 281, 282,
-	282, 256, 272, 273, 271, 271, 271, 271, 271, 60,
+	230, 230, 231, 231, 255, 287, 287, 287, 287, 288,
+	288, 256, 278, 279, 277, 277, 277, 277, 277, 60,
 	60, 60, 186, 186, 186, 245, 245, 244, 244, 244,
 	246, 246, 243, 243, 243, 243, 243, 243, 243, 243,
 	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
 	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	181, 181, 181, 270, 270, 270, 270, 270, 270, 269,
-	269, 269, 242, 242, 242, 268, 268, 131, 131, 132,
-	132, 30, 30, 30, 30, 30, 30, 29, 29, 29,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+	181, 181, 181, 276, 276, 276, 276, 276, 276, 275,
+	275, 275, 242, 242, 242, 274, 274, 131, 131, 131,
+	132, 132, 30, 30, 30, 30, 30, 30, 29, 29,
+	29, 25, 25, 25, 25, 25, 25, 25, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 31, 31, 26, 26, 26, 26, 26, 26,
-	26, 26, 26, 16, 16, 16, 16, 16, 16, 16,
+	25, 25, 25, 25, 31, 31, 26, 26, 26, 26,
+	26, 26, 26, 26, 26, 16, 16, 16, 16, 16,
 	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 16, 259, 259, 259, 259, 259, 259, 259, 259,
+	16, 16, 16, 16, 16, 259, 259, 259, 259, 259,
 	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 225, 225, 225, 257, 257, 258,
-	258, 17, 22, 22, 18, 18, 18, 18, 19, 19,
-	41, 42, 42, 42, 42, 42, 42, 42, 42, 42,
+	259, 259, 259, 259, 259, 259, 259, 225, 225, 225,
+	257, 257, 258, 258, 258, 294, 294, 294, 260, 260,
+	261, 262, 262, 262, 262, 262, 265, 265, 264, 264,
+	263, 263, 17, 22, 22, 18, 18, 18, 18, 19,
+	19, 41, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 274, 274, 180,
-	180, 188, 188, 179, 179, 202, 202, 202, 182, 182,
-	182, 183, 183, 278, 278, 278, 43, 43, 45, 45,
-	46, 47, 47, 204, 204, 205, 205, 48, 49, 61,
-	61, 61, 61, 61, 61, 63, 63, 63, 7, 7,
-	7, 7, 57, 57, 57, 6, 6, 44, 44, 51,
-	275, 275, 276, 277, 277, 277, 277, 52, 54, 20,
-	20, 20, 20, 20, 20, 78, 78, 66, 66, 66,
-	66, 66, 66, 66, 66, 66, 66, 66, 66, 72,
-	72, 72, 67, 67, 288, 55, 56, 56, 70, 70,
-	70, 64, 64, 64, 69, 69, 69, 75, 75, 77,
-	77, 77, 77, 77, 79, 79, 79, 79, 79, 79,
-	79, 74, 74, 76, 76, 76, 76, 195, 195, 195,
-	194, 194, 87, 87, 88, 88, 89, 89, 90, 90,
-	90, 129, 105, 105, 162, 162, 161, 161, 163, 163,
-	163, 163, 165, 165, 91, 91, 91, 91, 92, 92,
-	93, 93, 94, 94, 203, 203, 200, 200, 200, 199,
-	199, 98, 98, 98, 100, 99, 99, 99, 99, 101,
-	101, 103, 103, 102, 102, 104, 106, 106, 106, 106,
-	106, 107, 107, 86, 86, 86, 86, 86, 86, 86,
-	86, 177, 177, 109, 109, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 120, 120, 120, 120, 120,
-	120, 110, 110, 110, 110, 110, 110, 110, 73, 73,
-	121, 121, 121, 128, 122, 122, 113, 113, 113, 113,
+	42, 42, 42, 42, 42, 42, 42, 42, 280, 280,
+	180, 180, 188, 188, 179, 179, 202, 202, 202, 182,
+	182, 182, 183, 183, 284, 284, 284, 43, 43, 45,
+	45, 46, 47, 47, 204, 204, 205, 205, 48, 49,
+	61, 61, 61, 61, 61, 61, 63, 63, 63, 7,
+	7, 7, 7, 57, 57, 57, 6, 6, 44, 44,
+	51, 281, 281, 282, 283, 283, 283, 283, 52, 54,
+	20, 20, 20, 20, 20, 20, 78, 78, 66, 66,
+	66, 66, 66, 66, 66, 66, 66, 66, 66, 66,
+	72, 72, 72, 67, 67, 295, 55, 56, 56, 70,
+	70, 70, 64, 64, 64, 69, 69, 69, 75, 75,
+	77, 77, 77, 77, 77, 79, 79, 79, 79, 79,
+	79, 79, 74, 74, 76, 76, 76, 76, 195, 195,
+	195, 194, 194, 87, 87, 88, 88, 89, 89, 90,
+	90, 90, 129, 105, 105, 162, 162, 161, 161, 163,
+	163, 163, 163, 165, 165, 91, 91, 91, 91, 92,
+	92, 93, 93, 94, 94, 203, 203, 200, 200, 200,
+	199, 199, 98, 98, 98, 100, 99, 99, 99, 99,
+	101, 101, 103, 103, 102, 102, 104, 106, 106, 106,
+	106, 106, 107, 107, 86, 86, 86, 86, 86, 86,
+	86, 86, 177, 177, 109, 109, 108, 108, 108, 108,
+	108, 108, 108, 108, 108, 108, 120, 120, 120, 120,
+	120, 120, 110, 110, 110, 110, 110, 110, 110, 73,
+	73, 121, 121, 121, 128, 122, 122, 113, 113, 113,
 	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
 	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
 	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
-	117, 117, 117, 117, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 116, 116,
+	113, 117, 117, 117, 117, 115, 115, 115, 115, 115,
+	115, 115, 115, 115, 115, 115, 115, 115, 115, 116,
 	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 289, 289, 119, 118, 118, 118,
-	118, 118, 118, 118, 68, 68, 68, 68, 68, 208,
-	208, 208, 210, 210, 210, 210, 210, 210, 210, 210,
-	210, 210, 210, 210, 210, 135, 135, 65, 65, 133,
-	133, 134, 136, 136, 130, 130, 130, 112, 112, 112,
-	112, 112, 112, 112, 112, 114, 114, 114, 137, 137,
-	138, 138, 139, 139, 140, 140, 141, 142, 142, 142,
-	143, 143, 143, 143, 32, 32, 32, 32, 32, 27,
-	27, 27, 27, 28, 28, 28, 80, 80, 80, 80,
-	82, 82, 81, 81, 58, 58, 59, 59, 59, 83,
-	83, 84, 84, 84, 84, 159, 159, 159, 144, 144,
-	144, 144, 151, 151, 151, 147, 147, 149, 149, 149,
-	150, 150, 150, 148, 156, 156, 158, 158, 157, 157,
-	153, 153, 154, 154, 155, 155, 155, 152, 152, 111,
-	111, 111, 111, 111, 160, 160, 160, 160, 166, 166,
-	124, 124, 126, 126, 125, 127, 167, 167, 171, 168,
-	168, 172, 172, 172, 172, 172, 169, 169, 170, 170,
-	196, 196, 196, 176, 176, 187, 187, 184, 184, 185,
-	185, 178, 178, 189, 189, 189, 53, 123, 123, 254,
-	254, 251, 192, 192, 193, 193, 197, 197, 201, 201,
-	198, 198, 190, 190, 190, 190, 190, 190, 190, 190,
+	116, 116, 116, 116, 116, 296, 296, 119, 118, 118,
+	118, 118, 118, 118, 118, 68, 68, 68, 68, 68,
+	208, 208, 208, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 135, 135, 65, 65,
+	133, 133, 134, 136, 136, 130, 130, 130, 112, 112,
+	112, 112, 112, 112, 112, 112, 114, 114, 114, 137,
+	137, 138, 138, 139, 139, 140, 140, 141, 142, 142,
+	142, 143, 143, 143, 143, 32, 32, 32, 32, 32,
+	27, 27, 27, 27, 28, 28, 28, 80, 80, 80,
+	80, 82, 82, 81, 81, 58, 58, 59, 59, 59,
+	83, 83, 84, 84, 84, 84, 84, 159, 159, 159,
+	144, 144, 144, 144, 151, 151, 151, 147, 147, 149,
+	149, 149, 150, 150, 150, 148, 156, 156, 158, 158,
+	157, 157, 153, 153, 154, 154, 155, 155, 155, 152,
+	152, 111, 111, 111, 111, 111, 160, 160, 160, 160,
+	166, 166, 124, 124, 126, 126, 125, 127, 167, 167,
+	171, 168, 168, 172, 172, 172, 172, 172, 169, 169,
+	170, 170, 196, 196, 196, 176, 176, 187, 187, 184,
+	184, 185, 185, 178, 178, 189, 189, 189, 53, 123,
+	123, 254, 254, 251, 192, 192, 193, 193, 197, 197,
+	201, 201, 198, 198, 190, 190, 190, 190, 190, 190,
 	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
 	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
 	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
@@ -4907,7 +5099,7 @@ var yyR1 = [...]int{
 	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
 	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
 	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	190, 190, 190, 191, 191, 191, 191, 191, 191, 191,
 	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
 	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
 	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
@@ -4934,8 +5126,8 @@ var yyR1 = [...]int{
 	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
 	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
 	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 285, 286, 206, 207, 207,
-	207,
+	191, 191, 191, 191, 191, 191, 191, 291, 292, 206,
+	207, 207, 207,
 }
 
 var yyR2 = [...]int{
@@ -4950,7 +5142,7 @@ var yyR2 = [...]int{
 	2, 2, 2, 1, 1, 1, 2, 2, 6, 12,
 	2, 0, 2, 0, 2, 1, 0, 2, 1, 3,
 	3, 6, 4, 7, 8, 8, 8, 6, 3, 1,
-	1, 4, 0, 1, 1, 1, 2, 2, 0, 1,
+	1, 5, 0, 1, 1, 1, 2, 2, 0, 1,
 	4, 4, 4, 4, 2, 4, 1, 3, 1, 1,
 	3, 4, 3, 3, 3, 4, 9, 0, 2, 0,
 	2, 3, 3, 4, 2, 3, 2, 1, 1, 0,
@@ -4972,72 +5164,74 @@ var yyR2 = [...]int{
 	4, 4, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 4, 5,
 	0, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 0, 1, 0, 2, 0,
-	2, 0, 1, 5, 1, 3, 7, 1, 3, 3,
-	1, 2, 2, 2, 5, 5, 5, 6, 6, 5,
-	5, 2, 2, 2, 2, 3, 3, 3, 4, 1,
-	3, 5, 1, 3, 3, 3, 3, 3, 3, 3,
-	3, 2, 2, 2, 4, 4, 2, 11, 3, 6,
-	8, 6, 6, 6, 13, 8, 6, 10, 5, 5,
-	5, 5, 5, 3, 7, 4, 4, 4, 4, 3,
-	3, 3, 7, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 2, 0, 2, 2, 1, 3, 8,
-	8, 3, 3, 5, 7, 7, 6, 5, 3, 2,
-	3, 3, 3, 7, 3, 3, 3, 3, 4, 7,
-	5, 2, 4, 4, 4, 4, 4, 5, 5, 4,
+	1, 1, 1, 1, 1, 0, 1, 0, 1, 2,
+	0, 2, 0, 1, 5, 1, 3, 7, 1, 3,
+	3, 1, 2, 2, 2, 5, 5, 5, 6, 6,
+	5, 5, 2, 2, 2, 2, 3, 3, 3, 4,
+	3, 1, 3, 5, 1, 3, 3, 3, 3, 3,
+	3, 3, 3, 2, 2, 2, 3, 4, 4, 2,
+	11, 3, 6, 8, 6, 6, 6, 13, 8, 6,
+	10, 5, 5, 5, 5, 5, 3, 7, 4, 4,
+	4, 4, 3, 3, 3, 7, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 2, 0, 2, 2,
+	1, 3, 9, 9, 7, 0, 4, 3, 0, 1,
+	5, 5, 4, 5, 4, 5, 0, 1, 0, 2,
+	0, 3, 3, 3, 5, 7, 7, 6, 5, 3,
+	2, 3, 3, 3, 7, 3, 3, 3, 3, 4,
+	7, 5, 2, 4, 4, 4, 4, 4, 5, 5,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	2, 4, 2, 4, 5, 4, 4, 4, 4, 3,
-	3, 5, 2, 3, 3, 3, 3, 1, 1, 0,
-	1, 0, 1, 1, 1, 0, 2, 2, 0, 2,
-	2, 0, 2, 0, 1, 1, 2, 1, 1, 2,
-	1, 1, 5, 0, 1, 0, 1, 2, 3, 0,
-	3, 3, 3, 3, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 1, 1, 3, 3, 2, 2, 3,
-	1, 3, 2, 1, 2, 1, 2, 2, 4, 3,
-	3, 6, 4, 7, 6, 1, 3, 2, 2, 2,
-	2, 1, 1, 1, 3, 2, 1, 1, 1, 0,
-	1, 1, 0, 3, 0, 2, 0, 2, 1, 2,
-	2, 0, 1, 1, 0, 1, 1, 0, 1, 0,
-	1, 2, 3, 4, 1, 1, 1, 1, 1, 1,
-	1, 1, 3, 1, 2, 3, 5, 0, 1, 2,
-	1, 1, 0, 2, 1, 3, 1, 1, 1, 3,
-	3, 3, 3, 7, 0, 3, 1, 3, 1, 1,
-	3, 3, 1, 3, 4, 4, 4, 3, 2, 4,
-	0, 1, 0, 2, 0, 1, 0, 1, 2, 1,
-	1, 1, 2, 2, 1, 2, 3, 2, 3, 2,
-	2, 2, 1, 1, 3, 3, 0, 5, 4, 5,
-	5, 0, 2, 1, 3, 3, 3, 2, 3, 1,
-	2, 0, 3, 1, 1, 3, 3, 4, 4, 5,
-	3, 4, 5, 6, 2, 1, 2, 1, 2, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 0, 2,
-	1, 1, 1, 3, 1, 3, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 3, 1, 1, 1, 1,
-	4, 5, 5, 6, 4, 4, 6, 6, 6, 8,
-	8, 8, 8, 9, 8, 5, 4, 2, 2, 2,
+	4, 2, 4, 2, 4, 5, 4, 4, 4, 4,
+	3, 3, 5, 2, 3, 3, 3, 3, 1, 1,
+	0, 1, 0, 1, 1, 1, 0, 2, 2, 0,
+	2, 2, 0, 2, 0, 1, 1, 2, 1, 1,
+	2, 1, 1, 5, 0, 1, 0, 1, 2, 3,
+	0, 3, 3, 3, 3, 1, 1, 1, 1, 1,
+	1, 1, 1, 0, 1, 1, 3, 3, 2, 2,
+	3, 1, 3, 2, 1, 2, 1, 2, 2, 4,
+	3, 3, 6, 4, 7, 6, 1, 3, 2, 2,
+	2, 2, 1, 1, 1, 3, 2, 1, 1, 1,
+	0, 1, 1, 0, 3, 0, 2, 0, 2, 1,
+	2, 2, 0, 1, 1, 0, 1, 1, 0, 1,
+	0, 1, 2, 3, 4, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 1, 2, 3, 5, 0, 1,
+	2, 1, 1, 0, 2, 1, 3, 1, 1, 1,
+	3, 3, 3, 3, 7, 0, 3, 1, 3, 1,
+	1, 3, 3, 1, 3, 4, 4, 4, 3, 2,
+	4, 0, 1, 0, 2, 0, 1, 0, 1, 2,
+	1, 1, 1, 2, 2, 1, 2, 3, 2, 3,
+	2, 2, 2, 1, 1, 3, 3, 0, 5, 4,
+	5, 5, 0, 2, 1, 3, 3, 3, 2, 3,
+	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
+	5, 3, 4, 5, 6, 2, 1, 2, 1, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	2, 1, 1, 1, 3, 1, 3, 1, 1, 1,
+	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
+	1, 4, 5, 5, 6, 4, 4, 6, 6, 6,
+	8, 8, 8, 8, 9, 8, 5, 4, 2, 2,
 	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 8, 8, 0, 2, 3, 4, 4, 4,
-	4, 4, 4, 4, 0, 3, 4, 7, 3, 1,
-	1, 1, 2, 3, 3, 1, 2, 2, 1, 2,
-	1, 2, 2, 1, 2, 0, 1, 0, 2, 1,
-	2, 4, 0, 2, 1, 3, 5, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 2, 0, 3,
-	0, 2, 0, 3, 1, 3, 2, 0, 1, 1,
-	0, 2, 4, 4, 0, 2, 2, 1, 1, 3,
-	3, 3, 3, 3, 3, 3, 0, 3, 3, 3,
-	0, 3, 1, 1, 0, 4, 0, 1, 1, 0,
-	3, 1, 3, 2, 1, 0, 2, 4, 0, 9,
-	3, 5, 0, 3, 3, 0, 1, 0, 2, 2,
-	0, 2, 2, 2, 0, 2, 1, 2, 3, 3,
-	0, 2, 1, 2, 3, 4, 3, 0, 1, 2,
-	1, 5, 4, 4, 1, 3, 3, 5, 0, 5,
-	1, 3, 1, 2, 3, 1, 1, 3, 3, 1,
-	3, 3, 3, 3, 3, 2, 1, 2, 1, 1,
-	1, 1, 1, 1, 1, 0, 1, 0, 2, 0,
-	3, 0, 1, 0, 1, 1, 5, 0, 1, 0,
-	1, 2, 1, 1, 1, 1, 1, 1, 0, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 2, 8, 8, 0, 2, 3, 4, 4,
+	4, 4, 4, 4, 4, 0, 3, 4, 7, 3,
+	1, 1, 1, 2, 3, 3, 1, 2, 2, 1,
+	2, 1, 2, 2, 1, 2, 0, 1, 0, 2,
+	1, 2, 4, 0, 2, 1, 3, 5, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 0,
+	3, 0, 2, 0, 3, 1, 3, 2, 0, 1,
+	1, 0, 2, 4, 4, 0, 2, 2, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 0, 3, 3,
+	3, 0, 3, 1, 1, 0, 4, 0, 1, 1,
+	0, 3, 1, 3, 2, 2, 1, 0, 2, 4,
+	0, 9, 3, 5, 0, 3, 3, 0, 1, 0,
+	2, 2, 0, 2, 2, 2, 0, 2, 1, 2,
+	3, 3, 0, 2, 1, 2, 3, 4, 3, 0,
+	1, 2, 1, 5, 4, 4, 1, 3, 3, 5,
+	0, 5, 1, 3, 1, 2, 3, 1, 1, 3,
+	3, 1, 3, 3, 3, 3, 3, 2, 1, 2,
+	1, 1, 1, 1, 1, 1, 1, 0, 1, 0,
+	2, 0, 3, 0, 1, 0, 1, 1, 5, 0,
+	1, 0, 1, 2, 1, 1, 1, 1, 1, 1,
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -5078,490 +5272,500 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 0, 1,
-	1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
+	0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -283, -1, -3, -8, -9, -10, -11, -12, -13,
+	-1000, -289, -1, -3, -8, -9, -10, -11, -12, -13,
 	-14, -15, -16, -17, -18, -19, -41, -42, -43, -45,
 	-46, -47, -48, -49, -6, -44, -20, -21, -50, -51,
-	-52, -53, -54, -4, -285, 6, 7, 8, -62, 10,
-	11, 30, -23, -33, 160, -34, -24, 161, -35, 163,
-	162, 198, 164, 191, 72, 237, 238, 240, 241, 242,
-	243, -63, 196, 197, 166, 34, 43, 31, 32, 35,
-	169, 82, 9, 341, 193, 192, 26, -284, 481, -70,
-	5, -139, 16, -3, -55, -288, -55, -55, -55, -55,
-	-55, -55, -239, -241, 82, 133, 82, -55, -39, -38,
-	-37, -36, -40, 29, -30, -31, -259, -29, -26, 165,
-	162, 206, 103, 104, 198, 199, 200, 164, 182, 197,
-	201, 196, 215, -25, 78, 31, 354, 357, -246, 161,
-	167, 168, 342, 106, 105, 73, 163, -243, 287, 458,
-	-40, 460, 96, 98, 459, 42, 172, 461, 462, 463,
-	464, 181, 465, 466, 467, 468, 474, 475, 476, 477,
-	107, 5, -55, -201, -197, -263, -191, 85, 86, 87,
-	351, 184, 385, 386, 231, 78, 39, 287, 458, 237,
-	251, 245, 272, 264, 352, 387, 234, 185, 219, 455,
-	262, 111, 460, 388, 199, 292, 293, 294, 96, 240,
-	328, 473, 236, 389, 471, 98, 459, 77, 49, 42,
-	194, 260, 256, 461, 220, 390, 362, 213, 106, 103,
-	480, 34, 254, 48, 28, 470, 105, 47, 462, 124,
-	391, 463, 296, 277, 449, 46, 297, 200, 392, 81,
-	356, 457, 298, 255, 299, 230, 469, 166, 393, 441,
-	300, 301, 450, 394, 278, 282, 395, 320, 302, 50,
-	396, 397, 451, 104, 398, 76, 464, 249, 250, 399,
-	228, 183, 322, 276, 181, 33, 303, 353, 232, 56,
-	207, 323, 44, 280, 43, 445, 400, 448, 275, 271,
-	51, 401, 402, 403, 404, 465, 274, 248, 270, 479,
-	225, 466, 60, 168, 284, 283, 285, 214, 319, 267,
-	405, 406, 407, 188, 79, 408, 257, 19, 409, 410,
-	304, 221, 411, 54, 412, 413, 326, 197, 414, 52,
-	467, 37, 202, 468, 415, 416, 417, 418, 419, 306,
-	420, 305, 279, 281, 209, 307, 355, 421, 253, 201,
-	472, 422, 189, 456, 203, 206, 196, 327, 190, 423,
-	424, 425, 426, 427, 235, 428, 429, 241, 474, 41,
-	430, 431, 432, 433, 229, 224, 321, 330, 59, 80,
-	289, 434, 454, 247, 222, 435, 238, 53, 475, 476,
-	477, 216, 478, 7, 309, 107, 226, 227, 45, 268,
-	208, 436, 437, 258, 259, 273, 246, 269, 239, 442,
-	210, 310, 198, 438, 329, 223, 290, 359, 215, 311,
-	453, 358, 266, 263, 217, 439, 312, 173, 211, 212,
-	440, 443, 313, 314, 316, 317, 233, 315, 318, 218,
-	357, 244, 261, 291, 171, -55, 171, -102, -197, 171,
-	-169, 293, -188, 295, 308, 303, 313, 301, -180, 304,
-	306, 209, -278, 320, 171, 310, 160, 151, 296, 305,
-	314, 315, 318, 218, -274, -263, 463, 478, 111, 265,
-	300, 298, 321, 445, 317, 316, -197, 239, -204, 244,
-	-192, -263, -191, 242, -102, -61, 441, 164, -206, -206,
-	-72, 445, 447, -122, -86, -108, 117, -113, 29, 24,
-	-112, -109, -130, -127, -128, 151, 152, 154, 153, 155,
-	140, 141, 148, 118, 156, -117, -115, -116, -118, 89,
+	-52, -53, -54, -4, -291, 6, 7, 8, -62, 10,
+	11, 30, -23, -33, 163, -34, -24, 164, -35, 166,
+	165, 201, 167, 194, 72, 240, 241, 243, 244, 245,
+	246, -63, 199, 200, 169, 34, 43, 31, 32, 35,
+	172, 82, 9, 344, 196, 195, 26, -290, 484, -70,
+	5, -139, 16, -3, -55, -295, -55, -55, -55, -55,
+	-55, -55, -239, -241, 82, 136, 82, -55, -39, -38,
+	-37, -36, -40, 29, -30, -31, -259, -29, -26, 168,
+	165, 208, 103, 104, 201, 202, 203, 167, 185, 200,
+	204, 199, 219, -25, 78, 31, 357, 360, -246, 164,
+	170, 171, 345, 106, 105, 73, 166, -243, 290, 461,
+	-40, 463, 96, 98, 462, 42, 175, 464, 465, 466,
+	467, 184, 468, 469, 470, 471, 477, 478, 479, 480,
+	107, 5, -55, -201, -197, -269, -191, 85, 86, 87,
+	354, 187, 388, 389, 113, 78, 39, 290, 461, 240,
+	254, 248, 275, 267, 355, 390, 237, 188, 223, 458,
+	265, 111, 463, 391, 202, 295, 296, 297, 96, 243,
+	331, 476, 239, 392, 474, 98, 462, 77, 49, 42,
+	197, 263, 259, 464, 224, 393, 365, 217, 106, 103,
+	483, 34, 257, 48, 28, 473, 105, 47, 465, 127,
+	394, 466, 299, 280, 452, 46, 300, 203, 395, 81,
+	359, 460, 301, 258, 302, 234, 472, 169, 396, 444,
+	303, 304, 453, 397, 281, 285, 398, 323, 305, 50,
+	399, 400, 454, 104, 401, 76, 467, 252, 253, 402,
+	232, 186, 325, 279, 184, 33, 306, 356, 235, 56,
+	209, 326, 44, 283, 43, 448, 403, 451, 278, 274,
+	51, 404, 405, 406, 407, 468, 277, 251, 273, 482,
+	229, 469, 60, 171, 287, 286, 288, 218, 322, 270,
+	408, 409, 410, 191, 79, 411, 260, 19, 412, 413,
+	307, 225, 414, 54, 415, 416, 329, 200, 417, 52,
+	470, 37, 205, 471, 418, 419, 420, 421, 422, 309,
+	423, 308, 282, 284, 211, 310, 358, 424, 256, 204,
+	475, 425, 192, 459, 114, 208, 199, 330, 193, 426,
+	427, 428, 429, 430, 238, 431, 432, 244, 477, 41,
+	433, 434, 435, 436, 233, 228, 324, 333, 59, 80,
+	292, 437, 457, 250, 226, 438, 241, 53, 478, 479,
+	480, 220, 481, 7, 312, 107, 230, 231, 45, 271,
+	210, 439, 440, 261, 262, 276, 249, 272, 242, 445,
+	212, 313, 201, 441, 332, 227, 293, 362, 219, 314,
+	456, 361, 269, 266, 221, 442, 315, 176, 215, 216,
+	443, 446, 316, 317, 319, 320, 236, 318, 321, 222,
+	360, 247, 264, 294, 174, -55, 174, -102, -197, 174,
+	-169, 296, -188, 298, 311, 306, 316, 304, -180, 307,
+	309, 211, -284, 323, 174, 313, 163, 154, 299, 308,
+	317, 318, 321, 222, -280, -269, 466, 481, 111, 268,
+	303, 301, 324, 448, 320, 319, -197, 242, -204, 247,
+	-192, -269, -191, 245, -102, -61, 444, 167, -206, -206,
+	-72, 448, 450, -122, -86, -108, 120, -113, 29, 24,
+	-112, -109, -130, -127, -128, 154, 155, 157, 156, 158,
+	143, 144, 151, 121, 159, -117, -115, -116, -118, 89,
 	88, 97, 90, 91, 92, 93, 99, 100, 101, -192,
-	-197, -125, -285, 66, 67, 342, 343, 344, 345, 350,
-	346, 120, 55, 337, 331, 340, 339, 338, 335, 336,
-	333, 334, 348, 349, 176, 332, 170, 146, 341, -263,
-	-191, 42, 309, 309, -102, -55, -5, -4, -285, 6,
-	21, 22, -143, 18, 17, -286, 84, -64, -77, 61,
-	62, -79, 22, 36, 65, 63, 21, -56, -76, 142,
-	-86, -197, -76, -178, 175, -178, -178, -168, -209, 239,
-	-172, 321, 320, -193, -170, -192, -190, -169, 319, 165,
-	360, 116, 23, 25, 119, 151, 17, 120, 35, 167,
-	265, 182, 150, 178, 342, 160, 70, 361, 333, 334,
-	331, 337, 344, 345, 332, 295, 29, 11, 363, 26,
-	192, 22, 36, 144, 162, 123, 195, 24, 193, 101,
-	366, 20, 73, 187, 12, 180, 38, 14, 367, 368,
-	15, 176, 175, 135, 172, 68, 9, 156, 27, 132,
-	64, 369, 113, 370, 371, 372, 373, 66, 133, 18,
-	335, 336, 31, 446, 374, 350, 204, 146, 71, 57,
-	447, 117, 375, 376, 99, 377, 102, 74, 452, 114,
-	16, 69, 40, 378, 205, 379, 177, 380, 324, 381,
-	134, 163, 341, 67, 382, 170, 308, 6, 347, 30,
-	191, 179, 109, 65, 383, 171, 122, 348, 349, 174,
-	100, 5, 112, 32, 10, 72, 75, 338, 339, 340,
-	55, 108, 354, 121, 13, 384, 325, 115, 111, -240,
-	133, -227, -231, -192, 186, -256, 182, -102, -249, -248,
-	-192, -71, -187, 172, 180, 179, 112, -267, 114, 226,
-	332, 170, -36, -37, -169, 150, 203, 83, 83, -231,
-	-230, -229, -268, 205, 186, -255, -247, 178, 187, -237,
-	179, 180, -232, 172, 113, -268, -232, 177, 187, 205,
-	205, 107, 205, 107, 205, 205, 205, 205, 205, 205,
-	205, 205, 205, 202, -238, 125, -238, 358, 358, -243,
-	-268, -268, -268, 174, 33, 33, -189, -232, 174, 23,
-	-238, -238, -169, 150, -238, -238, -238, -238, 213, 213,
-	-238, -238, -238, -238, -238, -238, -238, -238, -238, -238,
-	-238, -238, -238, -238, -238, 171, -267, -80, 318, 233,
-	78, -38, 215, -22, -102, -187, 172, 173, -267, -102,
-	157, -102, -182, 133, 13, -182, -179, 309, 307, 294,
-	299, -182, -182, -182, -182, 216, 292, -233, 172, 33,
-	183, 309, 216, 292, 216, 217, 216, 217, 302, 312,
-	216, -202, 12, 135, 332, 297, 301, 209, 171, 210,
-	173, 311, -263, 448, 217, -202, 309, 212, -182, -207,
-	-285, -193, 265, -207, -207, 30, 174, -192, -57, -192,
-	89, -7, -3, -11, -10, -12, 125, -78, 309, -66,
-	151, 463, 449, 450, 451, 448, 306, 456, 454, 452,
-	216, 453, 83, 116, 114, 115, 132, -86, -110, 135,
-	117, 133, 134, 119, 137, 136, 147, 140, 141, 142,
-	143, 144, 145, 146, 138, 139, 150, 125, 126, 127,
-	128, 129, 130, 131, -177, -285, -128, -285, 158, 159,
-	-113, -113, -113, -113, -113, -113, -113, -113, -113, -113,
-	-285, 157, -2, -122, -4, -285, -285, -285, -285, -285,
-	-285, -285, -285, -135, -86, -285, -289, -285, -289, -119,
-	-285, -289, -119, -289, -119, -289, -289, -119, -289, -119,
-	-289, -289, -119, -285, -285, -285, -285, -285, -285, -285,
-	-206, -275, -276, -105, -102, -285, 233, -139, -3, -55,
-	-159, 20, 31, -86, -140, -141, -86, -139, 57, -74,
-	-76, -79, 61, 62, 95, 12, -195, -194, 23, -192,
-	89, 157, 12, -103, 27, -102, -88, -89, -90, -91,
-	-105, -129, -285, 12, -95, -96, -102, -104, -197, 83,
-	239, -172, -209, -174, -173, 322, 324, 125, -196, -192,
-	89, 29, 84, 83, -102, -211, -214, -216, -215, -217,
-	-212, -213, 262, 263, 151, 266, 268, 269, 270, 271,
-	272, 273, 274, 275, 276, 277, 30, 194, 258, 259,
-	260, 261, 278, 279, 280, 281, 282, 283, 284, 285,
-	245, 264, 352, 246, 247, 248, 249, 250, 251, 253,
-	254, 255, 256, 257, -266, -263, 82, 84, 83, -218,
-	82, -80, 171, -263, 172, 172, 172, -55, 341, -238,
-	-238, 202, -29, -26, -259, 16, -25, -26, 165, 103,
-	104, 162, 82, -227, 82, -236, -266, -263, 82, 113,
-	177, 112, -235, -232, -235, -236, -263, -130, -192, -197,
-	-263, 113, 113, -165, -192, -165, -165, 21, -165, 21,
-	-165, 21, 90, -192, -165, 21, -165, 21, -165, 21,
-	-165, 21, -165, 21, 29, 76, 77, 29, 79, 80,
-	81, -130, -130, -227, -169, -102, -263, 90, 90, -238,
-	-238, 90, 89, 89, 89, -238, -238, 90, 89, -199,
-	-197, 89, -269, 188, 230, 232, 90, 90, 90, 90,
-	29, 89, -270, 29, 470, 469, 471, 472, 473, 90,
-	29, 90, 29, 90, -192, 82, -102, -83, 220, 160,
-	162, 165, 74, 89, 234, 125, 42, 83, 174, 171,
-	-263, -184, 176, -184, -198, -197, -190, 89, -86, -234,
-	12, 135, -202, -202, -182, -102, -234, -202, -182, -102,
-	-182, -182, -182, -182, -202, -202, -202, -182, -197, -197,
-	-102, -102, -102, -102, -102, -102, -102, -207, -207, -207,
-	-183, 133, -182, 74, -205, 242, 276, 442, 443, 444,
-	83, 354, -95, 448, 448, 448, 448, 448, 448, -86,
-	-86, -86, -86, -120, 99, 117, 100, 101, -113, -121,
-	-125, -128, 94, 135, 133, 134, 119, -113, -113, -113,
-	-113, -113, -113, -113, -113, -113, -113, -113, -113, -113,
-	-113, -113, -208, -263, 89, 151, -263, -112, -112, -192,
-	-75, 22, 36, -74, -193, -198, -190, -70, -286, -286,
-	-139, -74, -74, -86, -86, -130, 89, -74, -130, 89,
-	-74, -74, -69, 22, 36, -133, -134, 121, -130, -286,
-	-113, -192, -192, -74, -75, -75, -74, -74, 83, -277,
-	324, 325, 446, -200, 205, -199, 23, -123, -122, 89,
-	-143, -286, -144, 27, 10, 135, 83, 19, 83, -142,
-	25, 26, -143, -114, -192, 90, 93, -87, 83, 12,
-	-79, -102, -194, 142, -198, -102, -164, 205, -102, 30,
-	83, -98, -100, -99, -101, 64, 68, 70, 65, 66,
-	67, 71, -203, 23, -88, -3, -285, -102, -95, -287,
-	83, 12, 75, -287, 83, 157, -172, -174, 83, 323,
-	325, 326, 74, 102, -86, -220, 150, -245, -244, -243,
-	-227, -229, -230, -231, 84, -145, -85, 38, -223, 290,
-	289, -218, -218, -218, -218, -218, -219, -169, -219, -219,
-	-219, 82, 82, -218, -218, -218, -218, -221, 82, -221,
-	-221, -222, 82, -222, -256, -86, -253, -252, -250, -251,
-	181, 96, 354, 75, -248, -142, 90, -83, -185, 176,
-	-254, -251, -263, -263, -263, -185, -263, 89, -263, 89,
-	83, 17, -228, -227, -131, 230, -258, 205, -255, -249,
-	-236, 113, -235, -236, -236, 157, -263, 83, 27, 107,
-	107, 107, 107, 354, 162, 30, -227, -131, -208, 174,
-	-208, -208, 89, 89, -181, 478, -95, -82, 222, 125,
-	211, 211, 171, 171, 224, -102, 235, 236, 234, 21,
-	223, 225, 227, 213, -102, -102, -184, 74, -97, -102,
-	24, -197, -102, -182, -182, -102, -182, -182, 89, -102,
-	-192, -66, 324, 354, 20, -67, 20, 99, 100, 101,
-	-121, -113, -113, -113, -73, 195, 116, -286, -286, -74,
-	-74, -285, 157, -5, -143, -286, -286, 83, 75, 23,
-	12, 12, -286, 12, 12, -286, -286, -74, -136, -134,
-	123, -86, -286, -286, 83, 83, -286, -286, -286, -286,
-	-286, -276, 445, 325, -106, 72, 175, 73, -285, -199,
-	-286, -159, 40, 48, 59, -86, -86, -141, -159, -176,
-	20, 12, 55, 55, -107, 13, -76, -88, -79, 157,
-	-107, -111, 30, 55, -3, -285, -285, -167, -171, -130,
-	-89, -90, -90, -89, -90, 64, 64, 64, 69, 64,
-	69, 64, -99, -197, -286, -286, -3, -164, 75, -88,
-	-102, -88, -104, -197, 142, -173, -175, 327, 324, 330,
-	-263, 89, 83, -243, -231, -282, 99, 117, 29, 74,
-	287, 96, -280, -281, 177, 112, 113, 189, 23, 39,
-	-224, 291, -219, -219, -220, -263, 89, 151, -220, -220,
-	-220, -226, 89, -226, 90, 90, 84, -32, -27, -28,
-	31, 78, -250, -238, 89, 37, -192, 84, -82, -102,
-	117, 74, -254, -254, -254, -197, 16, -161, -192, 83,
-	84, -132, 231, -130, 84, -192, 84, 82, -236, -236,
-	-193, -192, -285, 171, 29, 29, -131, -132, -220, -263,
-	480, 479, 84, 173, 229, -84, 337, 89, 85, -102,
-	-102, -102, -102, -102, 165, 162, 214, 174, -95, -102,
-	83, -60, 190, 185, -202, -202, 31, 324, 457, 455,
-	-73, 116, -113, -113, -286, -286, -75, -193, -139, -159,
-	-210, 151, 262, 194, 260, 256, 276, 267, 289, 258,
-	290, -208, -210, -113, -113, -113, -113, 351, -139, 124,
-	-86, 122, -113, -113, 172, 172, 172, -165, 41, 89,
-	89, 60, -102, -137, 14, -86, 142, -143, -166, 74,
-	-167, -124, -126, -125, -285, -160, -286, -192, -165, -107,
-	83, 125, -93, -92, 74, 75, -94, 74, -92, 64,
-	64, -286, -107, -88, -107, -107, 157, 324, 328, 329,
-	-243, 99, -113, 10, 89, 113, 113, -102, 82, -220,
-	-220, 84, 83, 84, 83, 84, 83, -186, 391, 117,
-	-28, -27, -238, -238, 90, -263, 173, 24, -102, 74,
-	74, 74, 17, 83, -227, -130, 55, -253, -161, -257,
-	-258, -102, -112, -132, -102, -81, 220, 228, 82, 86,
-	-265, 75, 211, 287, 211, -102, -60, -32, -102, -182,
-	-182, 31, -263, -113, -286, -143, -286, -218, -218, -218,
-	-222, -218, 250, -218, 250, -286, -286, 20, 20, 20,
-	20, -285, -65, 347, -86, 83, 83, -285, -285, -285,
-	-286, 89, -219, -138, 15, 17, 28, -166, 83, -286,
-	-286, 83, 55, 157, -286, -139, -171, -86, -86, 82,
-	-86, -139, -107, -116, 82, -113, -219, 89, -219, 90,
-	90, 391, 29, 79, 80, 81, 29, 76, 77, -102,
-	-102, -102, -102, -161, -192, 207, 84, -286, 83, -225,
-	354, 357, -162, 82, 84, -262, 354, -264, -263, -192,
-	-192, -192, -159, -219, -263, -113, -113, -113, -113, -113,
-	-143, 89, -113, -113, -163, -286, -192, 177, -163, -163,
-	-200, -219, -148, -153, -179, -86, -122, 113, -126, 55,
-	-3, -192, -124, -192, -143, -161, -143, -161, 84, -220,
-	-220, 84, 84, -162, 208, -281, -258, 358, 358, 23,
-	-161, -261, -260, -193, 82, 75, -286, -286, -286, -286,
-	-68, 135, 354, -286, -286, -286, 83, -286, -286, -286,
-	-106, -151, 441, -156, 44, -154, -155, 45, -152, 46,
-	54, 10, -124, 157, 84, 84, -146, 23, -285, -3,
-	84, 83, 125, -161, -102, -286, 352, 71, 355, -192,
-	177, -148, 49, 268, -158, -157, 53, 45, -155, 17,
-	47, 17, -167, -192, -272, -273, 74, -282, -279, 99,
-	117, 96, -280, 108, 109, -3, -113, 204, -58, 354,
-	-260, -242, -193, 89, 90, 84, 60, 353, 356, -149,
-	51, -147, 50, -147, -157, 17, 17, 89, 17, 89,
-	-273, 74, 11, 10, 99, 89, -58, -286, -286, -59,
-	219, 445, -265, 60, -150, 52, 74, 102, 89, 89,
-	89, -271, 190, 185, 188, 30, -271, 182, -262, 354,
-	74, 102, 184, 29, 99, 221, 355, 356,
+	-197, -125, -291, 66, 67, 345, 346, 347, 348, 353,
+	349, 123, 55, 340, 334, 343, 342, 341, 338, 339,
+	336, 337, 351, 352, 179, 335, 173, 149, 344, -269,
+	-191, 42, 312, 312, -102, -55, -5, -4, -291, 6,
+	21, 22, -143, 18, 17, -292, 84, -64, -77, 61,
+	62, -79, 22, 36, 65, 63, 21, -56, -76, 145,
+	-86, -197, -76, -178, 178, -178, -178, -168, -209, 242,
+	-172, 324, 323, -193, -170, -192, -190, -169, 322, 168,
+	363, 119, 23, 25, 122, 154, 17, 123, 35, 170,
+	268, 185, 153, 181, 345, 163, 70, 364, 336, 337,
+	334, 340, 347, 348, 335, 298, 29, 11, 366, 26,
+	195, 22, 36, 147, 165, 126, 198, 24, 196, 101,
+	369, 20, 73, 190, 12, 183, 38, 14, 370, 371,
+	15, 179, 178, 138, 175, 68, 9, 159, 27, 135,
+	64, 372, 116, 373, 374, 375, 376, 66, 136, 18,
+	338, 339, 31, 449, 377, 353, 206, 149, 71, 57,
+	450, 120, 378, 379, 99, 380, 102, 74, 455, 117,
+	16, 69, 40, 381, 207, 382, 180, 383, 327, 384,
+	137, 166, 344, 67, 385, 173, 311, 6, 350, 30,
+	194, 182, 109, 65, 386, 174, 125, 351, 352, 177,
+	100, 5, 115, 32, 10, 72, 75, 341, 342, 343,
+	55, 108, 357, 124, 13, 387, 328, 118, 111, -240,
+	136, -227, -231, -192, 189, -256, 185, -102, -249, -248,
+	-192, -71, -187, 175, 183, 182, 115, -273, 117, 230,
+	335, 173, -36, -37, -169, 153, -261, 114, 207, 83,
+	83, -231, -230, -229, -274, 207, 189, -255, -247, 181,
+	190, -237, 182, 183, -232, 175, 116, -274, -232, 180,
+	190, 185, 207, 207, 107, 207, 107, 207, 207, 207,
+	207, 207, 207, 207, 207, 207, 205, -238, 128, -238,
+	361, 361, -243, -274, -274, -274, 177, 33, 33, -189,
+	-232, 177, 23, -238, -238, -169, 153, -238, -238, -238,
+	-238, 217, 217, -238, -238, -238, -238, -238, -238, -238,
+	-238, -238, -238, -238, -238, -238, -238, -238, 174, -273,
+	-80, 321, 236, 78, -38, 219, -22, -102, -187, 175,
+	176, -273, -102, 160, -102, -182, 136, 13, -182, -179,
+	312, 310, 297, 302, -182, -182, -182, -182, 220, 295,
+	-233, 175, 33, 186, 312, 220, 295, 220, 221, 220,
+	221, 305, 315, 220, -202, 12, 138, 335, 300, 304,
+	211, 174, 212, 176, 314, -269, 451, 221, -202, 312,
+	216, -182, -207, -291, -193, 268, -207, -207, 30, 177,
+	-192, -57, -192, 89, -7, -3, -11, -10, -12, 128,
+	-78, 312, -66, 154, 466, 452, 453, 454, 451, 309,
+	459, 457, 455, 220, 456, 83, 119, 117, 118, 135,
+	-86, -110, 138, 120, 136, 137, 122, 140, 139, 150,
+	143, 144, 145, 146, 147, 148, 149, 141, 142, 153,
+	128, 129, 130, 131, 132, 133, 134, -177, -291, -128,
+	-291, 161, 162, -113, -113, -113, -113, -113, -113, -113,
+	-113, -113, -113, -291, 160, -2, -122, -4, -291, -291,
+	-291, -291, -291, -291, -291, -291, -135, -86, -291, -296,
+	-291, -296, -119, -291, -296, -119, -296, -119, -296, -296,
+	-119, -296, -119, -296, -296, -119, -291, -291, -291, -291,
+	-291, -291, -291, -206, -281, -282, -105, -102, -291, 236,
+	-139, -3, -55, -159, 20, 31, -86, -140, -141, -86,
+	-139, 57, -74, -76, -79, 61, 62, 95, 12, -195,
+	-194, 23, -192, 89, 160, 12, -103, 27, -102, -88,
+	-89, -90, -91, -105, -129, -291, 12, -95, -96, -102,
+	-104, -197, 83, 242, -172, -209, -174, -173, 325, 327,
+	128, -196, -192, 89, 29, 84, 83, -102, -211, -214,
+	-216, -215, -217, -212, -213, 265, 266, 154, 269, 271,
+	272, 273, 274, 275, 276, 277, 278, 279, 280, 30,
+	197, 261, 262, 263, 264, 281, 282, 283, 284, 285,
+	286, 287, 288, 248, 267, 355, 249, 250, 251, 252,
+	253, 254, 256, 257, 258, 259, 260, -272, -269, 82,
+	84, 83, -218, 82, -80, 174, -269, 175, 175, 175,
+	-55, 344, -238, -238, 205, 17, -29, -26, -259, 16,
+	-25, -26, 168, 103, 104, 165, 82, -227, 82, -236,
+	-272, -269, 82, 116, 180, 115, -235, -232, -235, -236,
+	-269, -130, -192, -197, -269, 116, 116, -269, -165, -192,
+	-165, -165, 21, -165, 21, -165, 21, 90, -192, -165,
+	21, -165, 21, -165, 21, -165, 21, -165, 21, 29,
+	76, 77, 29, 79, 80, 81, -130, -130, -227, -169,
+	-102, -269, 90, 90, -238, -238, 90, 89, 89, 89,
+	-238, -238, 90, 89, -199, -197, 89, -275, 191, 234,
+	235, 90, 90, 90, 90, 29, 89, -276, 29, 473,
+	472, 474, 475, 476, 90, 29, 90, 29, 90, -192,
+	82, -102, -83, 224, 163, 165, 168, 74, 89, 237,
+	128, 42, 83, 177, 174, -269, -184, 179, -184, -198,
+	-197, -190, 89, -86, -234, 12, 138, -202, -202, -182,
+	-102, -234, -202, -182, -102, -182, -182, -182, -182, -202,
+	-202, -202, -182, -197, -197, -102, -102, -102, -102, -102,
+	-102, -102, -207, -207, -207, -183, 136, -182, 74, -205,
+	245, 279, 445, 446, 447, 83, 357, -95, 451, 451,
+	451, 451, 451, 451, -86, -86, -86, -86, -120, 99,
+	120, 100, 101, -113, -121, -125, -128, 94, 138, 136,
+	137, 122, -113, -113, -113, -113, -113, -113, -113, -113,
+	-113, -113, -113, -113, -113, -113, -113, -208, -269, 89,
+	154, -269, -112, -112, -192, -75, 22, 36, -74, -193,
+	-198, -190, -70, -292, -292, -139, -74, -74, -86, -86,
+	-130, 89, -74, -130, 89, -74, -74, -69, 22, 36,
+	-133, -134, 124, -130, -292, -113, -192, -192, -74, -75,
+	-75, -74, -74, 83, -283, 327, 328, 449, -200, 207,
+	-199, 23, -123, -122, 89, -143, -292, -144, 27, 10,
+	138, 83, 19, 83, -142, 25, 26, -143, -114, -192,
+	90, 93, -87, 83, 12, -79, -102, -194, 145, -198,
+	-102, -164, 207, -102, 30, 83, -98, -100, -99, -101,
+	64, 68, 70, 65, 66, 67, 71, -203, 23, -88,
+	-3, -291, -102, -95, -293, 83, 12, 75, -293, 83,
+	160, -172, -174, 83, 326, 328, 329, 74, 102, -86,
+	-220, 153, -245, -244, -243, -227, -229, -230, -231, 84,
+	-145, -85, 38, -223, 293, 292, -218, -218, -218, -218,
+	-218, -219, -169, -219, -219, -219, 82, 82, -218, -218,
+	-218, -218, -221, 82, -221, -221, -222, 82, -222, -256,
+	-86, -253, -252, -250, -251, 184, 96, 357, 75, -248,
+	-142, 90, -83, -185, 179, -254, -251, -269, -269, -269,
+	-185, -269, 89, -269, 89, -262, -265, 214, 213, 83,
+	17, -228, -227, -131, 234, -258, 207, -255, -249, -236,
+	116, -235, -236, -236, 160, -269, 83, 27, 107, 107,
+	107, 107, 357, 165, 30, -227, -131, -208, 177, -208,
+	-208, 89, 89, -181, 481, -95, -82, 226, 128, 215,
+	215, 174, 174, 228, -102, 238, 239, 237, 21, 227,
+	229, 231, 217, -102, -102, -184, 74, -97, -102, 24,
+	-197, -102, -182, -182, -102, -182, -182, 89, -102, -192,
+	-66, 327, 357, 20, -67, 20, 99, 100, 101, -121,
+	-113, -113, -113, -73, 198, 119, -292, -292, -74, -74,
+	-291, 160, -5, -143, -292, -292, 83, 75, 23, 12,
+	12, -292, 12, 12, -292, -292, -74, -136, -134, 126,
+	-86, -292, -292, 83, 83, -292, -292, -292, -292, -292,
+	-282, 448, 328, -106, 72, 178, 73, -291, -199, -292,
+	-159, 40, 48, 59, -86, -86, -141, -159, -176, 20,
+	12, 55, 55, -107, 13, -76, -88, -79, 160, -107,
+	-111, 30, 55, -3, -291, -291, -167, -171, -130, -89,
+	-90, -90, -89, -90, 64, 64, 64, 69, 64, 69,
+	64, -99, -197, -292, -292, -3, -164, 75, -88, -102,
+	-88, -104, -197, 145, -173, -175, 330, 327, 333, -269,
+	89, -260, -261, 83, -243, -231, -288, 99, 120, 29,
+	74, 290, 96, -286, -287, 180, 115, 116, 192, 23,
+	39, -224, 294, -219, -219, -220, -269, 89, 154, -220,
+	-220, -220, -226, 89, -226, 90, 90, 84, -32, -27,
+	-28, 31, 78, -250, -238, 89, 37, -192, 84, -82,
+	-102, 120, 74, -254, -254, -254, -197, -264, -192, -192,
+	116, -291, 297, 16, -161, -192, 83, 84, -132, 113,
+	-130, 84, -192, 84, 82, -236, -236, -193, -192, -291,
+	174, 29, 29, -131, -132, -220, -269, 483, 482, 84,
+	176, 233, -84, 340, 89, 85, -102, -102, -102, -102,
+	-102, 168, 165, 218, 177, -95, -102, 83, -60, 193,
+	188, -202, -202, 31, 327, 460, 458, -73, 119, -113,
+	-113, -292, -292, -75, -193, -139, -159, -210, 154, 265,
+	197, 263, 259, 279, 270, 292, 261, 293, -208, -210,
+	-113, -113, -113, -113, 354, -139, 127, -86, 125, -113,
+	-113, 175, 175, 175, -165, 41, 89, 89, 60, -102,
+	-137, 14, -86, 145, -143, -166, 74, -167, -124, -126,
+	-125, -291, -160, -292, -192, -165, -107, 83, 128, -93,
+	-92, 74, 75, -94, 74, -92, 64, 64, -292, -107,
+	-88, -107, -107, 160, 327, 331, 332, -243, 99, -113,
+	10, 89, 116, 116, -102, 82, -220, -220, 84, 83,
+	84, 83, 84, 83, -186, 394, 120, -28, -27, -238,
+	-238, 90, -269, 176, 24, -102, 74, 74, 74, -263,
+	-291, 90, -291, -291, -86, -291, 17, 83, -227, -130,
+	55, -253, -161, -257, -258, -102, -112, -132, -102, -81,
+	224, 232, 82, 86, 86, -271, 75, 215, 290, 215,
+	-102, -60, -32, -102, -182, -182, 31, -269, -113, -292,
+	-143, -292, -218, -218, -218, -222, -218, 253, -218, 253,
+	-292, -292, 20, 20, 20, 20, -291, -65, 350, -86,
+	83, 83, -291, -291, -291, -292, 89, -219, -138, 15,
+	17, 28, -166, 83, -292, -292, 83, 55, 160, -292,
+	-139, -171, -86, -86, 82, -86, -139, -107, -116, 82,
+	-113, -219, 89, -219, 90, 90, 394, 29, 79, 80,
+	81, 29, 76, 77, -102, -102, -102, -102, -257, -86,
+	-292, -161, -292, -161, -161, -192, 209, 84, -292, 83,
+	-225, 357, 360, -162, 82, 84, -268, 357, -270, -269,
+	-192, -192, -192, -159, -219, -269, -113, -113, -113, -113,
+	-113, -143, 89, -113, -113, -163, -292, -192, 180, -163,
+	-163, -200, -219, -148, -153, -179, -86, -122, 116, -126,
+	55, -3, -192, -124, -192, -143, -161, -143, -161, 84,
+	-220, -220, 84, 84, -162, -292, -292, -292, -292, 210,
+	-287, -258, 361, 361, 23, -161, -267, -266, -193, 82,
+	75, -292, -292, -292, -292, -68, 138, 357, -292, -292,
+	-292, 83, -292, -292, -292, -106, -151, 444, -156, 44,
+	-154, -155, 45, -152, 46, 54, 10, -124, 160, 84,
+	84, -146, 23, -291, 206, -3, 84, 83, 128, -161,
+	-102, -292, 355, 71, 358, -192, 180, -148, 49, 271,
+	-158, -157, 53, 45, -155, 17, 47, 17, -167, -192,
+	-278, -279, 74, -288, -285, 99, 120, 96, -286, 108,
+	109, -3, -113, 206, -294, 481, 466, -58, 357, -266,
+	-242, -193, 89, 90, 84, 60, 356, 359, -149, 51,
+	-147, 50, -147, -157, 17, 17, 89, 17, 89, -279,
+	74, 11, 10, 99, 89, -58, -292, -292, 466, -238,
+	-59, 223, 448, -271, 60, -150, 52, 74, 102, 89,
+	89, 89, -277, 193, 188, 191, 30, -277, -294, -294,
+	-238, -199, 185, -268, 357, 74, 102, 187, 29, 99,
+	-199, 225, 358, 359,
 }
 
 var yyDef = [...]int{
 	34, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 842, 0, 574, 574, 574, 574, 574,
-	574, 574, 0, 0, 574, -2, -2, 574, 978, 0,
-	574, 0, 0, -2, 507, 508, 0, 510, -2, 0,
-	0, 519, 1397, 1397, 569, 0, 0, 0, 0, 0,
-	574, 1395, 55, 56, 525, 526, 527, 1, 3, 0,
-	578, 850, 0, 0, -2, 576, 0, 0, 961, 961,
-	961, 0, 86, 87, 0, 0, 0, -2, 90, -2,
-	114, 115, 0, 119, 383, 344, 386, 342, 372, -2,
+	31, 32, 33, 863, 0, 595, 595, 595, 595, 595,
+	595, 595, 0, 0, 595, -2, -2, 595, 1000, 0,
+	595, 0, 0, -2, 528, 529, 0, 531, -2, 0,
+	0, 540, 1419, 1419, 590, 0, 0, 0, 0, 0,
+	595, 1417, 55, 56, 546, 547, 548, 1, 3, 0,
+	599, 871, 0, 0, -2, 597, 0, 0, 983, 983,
+	983, 0, 86, 87, 0, 0, 0, -2, 90, -2,
+	114, 115, 0, 119, 385, 345, 389, 343, 374, -2,
 	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 347, 237, 237, 0, 0, -2, 335,
-	335, 335, 0, 0, 0, 369, 963, 290, 237, 237,
+	0, 0, 0, 348, 237, 237, 0, 0, -2, 335,
+	335, 335, 0, 0, 0, 371, 985, 290, 237, 237,
 	0, 237, 237, 237, 237, 0, 0, 237, 237, 237,
 	237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
-	237, 237, 866, 118, 979, 976, 977, 35, 36, 37,
-	1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130,
-	1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140,
-	1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150,
-	1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160,
-	1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170,
-	1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180,
-	1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190,
-	1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200,
-	1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210,
-	1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220,
-	1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230,
-	1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240,
-	1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250,
-	1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260,
-	1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270,
-	1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280,
-	1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290,
-	1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300,
-	1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310,
-	1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320,
-	1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330,
-	1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340,
-	1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350,
-	1351, 1352, 1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360,
-	1361, 1362, 1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370,
-	1371, 1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380,
-	1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390,
-	1391, 1392, 1393, 1394, 0, 955, 0, 439, 663, 0,
-	498, 498, 0, 498, 498, 498, 498, 0, 0, 0,
-	451, 0, 0, 0, 0, 495, 0, 0, 470, 472,
-	0, 495, 0, 482, 498, 1398, 1398, 1398, 946, 0,
-	492, 490, 504, 505, 487, 488, 506, 509, 0, 514,
-	517, 972, 973, 0, 532, 0, 1200, 524, 537, 538,
-	0, 570, 571, 40, 714, 673, 0, 679, 681, 0,
-	716, 717, 718, 719, 720, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 746, 747, 748, 749, 827,
-	828, 829, 830, 831, 832, 833, 834, 683, 684, 824,
-	0, 935, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 815, 0, 784, 784, 784, 784, 784, 784, 784,
-	784, 784, 0, 0, 0, 0, 0, 0, 0, -2,
-	-2, 1397, 0, 547, 0, 0, 842, 51, 0, 574,
-	579, 580, 885, 0, 0, 842, 1396, 0, 0, -2,
-	-2, 590, 596, 597, 598, 599, 600, 575, 0, 603,
-	607, 0, 0, 0, 962, 0, 0, 72, 0, 1359,
-	939, -2, -2, 0, 0, 974, 975, 948, -2, 982,
-	983, 984, 985, 986, 987, 988, 989, 990, 991, 992,
-	993, 994, 995, 996, 997, 998, 999, 1000, 1001, 1002,
-	1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011, 1012,
-	1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022,
-	1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032,
-	1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042,
-	1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052,
-	1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062,
-	1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072,
-	1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082,
-	1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092,
-	1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102,
-	1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112,
-	1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, -2, 0,
+	237, 237, 887, 118, 1001, 998, 999, 35, 36, 37,
+	1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152,
+	1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162,
+	1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172,
+	1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182,
+	1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
+	1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202,
+	1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212,
+	1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
+	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
+	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
+	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
+	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
+	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
+	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
+	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
+	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
+	1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312,
+	1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322,
+	1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332,
+	1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342,
+	1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352,
+	1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362,
+	1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372,
+	1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382,
+	1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392,
+	1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402,
+	1403, 1404, 1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412,
+	1413, 1414, 1415, 1416, 0, 977, 0, 460, 684, 0,
+	519, 519, 0, 519, 519, 519, 519, 0, 0, 0,
+	472, 0, 0, 0, 0, 516, 0, 0, 491, 493,
+	0, 516, 0, 503, 519, 1420, 1420, 1420, 968, 0,
+	513, 511, 525, 526, 508, 509, 527, 530, 0, 535,
+	538, 994, 995, 0, 553, 0, 1222, 545, 558, 559,
+	0, 591, 592, 40, 735, 694, 0, 700, 702, 0,
+	737, 738, 739, 740, 741, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 767, 768, 769, 770, 848,
+	849, 850, 851, 852, 853, 854, 855, 704, 705, 845,
+	0, 957, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 836, 0, 805, 805, 805, 805, 805, 805, 805,
+	805, 805, 0, 0, 0, 0, 0, 0, 0, -2,
+	-2, 1419, 0, 568, 0, 0, 863, 51, 0, 595,
+	600, 601, 907, 0, 0, 863, 1418, 0, 0, -2,
+	-2, 611, 617, 618, 619, 620, 621, 596, 0, 624,
+	628, 0, 0, 0, 984, 0, 0, 72, 0, 1381,
+	961, -2, -2, 0, 0, 996, 997, 970, -2, 1004,
+	1005, 1006, 1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014,
+	1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024,
+	1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034,
+	1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044,
+	1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054,
+	1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064,
+	1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074,
+	1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084,
+	1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094,
+	1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104,
+	1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114,
+	1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124,
+	1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134,
+	1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, -2, 0,
 	0, 128, 129, 0, 38, 263, 0, 124, 0, 257,
-	209, 866, 0, 0, 0, 0, 0, 574, 0, 956,
-	109, 110, 116, 117, 237, 237, 0, 118, 118, 351,
-	352, 353, 0, 0, -2, 261, 0, 336, 0, 0,
-	251, 251, 255, 253, 254, 0, 0, 0, 0, 0,
-	0, 363, 0, 364, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 423, 0, 238, 0, 381, 382, 291,
-	0, 0, 0, 0, 361, 362, 0, 0, 964, 965,
-	0, 0, 237, 237, 0, 0, 0, 0, 237, 237,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 108, 879, 0, 0,
-	0, -2, 0, 431, 0, 0, 0, 957, 957, 438,
-	0, 440, 441, 0, 0, 442, 0, 495, 495, 493,
-	494, 444, 445, 446, 447, 498, 0, 0, 246, 247,
-	248, 495, 498, 0, 498, 498, 498, 498, 495, 495,
-	495, 498, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1398, 1398, 1398, 501, 498, 479, 480, 483, 484,
-	1399, 1400, 993, 485, 486, 947, 515, 518, 535, 533,
-	534, 536, 528, 529, 530, 531, 0, 549, 550, 555,
-	0, 0, 0, 0, 561, 562, 563, 0, 0, 566,
-	567, 568, 0, 0, 0, 0, 0, 677, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 701, 702, 703,
-	704, 705, 706, 707, 680, 0, 694, 0, 0, 0,
-	736, 737, 738, 739, 740, 741, 742, 743, 744, 0,
-	587, 0, 0, 0, 842, 0, 0, 0, 0, 0,
-	0, 0, 584, 0, 816, 0, 767, 0, 768, 776,
-	0, 769, 777, 770, 778, 771, 772, 779, 773, 780,
-	774, 775, 781, 0, 0, 0, 587, 587, 0, 0,
-	41, 539, 540, 0, 646, 967, 0, 850, 0, 589,
-	888, 0, 0, 851, 843, 844, 847, 850, 0, 612,
-	601, 591, 594, 595, 577, 0, 604, 608, 0, 610,
-	611, 0, 0, 70, 0, 662, 0, 614, 616, 617,
-	618, 644, 0, 0, 0, 0, 66, 68, 663, 0,
-	1359, 945, 0, 74, 75, 0, 0, 0, 225, 950,
-	951, 952, -2, 244, 0, -2, 216, 160, 161, 162,
-	209, 164, 209, 209, 209, 209, 221, 221, 221, 221,
-	192, 193, 194, 195, 196, 0, 0, 179, 209, 209,
-	209, 209, 199, 200, 201, 202, 203, 204, 205, 206,
-	165, 166, 167, 168, 169, 170, 171, 172, 173, 211,
-	211, 211, 213, 213, 0, 39, 0, 229, 0, 847,
-	0, 879, 959, 969, 0, 0, 0, 959, 92, 0,
-	0, 384, 345, 373, 385, 0, 348, 349, -2, 0,
-	0, 335, 0, 337, 0, 245, 0, -2, 0, 255,
-	0, 251, 255, 252, 255, 243, 256, 365, 824, 0,
-	366, 367, 0, 403, 632, 0, 0, 0, 0, 0,
-	409, 410, 411, 0, 413, 414, 415, 416, 417, 418,
-	419, 420, 421, 422, 374, 375, 376, 377, 378, 379,
-	380, 0, 0, 337, 0, 370, 0, 292, 293, 0,
-	0, 296, 297, 298, 299, 0, 0, 302, 303, 304,
-	649, 650, 305, 329, 330, 331, 306, 307, 308, 309,
-	310, 311, 312, 323, 324, 325, 326, 327, 328, 313,
-	314, 315, 316, 317, 320, 0, 102, 870, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 957,
-	0, 0, 0, 0, 664, 980, 981, 499, 500, 0,
-	249, 250, 498, 498, 448, 471, 0, 498, 452, 473,
-	453, 455, 454, 456, 475, 476, 498, 459, 496, 497,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	477, 0, 478, 0, 0, 516, 520, 521, 522, 523,
-	0, 0, 552, 557, 558, 559, 560, 572, 565, 715,
-	674, 675, 676, 678, 695, 0, 697, 699, 685, 686,
-	710, 711, 712, 0, 0, 0, 0, 708, 690, 0,
-	721, 722, 723, 724, 725, 726, 727, 728, 729, 730,
-	731, 732, 735, 799, 800, 801, 0, 733, 734, 745,
-	0, 0, 0, 588, 825, 0, -2, 0, 713, 934,
-	850, 0, 0, 0, 0, 718, 827, 0, 718, 827,
-	0, 0, 0, 585, 586, 822, 819, 0, 0, 785,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 542,
-	543, 545, 0, 666, 0, 647, 0, 0, 968, 548,
-	885, 52, 42, 0, 886, 0, 0, 0, 0, 846,
-	848, 849, 885, 0, 835, 0, 0, 671, 0, 0,
-	592, 48, 609, 605, 0, 671, 0, 0, 661, 0,
-	0, 0, 0, 0, 0, 651, 0, 0, 654, 0,
-	0, 0, 0, 645, 0, 0, 0, -2, 0, 0,
-	0, 62, 63, 0, 0, 0, 940, 73, 0, 0,
-	78, 79, 941, 942, 943, 944, 0, 111, -2, 287,
-	130, 132, 133, 134, 125, 269, 0, 0, 219, 217,
-	218, 163, 221, 221, 186, 187, 225, 0, 225, 225,
-	225, 0, 0, 180, 181, 182, 183, 174, 0, 175,
-	176, 177, 0, 178, 262, 0, 854, 230, 231, 233,
-	237, 0, 0, 0, 258, 259, 0, 870, 0, 0,
-	0, 970, 969, 969, 969, 0, 120, 121, 122, 123,
-	118, 0, 0, 126, 339, 0, 0, 0, 260, 0,
-	0, 255, 255, 240, 241, 0, 368, 0, 0, 405,
-	406, 407, 408, 0, 0, 0, 337, 339, 225, 0,
-	294, 295, 300, 301, 318, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 398, 399, 400, 401,
-	867, 868, 869, 0, 0, 432, 0, 0, 279, 64,
-	958, 437, 495, 458, 474, 495, 450, 457, 502, 481,
-	512, 556, 0, 0, 0, 564, 0, 696, 698, 700,
-	687, 708, 691, 0, 688, 0, 0, 682, 750, 0,
-	0, 587, 0, 842, 885, 754, 755, 0, 0, 0,
-	0, 0, 792, 0, 0, 793, 0, 842, 0, 820,
-	0, 0, 766, 786, 0, 0, 787, 788, 789, 790,
-	791, 541, 544, 546, 622, 0, 0, 0, 0, 648,
-	966, 44, 0, 0, 0, 852, 853, 845, 43, 0,
-	953, 954, 836, 837, 838, 0, 602, 613, 593, 0,
-	850, 928, 0, 0, 920, 0, 0, 671, 936, 0,
-	615, 640, 642, 0, 637, 652, 653, 655, 0, 657,
-	0, 659, 660, 619, 620, 621, 0, 671, 0, 671,
-	67, 671, 69, 0, 665, 76, 77, 0, 0, 83,
-	226, 227, 118, 289, 131, 135, 140, 0, 0, 0,
-	144, 0, 146, 270, 0, 156, 158, 0, 0, 138,
-	159, 220, 225, 225, 188, 222, 223, 224, 189, 190,
-	191, 0, 207, 0, 0, 0, 282, 88, 858, 857,
-	237, 237, 232, 0, 235, 0, 971, 210, 0, 101,
-	0, 0, 0, 0, 0, 107, 0, 343, 626, 0,
-	354, 355, 0, 338, 402, 0, 229, 0, 239, 242,
-	825, 633, 0, 0, 356, 0, 339, 359, 360, 371,
-	321, 322, 319, 0, 0, 880, 881, 0, 884, 93,
-	391, 393, 392, 396, 0, 0, 389, 0, 279, 854,
-	0, 436, 280, 281, 498, 498, 551, 0, 554, 0,
-	689, 0, 709, 692, 751, 752, 0, 826, 850, 46,
-	0, 209, 209, 805, 209, 213, 808, 209, 810, 209,
-	813, 0, 0, 0, 0, 0, 0, 0, 817, 765,
-	823, 0, 0, 0, 0, 0, 0, 0, 0, 221,
-	890, 887, 45, 840, 0, 672, 606, 49, 53, 0,
-	928, 919, 930, 932, 0, 0, 0, 924, 0, 842,
-	0, 0, 634, 641, 0, 0, 635, 0, 636, 656,
-	658, -2, 842, 671, 60, 61, 0, 80, 81, 82,
-	288, 141, 142, 0, 145, 155, 157, 0, 0, 184,
-	185, 221, 0, 221, 0, 214, 0, 271, 283, 0,
-	855, 856, 0, 0, 234, 236, 0, 960, 103, 0,
-	0, 0, 0, 0, 127, 340, 0, 228, 0, 0,
-	427, 424, 357, 358, 624, 871, 872, 873, 0, 883,
-	96, 0, 0, 0, 0, 433, 434, 435, 65, 443,
-	449, 553, 573, 693, 753, 885, 756, 802, 221, 806,
-	807, 809, 811, 812, 814, 758, 757, 0, 0, 0,
-	0, 0, 850, 0, 821, 0, 0, 0, 0, 0,
-	646, 221, 910, 50, 0, 0, 0, 54, 0, 933,
-	0, 0, 0, 0, 71, 850, 937, 938, 638, 0,
-	643, 850, 59, 143, 0, 0, 225, 208, 225, 0,
-	0, 284, 859, 860, 861, 862, 863, 864, 865, 624,
-	104, 105, 106, 346, 627, 0, 0, 404, 0, 412,
-	0, 0, 0, 0, 882, 390, 0, 94, 95, 0,
-	0, 395, 47, 803, 804, 0, 0, 0, 0, 794,
-	0, 818, 0, 0, 0, 668, 628, 629, 0, 0,
-	666, 892, 891, 904, 917, 841, 839, 0, 931, 0,
-	923, 926, 922, 925, 57, 0, 58, 0, 149, 197,
-	198, 212, 215, 0, 0, 264, 428, 425, 426, 0,
-	0, 97, 98, 0, 0, 0, 759, 761, 760, 762,
-	0, 0, 0, 764, 782, 783, 0, 667, 669, 670,
-	623, 910, 0, 903, 0, -2, 912, 0, 0, 0,
-	918, 0, 921, 0, 639, 265, 269, 0, 0, 874,
-	625, 0, 0, 0, 397, 763, 0, 0, 0, 630,
-	631, 897, 895, 895, 905, 906, 0, 0, 913, 0,
-	0, 0, 929, 927, 266, 267, 0, 136, 150, 151,
-	0, 0, 154, 147, 148, 874, 0, 0, 387, 876,
-	99, 100, 332, 333, 334, 93, 795, 0, 798, 900,
-	0, 893, 896, 894, 907, 0, 0, 914, 0, 916,
-	268, 0, 0, 0, 152, 153, 89, 429, 430, 0,
-	877, 878, 96, 796, 889, 0, 898, 899, 908, 909,
-	915, 272, 274, 275, 0, 0, 273, 0, 394, 0,
-	901, 902, 276, 277, 278, 875, 0, 797,
+	209, 887, 0, 0, 0, 0, 0, 595, 0, 978,
+	109, 110, 116, 117, 237, 237, 386, 0, 0, 118,
+	118, 352, 353, 354, 0, 0, -2, 261, 0, 336,
+	0, 0, 251, 251, 255, 253, 254, 0, 0, 0,
+	0, 0, 0, 0, 364, 0, 365, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 426, 0, 238, 0,
+	383, 384, 291, 0, 0, 0, 0, 362, 363, 0,
+	0, 986, 987, 0, 0, 237, 237, 0, 0, 0,
+	0, 237, 237, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 108,
+	900, 0, 0, 0, -2, 0, 452, 0, 0, 0,
+	979, 979, 459, 0, 461, 462, 0, 0, 463, 0,
+	516, 516, 514, 515, 465, 466, 467, 468, 519, 0,
+	0, 246, 247, 248, 516, 519, 0, 519, 519, 519,
+	519, 516, 516, 516, 519, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1420, 1420, 1420, 522, 519, 500,
+	501, 504, 505, 1421, 1422, 1015, 506, 507, 969, 536,
+	539, 556, 554, 555, 557, 549, 550, 551, 552, 0,
+	570, 571, 576, 0, 0, 0, 0, 582, 583, 584,
+	0, 0, 587, 588, 589, 0, 0, 0, 0, 0,
+	698, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	722, 723, 724, 725, 726, 727, 728, 701, 0, 715,
+	0, 0, 0, 757, 758, 759, 760, 761, 762, 763,
+	764, 765, 0, 608, 0, 0, 0, 863, 0, 0,
+	0, 0, 0, 0, 0, 605, 0, 837, 0, 788,
+	0, 789, 797, 0, 790, 798, 791, 799, 792, 793,
+	800, 794, 801, 795, 796, 802, 0, 0, 0, 608,
+	608, 0, 0, 41, 560, 561, 0, 667, 989, 0,
+	871, 0, 610, 910, 0, 0, 872, 864, 865, 868,
+	871, 0, 633, 622, 612, 615, 616, 598, 0, 625,
+	629, 0, 631, 632, 0, 0, 70, 0, 683, 0,
+	635, 637, 638, 639, 665, 0, 0, 0, 0, 66,
+	68, 684, 0, 1381, 967, 0, 74, 75, 0, 0,
+	0, 225, 972, 973, 974, -2, 244, 0, -2, 216,
+	160, 161, 162, 209, 164, 209, 209, 209, 209, 221,
+	221, 221, 221, 192, 193, 194, 195, 196, 0, 0,
+	179, 209, 209, 209, 209, 199, 200, 201, 202, 203,
+	204, 205, 206, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 211, 211, 211, 213, 213, 0, 39, 0,
+	229, 0, 868, 0, 900, 981, 991, 0, 0, 0,
+	981, 92, 0, 0, 387, 446, 346, 375, 388, 0,
+	349, 350, -2, 0, 0, 335, 0, 337, 0, 245,
+	0, -2, 0, 255, 0, 251, 255, 252, 255, 243,
+	256, 366, 845, 0, 367, 368, 0, 370, 406, 653,
+	0, 0, 0, 0, 0, 412, 413, 414, 0, 416,
+	417, 418, 419, 420, 421, 422, 423, 424, 425, 376,
+	377, 378, 379, 380, 381, 382, 0, 0, 337, 0,
+	372, 0, 292, 293, 0, 0, 296, 297, 298, 299,
+	0, 0, 302, 303, 304, 670, 671, 305, 329, 330,
+	331, 306, 307, 308, 309, 310, 311, 312, 323, 324,
+	325, 326, 327, 328, 313, 314, 315, 316, 317, 320,
+	0, 102, 891, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 979, 0, 0, 0, 0, 685,
+	1002, 1003, 520, 521, 0, 249, 250, 519, 519, 469,
+	492, 0, 519, 473, 494, 474, 476, 475, 477, 496,
+	497, 519, 480, 517, 518, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 490, 498, 0, 499, 0, 0,
+	537, 541, 542, 543, 544, 0, 0, 573, 578, 579,
+	580, 581, 593, 586, 736, 695, 696, 697, 699, 716,
+	0, 718, 720, 706, 707, 731, 732, 733, 0, 0,
+	0, 0, 729, 711, 0, 742, 743, 744, 745, 746,
+	747, 748, 749, 750, 751, 752, 753, 756, 820, 821,
+	822, 0, 754, 755, 766, 0, 0, 0, 609, 846,
+	0, -2, 0, 734, 956, 871, 0, 0, 0, 0,
+	739, 848, 0, 739, 848, 0, 0, 0, 606, 607,
+	843, 840, 0, 0, 806, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 563, 564, 566, 0, 687, 0,
+	668, 0, 0, 990, 569, 907, 52, 42, 0, 908,
+	0, 0, 0, 0, 867, 869, 870, 907, 0, 856,
+	0, 0, 692, 0, 0, 613, 48, 630, 626, 0,
+	692, 0, 0, 682, 0, 0, 0, 0, 0, 0,
+	672, 0, 0, 675, 0, 0, 0, 0, 666, 0,
+	0, 0, -2, 0, 0, 0, 62, 63, 0, 0,
+	0, 962, 73, 0, 0, 78, 79, 963, 964, 965,
+	966, 0, 438, -2, 287, 130, 132, 133, 134, 125,
+	269, 0, 0, 219, 217, 218, 163, 221, 221, 186,
+	187, 225, 0, 225, 225, 225, 0, 0, 180, 181,
+	182, 183, 174, 0, 175, 176, 177, 0, 178, 262,
+	0, 875, 230, 231, 233, 237, 0, 0, 0, 258,
+	259, 0, 891, 0, 0, 0, 992, 991, 991, 991,
+	0, 120, 121, 122, 123, 448, 0, 0, 447, 118,
+	0, 0, 126, 340, 338, 0, 0, 260, 0, 0,
+	255, 255, 240, 241, 0, 369, 0, 0, 408, 409,
+	410, 411, 0, 0, 0, 337, 340, 225, 0, 294,
+	295, 300, 301, 318, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 403, 404, 888,
+	889, 890, 0, 0, 453, 0, 0, 279, 64, 980,
+	458, 516, 479, 495, 516, 471, 478, 523, 502, 533,
+	577, 0, 0, 0, 585, 0, 717, 719, 721, 708,
+	729, 712, 0, 709, 0, 0, 703, 771, 0, 0,
+	608, 0, 863, 907, 775, 776, 0, 0, 0, 0,
+	0, 813, 0, 0, 814, 0, 863, 0, 841, 0,
+	0, 787, 807, 0, 0, 808, 809, 810, 811, 812,
+	562, 565, 567, 643, 0, 0, 0, 0, 669, 988,
+	44, 0, 0, 0, 873, 874, 866, 43, 0, 975,
+	976, 857, 858, 859, 0, 623, 634, 614, 0, 871,
+	950, 0, 0, 942, 0, 0, 692, 958, 0, 636,
+	661, 663, 0, 658, 673, 674, 676, 0, 678, 0,
+	680, 681, 640, 641, 642, 0, 692, 0, 692, 67,
+	692, 69, 0, 686, 76, 77, 0, 0, 83, 226,
+	227, 111, 439, 118, 289, 131, 135, 140, 0, 0,
+	0, 144, 0, 146, 270, 0, 156, 158, 0, 0,
+	138, 159, 220, 225, 225, 188, 222, 223, 224, 189,
+	190, 191, 0, 207, 0, 0, 0, 282, 88, 879,
+	878, 237, 237, 232, 0, 235, 0, 993, 210, 0,
+	101, 0, 0, 0, 0, 0, 107, 450, 0, 0,
+	0, 0, 0, 0, 344, 647, 0, 355, 356, 0,
+	339, 405, 0, 229, 0, 239, 242, 846, 654, 0,
+	0, 357, 0, 340, 360, 361, 373, 321, 322, 319,
+	0, 0, 901, 902, 0, 906, 93, 394, 396, 395,
+	399, 0, 0, 392, 0, 279, 875, 0, 457, 280,
+	281, 519, 519, 572, 0, 575, 0, 710, 0, 730,
+	713, 772, 773, 0, 847, 871, 46, 0, 209, 209,
+	826, 209, 213, 829, 209, 831, 209, 834, 0, 0,
+	0, 0, 0, 0, 0, 838, 786, 844, 0, 0,
+	0, 0, 0, 0, 0, 0, 221, 912, 909, 45,
+	861, 0, 693, 627, 49, 53, 0, 950, 941, 952,
+	954, 0, 0, 0, 946, 0, 863, 0, 0, 655,
+	662, 0, 0, 656, 0, 657, 677, 679, -2, 863,
+	692, 60, 61, 0, 80, 81, 82, 288, 141, 142,
+	0, 145, 155, 157, 0, 0, 184, 185, 221, 0,
+	221, 0, 214, 0, 271, 283, 0, 876, 877, 0,
+	0, 234, 236, 0, 982, 103, 0, 0, 0, 440,
+	0, 449, 0, 0, 0, 0, 0, 0, 127, 341,
+	0, 228, 0, 0, 430, 427, 358, 359, 645, 892,
+	893, 894, 0, 904, 905, 96, 0, 0, 0, 0,
+	454, 455, 456, 65, 464, 470, 574, 594, 714, 774,
+	907, 777, 823, 221, 827, 828, 830, 832, 833, 835,
+	779, 778, 0, 0, 0, 0, 0, 871, 0, 842,
+	0, 0, 0, 0, 0, 667, 221, 932, 50, 0,
+	0, 0, 54, 0, 955, 0, 0, 0, 0, 71,
+	871, 959, 960, 659, 0, 664, 871, 59, 143, 0,
+	0, 225, 208, 225, 0, 0, 284, 880, 881, 882,
+	883, 884, 885, 886, 645, 104, 105, 106, 0, 0,
+	442, 0, 444, 0, 347, 648, 0, 0, 407, 0,
+	415, 0, 0, 0, 0, 903, 393, 0, 94, 95,
+	0, 0, 398, 47, 824, 825, 0, 0, 0, 0,
+	815, 0, 839, 0, 0, 0, 689, 649, 650, 0,
+	0, 687, 914, 913, 926, 939, 862, 860, 0, 953,
+	0, 945, 948, 944, 947, 57, 0, 58, 0, 149,
+	197, 198, 212, 215, 0, 451, 441, 443, 445, 0,
+	264, 431, 428, 429, 0, 0, 97, 98, 0, 0,
+	0, 780, 782, 781, 783, 0, 0, 0, 785, 803,
+	804, 0, 688, 690, 691, 644, 932, 0, 925, 0,
+	-2, 934, 0, 0, 0, 940, 0, 943, 0, 660,
+	265, 269, 0, 0, 435, 895, 646, 0, 0, 0,
+	400, 784, 0, 0, 0, 651, 652, 919, 917, 917,
+	927, 928, 0, 0, 935, 0, 0, 0, 951, 949,
+	266, 267, 0, 136, 150, 151, 0, 0, 154, 147,
+	148, 895, 0, 0, 434, 0, 237, 390, 897, 99,
+	100, 332, 333, 334, 93, 816, 0, 819, 922, 0,
+	915, 918, 916, 929, 0, 0, 936, 0, 938, 268,
+	0, 0, 0, 152, 153, 89, 435, 435, 237, 0,
+	0, 898, 899, 96, 817, 911, 0, 920, 921, 930,
+	931, 937, 272, 274, 275, 0, 0, 273, 432, 433,
+	0, 437, 0, 397, 0, 923, 924, 276, 277, 278,
+	436, 896, 0, 818,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 118, 3, 3, 3, 145, 137, 3,
-	82, 84, 142, 140, 83, 141, 157, 143, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 481,
-	126, 125, 127, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 121, 3, 3, 3, 148, 140, 3,
+	82, 84, 145, 143, 83, 144, 160, 146, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 484,
+	129, 128, 130, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 147, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 150, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 136, 3, 148,
+	3, 3, 3, 3, 139, 3, 151,
 }
 
 var yyTok2 = [...]int{
@@ -5576,10 +5780,10 @@ var yyTok2 = [...]int{
 	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
 	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 119, 120, 121, 122, 123, 124, 128,
-	129, 130, 131, 132, 133, 134, 135, 138, 139, 144,
-	146, 149, 150, 151, 152, 153, 154, 155, 156, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
+	115, 116, 117, 118, 119, 120, 122, 123, 124, 125,
+	126, 127, 131, 132, 133, 134, 135, 136, 137, 138,
+	141, 142, 147, 149, 152, 153, 154, 155, 156, 157,
+	158, 159, 161, 162, 163, 164, 165, 166, 167, 168,
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
 	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
 	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
@@ -5635,7 +5839,7 @@ var yyTok3 = [...]int{
 	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
 	57795, 470, 57796, 471, 57797, 472, 57798, 473, 57799, 474,
 	57800, 475, 57801, 476, 57802, 477, 57803, 478, 57804, 479,
-	57805, 480, 0,
+	57805, 480, 57806, 481, 57807, 482, 57808, 483, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5985,68 +6189,68 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:435
+//line sql.y:444
 		{
 			setParseTree(yylex, yyDollar[1].statementUnion())
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:440
+//line sql.y:449
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:441
+//line sql.y:450
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:445
+//line sql.y:454
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 34:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:478
+//line sql.y:487
 		{
 			setParseTree(yylex, nil)
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:484
+//line sql.y:493
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), NoAt)
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:488
+//line sql.y:497
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), SingleAt)
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:492
+//line sql.y:501
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), DoubleAt)
 		}
 	case 38:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:497
+//line sql.y:506
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:501
+//line sql.y:510
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 40:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:507
+//line sql.y:516
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
@@ -6054,7 +6258,7 @@ yydefault:
 	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:513
+//line sql.y:522
 		{
 			yyLOCAL = &Load{}
 		}
@@ -6062,7 +6266,7 @@ yydefault:
 	case 42:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:519
+//line sql.y:528
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -6075,7 +6279,7 @@ yydefault:
 	case 43:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:528
+//line sql.y:537
 		{
 			yyLOCAL = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmtUnion()}, OrderBy: yyDollar[4].orderByUnion(), Limit: yyDollar[5].limitUnion(), Lock: yyDollar[6].lockUnion()}
 		}
@@ -6083,7 +6287,7 @@ yydefault:
 	case 44:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:532
+//line sql.y:541
 		{
 			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
@@ -6091,7 +6295,7 @@ yydefault:
 	case 45:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:536
+//line sql.y:545
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), SelectExprs{&Nextval{Expr: yyDollar[5].exprUnion()}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
@@ -6099,7 +6303,7 @@ yydefault:
 	case 46:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:559
+//line sql.y:568
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -6111,7 +6315,7 @@ yydefault:
 	case 47:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:567
+//line sql.y:576
 		{
 			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
@@ -6119,7 +6323,7 @@ yydefault:
 	case 48:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:573
+//line sql.y:582
 		{
 			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName}
 		}
@@ -6127,7 +6331,7 @@ yydefault:
 	case 49:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:579
+//line sql.y:588
 		{
 			yyLOCAL = &VStream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].exprUnion()), Limit: yyDollar[7].limitUnion()}
 		}
@@ -6135,7 +6339,7 @@ yydefault:
 	case 50:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:587
+//line sql.y:596
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
 		}
@@ -6143,7 +6347,7 @@ yydefault:
 	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:593
+//line sql.y:602
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
@@ -6151,7 +6355,7 @@ yydefault:
 	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:597
+//line sql.y:606
 		{
 			yyLOCAL = &ParenSelect{Select: yyDollar[2].selStmtUnion()}
 		}
@@ -6159,7 +6363,7 @@ yydefault:
 	case 53:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:604
+//line sql.y:613
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].insUnion()
@@ -6175,7 +6379,7 @@ yydefault:
 	case 54:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:616
+//line sql.y:625
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprsUnion()))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprsUnion()))
@@ -6189,7 +6393,7 @@ yydefault:
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:628
+//line sql.y:637
 		{
 			yyLOCAL = InsertAct
 		}
@@ -6197,7 +6401,7 @@ yydefault:
 	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:632
+//line sql.y:641
 		{
 			yyLOCAL = ReplaceAct
 		}
@@ -6205,7 +6409,7 @@ yydefault:
 	case 57:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:638
+//line sql.y:647
 		{
 			yyLOCAL = &Update{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: yyDollar[4].tableExprsUnion(), Exprs: yyDollar[6].updateExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
@@ -6213,7 +6417,7 @@ yydefault:
 	case 58:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:644
+//line sql.y:653
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitionsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
@@ -6221,7 +6425,7 @@ yydefault:
 	case 59:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:648
+//line sql.y:657
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
@@ -6229,7 +6433,7 @@ yydefault:
 	case 60:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:652
+//line sql.y:661
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
@@ -6237,32 +6441,32 @@ yydefault:
 	case 61:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:656
+//line sql.y:665
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
 	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:661
+//line sql.y:670
 		{
 		}
 	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:662
+//line sql.y:671
 		{
 		}
 	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:666
+//line sql.y:675
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
 		yyVAL.union = yyLOCAL
 	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:670
+//line sql.y:679
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName.ToViewName())
@@ -6270,14 +6474,14 @@ yydefault:
 	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:676
+//line sql.y:685
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:680
+//line sql.y:689
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
@@ -6285,14 +6489,14 @@ yydefault:
 	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:686
+//line sql.y:695
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:690
+//line sql.y:699
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
@@ -6300,7 +6504,7 @@ yydefault:
 	case 70:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:695
+//line sql.y:704
 		{
 			yyLOCAL = nil
 		}
@@ -6308,7 +6512,7 @@ yydefault:
 	case 71:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:699
+//line sql.y:708
 		{
 			yyLOCAL = yyDollar[3].partitionsUnion()
 		}
@@ -6316,7 +6520,7 @@ yydefault:
 	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:705
+//line sql.y:714
 		{
 			yyLOCAL = &Set{Comments: Comments(yyDollar[2].strs), Exprs: yyDollar[3].setExprsUnion()}
 		}
@@ -6324,7 +6528,7 @@ yydefault:
 	case 73:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:711
+//line sql.y:720
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Scope: yyDollar[3].scopeUnion(), Characteristics: yyDollar[5].characteristicsUnion()}
 		}
@@ -6332,7 +6536,7 @@ yydefault:
 	case 74:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:715
+//line sql.y:724
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Characteristics: yyDollar[4].characteristicsUnion(), Scope: ImplicitScope}
 		}
@@ -6340,14 +6544,14 @@ yydefault:
 	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []Characteristic
-//line sql.y:721
+//line sql.y:730
 		{
 			yyLOCAL = []Characteristic{yyDollar[1].characteristicUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:725
+//line sql.y:734
 		{
 			yySLICE := (*[]Characteristic)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].characteristicUnion())
@@ -6355,7 +6559,7 @@ yydefault:
 	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:731
+//line sql.y:740
 		{
 			yyLOCAL = yyDollar[3].isolationLevelUnion()
 		}
@@ -6363,7 +6567,7 @@ yydefault:
 	case 78:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:735
+//line sql.y:744
 		{
 			yyLOCAL = ReadWrite
 		}
@@ -6371,7 +6575,7 @@ yydefault:
 	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:739
+//line sql.y:748
 		{
 			yyLOCAL = ReadOnly
 		}
@@ -6379,7 +6583,7 @@ yydefault:
 	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:745
+//line sql.y:754
 		{
 			yyLOCAL = RepeatableRead
 		}
@@ -6387,7 +6591,7 @@ yydefault:
 	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:749
+//line sql.y:758
 		{
 			yyLOCAL = ReadCommitted
 		}
@@ -6395,7 +6599,7 @@ yydefault:
 	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:753
+//line sql.y:762
 		{
 			yyLOCAL = ReadUncommitted
 		}
@@ -6403,7 +6607,7 @@ yydefault:
 	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:757
+//line sql.y:766
 		{
 			yyLOCAL = Serializable
 		}
@@ -6411,7 +6615,7 @@ yydefault:
 	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:763
+//line sql.y:772
 		{
 			yyLOCAL = SessionScope
 		}
@@ -6419,7 +6623,7 @@ yydefault:
 	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:767
+//line sql.y:776
 		{
 			yyLOCAL = GlobalScope
 		}
@@ -6427,7 +6631,7 @@ yydefault:
 	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:773
+//line sql.y:782
 		{
 			yyDollar[1].createTableUnion().TableSpec = yyDollar[2].tableSpecUnion()
 			yyDollar[1].createTableUnion().FullyParsed = true
@@ -6437,7 +6641,7 @@ yydefault:
 	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:779
+//line sql.y:788
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTableUnion().OptLike = yyDollar[2].optLikeUnion()
@@ -6448,7 +6652,7 @@ yydefault:
 	case 88:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:786
+//line sql.y:795
 		{
 			indexDef := yyDollar[1].alterTableUnion().AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumnsUnion()
@@ -6461,15 +6665,15 @@ yydefault:
 	case 89:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:795
+//line sql.y:804
 		{
-			yyLOCAL = &CreateView{ViewName: yyDollar[8].tableName.ToViewName(), IsReplace: yyDollar[3].booleanUnion(), Algorithm: yyDollar[4].str, Definer: yyDollar[5].str, Security: yyDollar[6].str, Columns: yyDollar[9].columnsUnion(), Select: yyDollar[11].selStmtUnion(), CheckOption: yyDollar[12].str}
+			yyLOCAL = &CreateView{Comments: Comments(yyDollar[2].strs), ViewName: yyDollar[8].tableName.ToViewName(), IsReplace: yyDollar[3].booleanUnion(), Algorithm: yyDollar[4].str, Definer: yyDollar[5].str, Security: yyDollar[6].str, Columns: yyDollar[9].columnsUnion(), Select: yyDollar[11].selStmtUnion(), CheckOption: yyDollar[12].str}
 		}
 		yyVAL.union = yyLOCAL
 	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:799
+//line sql.y:808
 		{
 			yyDollar[1].createDatabaseUnion().FullyParsed = true
 			yyDollar[1].createDatabaseUnion().CreateOptions = yyDollar[2].collateAndCharsetsUnion()
@@ -6479,7 +6683,7 @@ yydefault:
 	case 91:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:806
+//line sql.y:815
 		{
 			yyLOCAL = false
 		}
@@ -6487,33 +6691,33 @@ yydefault:
 	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:810
+//line sql.y:819
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 93:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:815
+//line sql.y:824
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
 	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:819
+//line sql.y:828
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
 	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:825
+//line sql.y:834
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 96:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:830
+//line sql.y:839
 		{
 			var v []VindexParam
 			yyLOCAL = v
@@ -6522,7 +6726,7 @@ yydefault:
 	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:835
+//line sql.y:844
 		{
 			yyLOCAL = yyDollar[2].vindexParamsUnion()
 		}
@@ -6530,7 +6734,7 @@ yydefault:
 	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:841
+//line sql.y:850
 		{
 			yyLOCAL = make([]VindexParam, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].vindexParam)
@@ -6538,21 +6742,21 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:846
+//line sql.y:855
 		{
 			yySLICE := (*[]VindexParam)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].vindexParam)
 		}
 	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:852
+//line sql.y:861
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
 	case 101:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateTable
-//line sql.y:858
+//line sql.y:867
 		{
 			yyLOCAL = &CreateTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[6].tableName, IfNotExists: yyDollar[5].booleanUnion(), Temp: yyDollar[3].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
@@ -6561,7 +6765,7 @@ yydefault:
 	case 102:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:865
+//line sql.y:874
 		{
 			yyLOCAL = &AlterTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[4].tableName}
 			setDDL(yylex, yyLOCAL)
@@ -6570,7 +6774,7 @@ yydefault:
 	case 103:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:872
+//line sql.y:881
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[3].str)}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -6579,7 +6783,7 @@ yydefault:
 	case 104:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:877
+//line sql.y:886
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Fulltext: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -6588,7 +6792,7 @@ yydefault:
 	case 105:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:882
+//line sql.y:891
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Spatial: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -6597,7 +6801,7 @@ yydefault:
 	case 106:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:887
+//line sql.y:896
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Unique: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -6606,7 +6810,7 @@ yydefault:
 	case 107:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateDatabase
-//line sql.y:894
+//line sql.y:903
 		{
 			yyLOCAL = &CreateDatabase{Comments: Comments(yyDollar[4].strs), DBName: yyDollar[6].tableIdent, IfNotExists: yyDollar[5].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
@@ -6615,25 +6819,26 @@ yydefault:
 	case 108:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AlterDatabase
-//line sql.y:901
+//line sql.y:910
 		{
 			yyLOCAL = &AlterDatabase{}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
 	case 111:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:912
+//line sql.y:921
 		{
 			yyLOCAL = yyDollar[2].tableSpecUnion()
 			yyLOCAL.Options = yyDollar[4].tableOptionsUnion()
+			yyLOCAL.PartitionOption = yyDollar[5].partitionOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 112:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:918
+//line sql.y:928
 		{
 			yyLOCAL = nil
 		}
@@ -6641,7 +6846,7 @@ yydefault:
 	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:922
+//line sql.y:932
 		{
 			yyLOCAL = yyDollar[1].collateAndCharsetsUnion()
 		}
@@ -6649,7 +6854,7 @@ yydefault:
 	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:928
+//line sql.y:938
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
@@ -6657,21 +6862,21 @@ yydefault:
 	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:932
+//line sql.y:942
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
 	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:936
+//line sql.y:946
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
 	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:940
+//line sql.y:950
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
@@ -6679,7 +6884,7 @@ yydefault:
 	case 118:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:946
+//line sql.y:956
 		{
 			yyLOCAL = false
 		}
@@ -6687,39 +6892,39 @@ yydefault:
 	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:950
+//line sql.y:960
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 120:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:956
+//line sql.y:966
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 121:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:960
+//line sql.y:970
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 122:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:966
+//line sql.y:976
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:970
+//line sql.y:980
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:977
+//line sql.y:987
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
@@ -6727,7 +6932,7 @@ yydefault:
 	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:981
+//line sql.y:991
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
@@ -6735,14 +6940,14 @@ yydefault:
 	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*ColumnDefinition
-//line sql.y:987
+//line sql.y:997
 		{
 			yyLOCAL = []*ColumnDefinition{yyDollar[1].columnDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:991
+//line sql.y:1001
 		{
 			yySLICE := (*[]*ColumnDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].columnDefinitionUnion())
@@ -6750,7 +6955,7 @@ yydefault:
 	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:997
+//line sql.y:1007
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddColumn(yyDollar[1].columnDefinitionUnion())
@@ -6759,7 +6964,7 @@ yydefault:
 	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1002
+//line sql.y:1012
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddConstraint(yyDollar[1].constraintDefinitionUnion())
@@ -6767,39 +6972,39 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1007
+//line sql.y:1017
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 		}
 	case 131:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1011
+//line sql.y:1021
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[4].constraintDefinitionUnion())
 		}
 	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1016
+//line sql.y:1026
 		{
 			yyVAL.tableSpecUnion().AddIndex(yyDollar[3].indexDefinitionUnion())
 		}
 	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1020
+//line sql.y:1030
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
 	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1024
+//line sql.y:1034
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
 	case 135:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1030
+//line sql.y:1040
 		{
 			yyDollar[2].columnType.Options = yyDollar[3].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.Reference = yyDollar[4].referenceDefinitionUnion()
@@ -6809,7 +7014,7 @@ yydefault:
 	case 136:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1036
+//line sql.y:1046
 		{
 			yyDollar[2].columnType.Options = yyDollar[8].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.As = yyDollar[6].exprUnion()
@@ -6819,28 +7024,28 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 137:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1044
+//line sql.y:1054
 		{
 			yyVAL.str = ""
 		}
 	case 138:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1048
+//line sql.y:1058
 		{
 			yyVAL.str = ""
 		}
 	case 139:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1057
+//line sql.y:1067
 		{
-			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil, As: nil}
+			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: ColKeyNone, Comment: nil, As: nil}
 		}
 		yyVAL.union = yyLOCAL
 	case 140:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1061
+//line sql.y:1071
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -6850,7 +7055,7 @@ yydefault:
 	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1067
+//line sql.y:1077
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -6860,7 +7065,7 @@ yydefault:
 	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1073
+//line sql.y:1083
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[3].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6869,7 +7074,7 @@ yydefault:
 	case 143:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1078
+//line sql.y:1088
 		{
 			yyDollar[1].columnTypeOptionsUnion().OnUpdate = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6878,7 +7083,7 @@ yydefault:
 	case 144:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1083
+//line sql.y:1093
 		{
 			yyDollar[1].columnTypeOptionsUnion().Autoincrement = true
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6887,7 +7092,7 @@ yydefault:
 	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1088
+//line sql.y:1098
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6896,7 +7101,7 @@ yydefault:
 	case 146:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1093
+//line sql.y:1103
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6905,7 +7110,7 @@ yydefault:
 	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1100
+//line sql.y:1110
 		{
 			yyLOCAL = VirtualStorage
 		}
@@ -6913,7 +7118,7 @@ yydefault:
 	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1104
+//line sql.y:1114
 		{
 			yyLOCAL = StoredStorage
 		}
@@ -6921,7 +7126,7 @@ yydefault:
 	case 149:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1109
+//line sql.y:1119
 		{
 			yyLOCAL = &ColumnTypeOptions{}
 		}
@@ -6929,7 +7134,7 @@ yydefault:
 	case 150:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1113
+//line sql.y:1123
 		{
 			yyDollar[1].columnTypeOptionsUnion().Storage = yyDollar[2].columnStorageUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6938,7 +7143,7 @@ yydefault:
 	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1118
+//line sql.y:1128
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -6948,7 +7153,7 @@ yydefault:
 	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1124
+//line sql.y:1134
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -6958,7 +7163,7 @@ yydefault:
 	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1130
+//line sql.y:1140
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6967,7 +7172,7 @@ yydefault:
 	case 154:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1135
+//line sql.y:1145
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6976,38 +7181,38 @@ yydefault:
 	case 155:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1142
+//line sql.y:1152
 		{
-			yyLOCAL = colKeyPrimary
+			yyLOCAL = ColKeyPrimary
 		}
 		yyVAL.union = yyLOCAL
 	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1146
+//line sql.y:1156
 		{
-			yyLOCAL = colKeyUnique
+			yyLOCAL = ColKeyUnique
 		}
 		yyVAL.union = yyLOCAL
 	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1150
+//line sql.y:1160
 		{
-			yyLOCAL = colKeyUniqueKey
+			yyLOCAL = ColKeyUniqueKey
 		}
 		yyVAL.union = yyLOCAL
 	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1154
+//line sql.y:1164
 		{
-			yyLOCAL = colKey
+			yyLOCAL = ColKey
 		}
 		yyVAL.union = yyLOCAL
 	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1160
+//line sql.y:1170
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
@@ -7015,74 +7220,74 @@ yydefault:
 		}
 	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1171
+//line sql.y:1181
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literalUnion()
 		}
 	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1176
+//line sql.y:1186
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1182
+//line sql.y:1192
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1186
+//line sql.y:1196
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1190
+//line sql.y:1200
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1194
+//line sql.y:1204
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1198
+//line sql.y:1208
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1202
+//line sql.y:1212
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1206
+//line sql.y:1216
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1210
+//line sql.y:1220
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1214
+//line sql.y:1224
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1220
+//line sql.y:1230
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -7090,7 +7295,7 @@ yydefault:
 		}
 	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1226
+//line sql.y:1236
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -7098,7 +7303,7 @@ yydefault:
 		}
 	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1232
+//line sql.y:1242
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -7106,7 +7311,7 @@ yydefault:
 		}
 	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1238
+//line sql.y:1248
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -7114,7 +7319,7 @@ yydefault:
 		}
 	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1244
+//line sql.y:1254
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -7122,189 +7327,189 @@ yydefault:
 		}
 	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1252
+//line sql.y:1262
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1256
+//line sql.y:1266
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1260
+//line sql.y:1270
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1264
+//line sql.y:1274
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1268
+//line sql.y:1278
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1274
+//line sql.y:1284
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 185:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1278
+//line sql.y:1288
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1282
+//line sql.y:1292
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1286
+//line sql.y:1296
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 188:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1290
+//line sql.y:1300
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 189:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1294
+//line sql.y:1304
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1298
+//line sql.y:1308
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1302
+//line sql.y:1312
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1306
+//line sql.y:1316
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1310
+//line sql.y:1320
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1314
+//line sql.y:1324
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1318
+//line sql.y:1328
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1322
+//line sql.y:1332
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 197:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1326
+//line sql.y:1336
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 198:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1331
+//line sql.y:1341
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1337
+//line sql.y:1347
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1341
+//line sql.y:1351
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1345
+//line sql.y:1355
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1349
+//line sql.y:1359
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1353
+//line sql.y:1363
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1357
+//line sql.y:1367
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1361
+//line sql.y:1371
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1365
+//line sql.y:1375
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1371
+//line sql.y:1381
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, encodeSQLString(yyDollar[1].str))
 		}
 	case 208:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1376
+//line sql.y:1386
 		{
 			yyVAL.strs = append(yyDollar[1].strs, encodeSQLString(yyDollar[3].str))
 		}
 	case 209:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1381
+//line sql.y:1391
 		{
 			yyLOCAL = nil
 		}
@@ -7312,20 +7517,20 @@ yydefault:
 	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1385
+//line sql.y:1395
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
 	case 211:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1390
+//line sql.y:1400
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 212:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1394
+//line sql.y:1404
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -7334,13 +7539,13 @@ yydefault:
 		}
 	case 213:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1402
+//line sql.y:1412
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 214:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1406
+//line sql.y:1416
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -7348,7 +7553,7 @@ yydefault:
 		}
 	case 215:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1412
+//line sql.y:1422
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -7358,7 +7563,7 @@ yydefault:
 	case 216:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1420
+//line sql.y:1430
 		{
 			yyLOCAL = false
 		}
@@ -7366,7 +7571,7 @@ yydefault:
 	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1424
+//line sql.y:1434
 		{
 			yyLOCAL = true
 		}
@@ -7374,7 +7579,7 @@ yydefault:
 	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1428
+//line sql.y:1438
 		{
 			yyLOCAL = false
 		}
@@ -7382,7 +7587,7 @@ yydefault:
 	case 219:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1433
+//line sql.y:1443
 		{
 			yyLOCAL = false
 		}
@@ -7390,57 +7595,57 @@ yydefault:
 	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1437
+//line sql.y:1447
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 221:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1442
+//line sql.y:1452
 		{
 			yyVAL.str = ""
 		}
 	case 222:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1446
+//line sql.y:1456
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
 	case 223:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1450
+//line sql.y:1460
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
 	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1454
+//line sql.y:1464
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
 	case 225:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1459
+//line sql.y:1469
 		{
 			yyVAL.str = ""
 		}
 	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1463
+//line sql.y:1473
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
 	case 227:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1467
+//line sql.y:1477
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
 	case 228:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexDefinition
-//line sql.y:1474
+//line sql.y:1484
 		{
 			yyLOCAL = &IndexDefinition{Info: yyDollar[1].indexInfoUnion(), Columns: yyDollar[3].indexColumnsUnion(), Options: yyDollar[5].indexOptionsUnion()}
 		}
@@ -7448,7 +7653,7 @@ yydefault:
 	case 229:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1479
+//line sql.y:1489
 		{
 			yyLOCAL = nil
 		}
@@ -7456,7 +7661,7 @@ yydefault:
 	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1483
+//line sql.y:1493
 		{
 			yyLOCAL = yyDollar[1].indexOptionsUnion()
 		}
@@ -7464,14 +7669,14 @@ yydefault:
 	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1489
+//line sql.y:1499
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1493
+//line sql.y:1503
 		{
 			yySLICE := (*[]*IndexOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexOptionUnion())
@@ -7479,7 +7684,7 @@ yydefault:
 	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1499
+//line sql.y:1509
 		{
 			yyLOCAL = yyDollar[1].indexOptionUnion()
 		}
@@ -7487,7 +7692,7 @@ yydefault:
 	case 234:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1503
+//line sql.y:1513
 		{
 			// should not be string
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
@@ -7496,7 +7701,7 @@ yydefault:
 	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1508
+//line sql.y:1518
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[2].str)}
 		}
@@ -7504,27 +7709,27 @@ yydefault:
 	case 236:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1512
+//line sql.y:1522
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str) + " " + string(yyDollar[2].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
 	case 237:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1518
+//line sql.y:1528
 		{
 			yyVAL.str = ""
 		}
 	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1522
+//line sql.y:1532
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 239:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1528
+//line sql.y:1538
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
@@ -7532,7 +7737,7 @@ yydefault:
 	case 240:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1532
+//line sql.y:1542
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
@@ -7540,7 +7745,7 @@ yydefault:
 	case 241:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1536
+//line sql.y:1546
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
@@ -7548,7 +7753,7 @@ yydefault:
 	case 242:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1540
+//line sql.y:1550
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
@@ -7556,100 +7761,100 @@ yydefault:
 	case 243:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1544
+//line sql.y:1554
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 		yyVAL.union = yyLOCAL
 	case 244:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1549
+//line sql.y:1559
 		{
 			yyVAL.str = ""
 		}
 	case 245:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1553
+//line sql.y:1563
 		{
 			yyVAL.str = yyDollar[2].str
 		}
 	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1559
+//line sql.y:1569
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1563
+//line sql.y:1573
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1567
+//line sql.y:1577
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1574
+//line sql.y:1584
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1578
+//line sql.y:1588
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 251:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1583
+//line sql.y:1593
 		{
 			yyVAL.str = "key"
 		}
 	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1587
+//line sql.y:1597
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1593
+//line sql.y:1603
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1597
+//line sql.y:1607
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 255:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1602
+//line sql.y:1612
 		{
 			yyVAL.str = ""
 		}
 	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1606
+//line sql.y:1616
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
 	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexColumn
-//line sql.y:1612
+//line sql.y:1622
 		{
 			yyLOCAL = []*IndexColumn{yyDollar[1].indexColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 258:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1616
+//line sql.y:1626
 		{
 			yySLICE := (*[]*IndexColumn)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].indexColumnUnion())
@@ -7657,7 +7862,7 @@ yydefault:
 	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexColumn
-//line sql.y:1622
+//line sql.y:1632
 		{
 			yyLOCAL = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literalUnion(), Direction: yyDollar[3].orderDirectionUnion()}
 		}
@@ -7665,7 +7870,7 @@ yydefault:
 	case 260:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1628
+//line sql.y:1638
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
@@ -7673,7 +7878,7 @@ yydefault:
 	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1632
+//line sql.y:1642
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
@@ -7681,7 +7886,7 @@ yydefault:
 	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1638
+//line sql.y:1648
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
@@ -7689,7 +7894,7 @@ yydefault:
 	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1642
+//line sql.y:1652
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
@@ -7697,7 +7902,7 @@ yydefault:
 	case 264:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1648
+//line sql.y:1658
 		{
 			yyLOCAL = &ForeignKeyDefinition{IndexName: NewColIdent(yyDollar[3].str), Source: yyDollar[5].columnsUnion(), ReferenceDefinition: yyDollar[7].referenceDefinitionUnion()}
 		}
@@ -7705,7 +7910,7 @@ yydefault:
 	case 265:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1654
+//line sql.y:1664
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion()}
 		}
@@ -7713,7 +7918,7 @@ yydefault:
 	case 266:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1658
+//line sql.y:1668
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion()}
 		}
@@ -7721,7 +7926,7 @@ yydefault:
 	case 267:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1662
+//line sql.y:1672
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnUpdate: yyDollar[6].ReferenceActionUnion()}
 		}
@@ -7729,7 +7934,7 @@ yydefault:
 	case 268:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1666
+//line sql.y:1676
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion(), OnUpdate: yyDollar[7].ReferenceActionUnion()}
 		}
@@ -7737,7 +7942,7 @@ yydefault:
 	case 269:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1671
+//line sql.y:1681
 		{
 			yyLOCAL = nil
 		}
@@ -7745,7 +7950,7 @@ yydefault:
 	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1675
+//line sql.y:1685
 		{
 			yyLOCAL = yyDollar[1].referenceDefinitionUnion()
 		}
@@ -7753,7 +7958,7 @@ yydefault:
 	case 271:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1681
+//line sql.y:1691
 		{
 			yyLOCAL = &CheckConstraintDefinition{Expr: yyDollar[3].exprUnion(), Enforced: yyDollar[5].booleanUnion()}
 		}
@@ -7761,7 +7966,7 @@ yydefault:
 	case 272:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1687
+//line sql.y:1697
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
@@ -7769,7 +7974,7 @@ yydefault:
 	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1693
+//line sql.y:1703
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
@@ -7777,7 +7982,7 @@ yydefault:
 	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1699
+//line sql.y:1709
 		{
 			yyLOCAL = Restrict
 		}
@@ -7785,7 +7990,7 @@ yydefault:
 	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1703
+//line sql.y:1713
 		{
 			yyLOCAL = Cascade
 		}
@@ -7793,7 +7998,7 @@ yydefault:
 	case 276:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1707
+//line sql.y:1717
 		{
 			yyLOCAL = NoAction
 		}
@@ -7801,7 +8006,7 @@ yydefault:
 	case 277:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1711
+//line sql.y:1721
 		{
 			yyLOCAL = SetDefault
 		}
@@ -7809,33 +8014,33 @@ yydefault:
 	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1715
+//line sql.y:1725
 		{
 			yyLOCAL = SetNull
 		}
 		yyVAL.union = yyLOCAL
 	case 279:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1720
+//line sql.y:1730
 		{
 			yyVAL.str = ""
 		}
 	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1724
+//line sql.y:1734
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1728
+//line sql.y:1738
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 282:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1733
+//line sql.y:1743
 		{
 			yyLOCAL = true
 		}
@@ -7843,7 +8048,7 @@ yydefault:
 	case 283:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1737
+//line sql.y:1747
 		{
 			yyLOCAL = true
 		}
@@ -7851,7 +8056,7 @@ yydefault:
 	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1741
+//line sql.y:1751
 		{
 			yyLOCAL = false
 		}
@@ -7859,7 +8064,7 @@ yydefault:
 	case 285:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1746
+//line sql.y:1756
 		{
 			yyLOCAL = nil
 		}
@@ -7867,7 +8072,7 @@ yydefault:
 	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1750
+//line sql.y:1760
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
@@ -7875,21 +8080,21 @@ yydefault:
 	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1756
+//line sql.y:1766
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1760
+//line sql.y:1770
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableOptionUnion())
 		}
 	case 289:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1764
+//line sql.y:1774
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
@@ -7897,14 +8102,14 @@ yydefault:
 	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1770
+//line sql.y:1780
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1774
+//line sql.y:1784
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
@@ -7912,7 +8117,7 @@ yydefault:
 	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1780
+//line sql.y:1790
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7920,7 +8125,7 @@ yydefault:
 	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1784
+//line sql.y:1794
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7928,7 +8133,7 @@ yydefault:
 	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1788
+//line sql.y:1798
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[2].str)), String: yyDollar[4].str}
 		}
@@ -7936,7 +8141,7 @@ yydefault:
 	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1792
+//line sql.y:1802
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[2].str), String: yyDollar[4].str}
 		}
@@ -7944,7 +8149,7 @@ yydefault:
 	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1796
+//line sql.y:1806
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7952,7 +8157,7 @@ yydefault:
 	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1800
+//line sql.y:1810
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -7960,7 +8165,7 @@ yydefault:
 	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1804
+//line sql.y:1814
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -7968,7 +8173,7 @@ yydefault:
 	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1808
+//line sql.y:1818
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -7976,7 +8181,7 @@ yydefault:
 	case 300:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1812
+//line sql.y:1822
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
@@ -7984,7 +8189,7 @@ yydefault:
 	case 301:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1816
+//line sql.y:1826
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
@@ -7992,7 +8197,7 @@ yydefault:
 	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1820
+//line sql.y:1830
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8000,7 +8205,7 @@ yydefault:
 	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1824
+//line sql.y:1834
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -8008,7 +8213,7 @@ yydefault:
 	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1828
+//line sql.y:1838
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: yyDollar[3].tableIdent.String()}
 		}
@@ -8016,7 +8221,7 @@ yydefault:
 	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1832
+//line sql.y:1842
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -8024,7 +8229,7 @@ yydefault:
 	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1836
+//line sql.y:1846
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8032,7 +8237,7 @@ yydefault:
 	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1840
+//line sql.y:1850
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8040,7 +8245,7 @@ yydefault:
 	case 308:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1844
+//line sql.y:1854
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8048,7 +8253,7 @@ yydefault:
 	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1848
+//line sql.y:1858
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8056,7 +8261,7 @@ yydefault:
 	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1852
+//line sql.y:1862
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -8064,7 +8269,7 @@ yydefault:
 	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1856
+//line sql.y:1866
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -8072,7 +8277,7 @@ yydefault:
 	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1860
+//line sql.y:1870
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -8080,7 +8285,7 @@ yydefault:
 	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1864
+//line sql.y:1874
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8088,7 +8293,7 @@ yydefault:
 	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1868
+//line sql.y:1878
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -8096,7 +8301,7 @@ yydefault:
 	case 315:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1872
+//line sql.y:1882
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8104,7 +8309,7 @@ yydefault:
 	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1876
+//line sql.y:1886
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -8112,7 +8317,7 @@ yydefault:
 	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1880
+//line sql.y:1890
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8120,7 +8325,7 @@ yydefault:
 	case 318:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1884
+//line sql.y:1894
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
@@ -8128,430 +8333,457 @@ yydefault:
 	case 319:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1888
+//line sql.y:1898
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 320:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1893
+//line sql.y:1903
 		{
 			yyVAL.str = ""
 		}
 	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1897
+//line sql.y:1907
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1901
+//line sql.y:1911
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 332:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1920
+//line sql.y:1930
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
 	case 333:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1924
+//line sql.y:1934
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
 	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1928
+//line sql.y:1938
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 335:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1933
+//line sql.y:1943
 		{
 			yyVAL.str = ""
 		}
 	case 337:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1939
+//line sql.y:1949
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
 	case 338:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *ColName
+//line sql.y:1953
+		{
+			yyLOCAL = &ColName{}
+		}
+		yyVAL.union = yyLOCAL
+	case 339:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1943
+//line sql.y:1957
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 340:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1948
+//line sql.y:1962
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 341:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1952
+//line sql.y:1966
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1957
+//line sql.y:1971
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1961
+//line sql.y:1975
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 344:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1965
+//line sql.y:1979
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &OrderByOption{Cols: yyDollar[5].columnsUnion()})
 		}
-	case 344:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1969
+//line sql.y:1983
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 346:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1973
+//line sql.y:1987
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionsUnion()...)
 		}
-	case 346:
+	case 347:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1977
+//line sql.y:1991
 		{
 			yyLOCAL = append(append(yyDollar[1].alterOptionsUnion(), yyDollar[3].alterOptionsUnion()...), &OrderByOption{Cols: yyDollar[7].columnsUnion()})
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1983
+//line sql.y:1997
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1987
+//line sql.y:2001
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 349:
+	case 350:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1991
+//line sql.y:2005
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 350:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1997
+//line sql.y:2011
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 352:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2001
+//line sql.y:2015
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2005
+//line sql.y:2019
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 354:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2009
+//line sql.y:2023
 		{
 			yyLOCAL = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 355:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2013
+//line sql.y:2027
 		{
 			yyLOCAL = &AddColumns{Columns: yyDollar[4].columnDefinitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 356:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2017
+//line sql.y:2031
 		{
 			yyLOCAL = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinitionUnion()}, First: yyDollar[4].colNameUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 357:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2021
+//line sql.y:2035
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 358:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2025
+//line sql.y:2039
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 359:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2029
+//line sql.y:2043
 		{
 			yyLOCAL = &ChangeColumn{OldColumn: yyDollar[3].colNameUnion(), NewColDefinition: yyDollar[4].columnDefinitionUnion(), First: yyDollar[5].colNameUnion(), After: yyDollar[6].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 360:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2033
+//line sql.y:2047
 		{
 			yyLOCAL = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinitionUnion(), First: yyDollar[4].colNameUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 361:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2037
+//line sql.y:2051
 		{
 			yyLOCAL = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2041
+//line sql.y:2055
 		{
 			yyLOCAL = &KeyState{Enable: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2045
+//line sql.y:2059
 		{
 			yyLOCAL = &KeyState{Enable: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2049
+//line sql.y:2063
 		{
 			yyLOCAL = &TablespaceOperation{Import: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 365:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2053
+//line sql.y:2067
 		{
 			yyLOCAL = &TablespaceOperation{Import: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2057
+//line sql.y:2071
 		{
 			yyLOCAL = &DropColumn{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2061
+//line sql.y:2075
 		{
 			yyLOCAL = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2065
+//line sql.y:2079
 		{
 			yyLOCAL = &DropKey{Type: PrimaryKeyType}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 369:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2069
+//line sql.y:2083
 		{
 			yyLOCAL = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 370:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL AlterOption
+//line sql.y:2087
+		{
+			yyLOCAL = &DropKey{Type: CheckKeyType, Name: yyDollar[3].colIdent}
+		}
+		yyVAL.union = yyLOCAL
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2073
+//line sql.y:2091
 		{
 			yyLOCAL = &Force{}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2077
+//line sql.y:2095
 		{
 			yyLOCAL = &RenameTableName{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 373:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2081
+//line sql.y:2099
 		{
 			yyLOCAL = &RenameIndex{OldName: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 374:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2087
+//line sql.y:2105
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2091
+//line sql.y:2109
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 374:
+	case 376:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2097
+//line sql.y:2115
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2101
+//line sql.y:2119
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2105
+//line sql.y:2123
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2109
+//line sql.y:2127
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2113
+//line sql.y:2131
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 381:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2117
+//line sql.y:2135
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 382:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2121
+//line sql.y:2139
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2125
+//line sql.y:2143
 		{
 			yyLOCAL = &Validation{With: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2129
+//line sql.y:2147
 		{
 			yyLOCAL = &Validation{With: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 385:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2135
+//line sql.y:2153
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 386:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2159
+		{
+			yyDollar[1].alterTableUnion().FullyParsed = true
+			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
+			yyDollar[1].alterTableUnion().PartitionOption = yyDollar[3].partitionOptionUnion()
+			yyLOCAL = yyDollar[1].alterTableUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 387:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2141
+//line sql.y:2166
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8559,10 +8791,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2148
+//line sql.y:2173
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8570,28 +8802,28 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 389:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2155
+//line sql.y:2180
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().PartitionSpec = yyDollar[2].partSpecUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 390:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2161
+//line sql.y:2186
 		{
-			yyLOCAL = &AlterView{ViewName: yyDollar[7].tableName.ToViewName(), Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
+			yyLOCAL = &AlterView{Comments: Comments(yyDollar[2].strs), ViewName: yyDollar[7].tableName.ToViewName(), Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2165
+//line sql.y:2190
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8599,10 +8831,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 392:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2172
+//line sql.y:2197
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8610,10 +8842,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 393:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2179
+//line sql.y:2204
 		{
 			yyLOCAL = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -8626,10 +8858,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 394:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2191
+//line sql.y:2216
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -8640,26 +8872,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 395:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2201
+//line sql.y:2226
 		{
 			yyLOCAL = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 396:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2205
+//line sql.y:2230
 		{
 			yyLOCAL = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 397:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2209
+//line sql.y:2234
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -8673,10 +8905,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 398:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2222
+//line sql.y:2247
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -8687,18 +8919,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 399:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2232
+//line sql.y:2257
 		{
 			yyLOCAL = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 400:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2236
+//line sql.y:2261
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -8710,10 +8942,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 401:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2247
+//line sql.y:2272
 		{
 			yyLOCAL = &AlterMigration{
 				Type: RetryMigrationType,
@@ -8721,10 +8953,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 402:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2254
+//line sql.y:2279
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CompleteMigrationType,
@@ -8732,10 +8964,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 403:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2261
+//line sql.y:2286
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelMigrationType,
@@ -8743,282 +8975,427 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 404:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2268
+//line sql.y:2293
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelAllMigrationType,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 405:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2276
+//line sql.y:2301
 		{
 			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2280
+//line sql.y:2305
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 407:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2284
+//line sql.y:2309
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 408:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2288
+//line sql.y:2313
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 409:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2292
+//line sql.y:2317
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 410:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2296
+//line sql.y:2321
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 411:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2300
+//line sql.y:2325
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2304
+//line sql.y:2329
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2308
+//line sql.y:2333
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2312
+//line sql.y:2337
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 415:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2316
+//line sql.y:2341
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2320
+//line sql.y:2345
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2324
+//line sql.y:2349
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2328
+//line sql.y:2353
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2332
+//line sql.y:2357
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2336
+//line sql.y:2361
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *PartitionSpec
-//line sql.y:2340
+	case 421:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *PartitionSpec
+//line sql.y:2365
+		{
+			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 422:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *PartitionSpec
+//line sql.y:2369
+		{
+			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 423:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *PartitionSpec
+//line sql.y:2373
+		{
+			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 424:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *PartitionSpec
+//line sql.y:2377
+		{
+			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 425:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *PartitionSpec
+//line sql.y:2381
+		{
+			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 426:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *PartitionSpec
+//line sql.y:2385
+		{
+			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
+		}
+		yyVAL.union = yyLOCAL
+	case 427:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:2390
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 428:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:2394
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 429:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:2398
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 430:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*PartitionDefinition
+//line sql.y:2405
+		{
+			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 431:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2409
+		{
+			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
+		}
+	case 432:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL *PartitionDefinition
+//line sql.y:2415
+		{
+			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 433:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL *PartitionDefinition
+//line sql.y:2419
+		{
+			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 434:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *PartitionDefinition
+//line sql.y:2423
+		{
+			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 435:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2432
+		{
+		}
+	case 436:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2435
+		{
+		}
+	case 437:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2438
+		{
+		}
+	case 438:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2442
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 439:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2446
 		{
-			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
+			yyLOCAL = yyDollar[1].partitionOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *PartitionSpec
-//line sql.y:2344
+	case 440:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2452
 		{
-			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
+			yyDollar[3].partitionOptionUnion().Partitions = yyDollar[4].literalUnion()
+			yyDollar[3].partitionOptionUnion().Definitions = yyDollar[5].partDefsUnion()
+			yyLOCAL = yyDollar[3].partitionOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *PartitionSpec
-//line sql.y:2348
+	case 441:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2460
 		{
-			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
+			if yyDollar[2].colIdent.Lowered() != "hash" {
+				yylex.Error("expecting hash, key or range partitioning")
+				return 1
+			}
+			yyLOCAL = &PartitionOption{Linear: yyDollar[1].booleanUnion(), Type: HashType, Expr: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *PartitionSpec
-//line sql.y:2352
+	case 442:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2468
 		{
-			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
+			yyLOCAL = &PartitionOption{Linear: yyDollar[1].booleanUnion(), Type: KeyType}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *PartitionSpec
-//line sql.y:2356
+	case 443:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2472
 		{
-			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
+			yyLOCAL = &PartitionOption{Linear: yyDollar[1].booleanUnion(), Type: KeyType, ColList: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL *PartitionSpec
-//line sql.y:2360
+	case 444:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2476
 		{
-			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
+			yyLOCAL = &PartitionOption{Type: RangeType, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL bool
-//line sql.y:2365
+	case 445:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2480
 		{
-			yyLOCAL = false
+			yyLOCAL = &PartitionOption{Type: RangeType, ColList: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 446:
+		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2369
+//line sql.y:2485
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 447:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2373
+//line sql.y:2489
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL []*PartitionDefinition
-//line sql.y:2380
+	case 448:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *Literal
+//line sql.y:2494
 		{
-			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2384
+	case 449:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *Literal
+//line sql.y:2498
 		{
-			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
+			if yyDollar[1].colIdent.Lowered() != "partitions" {
+				yylex.Error("expecting partitions")
+				return 1
+			}
+			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
-	case 429:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL *PartitionDefinition
-//line sql.y:2390
+		yyVAL.union = yyLOCAL
+	case 450:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*PartitionDefinition
+//line sql.y:2507
 		{
-			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].exprUnion()}
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL *PartitionDefinition
-//line sql.y:2394
+	case 451:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*PartitionDefinition
+//line sql.y:2511
 		{
-			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+			yyLOCAL = yyDollar[2].partDefsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2400
+//line sql.y:2517
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 453:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:2406
+//line sql.y:2523
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 454:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2410
+//line sql.y:2527
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 434:
+	case 455:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2416
+//line sql.y:2533
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[6].tableNamesUnion(), IfExists: yyDollar[5].booleanUnion(), Comments: Comments(yyDollar[2].strs), Temp: yyDollar[3].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 456:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2420
+//line sql.y:2537
 		{
 			// Change this to an alter statement
 			if yyDollar[4].colIdent.Lowered() == "primary" {
@@ -9028,387 +9405,387 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 457:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2429
+//line sql.y:2546
 		{
-			yyLOCAL = &DropView{FromTables: yyDollar[5].tableNamesUnion(), IfExists: yyDollar[4].booleanUnion()}
+			yyLOCAL = &DropView{Comments: Comments(yyDollar[2].strs), FromTables: yyDollar[5].tableNamesUnion(), IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 458:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2433
+//line sql.y:2550
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[2].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 459:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2439
+//line sql.y:2556
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2443
+//line sql.y:2560
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2448
+//line sql.y:2565
 		{
 			yyLOCAL = &OtherRead{}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2454
+//line sql.y:2571
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2458
+//line sql.y:2575
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 464:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2462
+//line sql.y:2579
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 465:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2466
+//line sql.y:2583
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2470
+//line sql.y:2587
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2474
+//line sql.y:2591
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 468:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2478
+//line sql.y:2595
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 469:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2482
+//line sql.y:2599
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 470:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2486
+//line sql.y:2603
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 471:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2490
+//line sql.y:2607
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 472:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2494
+//line sql.y:2611
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 473:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2498
+//line sql.y:2615
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 474:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2502
+//line sql.y:2619
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 475:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2506
+//line sql.y:2623
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 476:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2510
+//line sql.y:2627
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 477:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2514
+//line sql.y:2631
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 478:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2518
+//line sql.y:2635
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 479:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2522
+//line sql.y:2639
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 480:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2526
+//line sql.y:2643
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 481:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2530
+//line sql.y:2647
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 482:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2534
+//line sql.y:2651
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 483:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2538
+//line sql.y:2655
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 484:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2542
+//line sql.y:2659
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 485:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2546
+//line sql.y:2663
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 486:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2550
+//line sql.y:2667
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 487:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2554
+//line sql.y:2671
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 488:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2558
+//line sql.y:2675
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 489:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2562
+//line sql.y:2679
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 490:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2566
+//line sql.y:2683
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2570
+//line sql.y:2687
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 492:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2574
+//line sql.y:2691
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2578
+//line sql.y:2695
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 494:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2582
+//line sql.y:2699
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 495:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2586
+//line sql.y:2703
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 496:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2590
+//line sql.y:2707
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 497:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2594
+//line sql.y:2711
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 498:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2598
+//line sql.y:2715
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 499:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2603
+//line sql.y:2720
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 500:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2607
+//line sql.y:2724
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 501:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2611
+//line sql.y:2728
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 502:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2615
+//line sql.y:2732
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2619
+//line sql.y:2736
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Warnings}}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 504:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2624
+//line sql.y:2741
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -9416,1234 +9793,1234 @@ yydefault:
 			yyLOCAL = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 505:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2638
+//line sql.y:2755
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2642
+//line sql.y:2759
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2646
+//line sql.y:2763
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2652
+//line sql.y:2769
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 488:
+	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2656
+//line sql.y:2773
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 489:
+	case 510:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2662
+//line sql.y:2779
 		{
 			yyVAL.str = ""
 		}
-	case 490:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2666
+//line sql.y:2783
 		{
 			yyVAL.str = "extended "
 		}
-	case 491:
+	case 512:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2672
+//line sql.y:2789
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2676
+//line sql.y:2793
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2682
+//line sql.y:2799
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 494:
+	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2686
+//line sql.y:2803
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 495:
+	case 516:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2692
+//line sql.y:2809
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 496:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2696
+//line sql.y:2813
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 497:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2700
+//line sql.y:2817
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 498:
+	case 519:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2706
+//line sql.y:2823
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2710
+//line sql.y:2827
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2714
+//line sql.y:2831
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 522:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2720
+//line sql.y:2837
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2724
+//line sql.y:2841
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 524:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2730
+//line sql.y:2847
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 504:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2734
+//line sql.y:2851
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 505:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2738
+//line sql.y:2855
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 506:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2744
+//line sql.y:2861
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2748
+//line sql.y:2865
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2754
+//line sql.y:2871
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2758
+//line sql.y:2875
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2764
+//line sql.y:2881
 		{
 			yyLOCAL = &Commit{}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 532:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2770
+//line sql.y:2887
 		{
 			yyLOCAL = &Rollback{}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 533:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2774
+//line sql.y:2891
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 534:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2779
+//line sql.y:2896
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 514:
+	case 535:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2781
+//line sql.y:2898
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 515:
+	case 536:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2784
+//line sql.y:2901
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 516:
+	case 537:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2786
+//line sql.y:2903
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 517:
+	case 538:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2791
+//line sql.y:2908
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 539:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2797
+//line sql.y:2914
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 540:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2802
+//line sql.y:2919
 		{
 			yyLOCAL = EmptyType
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2806
+//line sql.y:2923
 		{
 			yyLOCAL = JSONType
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2810
+//line sql.y:2927
 		{
 			yyLOCAL = TreeType
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 543:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2814
+//line sql.y:2931
 		{
 			yyLOCAL = VitessType
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2818
+//line sql.y:2935
 		{
 			yyLOCAL = TraditionalType
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2822
+//line sql.y:2939
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 546:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2828
+//line sql.y:2945
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 526:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2832
+//line sql.y:2949
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 527:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2836
+//line sql.y:2953
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 528:
+	case 549:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2842
+//line sql.y:2959
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2846
+//line sql.y:2963
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 551:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2850
+//line sql.y:2967
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2854
+//line sql.y:2971
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 553:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2859
+//line sql.y:2976
 		{
 			yyVAL.str = ""
 		}
-	case 533:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2863
+//line sql.y:2980
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 534:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2867
+//line sql.y:2984
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 535:
+	case 556:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2873
+//line sql.y:2990
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 557:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2877
+//line sql.y:2994
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 558:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2883
+//line sql.y:3000
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 559:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2887
+//line sql.y:3004
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 560:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2893
+//line sql.y:3010
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:2899
+//line sql.y:3016
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 562:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2903
+//line sql.y:3020
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
 		}
-	case 542:
+	case 563:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:2909
+//line sql.y:3026
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2915
+//line sql.y:3032
 		{
 			yyLOCAL = Read
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2919
+//line sql.y:3036
 		{
 			yyLOCAL = ReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2923
+//line sql.y:3040
 		{
 			yyLOCAL = Write
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2927
+//line sql.y:3044
 		{
 			yyLOCAL = LowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2933
+//line sql.y:3050
 		{
 			yyLOCAL = &UnlockTables{}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 569:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2939
+//line sql.y:3056
 		{
 			yyLOCAL = &RevertMigration{Comments: Comments(yyDollar[2].strs), UUID: string(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 570:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2945
+//line sql.y:3062
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 571:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2949
+//line sql.y:3066
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 572:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2953
+//line sql.y:3070
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 573:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2957
+//line sql.y:3074
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 574:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2961
+//line sql.y:3078
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 575:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2965
+//line sql.y:3082
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2971
+//line sql.y:3088
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 556:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2975
+//line sql.y:3092
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 557:
+	case 578:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2981
+//line sql.y:3098
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 558:
+	case 579:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2985
+//line sql.y:3102
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 559:
+	case 580:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2989
+//line sql.y:3106
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 560:
+	case 581:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2993
+//line sql.y:3110
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 561:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2997
+//line sql.y:3114
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 562:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3001
+//line sql.y:3118
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 563:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3005
+//line sql.y:3122
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 564:
+	case 585:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3009
+//line sql.y:3126
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
-	case 565:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3013
+//line sql.y:3130
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 566:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3017
+//line sql.y:3134
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 567:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3021
+//line sql.y:3138
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 568:
+	case 589:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3025
+//line sql.y:3142
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 569:
+	case 590:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3030
+//line sql.y:3147
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3034
+//line sql.y:3151
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3038
+//line sql.y:3155
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 593:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3043
+//line sql.y:3160
 		{
 			yyVAL.str = ""
 		}
-	case 573:
+	case 594:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3047
+//line sql.y:3164
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
-	case 574:
+	case 595:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3052
+//line sql.y:3169
 		{
 			setAllowComments(yylex, true)
 		}
-	case 575:
+	case 596:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3056
+//line sql.y:3173
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
-	case 576:
+	case 597:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3062
+//line sql.y:3179
 		{
 			yyVAL.strs = nil
 		}
-	case 577:
+	case 598:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3066
+//line sql.y:3183
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 578:
+	case 599:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3072
+//line sql.y:3189
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 600:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3076
+//line sql.y:3193
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 601:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3080
+//line sql.y:3197
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 602:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3085
+//line sql.y:3202
 		{
 			yyVAL.str = ""
 		}
-	case 582:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3089
+//line sql.y:3206
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 583:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3093
+//line sql.y:3210
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 584:
+	case 605:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3098
+//line sql.y:3215
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3102
+//line sql.y:3219
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3106
+//line sql.y:3223
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 608:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3111
+//line sql.y:3228
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3115
+//line sql.y:3232
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 610:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3120
+//line sql.y:3237
 		{
 			yyVAL.strs = nil
 		}
-	case 590:
+	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3124
+//line sql.y:3241
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 591:
+	case 612:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3128
+//line sql.y:3245
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 592:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3132
+//line sql.y:3249
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 593:
+	case 614:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3136
+//line sql.y:3253
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 594:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3142
+//line sql.y:3259
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 595:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3146
+//line sql.y:3263
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 596:
+	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3150
+//line sql.y:3267
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 597:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3154
+//line sql.y:3271
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 598:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3158
+//line sql.y:3275
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 599:
+	case 620:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3162
+//line sql.y:3279
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 600:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3166
+//line sql.y:3283
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 601:
+	case 622:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3172
+//line sql.y:3289
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 623:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3176
+//line sql.y:3293
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
 		}
-	case 603:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3182
+//line sql.y:3299
 		{
 			yyLOCAL = &StarExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3186
+//line sql.y:3303
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3190
+//line sql.y:3307
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 627:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3194
+//line sql.y:3311
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 628:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3199
+//line sql.y:3316
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 608:
+	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3203
+//line sql.y:3320
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 609:
+	case 630:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3207
+//line sql.y:3324
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 611:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3214
+//line sql.y:3331
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 612:
+	case 633:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3219
+//line sql.y:3336
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 634:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3223
+//line sql.y:3340
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3229
+//line sql.y:3346
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 636:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3233
+//line sql.y:3350
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
 		}
-	case 618:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3243
+//line sql.y:3360
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 640:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3247
+//line sql.y:3364
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 641:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3251
+//line sql.y:3368
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3257
+//line sql.y:3374
 		{
 			yyLOCAL = &DerivedTable{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 643:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3263
+//line sql.y:3380
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 644:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3267
+//line sql.y:3384
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 645:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3272
+//line sql.y:3389
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 646:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3276
+//line sql.y:3393
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3282
+//line sql.y:3399
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3286
+//line sql.y:3403
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 628:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3292
+//line sql.y:3409
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3296
+//line sql.y:3413
 		{
 			yyLOCAL = Columns{NewColIdent(string(yyDollar[1].str))}
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3300
+//line sql.y:3417
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 631:
+	case 652:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3304
+//line sql.y:3421
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, NewColIdent(string(yyDollar[3].str)))
 		}
-	case 632:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:3310
+//line sql.y:3427
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 654:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3314
+//line sql.y:3431
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 634:
+	case 655:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3327
+//line sql.y:3444
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 656:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3331
+//line sql.y:3448
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 657:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3335
+//line sql.y:3452
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3339
+//line sql.y:3456
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 659:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3345
+//line sql.y:3462
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 639:
+	case 660:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3347
+//line sql.y:3464
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
-	case 640:
+	case 661:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3351
+//line sql.y:3468
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 641:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3353
+//line sql.y:3470
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 642:
+	case 663:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3357
+//line sql.y:3474
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 643:
+	case 664:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3359
+//line sql.y:3476
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 644:
+	case 665:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3362
+//line sql.y:3479
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 645:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3364
+//line sql.y:3481
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 646:
+	case 667:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3367
+//line sql.y:3484
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 647:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3371
+//line sql.y:3488
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 648:
+	case 669:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3375
+//line sql.y:3492
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 650:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3382
+//line sql.y:3499
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 651:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3388
+//line sql.y:3505
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 673:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3392
+//line sql.y:3509
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3396
+//line sql.y:3513
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3402
+//line sql.y:3519
 		{
 			yyLOCAL = StraightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 676:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3408
+//line sql.y:3525
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3412
+//line sql.y:3529
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 678:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3416
+//line sql.y:3533
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 679:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3420
+//line sql.y:3537
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 680:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3426
+//line sql.y:3543
 		{
 			yyLOCAL = NaturalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 681:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3430
+//line sql.y:3547
 		{
 			if yyDollar[2].joinTypeUnion() == LeftJoinType {
 				yyLOCAL = NaturalLeftJoinType
@@ -10652,668 +11029,668 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 682:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3440
+//line sql.y:3557
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 662:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3444
+//line sql.y:3561
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 663:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3450
+//line sql.y:3567
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 664:
+	case 685:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3454
+//line sql.y:3571
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 665:
+	case 686:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3460
+//line sql.y:3577
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 666:
+	case 687:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3465
+//line sql.y:3582
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 688:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3469
+//line sql.y:3586
 		{
 			yyLOCAL = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 689:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3473
+//line sql.y:3590
 		{
 			yyLOCAL = &IndexHints{Type: UseOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 690:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3477
+//line sql.y:3594
 		{
 			yyLOCAL = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 691:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3481
+//line sql.y:3598
 		{
 			yyLOCAL = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 692:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3486
+//line sql.y:3603
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 693:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3490
+//line sql.y:3607
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3496
+//line sql.y:3613
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 695:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3500
+//line sql.y:3617
 		{
 			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3504
+//line sql.y:3621
 		{
 			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 697:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3508
+//line sql.y:3625
 		{
 			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3512
+//line sql.y:3629
 		{
 			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 699:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3516
+//line sql.y:3633
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].isExprOperatorUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3520
+//line sql.y:3637
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 701:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3524
+//line sql.y:3641
 		{
 			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 702:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3530
+//line sql.y:3647
 		{
 			yyVAL.str = ""
 		}
-	case 682:
+	case 703:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3534
+//line sql.y:3651
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 683:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3540
+//line sql.y:3657
 		{
 			yyLOCAL = BoolVal(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3544
+//line sql.y:3661
 		{
 			yyLOCAL = BoolVal(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 706:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3550
+//line sql.y:3667
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 707:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3554
+//line sql.y:3671
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 708:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3558
+//line sql.y:3675
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 709:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3562
+//line sql.y:3679
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 710:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3566
+//line sql.y:3683
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 711:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3570
+//line sql.y:3687
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 712:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3574
+//line sql.y:3691
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 713:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3578
+//line sql.y:3695
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: BetweenOp, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 714:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3582
+//line sql.y:3699
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: NotBetweenOp, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3586
+//line sql.y:3703
 		{
 			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 716:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3592
+//line sql.y:3709
 		{
 			yyLOCAL = IsNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 717:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3596
+//line sql.y:3713
 		{
 			yyLOCAL = IsNotNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3600
+//line sql.y:3717
 		{
 			yyLOCAL = IsTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 719:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3604
+//line sql.y:3721
 		{
 			yyLOCAL = IsNotTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3608
+//line sql.y:3725
 		{
 			yyLOCAL = IsFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 721:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3612
+//line sql.y:3729
 		{
 			yyLOCAL = IsNotFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3618
+//line sql.y:3735
 		{
 			yyLOCAL = EqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3622
+//line sql.y:3739
 		{
 			yyLOCAL = LessThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3626
+//line sql.y:3743
 		{
 			yyLOCAL = GreaterThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3630
+//line sql.y:3747
 		{
 			yyLOCAL = LessEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3634
+//line sql.y:3751
 		{
 			yyLOCAL = GreaterEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 727:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3638
+//line sql.y:3755
 		{
 			yyLOCAL = NotEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 728:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3642
+//line sql.y:3759
 		{
 			yyLOCAL = NullSafeEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 729:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3647
+//line sql.y:3764
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3651
+//line sql.y:3768
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 731:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3657
+//line sql.y:3774
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 732:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3661
+//line sql.y:3778
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3665
+//line sql.y:3782
 		{
 			yyLOCAL = ListArg(yyDollar[1].str[2:])
 			bindVariable(yylex, yyDollar[1].str[2:])
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Subquery
-//line sql.y:3672
+//line sql.y:3789
 		{
 			yyLOCAL = &Subquery{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:3678
+//line sql.y:3795
 		{
 			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3682
+//line sql.y:3799
 		{
 			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
 		}
-	case 716:
+	case 737:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3688
+//line sql.y:3805
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3692
+//line sql.y:3809
 		{
 			yyLOCAL = yyDollar[1].boolValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3696
+//line sql.y:3813
 		{
 			yyLOCAL = yyDollar[1].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 740:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3700
+//line sql.y:3817
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 741:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3704
+//line sql.y:3821
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3708
+//line sql.y:3825
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3712
+//line sql.y:3829
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 744:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3716
+//line sql.y:3833
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3720
+//line sql.y:3837
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 746:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3724
+//line sql.y:3841
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3728
+//line sql.y:3845
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3732
+//line sql.y:3849
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3736
+//line sql.y:3853
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 750:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3740
+//line sql.y:3857
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3744
+//line sql.y:3861
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 752:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3748
+//line sql.y:3865
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 753:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3752
+//line sql.y:3869
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 754:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3756
+//line sql.y:3873
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 755:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3760
+//line sql.y:3877
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3764
+//line sql.y:3881
 		{
 			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Charset: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 757:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3768
+//line sql.y:3885
 		{
 			yyLOCAL = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 758:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3772
+//line sql.y:3889
 		{
 			yyLOCAL = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 759:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3776
+//line sql.y:3893
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3780
+//line sql.y:3897
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 761:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3784
+//line sql.y:3901
 		{
 			yyLOCAL = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 762:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3788
+//line sql.y:3905
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 763:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3792
+//line sql.y:3909
 		{
 			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 764:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3796
+//line sql.y:3913
 		{
 			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 765:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3800
+//line sql.y:3917
 		{
 			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3804
+//line sql.y:3921
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -11322,666 +11699,666 @@ yydefault:
 			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 771:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3822
+//line sql.y:3939
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 772:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3826
+//line sql.y:3943
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 773:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3830
+//line sql.y:3947
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 774:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3834
+//line sql.y:3951
 		{
 			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 775:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3844
+//line sql.y:3961
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 776:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3848
+//line sql.y:3965
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 777:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3852
+//line sql.y:3969
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 778:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3856
+//line sql.y:3973
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 779:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3860
+//line sql.y:3977
 		{
 			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 780:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3864
+//line sql.y:3981
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 781:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3868
+//line sql.y:3985
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 782:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3872
+//line sql.y:3989
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 783:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3876
+//line sql.y:3993
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 784:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3880
+//line sql.y:3997
 		{
 			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 785:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3884
+//line sql.y:4001
 		{
 			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 786:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3888
+//line sql.y:4005
 		{
 			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 787:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3892
+//line sql.y:4009
 		{
 			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 788:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3896
+//line sql.y:4013
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 789:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3906
+//line sql.y:4023
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 790:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3910
+//line sql.y:4027
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 791:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3914
+//line sql.y:4031
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 792:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3919
+//line sql.y:4036
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 793:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3924
+//line sql.y:4041
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtime")}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 794:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3929
+//line sql.y:4046
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 795:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3935
+//line sql.y:4052
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 796:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3940
+//line sql.y:4057
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 797:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3945
+//line sql.y:4062
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3949
+//line sql.y:4066
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 799:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3953
+//line sql.y:4070
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 800:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3958
+//line sql.y:4075
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 801:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3963
+//line sql.y:4080
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 802:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3968
+//line sql.y:4085
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 803:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3972
+//line sql.y:4089
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 804:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3976
+//line sql.y:4093
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 807:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3986
+//line sql.y:4103
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 808:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3996
+//line sql.y:4113
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 809:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4000
+//line sql.y:4117
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 810:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4004
+//line sql.y:4121
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 811:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4008
+//line sql.y:4125
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 812:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4012
+//line sql.y:4129
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 813:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4016
+//line sql.y:4133
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 814:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4020
+//line sql.y:4137
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 815:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4026
+//line sql.y:4143
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 816:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4030
+//line sql.y:4147
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 817:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4034
+//line sql.y:4151
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 818:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4038
+//line sql.y:4155
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 819:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4042
+//line sql.y:4159
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 820:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4048
+//line sql.y:4165
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 800:
+	case 821:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4052
+//line sql.y:4169
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 801:
+	case 822:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4056
+//line sql.y:4173
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 802:
+	case 823:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4062
+//line sql.y:4179
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 824:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4066
+//line sql.y:4183
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 825:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4070
+//line sql.y:4187
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: string(yyDollar[3].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 826:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4074
+//line sql.y:4191
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 827:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4078
+//line sql.y:4195
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 828:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4082
+//line sql.y:4199
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4088
+//line sql.y:4205
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 830:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4092
+//line sql.y:4209
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 831:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4096
+//line sql.y:4213
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 832:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4100
+//line sql.y:4217
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 833:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4104
+//line sql.y:4221
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 834:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4108
+//line sql.y:4225
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 835:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4112
+//line sql.y:4229
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 836:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4117
+//line sql.y:4234
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 837:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4121
+//line sql.y:4238
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 838:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4126
+//line sql.y:4243
 		{
 			yyVAL.str = string("")
 		}
-	case 818:
+	case 839:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4130
+//line sql.y:4247
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 819:
+	case 840:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:4136
+//line sql.y:4253
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 841:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4140
+//line sql.y:4257
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 821:
+	case 842:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:4146
+//line sql.y:4263
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 843:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4151
+//line sql.y:4268
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 844:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4155
+//line sql.y:4272
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 845:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4161
+//line sql.y:4278
 		{
 			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 846:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4165
+//line sql.y:4282
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 847:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4169
+//line sql.y:4286
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 848:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4175
+//line sql.y:4292
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 849:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4179
+//line sql.y:4296
 		{
 			yyLOCAL = NewHexLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 850:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4183
+//line sql.y:4300
 		{
 			yyLOCAL = NewBitLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 851:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4187
+//line sql.y:4304
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 852:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4191
+//line sql.y:4308
 		{
 			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 853:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4195
+//line sql.y:4312
 		{
 			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4199
+//line sql.y:4316
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 855:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4204
+//line sql.y:4321
 		{
 			yyLOCAL = &NullVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 856:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4210
+//line sql.y:4327
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -11991,707 +12368,713 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 857:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4219
+//line sql.y:4336
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 858:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4223
+//line sql.y:4340
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 859:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4229
+//line sql.y:4346
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 860:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4233
+//line sql.y:4350
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 861:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4238
+//line sql.y:4355
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 862:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4242
+//line sql.y:4359
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 863:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4247
+//line sql.y:4364
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 864:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4251
+//line sql.y:4368
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4257
+//line sql.y:4374
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 866:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4261
+//line sql.y:4378
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 846:
+	case 867:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:4267
+//line sql.y:4384
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 868:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4272
+//line sql.y:4389
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 869:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4276
+//line sql.y:4393
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 870:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4280
+//line sql.y:4397
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 871:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4285
+//line sql.y:4402
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 872:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4289
+//line sql.y:4406
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 873:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4293
+//line sql.y:4410
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 874:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4297
+//line sql.y:4414
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 875:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4302
+//line sql.y:4419
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 876:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4306
+//line sql.y:4423
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 877:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4310
+//line sql.y:4427
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 878:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4314
+//line sql.y:4431
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 879:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4318
+//line sql.y:4435
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 880:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4325
+//line sql.y:4442
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 881:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4329
+//line sql.y:4446
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 882:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4333
+//line sql.y:4450
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 883:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4337
+//line sql.y:4454
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 884:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4343
+//line sql.y:4460
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 885:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4347
+//line sql.y:4464
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 886:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4351
+//line sql.y:4468
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 887:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4356
+//line sql.y:4473
 		{
 			yyVAL.str = ""
 		}
-	case 867:
+	case 888:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4360
+//line sql.y:4477
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 868:
+	case 889:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4364
+//line sql.y:4481
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 869:
+	case 890:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4368
+//line sql.y:4485
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 870:
+	case 891:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4373
+//line sql.y:4490
 		{
 			yyVAL.str = ""
 		}
-	case 871:
+	case 892:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4377
+//line sql.y:4494
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 872:
+	case 893:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4383
+//line sql.y:4500
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 873:
+	case 894:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4387
+//line sql.y:4504
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 874:
+	case 895:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4392
+//line sql.y:4509
 		{
 			yyVAL.str = ""
 		}
-	case 875:
+	case 896:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4396
+//line sql.y:4513
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 876:
+	case 897:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4401
+//line sql.y:4518
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 877:
+	case 898:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4405
+//line sql.y:4522
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 878:
+	case 899:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4409
+//line sql.y:4526
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 879:
+	case 900:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4414
+//line sql.y:4531
 		{
 			yyVAL.str = ""
 		}
-	case 880:
+	case 901:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4418
+//line sql.y:4535
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 881:
+	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4424
+//line sql.y:4541
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 882:
+	case 903:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4428
+//line sql.y:4545
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 883:
+	case 904:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4432
+//line sql.y:4549
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str) + "@" + string(yyDollar[2].str)
 		}
-	case 884:
+	case 905:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4553
+		{
+			yyVAL.str = encodeSQLIdentifier(yyDollar[1].str) + "@" + encodeSQLIdentifier(yyDollar[2].str)
+		}
+	case 906:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4436
+//line sql.y:4557
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 885:
+	case 907:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4441
+//line sql.y:4562
 		{
 			yyLOCAL = NoLock
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 908:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4445
+//line sql.y:4566
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 909:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4449
+//line sql.y:4570
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 910:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4454
+//line sql.y:4575
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 911:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4458
+//line sql.y:4579
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 912:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4462
+//line sql.y:4583
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 913:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4466
+//line sql.y:4587
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 914:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4471
+//line sql.y:4592
 		{
 			yyVAL.str = ""
 		}
-	case 893:
+	case 915:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4475
+//line sql.y:4596
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 894:
+	case 916:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4479
+//line sql.y:4600
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 895:
+	case 917:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4484
+//line sql.y:4605
 		{
 			yyVAL.str = ""
 		}
-	case 896:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4488
+//line sql.y:4609
 		{
 			yyVAL.str = " header"
 		}
-	case 897:
+	case 919:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4493
+//line sql.y:4614
 		{
 			yyVAL.str = ""
 		}
-	case 898:
+	case 920:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4497
+//line sql.y:4618
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 899:
+	case 921:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4501
+//line sql.y:4622
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 900:
+	case 922:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4506
+//line sql.y:4627
 		{
 			yyVAL.str = ""
 		}
-	case 901:
+	case 923:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4510
+//line sql.y:4631
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 902:
+	case 924:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4514
+//line sql.y:4635
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 903:
+	case 925:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4520
+//line sql.y:4641
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 904:
+	case 926:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4525
+//line sql.y:4646
 		{
 			yyVAL.str = ""
 		}
-	case 905:
+	case 927:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4529
+//line sql.y:4650
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 906:
+	case 928:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4535
+//line sql.y:4656
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 907:
+	case 929:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4539
+//line sql.y:4660
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 908:
+	case 930:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4545
+//line sql.y:4666
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 909:
+	case 931:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4549
+//line sql.y:4670
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 910:
+	case 932:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4554
+//line sql.y:4675
 		{
 			yyVAL.str = ""
 		}
-	case 911:
+	case 933:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4558
+//line sql.y:4679
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 912:
+	case 934:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4564
+//line sql.y:4685
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 913:
+	case 935:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4568
+//line sql.y:4689
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 914:
+	case 936:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4574
+//line sql.y:4695
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 915:
+	case 937:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4578
+//line sql.y:4699
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 916:
+	case 938:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4582
+//line sql.y:4703
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 917:
+	case 939:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4587
+//line sql.y:4708
 		{
 			yyVAL.str = ""
 		}
-	case 918:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4591
+//line sql.y:4712
 		{
 			yyVAL.str = " optionally"
 		}
-	case 919:
+	case 941:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4604
+//line sql.y:4725
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 942:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4608
+//line sql.y:4729
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 943:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4612
+//line sql.y:4733
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 944:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4616
+//line sql.y:4737
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 945:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4620
+//line sql.y:4741
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4626
+//line sql.y:4747
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 947:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4630
+//line sql.y:4751
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 948:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4634
+//line sql.y:4755
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 927:
+	case 949:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4638
+//line sql.y:4759
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 928:
+	case 950:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4643
+//line sql.y:4764
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 951:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4647
+//line sql.y:4768
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:4653
+//line sql.y:4774
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 953:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4657
+//line sql.y:4778
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 932:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4663
+//line sql.y:4784
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 955:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4667
+//line sql.y:4788
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 956:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4673
+//line sql.y:4794
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4679
+//line sql.y:4800
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -12700,329 +13083,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4689
+//line sql.y:4810
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 959:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4693
+//line sql.y:4814
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 938:
+	case 960:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:4699
+//line sql.y:4820
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 961:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:4705
+//line sql.y:4826
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 962:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4709
+//line sql.y:4830
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 941:
+	case 963:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4715
+//line sql.y:4836
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 964:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4719
+//line sql.y:4840
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 965:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4723
+//line sql.y:4844
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 966:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4727
+//line sql.y:4848
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 967:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4731
+//line sql.y:4852
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 969:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4739
+//line sql.y:4860
 		{
 			yyVAL.str = "charset"
 		}
-	case 950:
+	case 972:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4749
+//line sql.y:4870
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4753
+//line sql.y:4874
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4757
+//line sql.y:4878
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 977:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4766
+//line sql.y:4887
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4768
+//line sql.y:4889
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 979:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4771
+//line sql.y:4892
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 980:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4773
+//line sql.y:4894
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 981:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4776
+//line sql.y:4897
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 982:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4778
+//line sql.y:4899
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 983:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4781
+//line sql.y:4902
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4783
+//line sql.y:4904
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 985:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4786
+//line sql.y:4907
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 964:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4788
+//line sql.y:4909
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 965:
+	case 987:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4790
+//line sql.y:4911
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 966:
+	case 988:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:4794
+//line sql.y:4915
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 989:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4799
+//line sql.y:4920
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 990:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4803
+//line sql.y:4924
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 991:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4808
+//line sql.y:4929
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 992:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4810
+//line sql.y:4931
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 993:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:4814
+//line sql.y:4935
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 994:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4820
+//line sql.y:4941
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 973:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4824
+//line sql.y:4945
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 975:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4831
+//line sql.y:4952
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 976:
+	case 998:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4837
+//line sql.y:4958
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 977:
+	case 999:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4841
+//line sql.y:4962
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 978:
+	case 1000:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4847
+//line sql.y:4968
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 979:
+	case 1001:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4851
+//line sql.y:4972
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 981:
+	case 1003:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4858
+//line sql.y:4979
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1395:
+	case 1417:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5296
+//line sql.y:5417
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1396:
+	case 1418:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5305
+//line sql.y:5426
 		{
 			decNesting(yylex)
 		}
-	case 1397:
+	case 1419:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5310
+//line sql.y:5431
 		{
 			skipToEnd(yylex)
 		}
-	case 1398:
+	case 1420:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5315
+//line sql.y:5436
 		{
 			skipToEnd(yylex)
 		}
-	case 1399:
+	case 1421:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5319
+//line sql.y:5440
 		{
 			skipToEnd(yylex)
 		}
-	case 1400:
+	case 1422:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5323
+//line sql.y:5444
 		{
 			skipToEnd(yylex)
 		}
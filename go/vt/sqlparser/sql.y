@@ -100,6 +100,7 @@ func bindVariable(yylex yyLexer, bvar string) {
   indexColumn   *IndexColumn
   partDef       *PartitionDefinition
   partSpec      *PartitionSpec
+  partitionOption *PartitionOption
   showFilter    *ShowFilter
   optLike       *OptLike
   selectInto	  *SelectInto
@@ -187,6 +188,9 @@ func bindVariable(yylex yyLexer, bvar string) {
 // * NOTE: If you change anything here, update precedence.go as well *
 %nonassoc <str> LOWER_THAN_CHARSET
 %nonassoc <str> CHARSET
+// Resolve FIRST column position ambiguity.
+%nonassoc <str> LOWER_THAN_COLUMN_NAME
+%nonassoc <str> AFTER REMOVE
 // Resolve column attribute ambiguity.
 %right <str> UNIQUE KEY
 %left <str> OR
@@ -217,11 +221,12 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <str> REVERT
 %token <str> SCHEMA TABLE INDEX VIEW TO IGNORE IF PRIMARY COLUMN SPATIAL FULLTEXT KEY_BLOCK_SIZE CHECK INDEXES
 %token <str> ACTION CASCADE CONSTRAINT FOREIGN NO REFERENCES RESTRICT
-%token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE COALESCE EXCHANGE REBUILD PARTITIONING REMOVE
+%token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE COALESCE EXCHANGE REBUILD PARTITIONING
 %token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <str> LINEAR RANGE
 %token <str> VINDEX VINDEXES DIRECTORY NAME UPGRADE
 %token <str> STATUS VARIABLES WARNINGS CASCADED DEFINER OPTION SQL UNDEFINED
-%token <str> SEQUENCE MERGE TEMPORARY TEMPTABLE INVOKER SECURITY FIRST AFTER LAST
+%token <str> SEQUENCE MERGE TEMPORARY TEMPTABLE INVOKER SECURITY FIRST LAST
 
 // Migration tokens
 %token <str> VITESS_MIGRATION CANCEL RETRY COMPLETE
@@ -412,6 +417,10 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <partDefs> partition_definitions
 %type <partDef> partition_definition
 %type <partSpec> partition_operation
+%type <partitionOption> partition_option_opt partition_option partition_method
+%type <partDefs> partition_definitions_opt
+%type <literal> partitions_opt
+%type <boolean> linear_opt
 %type <vindexParam> vindex_param
 %type <vindexParams> vindex_param_list vindex_params_opt
 %type <colIdent> id_or_var vindex_type vindex_type_opt id_or_var_opt
@@ -793,7 +802,7 @@ create_statement:
   }
 | CREATE comment_opt replace_opt algorithm_view definer_opt security_view_opt VIEW table_name column_list_opt AS select_statement check_option_opt
   {
-    $$ = &CreateView{ViewName: $8.ToViewName(), IsReplace:$3, Algorithm:$4, Definer: $5 ,Security:$6, Columns:$9, Select: $11, CheckOption: $12 }
+    $$ = &CreateView{Comments: Comments($2), ViewName: $8.ToViewName(), IsReplace:$3, Algorithm:$4, Definer: $5 ,Security:$6, Columns:$9, Select: $11, CheckOption: $12 }
   }
 | create_database_prefix create_options_opt
   {
@@ -908,10 +917,11 @@ database_or_schema:
 | SCHEMA
 
 table_spec:
-  '(' table_column_list ')' table_option_list_opt
+  '(' table_column_list ')' table_option_list_opt partition_option_opt
   {
     $$ = $2
     $$.Options = $4
+    $$.PartitionOption = $5
   }
 
 create_options_opt:
@@ -1055,7 +1065,7 @@ generated_always_opt:
 // was specific (as stated in the MySQL guide) and did not accept arbitrary order options. For example NOT NULL DEFAULT 1 and not DEFAULT 1 NOT NULL
 column_attribute_list_opt:
   {
-    $$ = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil, As: nil}
+    $$ = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: ColKeyNone, Comment: nil, As: nil}
   }
 | column_attribute_list_opt NULL
   {
@@ -1140,19 +1150,19 @@ generated_column_attribute_list_opt:
 keys:
   PRIMARY KEY
   {
-    $$ = colKeyPrimary
+    $$ = ColKeyPrimary
   }
 | UNIQUE
   {
-    $$ = colKeyUnique
+    $$ = ColKeyUnique
   }
 | UNIQUE KEY
   {
-    $$ = colKeyUniqueKey
+    $$ = ColKeyUniqueKey
   }
 | KEY
   {
-    $$ = colKey
+    $$ = ColKey
   }
 
 column_type:
@@ -1939,6 +1949,10 @@ first_opt:
   {
     $$ = nil
   }
+| FIRST %prec LOWER_THAN_COLUMN_NAME
+  {
+    $$ = &ColName{}
+  }
 | FIRST column_name
   {
     $$ = $2
@@ -2069,6 +2083,10 @@ alter_option:
   {
     $$ = &DropKey{Type:ForeignKeyType, Name:$4}
   }
+| DROP CHECK id_or_var
+  {
+    $$ = &DropKey{Type:CheckKeyType, Name:$3}
+  }
 | FORCE
   {
     $$ = &Force{}
@@ -2137,6 +2155,13 @@ alter_statement:
     $1.AlterOptions = $2
     $$ = $1
   }
+| alter_table_prefix alter_commands_list partition_option
+  {
+    $1.FullyParsed = true
+    $1.AlterOptions = $2
+    $1.PartitionOption = $3
+    $$ = $1
+  }
 | alter_table_prefix alter_commands_list REMOVE PARTITIONING
   {
     $1.FullyParsed = true
@@ -2159,7 +2184,7 @@ alter_statement:
   }
 | ALTER comment_opt algorithm_view definer_opt security_view_opt VIEW table_name column_list_opt AS select_statement check_option_opt
   {
-    $$ = &AlterView{ViewName: $7.ToViewName(), Algorithm:$3, Definer: $4 ,Security:$5, Columns:$8, Select: $10, CheckOption: $11 }
+    $$ = &AlterView{Comments: Comments($2), ViewName: $7.ToViewName(), Algorithm:$3, Definer: $4 ,Security:$5, Columns:$8, Select: $10, CheckOption: $11 }
   }
 | alter_database_prefix table_id_opt create_options
   {
@@ -2386,14 +2411,106 @@ partition_definitions:
   }
 
 partition_definition:
-  PARTITION sql_id VALUES LESS THAN openb value_expression closeb
+  PARTITION sql_id VALUES LESS THAN openb value_expression closeb partition_engine_opt
   {
     $$ = &PartitionDefinition{Name: $2, Limit: $7}
   }
-| PARTITION sql_id VALUES LESS THAN openb MAXVALUE closeb
+| PARTITION sql_id VALUES LESS THAN openb MAXVALUE closeb partition_engine_opt
   {
     $$ = &PartitionDefinition{Name: $2, Maxvalue: true}
   }
+| PARTITION sql_id VALUES LESS THAN MAXVALUE partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, Maxvalue: true}
+  }
+
+/*
+  The storage engine of a partition is the storage engine of its table,
+  which is how SHOW CREATE TABLE prints partition definitions.
+*/
+partition_engine_opt:
+  {
+  }
+| STORAGE ENGINE equal_opt table_alias
+  {
+  }
+| ENGINE equal_opt table_alias
+  {
+  }
+
+partition_option_opt:
+  {
+    $$ = nil
+  }
+| partition_option
+  {
+    $$ = $1
+  }
+
+partition_option:
+  PARTITION BY partition_method partitions_opt partition_definitions_opt
+  {
+    $3.Partitions = $4
+    $3.Definitions = $5
+    $$ = $3
+  }
+
+partition_method:
+  linear_opt sql_id openb expression closeb
+  {
+    if $2.Lowered() != "hash" {
+      yylex.Error("expecting hash, key or range partitioning")
+      return 1
+    }
+    $$ = &PartitionOption{Linear: $1, Type: HashType, Expr: $4}
+  }
+| linear_opt KEY openb closeb
+  {
+    $$ = &PartitionOption{Linear: $1, Type: KeyType}
+  }
+| linear_opt KEY openb column_list closeb
+  {
+    $$ = &PartitionOption{Linear: $1, Type: KeyType, ColList: $4}
+  }
+| RANGE openb expression closeb
+  {
+    $$ = &PartitionOption{Type: RangeType, Expr: $3}
+  }
+| RANGE COLUMNS openb column_list closeb
+  {
+    $$ = &PartitionOption{Type: RangeType, ColList: $4}
+  }
+
+linear_opt:
+  {
+    $$ = false
+  }
+| LINEAR
+  {
+    $$ = true
+  }
+
+partitions_opt:
+  {
+    $$ = nil
+  }
+| sql_id INTEGRAL
+  {
+    if $1.Lowered() != "partitions" {
+      yylex.Error("expecting partitions")
+      return 1
+    }
+    $$ = NewIntLiteral($2)
+  }
+
+partition_definitions_opt:
+  {
+    $$ = nil
+  }
+| openb partition_definitions closeb
+  {
+    $$ = $2
+  }
 
 rename_statement:
   RENAME TABLE rename_list
@@ -2427,7 +2544,7 @@ drop_statement:
   }
 | DROP comment_opt VIEW exists_opt view_name_list restrict_or_cascade_opt
   {
-    $$ = &DropView{FromTables: $5, IfExists: $4}
+    $$ = &DropView{Comments: Comments($2), FromTables: $5, IfExists: $4}
   }
 | DROP comment_opt database_or_schema exists_opt table_id
   {
@@ -4432,6 +4549,10 @@ CURRENT_USER
   {
     $$ = encodeSQLString($1) + "@" + string($2)
   }
+| ID AT_ID
+  {
+    $$ = encodeSQLIdentifier($1) + "@" + encodeSQLIdentifier($2)
+  }
 | ID
   {
     $$ = string($1)
@@ -37,3 +37,58 @@ func (cached *ACLResult) CachedSize(alloc bool) int64 {
 	size += int64(len(cached.GroupName))
 	return size
 }
+func (cached *ColumnACLResult) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(72)
+	}
+	// field ACL vitess.io/vitess/go/vt/tableacl/acl.ACL
+	if cc, ok := cached.ACL.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Name string
+	size += int64(len(cached.Name))
+	// field Columns []string
+	{
+		size += int64(cap(cached.Columns)) * int64(16)
+		for _, elem := range cached.Columns {
+			size += int64(len(elem))
+		}
+	}
+	// field mask vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.mask.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *RowFilterResult) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field ACL vitess.io/vitess/go/vt/tableacl/acl.ACL
+	if cc, ok := cached.ACL.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Name string
+	size += int64(len(cached.Name))
+	// field Predicate vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Predicate.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field bindVars map[string]string
+	if cached.bindVars != nil {
+		size += int64(48)
+		for k, v := range cached.bindVars {
+			size += int64(len(k))
+			size += int64(len(v))
+		}
+	}
+	return size
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tableacl
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/tableacl/acl"
+)
+
+// Masks of the columns of column ACLs.
+const (
+	// MaskNull replaces the values by NULL.
+	MaskNull = "null"
+	// MaskHash replaces the values by their SHA-256 hash.
+	MaskHash = "hash"
+	// MaskPartial replaces all but the last 4 characters of the values by '*'.
+	MaskPartial = "partial"
+)
+
+// maskArgument is the argument of the mask templates that stands for the column.
+const maskArgument = "column"
+
+var maskTemplates = map[string]string{
+	MaskNull:    "null",
+	MaskHash:    "sha2(:column, 256)",
+	MaskPartial: "concat(repeat('*', greatest(char_length(:column) - 4, 0)), right(:column, 4))",
+}
+
+// ColumnACL restricts the access to columns of a set of tables. Only its
+// readers, which are users or groups of the immediate caller, can read
+// the columns. The queries of other callers that reference the columns
+// are denied, unless Mask is set: the columns are then replaced by the
+// mask in the projections of SELECTs, including the expansions of '*',
+// and only their other references are denied. Mask is null, hash or
+// partial.
+//
+// Column ACLs can only be set in the json format of the config file:
+//
+// {
+//   "table_groups": [...],
+//   "column_acls": [
+//     {
+//       "name": "pii",
+//       "table_names_or_prefixes": ["customer%"],
+//       "columns": ["email", "ssn"],
+//       "readers": ["support"],
+//       "mask": "partial"
+//     }
+//   ]
+// }
+type ColumnACL struct {
+	Name                 string   `json:"name"`
+	TableNamesOrPrefixes []string `json:"table_names_or_prefixes"`
+	Columns              []string `json:"columns"`
+	Readers              []string `json:"readers"`
+	Mask                 string   `json:"mask,omitempty"`
+}
+
+// ColumnACLResult is a column ACL that applies to a table. Its ACL
+// are the readers of the columns, whose names are lowercased.
+type ColumnACLResult struct {
+	acl.ACL
+	Name    string
+	Columns []string
+	// mask is the template of the mask, nil if the columns are denied.
+	mask sqlparser.Expr
+}
+
+type columnACLEntry struct {
+	tableNamesOrPrefixes []string
+	result               *ColumnACLResult
+}
+
+// loadColumnACLs validates the column ACLs and builds their entries.
+func loadColumnACLs(columnACLs []*ColumnACL, newACL func([]string) (acl.ACL, error)) ([]*columnACLEntry, error) {
+	var entries []*columnACLEntry
+	names := make(map[string]bool)
+	for _, ca := range columnACLs {
+		if ca.Name == "" {
+			return nil, fmt.Errorf("column acls must have a name")
+		}
+		if names[ca.Name] {
+			return nil, fmt.Errorf("duplicate column acl: %s", ca.Name)
+		}
+		names[ca.Name] = true
+		if len(ca.TableNamesOrPrefixes) == 0 {
+			return nil, fmt.Errorf("column acl %s: no tables", ca.Name)
+		}
+		for _, name := range ca.TableNamesOrPrefixes {
+			if strings.Contains(strings.TrimSuffix(name, "%"), "%") {
+				return nil, fmt.Errorf("column acl %s: got: %s, '%%' means this entry is a prefix and should not appear in the middle of name or prefix", ca.Name, name)
+			}
+		}
+		if len(ca.Columns) == 0 {
+			return nil, fmt.Errorf("column acl %s: no columns", ca.Name)
+		}
+		columns := make([]string, 0, len(ca.Columns))
+		for _, column := range ca.Columns {
+			if column == "" {
+				return nil, fmt.Errorf("column acl %s: empty column name", ca.Name)
+			}
+			columns = append(columns, strings.ToLower(column))
+		}
+		readers, err := newACL(ca.Readers)
+		if err != nil {
+			return nil, err
+		}
+		var mask sqlparser.Expr
+		if ca.Mask != "" {
+			template, ok := maskTemplates[ca.Mask]
+			if !ok {
+				return nil, fmt.Errorf("column acl %s: invalid mask: %s", ca.Name, ca.Mask)
+			}
+			stmt, err := sqlparser.Parse("select " + template + " from dual")
+			if err != nil {
+				return nil, fmt.Errorf("column acl %s: %v", ca.Name, err)
+			}
+			mask = stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+		}
+		entries = append(entries, &columnACLEntry{
+			tableNamesOrPrefixes: ca.TableNamesOrPrefixes,
+			result: &ColumnACLResult{
+				ACL:     readers,
+				Name:    ca.Name,
+				Columns: columns,
+				mask:    mask,
+			},
+		})
+	}
+	return entries, nil
+}
+
+// Masked returns true if the columns are masked rather than denied.
+func (ca *ColumnACLResult) Masked() bool {
+	return ca.mask != nil
+}
+
+// MaskExpr returns the mask of a column. It returns nil if the columns
+// are denied.
+func (ca *ColumnACLResult) MaskExpr(col *sqlparser.ColName) sqlparser.Expr {
+	if ca.mask == nil {
+		return nil
+	}
+	return sqlparser.Rewrite(sqlparser.CloneExpr(ca.mask), func(cursor *sqlparser.Cursor) bool {
+		if arg, ok := cursor.Node().(sqlparser.Argument); ok && string(arg) == maskArgument {
+			cursor.Replace(sqlparser.CloneExpr(col))
+		}
+		return true
+	}, nil).(sqlparser.Expr)
+}
+
+// ColumnACLs returns the column ACLs that apply to a table.
+func ColumnACLs(table string) []*ColumnACLResult {
+	return currentTableACL.ColumnACLs(table)
+}
+
+func (tacl *tableACL) ColumnACLs(table string) []*ColumnACLResult {
+	tacl.RLock()
+	defer tacl.RUnlock()
+	var results []*ColumnACLResult
+	for _, entry := range tacl.columnACLs {
+		if tableMatches(table, entry.tableNamesOrPrefixes) {
+			results = append(results, entry.result)
+		}
+	}
+	return results
+}
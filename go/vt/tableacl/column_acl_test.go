@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tableacl
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/tableacl/simpleacl"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
+)
+
+var columnACLJSON = `{
+  "table_groups": [
+    {
+      "name": "group01",
+      "table_names_or_prefixes": ["customer%"],
+      "readers": ["app", "support"]
+    }
+  ],
+  "row_filters": [
+    {
+      "name": "tenants",
+      "table_names_or_prefixes": ["customer%"],
+      "members": ["app"],
+      "predicate": "tenant_id = 1"
+    }
+  ],
+  "column_acls": [
+    {
+      "name": "pii",
+      "table_names_or_prefixes": ["customer%"],
+      "columns": ["Email", "phone"],
+      "readers": ["support"],
+      "mask": "partial"
+    },
+    {
+      "name": "secrets",
+      "table_names_or_prefixes": ["customer"],
+      "columns": ["ssn"],
+      "readers": []
+    }
+  ]
+}`
+
+func TestInitWithColumnACLs(t *testing.T) {
+	tacl := tableACL{factory: &simpleacl.Factory{}}
+	f, err := ioutil.TempFile("", "tableacl")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(columnACLJSON)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.NoError(t, tacl.init(f.Name(), func() {}))
+
+	assert.Len(t, tacl.RowFilters("customer"), 1)
+	assert.Empty(t, tacl.ColumnACLs("orders"))
+	assert.Len(t, tacl.ColumnACLs("customer_info"), 1)
+	acls := tacl.ColumnACLs("customer")
+	require.Len(t, acls, 2)
+
+	pii := acls[0]
+	assert.Equal(t, "pii", pii.Name)
+	assert.Equal(t, []string{"email", "phone"}, pii.Columns)
+	assert.True(t, pii.IsMember(&querypb.VTGateCallerID{Username: "support"}))
+	assert.False(t, pii.IsMember(&querypb.VTGateCallerID{Username: "app"}))
+	assert.True(t, pii.Masked())
+	col := sqlparser.NewColNameWithQualifier("email", sqlparser.TableName{Name: sqlparser.NewTableIdent("c")})
+	assert.Equal(t, "concat(repeat('*', greatest(char_length(c.email) - 4, 0)), right(c.email, 4))", sqlparser.String(pii.MaskExpr(col)))
+
+	secrets := acls[1]
+	assert.False(t, secrets.IsMember(&querypb.VTGateCallerID{Username: "support"}))
+	assert.False(t, secrets.Masked())
+	assert.Nil(t, secrets.MaskExpr(col))
+}
+
+func TestColumnACLMasks(t *testing.T) {
+	col := sqlparser.NewColName("ssn")
+	for mask, want := range map[string]string{
+		MaskNull:    "null",
+		MaskHash:    "sha2(ssn, 256)",
+		MaskPartial: "concat(repeat('*', greatest(char_length(ssn) - 4, 0)), right(ssn, 4))",
+	} {
+		entries, err := loadColumnACLs([]*ColumnACL{{Name: "c", TableNamesOrPrefixes: []string{"t"}, Columns: []string{"ssn"}, Mask: mask}}, (&simpleacl.Factory{}).New)
+		require.NoError(t, err)
+		assert.Equal(t, want, sqlparser.String(entries[0].result.MaskExpr(col)), mask)
+	}
+}
+
+func TestColumnACLValidation(t *testing.T) {
+	testcases := []struct {
+		columnACL *ColumnACL
+		err       string
+	}{{
+		columnACL: &ColumnACL{TableNamesOrPrefixes: []string{"t"}, Columns: []string{"c"}},
+		err:       "column acls must have a name",
+	}, {
+		columnACL: &ColumnACL{Name: "c", Columns: []string{"c"}},
+		err:       "column acl c: no tables",
+	}, {
+		columnACL: &ColumnACL{Name: "c", TableNamesOrPrefixes: []string{"t%t"}, Columns: []string{"c"}},
+		err:       "column acl c: got: t%t, '%' means this entry is a prefix and should not appear in the middle of name or prefix",
+	}, {
+		columnACL: &ColumnACL{Name: "c", TableNamesOrPrefixes: []string{"t"}},
+		err:       "column acl c: no columns",
+	}, {
+		columnACL: &ColumnACL{Name: "c", TableNamesOrPrefixes: []string{"t"}, Columns: []string{""}},
+		err:       "column acl c: empty column name",
+	}, {
+		columnACL: &ColumnACL{Name: "c", TableNamesOrPrefixes: []string{"t"}, Columns: []string{"c"}, Mask: "md5"},
+		err:       "column acl c: invalid mask: md5",
+	}, {
+		columnACL: &ColumnACL{Name: "c", TableNamesOrPrefixes: []string{"t%"}, Columns: []string{"c"}, Readers: []string{"r"}, Mask: MaskHash},
+	}}
+	for _, tcase := range testcases {
+		tacl := tableACL{factory: &simpleacl.Factory{}}
+		err := tacl.set(&tableaclpb.Config{}, &Restrictions{ColumnACLs: []*ColumnACL{tcase.columnACL}})
+		if tcase.err == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		assert.EqualError(t, err, tcase.err)
+	}
+
+	tacl := tableACL{factory: &simpleacl.Factory{}}
+	columnACL := &ColumnACL{Name: "c", TableNamesOrPrefixes: []string{"t"}, Columns: []string{"c"}}
+	assert.EqualError(t, tacl.set(&tableaclpb.Config{}, &Restrictions{ColumnACLs: []*ColumnACL{columnACL, columnACL}}), "duplicate column acl: c")
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tableacl
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/tableacl/acl"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Sources of the values of the bind variables of row filter predicates.
+const (
+	RowFilterPrincipal    = "principal"
+	RowFilterComponent    = "component"
+	RowFilterSubcomponent = "subcomponent"
+	RowFilterUsername     = "username"
+	// RowFilterGroupPrefix is followed by a prefix, and selects the rest
+	// of the first group of the immediate caller that has the prefix.
+	RowFilterGroupPrefix = "group:"
+)
+
+// RowFilter restricts the rows of a set of tables that its members can
+// access to the rows for which Predicate is true. Members are users or
+// groups of the immediate caller, like the readers of a table group.
+// Predicate is a boolean expression on the columns of the tables, like
+// "tenant_id = :tenant". The values of its bind variables come from the
+// caller IDs, as specified by BindVars, which maps their names to
+// principal, component, subcomponent, username or group:<prefix>.
+//
+// Row filters can only be set in the json format of the config file:
+//
+// {
+//   "table_groups": [...],
+//   "row_filters": [
+//     {
+//       "name": "tenants",
+//       "table_names_or_prefixes": ["orders", "customer%"],
+//       "members": ["tenant_apps"],
+//       "predicate": "tenant_id = :tenant",
+//       "bind_vars": {"tenant": "group:tenant-"}
+//     }
+//   ]
+// }
+type RowFilter struct {
+	Name                 string            `json:"name"`
+	TableNamesOrPrefixes []string          `json:"table_names_or_prefixes"`
+	Members              []string          `json:"members"`
+	Predicate            string            `json:"predicate"`
+	BindVars             map[string]string `json:"bind_vars,omitempty"`
+}
+
+// RowFilterResult is a row filter that applies to a table.
+// Its Predicate is unqualified, and its bind variables are
+// renamed so that they don't clash with those of the query.
+type RowFilterResult struct {
+	acl.ACL
+	Name      string
+	Predicate sqlparser.Expr
+	// bindVars maps the renamed bind variables to their source.
+	bindVars map[string]string
+}
+
+type rowFilterEntry struct {
+	tableNamesOrPrefixes []string
+	result               *RowFilterResult
+}
+
+// RowFilterBindVar returns the name of a bind variable of a row filter
+// predicate in the queries the filter is applied to.
+func RowFilterBindVar(filter, name string) string {
+	return fmt.Sprintf("#rowfilter_%s_%s", filter, name)
+}
+
+// loadRowFilters validates the row filters and builds their entries.
+func loadRowFilters(rowFilters []*RowFilter, newACL func([]string) (acl.ACL, error)) ([]*rowFilterEntry, error) {
+	var entries []*rowFilterEntry
+	names := make(map[string]bool)
+	for _, rf := range rowFilters {
+		if rf.Name == "" {
+			return nil, fmt.Errorf("row filters must have a name")
+		}
+		if names[rf.Name] {
+			return nil, fmt.Errorf("duplicate row filter: %s", rf.Name)
+		}
+		names[rf.Name] = true
+		if len(rf.TableNamesOrPrefixes) == 0 {
+			return nil, fmt.Errorf("row filter %s: no tables", rf.Name)
+		}
+		for _, name := range rf.TableNamesOrPrefixes {
+			if strings.Contains(strings.TrimSuffix(name, "%"), "%") {
+				return nil, fmt.Errorf("row filter %s: got: %s, '%%' means this entry is a prefix and should not appear in the middle of name or prefix", rf.Name, name)
+			}
+		}
+		if len(rf.Members) == 0 {
+			return nil, fmt.Errorf("row filter %s: no members", rf.Name)
+		}
+		members, err := newACL(rf.Members)
+		if err != nil {
+			return nil, err
+		}
+		predicate, bindVars, err := parseRowFilterPredicate(rf)
+		if err != nil {
+			return nil, fmt.Errorf("row filter %s: %v", rf.Name, err)
+		}
+		entries = append(entries, &rowFilterEntry{
+			tableNamesOrPrefixes: rf.TableNamesOrPrefixes,
+			result: &RowFilterResult{
+				ACL:       members,
+				Name:      rf.Name,
+				Predicate: predicate,
+				bindVars:  bindVars,
+			},
+		})
+	}
+	return entries, nil
+}
+
+// parseRowFilterPredicate parses the predicate of a row filter and renames its bind variables.
+func parseRowFilterPredicate(rf *RowFilter) (sqlparser.Expr, map[string]string, error) {
+	stmt, err := sqlparser.Parse("select 1 from dual where " + rf.Predicate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid predicate: %v", err)
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where == nil || sel.GroupBy != nil || sel.Having != nil || sel.OrderBy != nil || sel.Limit != nil || sel.Lock != sqlparser.NoLock {
+		return nil, nil, fmt.Errorf("invalid predicate: %s", rf.Predicate)
+	}
+	for name, source := range rf.BindVars {
+		switch {
+		case source == RowFilterPrincipal, source == RowFilterComponent, source == RowFilterSubcomponent, source == RowFilterUsername:
+		case strings.HasPrefix(source, RowFilterGroupPrefix) && len(source) > len(RowFilterGroupPrefix):
+		default:
+			return nil, nil, fmt.Errorf("invalid source for bind variable %s: %s", name, source)
+		}
+	}
+
+	bindVars := make(map[string]string)
+	var rewriteErr error
+	predicate := sqlparser.Rewrite(sel.Where.Expr, func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case sqlparser.Argument:
+			source, ok := rf.BindVars[string(node)]
+			if !ok {
+				rewriteErr = fmt.Errorf("no source for bind variable %s", string(node))
+				return false
+			}
+			name := RowFilterBindVar(rf.Name, string(node))
+			bindVars[name] = source
+			cursor.Replace(sqlparser.NewArgument(name))
+		case *sqlparser.ColName:
+			if !node.Qualifier.IsEmpty() {
+				rewriteErr = fmt.Errorf("column %s must not be qualified", sqlparser.String(node))
+				return false
+			}
+		case *sqlparser.Subquery:
+			rewriteErr = fmt.Errorf("subqueries are not allowed: %s", rf.Predicate)
+			return false
+		case sqlparser.ListArg:
+			rewriteErr = fmt.Errorf("list bind variables are not allowed: %s", rf.Predicate)
+			return false
+		}
+		return true
+	}, nil)
+	if rewriteErr != nil {
+		return nil, nil, rewriteErr
+	}
+	return predicate.(sqlparser.Expr), bindVars, nil
+}
+
+// BindVariables returns the values of the bind variables of the predicate for the callers.
+func (rf *RowFilterResult) BindVariables(effective *vtrpcpb.CallerID, immediate *querypb.VTGateCallerID) (map[string]*querypb.BindVariable, error) {
+	bindVars := make(map[string]*querypb.BindVariable, len(rf.bindVars))
+	for name, source := range rf.bindVars {
+		var value string
+		switch source {
+		case RowFilterPrincipal:
+			value = callerid.GetPrincipal(effective)
+		case RowFilterComponent:
+			value = callerid.GetComponent(effective)
+		case RowFilterSubcomponent:
+			value = callerid.GetSubcomponent(effective)
+		case RowFilterUsername:
+			value = callerid.GetUsername(immediate)
+		default:
+			prefix := strings.TrimPrefix(source, RowFilterGroupPrefix)
+			for _, group := range immediate.GetGroups() {
+				if strings.HasPrefix(group, prefix) {
+					value = strings.TrimPrefix(group, prefix)
+					break
+				}
+			}
+		}
+		if value == "" {
+			return nil, fmt.Errorf("caller has no %s", source)
+		}
+		bindVars[name] = sqltypes.StringBindVariable(value)
+	}
+	return bindVars, nil
+}
+
+// Restrictions are the row filters and the column ACLs of a config.
+type Restrictions struct {
+	RowFilters []*RowFilter `json:"row_filters,omitempty"`
+	ColumnACLs []*ColumnACL `json:"column_acls,omitempty"`
+}
+
+// splitRestrictions separates the restrictions from the rest of a json
+// config, which can then be unmarshaled as a tableaclpb.Config.
+func splitRestrictions(data []byte) ([]byte, *Restrictions, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		// Let the caller report the error.
+		return data, nil, nil
+	}
+	restrictions := &Restrictions{}
+	for key, value := range map[string]interface{}{
+		"row_filters": &restrictions.RowFilters,
+		"column_acls": &restrictions.ColumnACLs,
+	} {
+		raw, ok := fields[key]
+		if !ok {
+			continue
+		}
+		if err := json2.Unmarshal(raw, value); err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", key, err)
+		}
+		delete(fields, key)
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, restrictions, nil
+}
+
+// tableMatches returns true if the table is one of the names or prefixes.
+func tableMatches(table string, tableNamesOrPrefixes []string) bool {
+	for _, val := range tableNamesOrPrefixes {
+		if table == val || (strings.HasSuffix(val, "%") && strings.HasPrefix(table, val[:len(val)-1])) {
+			return true
+		}
+	}
+	return false
+}
+
+// RowFilters returns the row filters that apply to a table.
+func RowFilters(table string) []*RowFilterResult {
+	return currentTableACL.RowFilters(table)
+}
+
+func (tacl *tableACL) RowFilters(table string) []*RowFilterResult {
+	tacl.RLock()
+	defer tacl.RUnlock()
+	var results []*RowFilterResult
+	for _, entry := range tacl.rowFilters {
+		if tableMatches(table, entry.tableNamesOrPrefixes) {
+			results = append(results, entry.result)
+		}
+	}
+	return results
+}
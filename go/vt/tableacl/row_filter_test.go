@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tableacl
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/tableacl/simpleacl"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
+)
+
+var rowFilterJSON = `{
+  "table_groups": [
+    {
+      "name": "group01",
+      "table_names_or_prefixes": ["orders", "customer%"],
+      "readers": ["tenant_apps"]
+    }
+  ],
+  "row_filters": [
+    {
+      "name": "tenants",
+      "table_names_or_prefixes": ["orders", "customer%"],
+      "members": ["tenant_apps"],
+      "predicate": "tenant_id = :tenant and region = :region",
+      "bind_vars": {"tenant": "group:tenant-", "region": "component"}
+    }
+  ]
+}`
+
+func TestInitWithRowFilters(t *testing.T) {
+	tacl := tableACL{factory: &simpleacl.Factory{}}
+	f, err := ioutil.TempFile("", "tableacl")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(rowFilterJSON)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.NoError(t, tacl.init(f.Name(), func() {}))
+
+	assert.Empty(t, tacl.RowFilters("users"))
+	filters := tacl.RowFilters("customer_info")
+	require.Len(t, filters, 1)
+	rf := filters[0]
+	assert.Equal(t, "tenants", rf.Name)
+	assert.Equal(t, "tenant_id = :#rowfilter_tenants_tenant and region = :#rowfilter_tenants_region", sqlparser.String(rf.Predicate))
+
+	immediate := &querypb.VTGateCallerID{Username: "app", Groups: []string{"tenant_apps", "tenant-42"}}
+	assert.True(t, rf.IsMember(immediate))
+	assert.False(t, rf.IsMember(&querypb.VTGateCallerID{Username: "admin"}))
+
+	bindVars, err := rf.BindVariables(callerid.NewEffectiveCallerID("principal", "us-east", ""), immediate)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*querypb.BindVariable{
+		"#rowfilter_tenants_tenant": sqltypes.StringBindVariable("42"),
+		"#rowfilter_tenants_region": sqltypes.StringBindVariable("us-east"),
+	}, bindVars)
+
+	// Callers without a value for a bind variable are denied.
+	_, err = rf.BindVariables(callerid.NewEffectiveCallerID("principal", "us-east", ""), &querypb.VTGateCallerID{Username: "app", Groups: []string{"tenant_apps"}})
+	assert.EqualError(t, err, "caller has no group:tenant-")
+}
+
+func TestRowFilterValidation(t *testing.T) {
+	testcases := []struct {
+		filter *RowFilter
+		err    string
+	}{{
+		filter: &RowFilter{TableNamesOrPrefixes: []string{"t"}, Members: []string{"m"}, Predicate: "a = 1"},
+		err:    "row filters must have a name",
+	}, {
+		filter: &RowFilter{Name: "f", Members: []string{"m"}, Predicate: "a = 1"},
+		err:    "row filter f: no tables",
+	}, {
+		filter: &RowFilter{Name: "f", TableNamesOrPrefixes: []string{"t%t"}, Members: []string{"m"}, Predicate: "a = 1"},
+		err:    "row filter f: got: t%t, '%' means this entry is a prefix and should not appear in the middle of name or prefix",
+	}, {
+		filter: &RowFilter{Name: "f", TableNamesOrPrefixes: []string{"t"}, Predicate: "a = 1"},
+		err:    "row filter f: no members",
+	}, {
+		filter: &RowFilter{Name: "f", TableNamesOrPrefixes: []string{"t"}, Members: []string{"m"}, Predicate: "a = 1 limit 1"},
+		err:    "row filter f: invalid predicate: a = 1 limit 1",
+	}, {
+		filter: &RowFilter{Name: "f", TableNamesOrPrefixes: []string{"t"}, Members: []string{"m"}, Predicate: "a = :a"},
+		err:    "row filter f: no source for bind variable a",
+	}, {
+		filter: &RowFilter{Name: "f", TableNamesOrPrefixes: []string{"t"}, Members: []string{"m"}, Predicate: "a = :a", BindVars: map[string]string{"a": "group:"}},
+		err:    "row filter f: invalid source for bind variable a: group:",
+	}, {
+		filter: &RowFilter{Name: "f", TableNamesOrPrefixes: []string{"t"}, Members: []string{"m"}, Predicate: "t.a = 1"},
+		err:    "row filter f: column t.a must not be qualified",
+	}, {
+		filter: &RowFilter{Name: "f", TableNamesOrPrefixes: []string{"t"}, Members: []string{"m"}, Predicate: "a in (select a from u)"},
+		err:    "row filter f: subqueries are not allowed: a in (select a from u)",
+	}, {
+		filter: &RowFilter{Name: "f", TableNamesOrPrefixes: []string{"t"}, Members: []string{"m"}, Predicate: "a = :a", BindVars: map[string]string{"a": "username"}},
+	}}
+	for _, tcase := range testcases {
+		tacl := tableACL{factory: &simpleacl.Factory{}}
+		err := tacl.set(&tableaclpb.Config{}, &Restrictions{RowFilters: []*RowFilter{tcase.filter}})
+		if tcase.err == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		assert.EqualError(t, err, tcase.err)
+	}
+
+	tacl := tableACL{factory: &simpleacl.Factory{}}
+	filter := &RowFilter{Name: "f", TableNamesOrPrefixes: []string{"t"}, Members: []string{"m"}, Predicate: "a = 1"}
+	assert.EqualError(t, tacl.set(&tableaclpb.Config{}, &Restrictions{RowFilters: []*RowFilter{filter, filter}}), "duplicate row filter: f")
+}
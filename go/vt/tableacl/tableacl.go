@@ -72,8 +72,10 @@ var defaultACL string
 type tableACL struct {
 	// mutex protects entries, config, and callback
 	sync.RWMutex
-	entries aclEntries
-	config  *tableaclpb.Config
+	entries    aclEntries
+	rowFilters []*rowFilterEntry
+	columnACLs []*columnACLEntry
+	config     *tableaclpb.Config
 	// callback is executed on successful reload.
 	callback func()
 	// ACL Factory override for testing
@@ -98,6 +100,9 @@ var currentTableACL tableACL
 //     }
 //   ]
 // }
+//
+// The json format can also contain row filters and column ACLs,
+// see RowFilter and ColumnACL.
 func Init(configFile string, aclCB func()) error {
 	return currentTableACL.init(configFile, aclCB)
 }
@@ -113,14 +118,22 @@ func (tacl *tableACL) init(configFile string, aclCB func()) error {
 		return err
 	}
 	config := &tableaclpb.Config{}
+	var restrictions *Restrictions
 	if err := proto.Unmarshal(data, config); err != nil {
 		// try to parse tableacl as json file
-		if jsonErr := json2.Unmarshal(data, config); jsonErr != nil {
+		var jsonData []byte
+		var restrictionsErr error
+		jsonData, restrictions, restrictionsErr = splitRestrictions(data)
+		if restrictionsErr != nil {
+			log.Infof("unable to parse the restrictions of tableACL config file: %v", restrictionsErr)
+			return fmt.Errorf("unable to unmarshal Table ACL restrictions: %v", restrictionsErr)
+		}
+		if jsonErr := json2.Unmarshal(jsonData, config); jsonErr != nil {
 			log.Infof("unable to parse tableACL config file as a protobuf or json file.  protobuf err: %v  json err: %v", err, jsonErr)
 			return fmt.Errorf("unable to unmarshal Table ACL data: %s", data)
 		}
 	}
-	return tacl.Set(config)
+	return tacl.set(config, restrictions)
 }
 
 func (tacl *tableACL) SetCallback(callback func()) {
@@ -134,6 +147,11 @@ func InitFromProto(config *tableaclpb.Config) error {
 	return currentTableACL.Set(config)
 }
 
+// InitWithRestrictions inits table ACLs from a proto, row filters and column ACLs.
+func InitWithRestrictions(config *tableaclpb.Config, restrictions *Restrictions) error {
+	return currentTableACL.set(config, restrictions)
+}
+
 // load loads configurations from a proto-defined Config
 // If err is nil, then entries is guaranteed to be non-nil (though possibly empty).
 func load(config *tableaclpb.Config, newACL func([]string) (acl.ACL, error)) (entries aclEntries, err error) {
@@ -178,6 +196,10 @@ func (tacl *tableACL) aclFactory() (acl.Factory, error) {
 }
 
 func (tacl *tableACL) Set(config *tableaclpb.Config) error {
+	return tacl.set(config, nil)
+}
+
+func (tacl *tableACL) set(config *tableaclpb.Config, restrictions *Restrictions) error {
 	factory, err := tacl.aclFactory()
 	if err != nil {
 		return err
@@ -186,8 +208,21 @@ func (tacl *tableACL) Set(config *tableaclpb.Config) error {
 	if err != nil {
 		return err
 	}
+	if restrictions == nil {
+		restrictions = &Restrictions{}
+	}
+	rowFilterEntries, err := loadRowFilters(restrictions.RowFilters, factory.New)
+	if err != nil {
+		return err
+	}
+	columnACLEntries, err := loadColumnACLs(restrictions.ColumnACLs, factory.New)
+	if err != nil {
+		return err
+	}
 	tacl.Lock()
 	tacl.entries = entries
+	tacl.rowFilters = rowFilterEntries
+	tacl.columnACLs = columnACLEntries
 	tacl.config = proto.Clone(config).(*tableaclpb.Config)
 	callback := tacl.callback
 	tacl.Unlock()
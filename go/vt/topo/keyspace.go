@@ -293,6 +293,12 @@ func (ts *Server) DeleteKeyspace(ctx context.Context, keyspace string) error {
 	if err := ts.DeleteVSchema(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
 		return err
 	}
+	if err := ts.SaveThrottlerConfig(ctx, keyspace, nil); err != nil {
+		return err
+	}
+	if err := ts.SaveSchemaPolicy(ctx, keyspace, nil); err != nil {
+		return err
+	}
 
 	event.Dispatch(&events.KeyspaceChange{
 		KeyspaceName: keyspace,
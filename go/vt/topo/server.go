@@ -77,6 +77,8 @@ const (
 	SrvKeyspaceFile      = "SrvKeyspace"
 	RoutingRulesFile     = "RoutingRules"
 	ExternalClustersFile = "ExternalClusters"
+	ThrottlerConfigFile  = "ThrottlerConfig"
+	SchemaPolicyFile     = "SchemaPolicy"
 )
 
 // Path for all object types.
@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+)
+
+// This file provides the utility methods to save / retrieve the tablet
+// throttler configuration of a keyspace in the topology global cell.
+// The content is owned by the tablet throttler and is opaque to the topo server.
+
+// GetThrottlerConfig returns the tablet throttler configuration of the keyspace.
+// It returns a NoNode error if the keyspace has no throttler configuration.
+func (ts *Server) GetThrottlerConfig(ctx context.Context, keyspace string) ([]byte, error) {
+	nodePath := path.Join(KeyspacesPath, keyspace, ThrottlerConfigFile)
+	data, _, err := ts.globalCell.Get(ctx, nodePath)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// SaveThrottlerConfig saves the tablet throttler configuration of the keyspace.
+// If the configuration is empty, it is removed.
+func (ts *Server) SaveThrottlerConfig(ctx context.Context, keyspace string, data []byte) error {
+	nodePath := path.Join(KeyspacesPath, keyspace, ThrottlerConfigFile)
+	if len(data) == 0 {
+		if err := ts.globalCell.Delete(ctx, nodePath, nil); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+		return nil
+	}
+	_, err := ts.globalCell.Update(ctx, nodePath, data, nil)
+	return err
+}
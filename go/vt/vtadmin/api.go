@@ -135,6 +135,7 @@ func NewAPI(clusters []*cluster.Cluster, opts Options) *API {
 
 	experimentalRouter := router.PathPrefix("/experimental").Subrouter()
 	experimentalRouter.HandleFunc("/tablet/{tablet}/debug/vars", httpAPI.Adapt(experimental.TabletDebugVarsPassthrough)).Name("API.TabletDebugVarsPassthrough")
+	experimentalRouter.HandleFunc("/tablet/{tablet}/online_ddl/progress", httpAPI.Adapt(experimental.TabletOnlineDDLProgress)).Name("API.TabletOnlineDDLProgress")
 
 	if !opts.HTTPOpts.DisableDebug {
 		// Due to the way net/http/pprof insists on registering its handlers, we
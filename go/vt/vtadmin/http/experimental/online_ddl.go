@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experimental
+
+import (
+	"context"
+
+	vtadminhttp "vitess.io/vitess/go/vt/vtadmin/http"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+// onlineDDLProgressVars maps the tablet debug vars which describe running online DDL
+// migrations to the field names under which they are reported.
+var onlineDDLProgressVars = map[string]string{
+	"OnlineDDLMigrationProgress":               "progress",
+	"OnlineDDLMigrationETASeconds":             "eta_seconds",
+	"OnlineDDLMigrationVReplicationLagSeconds": "vreplication_lag_seconds",
+}
+
+// TabletOnlineDDLProgress reports the progress of online DDL migrations running
+// on a tablet, keyed by migration UUID, as extracted from the tablet's
+// /debug/vars, after looking up the tablet via VTAdmin's GetTablet rpc.
+func TabletOnlineDDLProgress(ctx context.Context, r vtadminhttp.Request, api *vtadminhttp.API) *vtadminhttp.JSONResponse {
+	vars := r.Vars()
+
+	tablet, err := api.Server().GetTablet(ctx, &vtadminpb.GetTabletRequest{
+		Alias:      vars["tablet"],
+		ClusterIds: r.URL.Query()["cluster"],
+	})
+
+	if err != nil {
+		return vtadminhttp.NewJSONResponse(nil, err)
+	}
+
+	debugVars, err := getDebugVars(ctx, api, tablet)
+	if err != nil {
+		return vtadminhttp.NewJSONResponse(nil, err)
+	}
+
+	return vtadminhttp.NewJSONResponse(onlineDDLProgressFromDebugVars(debugVars), nil)
+}
+
+func onlineDDLProgressFromDebugVars(debugVars map[string]interface{}) map[string]map[string]interface{} {
+	progress := map[string]map[string]interface{}{}
+
+	for varName, field := range onlineDDLProgressVars {
+		values, ok := debugVars[varName].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for uuid, value := range values {
+			if _, ok := progress[uuid]; !ok {
+				progress[uuid] = map[string]interface{}{}
+			}
+
+			progress[uuid][field] = value
+		}
+	}
+
+	return progress
+}
@@ -631,6 +631,14 @@ func (itmc *internalTabletManagerClient) GetPermissions(ctx context.Context, tab
 	return t.tm.GetPermissions(ctx)
 }
 
+func (itmc *internalTabletManagerClient) GetSchemaVersions(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GetSchemaVersionsRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.GetSchemaVersions(ctx, req)
+}
+
 func (itmc *internalTabletManagerClient) SetReadOnly(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
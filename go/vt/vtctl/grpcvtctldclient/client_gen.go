@@ -136,6 +136,15 @@ func (client *gRPCVtctldClient) DeleteTablets(ctx context.Context, in *vtctldata
 	return client.c.DeleteTablets(ctx, in, opts...)
 }
 
+// DiffSchemaVersions is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) DiffSchemaVersions(ctx context.Context, in *vtctldatapb.DiffSchemaVersionsRequest, opts ...grpc.CallOption) (*vtctldatapb.DiffSchemaVersionsResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.DiffSchemaVersions(ctx, in, opts...)
+}
+
 // EmergencyReparentShard is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) EmergencyReparentShard(ctx context.Context, in *vtctldatapb.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldatapb.EmergencyReparentShardResponse, error) {
 	if client.c == nil {
@@ -226,6 +235,24 @@ func (client *gRPCVtctldClient) GetSchema(ctx context.Context, in *vtctldatapb.G
 	return client.c.GetSchema(ctx, in, opts...)
 }
 
+// GetSchemaAtVersion is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetSchemaAtVersion(ctx context.Context, in *vtctldatapb.GetSchemaAtVersionRequest, opts ...grpc.CallOption) (*vtctldatapb.GetSchemaAtVersionResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetSchemaAtVersion(ctx, in, opts...)
+}
+
+// GetSchemaVersions is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetSchemaVersions(ctx context.Context, in *vtctldatapb.GetSchemaVersionsRequest, opts ...grpc.CallOption) (*vtctldatapb.GetSchemaVersionsResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetSchemaVersions(ctx, in, opts...)
+}
+
 // GetShard is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) GetShard(ctx context.Context, in *vtctldatapb.GetShardRequest, opts ...grpc.CallOption) (*vtctldatapb.GetShardResponse, error) {
 	if client.c == nil {
@@ -405,3 +432,12 @@ func (client *gRPCVtctldClient) UpdateCellsAlias(ctx context.Context, in *vtctld
 
 	return client.c.UpdateCellsAlias(ctx, in, opts...)
 }
+
+// VerifyLookupVindex is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) VerifyLookupVindex(ctx context.Context, in *vtctldatapb.VerifyLookupVindexRequest, opts ...grpc.CallOption) (*vtctldatapb.VerifyLookupVindexResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.VerifyLookupVindex(ctx, in, opts...)
+}
@@ -38,6 +38,7 @@ import (
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/mysqlctl/mysqlctlproto"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -558,6 +559,49 @@ func (s *VtctldServer) DeleteTablets(ctx context.Context, req *vtctldatapb.Delet
 	return &vtctldatapb.DeleteTabletsResponse{}, nil
 }
 
+// DiffSchemaVersions is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DiffSchemaVersions(ctx context.Context, req *vtctldatapb.DiffSchemaVersionsRequest) (*vtctldatapb.DiffSchemaVersionsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.DiffSchemaVersions")
+	defer span.Finish()
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+	span.Annotate("from_id", req.FromId)
+	span.Annotate("to_id", req.ToId)
+
+	tablet, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, fmt.Errorf("GetTablet(%v) failed: %w", req.TabletAlias, err)
+	}
+
+	versions, err := s.tmc.GetSchemaVersions(ctx, tablet.Tablet, &tabletmanagerdatapb.GetSchemaVersionsRequest{
+		Ids:           []int64{req.FromId, req.ToId},
+		IncludeSchema: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetSchemaVersions(%v) failed: %w", tablet.Tablet, err)
+	}
+
+	var from, to *tabletmanagerdatapb.SchemaVersion
+	for _, version := range versions {
+		if version.Id == req.FromId {
+			from = version
+		}
+		if version.Id == req.ToId {
+			to = version
+		}
+	}
+	if from == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "schema version %d not found", req.FromId)
+	}
+	if to == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "schema version %d not found", req.ToId)
+	}
+
+	return &vtctldatapb.DiffSchemaVersionsResponse{
+		Diffs: tmutils.DiffMinimalSchemas(from.Schema, to.Schema),
+	}, nil
+}
+
 // EmergencyReparentShard is part of the vtctldservicepb.VtctldServer interface.
 func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldatapb.EmergencyReparentShardRequest) (*vtctldatapb.EmergencyReparentShardResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.EmergencyReparentShard")
@@ -871,6 +915,63 @@ func (s *VtctldServer) GetSchema(ctx context.Context, req *vtctldatapb.GetSchema
 	}, nil
 }
 
+// GetSchemaAtVersion is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetSchemaAtVersion(ctx context.Context, req *vtctldatapb.GetSchemaAtVersionRequest) (*vtctldatapb.GetSchemaAtVersionResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetSchemaAtVersion")
+	defer span.Finish()
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+	span.Annotate("position", req.Position)
+
+	if (req.Position == "") == (req.Time == nil) {
+		return nil, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "must pass exactly one of req.Position and req.Time")
+	}
+
+	tablet, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, fmt.Errorf("GetTablet(%v) failed: %w", req.TabletAlias, err)
+	}
+
+	versions, err := s.tmc.GetSchemaVersions(ctx, tablet.Tablet, &tabletmanagerdatapb.GetSchemaVersionsRequest{
+		Position:      req.Position,
+		Time:          req.Time,
+		IncludeSchema: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetSchemaVersions(%v) failed: %w", tablet.Tablet, err)
+	}
+
+	if len(versions) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "no schema version found at or before the requested position or time")
+	}
+
+	return &vtctldatapb.GetSchemaAtVersionResponse{
+		SchemaVersion: versions[0],
+	}, nil
+}
+
+// GetSchemaVersions is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetSchemaVersions(ctx context.Context, req *vtctldatapb.GetSchemaVersionsRequest) (*vtctldatapb.GetSchemaVersionsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetSchemaVersions")
+	defer span.Finish()
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+
+	tablet, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, fmt.Errorf("GetTablet(%v) failed: %w", req.TabletAlias, err)
+	}
+
+	versions, err := s.tmc.GetSchemaVersions(ctx, tablet.Tablet, &tabletmanagerdatapb.GetSchemaVersionsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("GetSchemaVersions(%v) failed: %w", tablet.Tablet, err)
+	}
+
+	return &vtctldatapb.GetSchemaVersionsResponse{
+		SchemaVersions: versions,
+	}, nil
+}
+
 // GetShard is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) GetShard(ctx context.Context, req *vtctldatapb.GetShardRequest) (*vtctldatapb.GetShardResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetShard")
@@ -1982,6 +2083,18 @@ func (s *VtctldServer) UpdateCellsAlias(ctx context.Context, req *vtctldatapb.Up
 	}, nil
 }
 
+// VerifyLookupVindex is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VerifyLookupVindex(ctx context.Context, req *vtctldatapb.VerifyLookupVindexRequest) (*vtctldatapb.VerifyLookupVindexResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VerifyLookupVindex")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("vindex", req.Vindex)
+	span.Annotate("repair", req.Repair)
+
+	return s.ws.VerifyLookupVindex(ctx, req)
+}
+
 // StartServer registers a VtctldServer for RPCs on the given gRPC server.
 func StartServer(s *grpc.Server, ts *topo.Server) {
 	vtctlservicepb.RegisterVtctldServer(s, NewVtctldServer(ts))
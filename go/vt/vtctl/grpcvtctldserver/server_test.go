@@ -38,6 +38,7 @@ import (
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver/testutil"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
@@ -2480,6 +2481,103 @@ func TestDeleteTablets(t *testing.T) {
 	}
 }
 
+func TestDiffSchemaVersions(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	alias := &topodatapb.TabletAlias{
+		Cell: "zone1",
+		Uid:  100,
+	}
+	testutil.AddTablet(ctx, t, ts, &topodatapb.Tablet{
+		Alias: alias,
+	}, nil)
+
+	tmc := testutil.TabletManagerClient{
+		GetSchemaVersionsResults: map[string]struct {
+			Versions []*tabletmanagerdatapb.SchemaVersion
+			Error    error
+		}{
+			"zone1-0000000100": {
+				Versions: []*tabletmanagerdatapb.SchemaVersion{
+					{
+						Id: 1,
+						Schema: &binlogdatapb.MinimalSchema{
+							Tables: []*binlogdatapb.MinimalTable{{Name: "t1"}},
+						},
+					},
+					{
+						Id:  2,
+						Ddl: "create table t2 (id int)",
+						Schema: &binlogdatapb.MinimalSchema{
+							Tables: []*binlogdatapb.MinimalTable{{Name: "t1"}, {Name: "t2"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.DiffSchemaVersionsRequest
+		expected  *vtctldatapb.DiffSchemaVersionsResponse
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			req: &vtctldatapb.DiffSchemaVersionsRequest{
+				TabletAlias: alias,
+				FromId:      1,
+				ToId:        2,
+			},
+			expected: &vtctldatapb.DiffSchemaVersionsResponse{
+				Diffs: []*tabletmanagerdatapb.SchemaVersionTableDiff{
+					{Table: "t2", Change: "added"},
+				},
+			},
+		},
+		{
+			name: "unknown version",
+			req: &vtctldatapb.DiffSchemaVersionsRequest{
+				TabletAlias: alias,
+				FromId:      1,
+				ToId:        3,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "no tablet",
+			req: &vtctldatapb.DiffSchemaVersionsRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  404,
+				},
+				FromId: 1,
+				ToId:   2,
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := vtctld.DiffSchemaVersions(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
 func TestEmergencyReparentShard(t *testing.T) {
 	t.Parallel()
 
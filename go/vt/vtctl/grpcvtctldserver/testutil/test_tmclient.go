@@ -141,6 +141,12 @@ type TabletManagerClient struct {
 		Schema *tabletmanagerdatapb.SchemaDefinition
 		Error  error
 	}
+	// keyed by tablet alias. Requests for specific ids are answered with the
+	// matching versions of the result.
+	GetSchemaVersionsResults map[string]struct {
+		Versions []*tabletmanagerdatapb.SchemaVersion
+		Error    error
+	}
 	// keyed by tablet alias.
 	MasterPositionDelays map[string]time.Duration
 	// keyed by tablet alias.
@@ -276,6 +282,40 @@ func (fake *TabletManagerClient) GetSchema(ctx context.Context, tablet *topodata
 	return nil, fmt.Errorf("%w: no schemas for %s", assert.AnError, key)
 }
 
+// GetSchemaVersions is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) GetSchemaVersions(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GetSchemaVersionsRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	if fake.GetSchemaVersionsResults == nil {
+		return nil, assert.AnError
+	}
+
+	if tablet.Alias == nil {
+		return nil, assert.AnError
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+
+	result, ok := fake.GetSchemaVersionsResults[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: no schema versions for %s", assert.AnError, key)
+	}
+
+	if result.Error != nil || len(req.Ids) == 0 {
+		return result.Versions, result.Error
+	}
+
+	var versions []*tabletmanagerdatapb.SchemaVersion
+	for _, version := range result.Versions {
+		for _, id := range req.Ids {
+			if version.Id == id {
+				versions = append(versions, version)
+				break
+			}
+		}
+	}
+
+	return versions, nil
+}
+
 // MasterPosition is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
 	if fake.MasterPositionResults == nil {
@@ -0,0 +1,243 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strconv"
+
+	"vitess.io/vitess/go/textutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/config"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+const tabletThrottlerGroupName = "Tablet Throttler"
+
+// This file contains the commands to configure the tablet throttler,
+// which is used by online DDL, table GC and vreplication. The configuration is
+// stored in the topo, and the tablets pick up changes without a restart.
+
+func init() {
+	addCommandGroup(tabletThrottlerGroupName)
+
+	addCommand(tabletThrottlerGroupName, command{
+		"GetThrottlerMetrics",
+		commandGetThrottlerMetrics,
+		"<keyspace>",
+		"Displays the tablet throttler configuration of the keyspace: its metrics, and the metrics, priorities and quotas of the apps."})
+	addCommand(tabletThrottlerGroupName, command{
+		"UpdateThrottlerMetric",
+		commandUpdateThrottlerMetric,
+		"{-threshold=<value> [-query=<query>] || -remove} <keyspace> <metric>",
+		"Adds or updates a tablet throttler metric of the keyspace. The query is either a read-only SELECT returning a single value, or a SHOW GLOBAL STATUS/VARIABLES LIKE query returning a single variable; it runs on each tablet, and the primary aggregates the values of the shard's replicas, which it probes as the vt_tablet_throttler account. That account is granted SELECT on the tables the query reads, and nothing else. The builtin metrics lag, loadavg, threads_running and history_list_length need no query. Example: UpdateThrottlerMetric -threshold=100 commerce threads_running"})
+	addCommand(tabletThrottlerGroupName, command{
+		"SetThrottlerAppMetrics",
+		commandSetThrottlerAppMetrics,
+		"<keyspace> <app> [<metric>,<metric>,...]",
+		"Sets the tablet throttler metrics checked for an app that does not request specific metrics. Without metrics, the app is checked against the lag metric. Example: SetThrottlerAppMetrics commerce online-ddl lag,threads_running,history_list_length"})
+	addCommand(tabletThrottlerGroupName, command{
+		"SetThrottlerAppPriority",
+		commandSetThrottlerAppPriority,
+		"[-lowest_priority_threshold_ratio=<ratio>] <keyspace> <app> [<priority>]",
+		"Sets the tablet throttler priority of an app, from 1 (lowest) to 100 (highest). An app of the lowest priority is throttled when a metric reaches lowest_priority_threshold_ratio (default 0.5) of its threshold, and apps of higher priorities proportionally later. Without priority, the app gets the highest priority. Apps can also request a lower priority in the p parameter of the /throttler/check API. Example: SetThrottlerAppPriority commerce tablegc 10"})
+	addCommand(tabletThrottlerGroupName, command{
+		"SetThrottlerAppQuota",
+		commandSetThrottlerAppQuota,
+		"<keyspace> <app> [<checks_per_second>]",
+		"Limits the number of successful tablet throttler checks per second of an app, on each tablet. Without quota, the app is not limited. Example: SetThrottlerAppQuota commerce batch-job 20"})
+}
+
+// readThrottlerMetricsConfig reads the metrics configuration of the keyspace from the topo.
+func readThrottlerMetricsConfig(ctx context.Context, ts *topo.Server, keyspace string) (*config.MetricsConfig, error) {
+	data, err := ts.GetThrottlerConfig(ctx, keyspace)
+	if err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return nil, err
+	}
+	return config.ParseMetricsConfig(data)
+}
+
+// updateThrottlerMetricsConfig applies the update to the metrics configuration of
+// the keyspace under the keyspace lock, validates it and saves it.
+func updateThrottlerMetricsConfig(ctx context.Context, wr *wrangler.Wrangler, keyspace string, update func(metricsConfig *config.MetricsConfig) error) error {
+	if _, err := wr.TopoServer().GetKeyspace(ctx, keyspace); err != nil {
+		return err
+	}
+	ctx, unlock, lockErr := wr.TopoServer().LockKeyspace(ctx, keyspace, "UpdateThrottlerMetrics")
+	if lockErr != nil {
+		return lockErr
+	}
+	var err error
+	defer unlock(&err)
+
+	metricsConfig, err := readThrottlerMetricsConfig(ctx, wr.TopoServer(), keyspace)
+	if err != nil {
+		return err
+	}
+	if err = update(metricsConfig); err != nil {
+		return err
+	}
+	if err = metricsConfig.Validate(); err != nil {
+		return err
+	}
+	var data []byte
+	if !metricsConfig.IsEmpty() {
+		if data, err = json.MarshalIndent(metricsConfig, "", "  "); err != nil {
+			return err
+		}
+	}
+	if err = wr.TopoServer().SaveThrottlerConfig(ctx, keyspace, data); err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), metricsConfig)
+}
+
+func commandGetThrottlerMetrics(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the GetThrottlerMetrics command")
+	}
+	metricsConfig, err := readThrottlerMetricsConfig(ctx, wr.TopoServer(), subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), metricsConfig)
+}
+
+func commandUpdateThrottlerMetric(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	threshold := subFlags.Float64("threshold", 0, "Throttle when the metric exceeds this value")
+	query := subFlags.String("query", "", "Query that measures the metric. Not needed for builtin metrics")
+	remove := subFlags.Bool("remove", false, "Remove the metric")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace> and <metric> arguments are required for the UpdateThrottlerMetric command")
+	}
+	if *remove == (*threshold != 0) {
+		return fmt.Errorf("exactly one of -threshold and -remove must be specified")
+	}
+	keyspace, metricName := subFlags.Arg(0), subFlags.Arg(1)
+	return updateThrottlerMetricsConfig(ctx, wr, keyspace, func(metricsConfig *config.MetricsConfig) error {
+		if !*remove {
+			metricsConfig.SetMetric(&config.MetricConfig{
+				Name:      metricName,
+				Query:     *query,
+				Threshold: *threshold,
+			})
+			return nil
+		}
+		if !metricsConfig.RemoveMetric(metricName) {
+			return fmt.Errorf("metric %s not found in the throttler config of keyspace %s", metricName, keyspace)
+		}
+		for appName, metricNames := range metricsConfig.AppMetrics {
+			for _, name := range metricNames {
+				if name == metricName {
+					return fmt.Errorf("metric %s is still used by app %s", metricName, appName)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func commandSetThrottlerAppMetrics(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 && subFlags.NArg() != 3 {
+		return fmt.Errorf("the <keyspace> and <app> arguments are required for the SetThrottlerAppMetrics command")
+	}
+	keyspace, appName := subFlags.Arg(0), subFlags.Arg(1)
+	metricNames := textutil.SplitDelimitedList(subFlags.Arg(2))
+	return updateThrottlerMetricsConfig(ctx, wr, keyspace, func(metricsConfig *config.MetricsConfig) error {
+		if len(metricNames) == 0 {
+			delete(metricsConfig.AppMetrics, appName)
+			return nil
+		}
+		if metricsConfig.AppMetrics == nil {
+			metricsConfig.AppMetrics = make(map[string][]string)
+		}
+		metricsConfig.AppMetrics[appName] = metricNames
+		return nil
+	})
+}
+
+func commandSetThrottlerAppPriority(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	lowestRatio := subFlags.Float64("lowest_priority_threshold_ratio", 0, "Fraction of the metrics' thresholds which apps of the lowest priority may reach, for all apps of the keyspace")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 && subFlags.NArg() != 3 {
+		return fmt.Errorf("the <keyspace> and <app> arguments are required for the SetThrottlerAppPriority command")
+	}
+	keyspace, appName := subFlags.Arg(0), subFlags.Arg(1)
+	priority := 0
+	if subFlags.NArg() == 3 {
+		var err error
+		if priority, err = strconv.Atoi(subFlags.Arg(2)); err != nil {
+			return fmt.Errorf("invalid priority %s: %v", subFlags.Arg(2), err)
+		}
+	}
+	return updateThrottlerMetricsConfig(ctx, wr, keyspace, func(metricsConfig *config.MetricsConfig) error {
+		if *lowestRatio != 0 {
+			metricsConfig.LowestPriorityThresholdRatio = *lowestRatio
+		}
+		if priority == 0 {
+			delete(metricsConfig.AppPriorities, appName)
+			return nil
+		}
+		if metricsConfig.AppPriorities == nil {
+			metricsConfig.AppPriorities = make(map[string]int)
+		}
+		metricsConfig.AppPriorities[appName] = priority
+		return nil
+	})
+}
+
+func commandSetThrottlerAppQuota(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 && subFlags.NArg() != 3 {
+		return fmt.Errorf("the <keyspace> and <app> arguments are required for the SetThrottlerAppQuota command")
+	}
+	keyspace, appName := subFlags.Arg(0), subFlags.Arg(1)
+	quota := 0.0
+	if subFlags.NArg() == 3 {
+		var err error
+		if quota, err = strconv.ParseFloat(subFlags.Arg(2), 64); err != nil {
+			return fmt.Errorf("invalid quota %s: %v", subFlags.Arg(2), err)
+		}
+	}
+	return updateThrottlerMetricsConfig(ctx, wr, keyspace, func(metricsConfig *config.MetricsConfig) error {
+		if quota == 0 {
+			delete(metricsConfig.AppQuotas, appName)
+			return nil
+		}
+		if metricsConfig.AppQuotas == nil {
+			metricsConfig.AppQuotas = make(map[string]float64)
+		}
+		metricsConfig.AppQuotas[appName] = quota
+		return nil
+	})
+}
@@ -118,6 +118,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
@@ -305,6 +306,9 @@ var commands = []commandGroup{
 			{"Reshard", commandReshard,
 				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] [-skip_schema_copy] <keyspace.workflow> <source_shards> <target_shards>",
 				"Start a Resharding process. Example: Reshard -cells='zone1,alias1' -tablet_types='master,replica,rdonly'  ks.workflow001 '0' '-80,80-'"},
+			{"TimeBucketReshard", commandTimeBucketReshard,
+				"[-periods=<count>] [-cells=<cells>] [-tablet_types=<source_tablet_types>] [-skip_schema_copy] [-dry_run] <keyspace.workflow> <time_bucket vindex>",
+				"Start a Reshard that gives each of the next periods of a keyspace sharded by a time_bucket vindex its own shard. The source is the serving shard that holds the newest bucket; its rows, historic ones included, are copied to the first target shard, so the source should only hold recent buckets. The target shards must already exist. With -dry_run, only prints the source and target shards. Example: TimeBucketReshard -periods=7 events.by_day events_by_day"},
 			{"MoveTables", commandMoveTables,
 				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
 				`Move table(s) to another keyspace, table_specs is a list of tables or the tables section of the vschema for the target keyspace. Example: '{"t1":{"column_vindexes": [{"column": "id1", "name": "hash"}]}, "t2":{"column_vindexes": [{"column": "id2", "name": "hash"}]}}'.  In the case of an unsharded target keyspace the vschema for each table may be empty. Example: '{"t1":{}, "t2":{}}'.`},
@@ -320,6 +324,9 @@ var commands = []commandGroup{
 			{"ExternalizeVindex", commandExternalizeVindex,
 				"<keyspace>.<vindex>",
 				`Externalize a backfilled vindex.`},
+			{"VerifyLookupVindex", commandVerifyLookupVindex,
+				"[-repair] [-chunk_size=<rows>] <keyspace>.<vindex>",
+				`Compare the rows of the owner table of a lookup vindex with its lookup table, and report missing and orphaned entries for each shard of the lookup table. Only lookup vindexes that store keyspace ids are supported. With -repair, missing entries are inserted and orphaned entries are deleted.`},
 			{"Materialize", commandMaterialize,
 				`[-cells=<cells>] [-tablet_types=<source_tablet_types>] <json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
 				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL."},
@@ -387,6 +394,15 @@ var commands = []commandGroup{
 			{"GetSchema", commandGetSchema,
 				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] <tablet alias>",
 				"Displays the full schema for a tablet, or just the schema for the specified tables in that tablet."},
+			{"GetSchemaVersions", commandGetSchemaVersions,
+				"<tablet alias>",
+				"Lists the schema versions which a tablet tracks in _vt.schema_version, with the DDL, GTID position and time of each version. Schema versions are tracked by tablets running with -track_schema_versions."},
+			{"GetSchemaAtVersion", commandGetSchemaAtVersion,
+				"{-position=<gtid position> || -time=<RFC3339 time>} <tablet alias>",
+				"Displays the schema version, including the full schema, which was in effect on a tablet at the given GTID position or time."},
+			{"DiffSchemaVersions", commandDiffSchemaVersions,
+				"<tablet alias> <from version id> <to version id>",
+				"Displays the tables and columns which were added, dropped or modified between two schema versions of a tablet."},
 			{"ReloadSchema", commandReloadSchema,
 				"<tablet alias>",
 				"Reloads the schema on a remote tablet."},
@@ -403,8 +419,20 @@ var commands = []commandGroup{
 				"[-exclude_tables=''] [-include-views] [-skip-no-master] [-include-vschema] <keyspace name>",
 				"Validates that the master schema from shard 0 matches the schema on all of the other tablets in the keyspace."},
 			{"ApplySchema", commandApplySchema,
-				"[-allow_long_unavailability] [-wait_replicas_timeout=10s] [-ddl_strategy=<ddl_strategy>] [-request_context=<unique-request-context>] [-skip_preflight] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
-				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. -ddl_strategy is used to intruct migrations via vreplication, gh-ost or pt-osc with optional parameters. -request_context allows the user to specify a custom request context for online DDL migrations. If -skip_preflight, SQL goes directly to shards without going through sanity checks"},
+				"[-allow_long_unavailability] [-wait_replicas_timeout=10s] [-ddl_strategy=<ddl_strategy>] [-request_context=<unique-request-context>] [-skip_preflight] [-dry_run] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
+				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. -ddl_strategy is used to intruct migrations via vreplication, gh-ost or pt-osc with optional parameters. -request_context allows the user to specify a custom request context for online DDL migrations. If -skip_preflight, SQL goes directly to shards without going through sanity checks. With -dry_run, the statements are printed but not applied; with a -declarative -ddl_strategy, CREATE TABLE and CREATE VIEW statements are compared with the schema of the keyspace, and printed as the statements that apply them."},
+			{"ApplyDeclarativeSchema", commandApplyDeclarativeSchema,
+				"[-dry_run] [-ddl_strategy=<ddl_strategy>] [-request_context=<unique-request-context>] [-skip_preflight] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
+				"Applies a desired schema, given as a full list of CREATE TABLE and CREATE VIEW statements, to the specified keyspace. The desired schema is compared with the schema of the keyspace, and the resulting CREATE, ALTER and DROP statements are submitted as online DDL migrations that share a single migration context, in dependency order: foreign key parents before their children, and views after the tables they select from. Tables and views that are not in the desired schema are dropped. -ddl_strategy must be an online strategy. With -allow-concurrent, the statements that depend on earlier statements are submitted without it, so that they only run once the earlier migrations complete. With -dry_run, the statements are printed but not applied."},
+			{"GetSchemaPolicy", commandGetSchemaPolicy,
+				"<keyspace>",
+				"Displays the schema policy of the keyspace, which DDL statements must satisfy."},
+			{"ApplySchemaPolicy", commandApplySchemaPolicy,
+				"{-policy=<json> || -policy-file=<filename>} <keyspace>",
+				"Sets the schema policy of the keyspace. The policy is enforced by ApplySchema, by vtgate for DDL statements, and by the tablets for online DDL migrations. Its rules are: require_primary_key, forbidden_column_types, forbidden_charsets, max_indexes (in CREATE TABLE), require_not_null_default, forbid_drop_non_empty_table, and table_name_pattern, column_name_pattern and index_name_pattern (regular expressions). An empty policy, {}, removes all rules. Example: ApplySchemaPolicy -policy='{\"require_primary_key\": true, \"forbidden_charsets\": [\"latin1\"]}' commerce"},
+			{"CheckSchemaPolicy", commandCheckSchemaPolicy,
+				"{-sql=<sql> || -sql-file=<filename>} <keyspace>",
+				"Lints the DDL statements against the schema policy of the keyspace, without applying them. Rules which depend on table data or on the existing indexes of a table are not evaluated."},
 			{"CopySchemaShard", commandCopySchemaShard,
 				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-skip-verify] [-wait_replicas_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
 				"Copies the schema from a source shard's master (or a specific tablet) to a destination shard. The schema is applied directly on the master of the destination shard, and it is propagated to the replicas through binlogs."},
@@ -417,6 +445,7 @@ var commands = []commandGroup{
 					" \nvtctl OnlineDDL test_keyspace show complete" +
 					" \nvtctl OnlineDDL test_keyspace show failed" +
 					" \nvtctl OnlineDDL test_keyspace retry 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
+					" \nvtctl OnlineDDL test_keyspace complete 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
 					" \nvtctl OnlineDDL test_keyspace cancel 82fa54ac_e83e_11ea_96b7_f875a4d24e90",
 			},
 
@@ -443,6 +472,9 @@ var commands = []commandGroup{
 			{"ApplyVSchema", commandApplyVSchema,
 				"{-vschema=<vschema> || -vschema_file=<vschema file> || -sql=<sql> || -sql_file=<sql file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run] <keyspace>",
 				"Applies the VTGate routing schema to the provided keyspace. Shows the result after application."},
+			{"SuggestVSchema", commandSuggestVSchema,
+				"[-sharded] [-query_log=<file>] [-min_lookup_queries=<count>] [-dry-run] <keyspace>",
+				"Suggests a VTGate routing schema for the keyspace, based on the schema of its tables and on the queries of the optional query log, which contains one query per line. The output can be applied with ApplyVSchema. With -dry-run, shows the differences with the current routing schema instead."},
 			{"GetRoutingRules", commandGetRoutingRules,
 				"",
 				"Displays the VSchema routing rules."},
@@ -1861,6 +1893,38 @@ func commandReshard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.F
 		*tabletTypes, *autoStart, *stopAfterCopy)
 }
 
+func commandTimeBucketReshard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	periods := subFlags.Int("periods", 1, "Number of future periods that need their own shard.")
+	cells := subFlags.String("cells", "", "Cell(s) or CellAlias(es) (comma-separated) to replicate from.")
+	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
+	skipSchemaCopy := subFlags.Bool("skip_schema_copy", false, "Skip copying of schema to targets")
+	autoStart := subFlags.Bool("auto_start", true, "If false, streams will start in the Stopped state and will need to be explicitly started")
+	stopAfterCopy := subFlags.Bool("stop_after_copy", false, "Streams will be stopped once the copy phase is completed")
+	dryRun := subFlags.Bool("dry_run", false, "Only print the source and target shards")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace.workflow> and <time_bucket vindex> arguments are required for the TimeBucketReshard command")
+	}
+	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		source, targets, err := wr.PlanTimeBucketReshard(ctx, keyspace, subFlags.Arg(1), *periods, time.Now())
+		if err != nil {
+			return err
+		}
+		return printJSON(wr.Logger(), map[string]interface{}{
+			"source_shards": []string{source},
+			"target_shards": targets,
+		})
+	}
+	return wr.TimeBucketReshard(ctx, keyspace, workflow, subFlags.Arg(1), *periods, time.Now(), *skipSchemaCopy, *cells,
+		*tabletTypes, *autoStart, *stopAfterCopy)
+}
+
 func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	for _, arg := range args {
 		if arg == "-v2" {
@@ -2296,6 +2360,22 @@ func commandExternalizeVindex(ctx context.Context, wr *wrangler.Wrangler, subFla
 	return wr.ExternalizeVindex(ctx, subFlags.Arg(0))
 }
 
+func commandVerifyLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	repair := subFlags.Bool("repair", false, "Insert missing entries and delete orphaned entries of the lookup table")
+	chunkSize := subFlags.Int64("chunk_size", 1000, "Number of rows read from a table at a time")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("one argument is required: keyspace.vindex")
+	}
+	resp, err := wr.VerifyLookupVindex(ctx, subFlags.Arg(0), *repair, *chunkSize)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), resp)
+}
+
 func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	cells := subFlags.String("cells", "", "Source cells to replicate from.")
 	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
@@ -2737,6 +2817,83 @@ func commandGetSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 	return printJSON(wr.Logger(), resp.Schema)
 }
 
+func commandGetSchemaVersions(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the GetSchemaVersions command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	versions, err := wr.GetSchemaVersions(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), versions)
+}
+
+func commandGetSchemaAtVersion(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	position := subFlags.String("position", "", "GTID position at which to read the schema")
+	atTime := subFlags.String("time", "", "Time, in RFC3339 format, at which to read the schema")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the GetSchemaAtVersion command")
+	}
+	if (*position == "") == (*atTime == "") {
+		return fmt.Errorf("exactly one of -position or -time is required for the GetSchemaAtVersion command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	var version *tabletmanagerdatapb.SchemaVersion
+	if *position != "" {
+		version, err = wr.GetSchemaVersionAtPosition(ctx, tabletAlias, *position)
+	} else {
+		t, parseErr := time.Parse(time.RFC3339, *atTime)
+		if parseErr != nil {
+			return fmt.Errorf("invalid -time value: %v", parseErr)
+		}
+		version, err = wr.GetSchemaVersionAtTime(ctx, tabletAlias, t)
+	}
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), version)
+}
+
+func commandDiffSchemaVersions(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 3 {
+		return fmt.Errorf("the <tablet alias>, <from version id> and <to version id> arguments are required for the DiffSchemaVersions command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	fromID, err := strconv.ParseInt(subFlags.Arg(1), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid <from version id>: %v", err)
+	}
+	toID, err := strconv.ParseInt(subFlags.Arg(2), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid <to version id>: %v", err)
+	}
+	diffs, err := wr.DiffSchemaVersions(ctx, tabletAlias, fromID, toID)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), diffs)
+}
+
 func commandReloadSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -2832,6 +2989,7 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	requestContext := subFlags.String("request_context", "", "For Only DDL, optionally supply a custom unique string used as context for the migration(s) in this command. By default a unique context is auto-generated by Vitess")
 	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", wrangler.DefaultWaitReplicasTimeout, "The amount of time to wait for replicas to receive the schema change via replication.")
 	skipPreflight := subFlags.Bool("skip_preflight", false, "Skip pre-apply schema checks, and dircetly forward schema change query to shards")
+	dryRun := subFlags.Bool("dry_run", false, "Only print the statements that would be applied")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -2844,6 +3002,24 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	if err != nil {
 		return err
 	}
+	if *dryRun {
+		ddlStrategySetting, err := schema.ParseDDLStrategy(*ddlStrategy)
+		if err != nil {
+			return err
+		}
+		queries, err := sqlparser.SplitStatementToPieces(change)
+		if err != nil {
+			return err
+		}
+		stmts, err := wr.DryRunApplySchema(ctx, keyspace, queries, ddlStrategySetting.IsDeclarative())
+		if err != nil {
+			return err
+		}
+		for _, sql := range stmts {
+			wr.Logger().Printf("%s;\n", sql)
+		}
+		return nil
+	}
 	executionUUID, err := schema.CreateUUID()
 	if err != nil {
 		return err
@@ -2869,6 +3045,101 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	)
 }
 
+func commandApplyDeclarativeSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	dryRun := subFlags.Bool("dry_run", false, "Only print the statements that apply the desired schema")
+	sql := subFlags.String("sql", "", "The CREATE TABLE and CREATE VIEW statements of the desired schema, semicolon-delimited")
+	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the desired schema")
+	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyOnline), "Online DDL strategy, compatible with @@ddl_strategy session variable (examples: 'online', 'gh-ost', 'pt-osc')")
+	requestContext := subFlags.String("request_context", "", "Optionally supply a custom unique string used as context for the migrations in this command. By default a unique context is auto-generated by Vitess")
+	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", wrangler.DefaultWaitReplicasTimeout, "The amount of time to wait for replicas to receive the schema change via replication.")
+	skipPreflight := subFlags.Bool("skip_preflight", false, "Skip pre-apply schema checks, and dircetly forward schema change query to shards")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ApplyDeclarativeSchema command")
+	}
+
+	keyspace := subFlags.Arg(0)
+	desired, err := getFileParam(*sql, *sqlFile, "sql")
+	if err != nil {
+		return err
+	}
+	ddlStrategySetting, err := schema.ParseDDLStrategy(*ddlStrategy)
+	if err != nil {
+		return err
+	}
+	if ddlStrategySetting.Strategy.IsDirect() {
+		return fmt.Errorf("ApplyDeclarativeSchema requires an online -ddl_strategy, got '%s'", *ddlStrategy)
+	}
+	if ddlStrategySetting.IsDeclarative() {
+		return fmt.Errorf("ApplyDeclarativeSchema computes the schema diff itself and does not support -declarative")
+	}
+	queries, err := sqlparser.SplitStatementToPieces(desired)
+	if err != nil {
+		return err
+	}
+	diff, dependent, err := wr.DiffSchemaKeyspace(ctx, keyspace, queries)
+	if err != nil {
+		return err
+	}
+	if len(diff) == 0 {
+		wr.Logger().Printf("Keyspace %v already has the desired schema\n", keyspace)
+		return nil
+	}
+	change := make([]string, 0, len(diff))
+	for _, stmt := range diff {
+		change = append(change, sqlparser.String(stmt))
+	}
+	if *dryRun {
+		for _, sql := range change {
+			wr.Logger().Printf("%s;\n", sql)
+		}
+		return nil
+	}
+
+	if *requestContext == "" {
+		executionUUID, err := schema.CreateUUID()
+		if err != nil {
+			return err
+		}
+		*requestContext = fmt.Sprintf("vtctl:%s", executionUUID)
+	}
+	// -allow-concurrent migrations may run in any order. A statement that depends on earlier statements is
+	// submitted without -allow-concurrent: its migration is a barrier, which only runs once the migrations
+	// submitted before it are complete, and which the migrations submitted after it wait for.
+	strategies := make([]string, len(change))
+	for i := range change {
+		strategies[i] = *ddlStrategy
+		if ddlStrategySetting.IsAllowConcurrent() && dependent[i] {
+			strategies[i] = ddlStrategySetting.WithoutAllowConcurrent().Variable()
+		}
+	}
+	for start := 0; start < len(change); {
+		end := start + 1
+		for end < len(change) && strategies[end] == strategies[start] {
+			end++
+		}
+		executor := schemamanager.NewTabletExecutor(*requestContext, wr, *waitReplicasTimeout)
+		if *skipPreflight {
+			executor.SkipPreflight()
+		}
+		if err := executor.SetDDLStrategy(strategies[start]); err != nil {
+			return err
+		}
+		if err := schemamanager.Run(
+			ctx,
+			schemamanager.NewPlainController(strings.Join(change[start:end], ";\n"), keyspace),
+			executor,
+		); err != nil {
+			return err
+		}
+		start = end
+	}
+	wr.Logger().Printf("Migration context: %s\n", *requestContext)
+	return nil
+}
+
 func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -2914,7 +3185,7 @@ func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 				}
 			}
 			query = fmt.Sprintf(`select
-				shard, mysql_schema, mysql_table, ddl_action, migration_uuid, strategy, started_timestamp, completed_timestamp, migration_status
+				shard, mysql_schema, mysql_table, ddl_action, migration_uuid, strategy, started_timestamp, completed_timestamp, migration_status, postpone_completion, ready_to_complete
 				from _vt.schema_migrations where %s`, condition)
 		}
 	case "retry":
@@ -2925,6 +3196,14 @@ func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 			uuid = arg
 			query, bindErr = sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status='retry' where migration_uuid=%a`, sqltypes.StringBindVariable(arg))
 		}
+	case "complete":
+		{
+			if arg == "" {
+				return fmt.Errorf("UUID required")
+			}
+			uuid = arg
+			query, bindErr = sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status='complete' where migration_uuid=%a`, sqltypes.StringBindVariable(arg))
+		}
 	case "cancel":
 		{
 			if arg == "" {
@@ -2987,6 +3266,88 @@ func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 	return nil
 }
 
+func commandGetSchemaPolicy(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the GetSchemaPolicy command")
+	}
+	policy, err := schema.ReadSchemaPolicy(ctx, wr.TopoServer(), subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), policy)
+}
+
+func commandApplySchemaPolicy(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	policyJSON := subFlags.String("policy", "", "The schema policy, in JSON format")
+	policyFile := subFlags.String("policy-file", "", "Identifies the file that contains the schema policy, in JSON format")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ApplySchemaPolicy command")
+	}
+	keyspace := subFlags.Arg(0)
+	data, err := getFileParam(*policyJSON, *policyFile, "policy")
+	if err != nil {
+		return err
+	}
+	policy, err := schema.ParseSchemaPolicy([]byte(data))
+	if err != nil {
+		return err
+	}
+	if _, err := wr.TopoServer().GetKeyspace(ctx, keyspace); err != nil {
+		return err
+	}
+	var b []byte
+	if !policy.IsEmpty() {
+		if b, err = json.MarshalIndent(policy, "", "  "); err != nil {
+			return err
+		}
+	}
+	if err := wr.TopoServer().SaveSchemaPolicy(ctx, keyspace, b); err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), policy)
+}
+
+func commandCheckSchemaPolicy(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	sql := subFlags.String("sql", "", "A list of semicolon-delimited SQL commands")
+	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the SQL commands")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the CheckSchemaPolicy command")
+	}
+	change, err := getFileParam(*sql, *sqlFile, "sql")
+	if err != nil {
+		return err
+	}
+	policy, err := schema.ReadSchemaPolicy(ctx, wr.TopoServer(), subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	sqls, err := sqlparser.SplitStatementToPieces(change)
+	if err != nil {
+		return err
+	}
+	violationsFound := false
+	for _, sql := range sqls {
+		if err := policy.CheckSQL(sql, nil, nil); err != nil {
+			wr.Logger().Printf("%v\n", err)
+			violationsFound = true
+		}
+	}
+	if violationsFound {
+		return fmt.Errorf("schema policy violations found")
+	}
+	wr.Logger().Printf("no schema policy violations found\n")
+	return nil
+}
+
 func commandCopySchemaShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	tables := subFlags.String("tables", "", "Specifies a comma-separated list of tables to copy. Each is either an exact match, or a regular expression of the form /regexp/")
 	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
@@ -3150,6 +3511,50 @@ func commandRebuildVSchemaGraph(ctx context.Context, wr *wrangler.Wrangler, subF
 	return err
 }
 
+func commandSuggestVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	sharded := subFlags.Bool("sharded", false, "Suggest a sharded routing schema even if the keyspace has a single shard")
+	queryLog := subFlags.String("query_log", "", "A file with sample queries, one per line, used to suggest lookup vindexes")
+	minLookupQueries := subFlags.Int("min_lookup_queries", 10, "The number of sample queries filtering on a column for equality above which a lookup vindex is suggested for it")
+	dryRun := subFlags.Bool("dry-run", false, "If set, show the differences with the current routing schema instead of the suggested routing schema")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the SuggestVSchema command")
+	}
+	var queries []string
+	if *queryLog != "" {
+		data, err := ioutil.ReadFile(*queryLog)
+		if err != nil {
+			return err
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				queries = append(queries, line)
+			}
+		}
+	}
+	suggestion, err := wr.SuggestVSchema(ctx, subFlags.Arg(0), *sharded, queries, *minLookupQueries)
+	if err != nil {
+		return err
+	}
+	for _, warning := range suggestion.Warnings {
+		wr.Logger().Warningf("%s", warning)
+	}
+	if len(suggestion.SequenceTables) > 0 {
+		wr.Logger().Warningf("create these sequence tables in an unsharded keyspace: %s", strings.Join(suggestion.SequenceTables, ", "))
+	}
+	if *dryRun {
+		if len(suggestion.Diff) == 0 {
+			wr.Logger().Printf("The suggested routing schema is identical to the current one.\n")
+			return nil
+		}
+		wr.Logger().Printf("%s\n", strings.Join(suggestion.Diff, "\n"))
+		return nil
+	}
+	return printJSON(wr.Logger(), suggestion.VSchema)
+}
+
 func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	vschema := subFlags.String("vschema", "", "Identifies the VTGate routing schema")
 	vschemaFile := subFlags.String("vschema_file", "", "Identifies the VTGate routing schema file")
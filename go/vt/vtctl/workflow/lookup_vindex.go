@@ -0,0 +1,748 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	// defaultLookupVindexChunkSize is the number of rows read from a table
+	// at a time when no chunk size is requested.
+	defaultLookupVindexChunkSize = 1000
+	// maxLookupVindexReportSampleRows is the number of rows reported as
+	// samples for each kind of difference.
+	maxLookupVindexReportSampleRows = 10
+)
+
+// keyspaceIDLookupTypes are the lookup vindexes whose lookup table maps the
+// 'from' columns to keyspace ids. The other lookup vindexes, like
+// lookup_hash, store the id of the owner row instead, which can't be
+// compared with the keyspace id computed from the owner table.
+var keyspaceIDLookupTypes = sets.NewString("lookup", "lookup_unique", "consistent_lookup", "consistent_lookup_unique")
+
+// lookupEntry is one row of a lookup table, or the row that a row of the
+// owner table should produce in the lookup table.
+type lookupEntry struct {
+	from []sqltypes.Value
+	ksid []byte
+}
+
+func (le *lookupEntry) key() string {
+	var b strings.Builder
+	for _, v := range le.from {
+		b.WriteString(strconv.Itoa(v.Len()))
+		b.WriteByte(':')
+		b.Write(v.ToBytes())
+	}
+	b.WriteByte('|')
+	b.Write(le.ksid)
+	return b.String()
+}
+
+// columnList is the deduplicated list of columns selected from a table,
+// which lets the different roles of a column share a single position.
+type columnList struct {
+	names []string
+	index map[string]int
+}
+
+func newColumnList(groups ...[]string) *columnList {
+	cl := &columnList{index: make(map[string]int)}
+	for _, group := range groups {
+		for _, name := range group {
+			lower := strings.ToLower(name)
+			if _, ok := cl.index[lower]; ok {
+				continue
+			}
+			cl.index[lower] = len(cl.names)
+			cl.names = append(cl.names, name)
+		}
+	}
+	return cl
+}
+
+// values returns the values of the columns of row.
+func (cl *columnList) values(row []sqltypes.Value, columns []string) []sqltypes.Value {
+	values := make([]sqltypes.Value, 0, len(columns))
+	for _, col := range columns {
+		values = append(values, row[cl.index[strings.ToLower(col)]])
+	}
+	return values
+}
+
+// lookupVerifier contains the metadata for verifying one lookup vindex.
+type lookupVerifier struct {
+	s         *Server
+	repair    bool
+	chunkSize int
+
+	ownerKeyspace string
+	ownerTable    string
+	ownerCols     []string
+	primaryCols   []string
+	primaryVindex vindexes.Vindex
+	ownerColumns  *columnList
+	ownerPKCols   []string
+	ownerShards   []*topo.ShardInfo
+
+	lookupKeyspace string
+	lookupTable    string
+	fromCols       []string
+	toCol          string
+	// lookupVindex is the primary vindex of the lookup table.
+	// It's nil if the lookup keyspace is unsharded.
+	lookupVindex  vindexes.Vindex
+	lookupColumns *columnList
+	lookupPKCols  []string
+	lookupShards  []*topo.ShardInfo
+
+	reports map[string]*vtctldatapb.LookupVindexReport
+}
+
+// VerifyLookupVindex compares the rows of the owner table of a lookup vindex
+// with the rows of its lookup table, and reports the differences for each
+// shard of the lookup table. If req.Repair is set, orphaned entries are
+// deleted and then entries missing from the lookup table are inserted.
+//
+// Both tables are read in chunks, in primary key order: the rows of each
+// chunk are looked up in the other table. Writes are not stopped, so each
+// orphaned entry is looked up again right before it's deleted, to avoid
+// deleting the entry of a row that was inserted in the meantime.
+func (s *Server) VerifyLookupVindex(ctx context.Context, req *vtctldatapb.VerifyLookupVindexRequest) (*vtctldatapb.VerifyLookupVindexResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.VerifyLookupVindex")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("vindex", req.Vindex)
+	span.Annotate("repair", req.Repair)
+
+	lv, err := s.buildLookupVerifier(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// Orphans are deleted before missing entries are inserted: an entry
+	// with a wrong keyspace id is both, and in a unique lookup table it
+	// would make the insert of the right entry a duplicate.
+	for _, si := range lv.lookupShards {
+		if err := lv.findOrphans(ctx, si); err != nil {
+			return nil, vterrors.Wrapf(err, "reading lookup table from shard %s", si.ShardName())
+		}
+	}
+	for _, si := range lv.ownerShards {
+		if err := lv.findMissing(ctx, si); err != nil {
+			return nil, vterrors.Wrapf(err, "reading owner table from shard %s", si.ShardName())
+		}
+	}
+	return &vtctldatapb.VerifyLookupVindexResponse{Reports: lv.reports}, nil
+}
+
+func (s *Server) buildLookupVerifier(ctx context.Context, req *vtctldatapb.VerifyLookupVindexRequest) (*lookupVerifier, error) {
+	if req.Keyspace == "" || req.Vindex == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace and vindex are required")
+	}
+	lv := &lookupVerifier{
+		s:             s,
+		repair:        req.Repair,
+		chunkSize:     int(req.ChunkSize),
+		ownerKeyspace: req.Keyspace,
+		reports:       make(map[string]*vtctldatapb.LookupVindexReport),
+	}
+	if lv.chunkSize <= 0 {
+		lv.chunkSize = defaultLookupVindexChunkSize
+	}
+	ownerVSchema, err := s.ts.GetVSchema(ctx, lv.ownerKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	vindex := ownerVSchema.Vindexes[req.Vindex]
+	if vindex == nil {
+		return nil, fmt.Errorf("vindex %s.%s not found in vschema", req.Keyspace, req.Vindex)
+	}
+	if !strings.Contains(vindex.Type, "lookup") {
+		return nil, fmt.Errorf("vindex %s is not a lookup type", vindex.Type)
+	}
+	if !keyspaceIDLookupTypes.Has(vindex.Type) {
+		return nil, fmt.Errorf("vindex %s.%s of type %s does not store keyspace ids, and cannot be verified", req.Keyspace, req.Vindex, vindex.Type)
+	}
+	if vindex.Owner == "" {
+		return nil, fmt.Errorf("vindex %s.%s has no owner", req.Keyspace, req.Vindex)
+	}
+	lv.ownerTable = vindex.Owner
+
+	strs := strings.Split(vindex.Params["table"], ".")
+	if len(strs) != 2 {
+		return nil, fmt.Errorf("vindex 'table' must be <keyspace>.<table>: %v", vindex)
+	}
+	lv.lookupKeyspace, lv.lookupTable = strs[0], strs[1]
+	lv.fromCols = strings.Split(vindex.Params["from"], ",")
+	lv.toCol = vindex.Params["to"]
+
+	ownerTable := ownerVSchema.Tables[lv.ownerTable]
+	if ownerTable == nil || len(ownerTable.ColumnVindexes) == 0 {
+		return nil, fmt.Errorf("owner table %s has no primary vindex", lv.ownerTable)
+	}
+	for _, colVindex := range ownerTable.ColumnVindexes {
+		if colVindex.Name == req.Vindex {
+			lv.ownerCols = columnVindexColumns(colVindex)
+			break
+		}
+	}
+	if len(lv.ownerCols) != len(lv.fromCols) {
+		return nil, fmt.Errorf("columns of vindex %s in table %s do not match its 'from' columns: %v vs %v", req.Vindex, lv.ownerTable, lv.ownerCols, lv.fromCols)
+	}
+	lv.primaryCols = columnVindexColumns(ownerTable.ColumnVindexes[0])
+	lv.primaryVindex, err = createVSchemaVindex(ownerVSchema, ownerTable.ColumnVindexes[0].Name)
+	if err != nil {
+		return nil, err
+	}
+	if lv.primaryVindex.NeedsVCursor() {
+		return nil, fmt.Errorf("primary vindex %v of table %s needs a VCursor, and cannot be used to compute keyspace ids", lv.primaryVindex, lv.ownerTable)
+	}
+
+	lookupVSchema, err := s.ts.GetVSchema(ctx, lv.lookupKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	if lookupVSchema.Sharded {
+		lookupTable := lookupVSchema.Tables[lv.lookupTable]
+		if lookupTable == nil || len(lookupTable.ColumnVindexes) == 0 {
+			return nil, fmt.Errorf("lookup table %s has no primary vindex", lv.lookupTable)
+		}
+		lv.lookupVindex, err = createVSchemaVindex(lookupVSchema, lookupTable.ColumnVindexes[0].Name)
+		if err != nil {
+			return nil, err
+		}
+		if lv.lookupVindex.NeedsVCursor() {
+			return nil, fmt.Errorf("primary vindex %v of table %s needs a VCursor, and cannot be used to compute keyspace ids", lv.lookupVindex, lv.lookupTable)
+		}
+	}
+
+	if lv.ownerShards, err = s.ts.GetServingShards(ctx, lv.ownerKeyspace); err != nil {
+		return nil, err
+	}
+	if lv.lookupShards, err = s.ts.GetServingShards(ctx, lv.lookupKeyspace); err != nil {
+		return nil, err
+	}
+	for _, si := range lv.lookupShards {
+		lv.reports[si.ShardName()] = &vtctldatapb.LookupVindexReport{Shard: si.ShardName()}
+	}
+	if lv.ownerPKCols, err = lv.primaryKeyColumns(ctx, lv.ownerShards[0], lv.ownerTable); err != nil {
+		return nil, err
+	}
+	if lv.lookupPKCols, err = lv.primaryKeyColumns(ctx, lv.lookupShards[0], lv.lookupTable); err != nil {
+		return nil, err
+	}
+	lv.ownerColumns = newColumnList(lv.ownerCols, lv.primaryCols, lv.ownerPKCols)
+	lv.lookupColumns = newColumnList(lv.fromCols, []string{lv.toCol}, lv.lookupPKCols)
+	return lv, nil
+}
+
+func columnVindexColumns(colVindex *vschemapb.ColumnVindex) []string {
+	if len(colVindex.Columns) != 0 {
+		return colVindex.Columns
+	}
+	return []string{colVindex.Column}
+}
+
+func createVSchemaVindex(vschema *vschemapb.Keyspace, name string) (vindexes.Vindex, error) {
+	vindex := vschema.Vindexes[name]
+	if vindex == nil {
+		return nil, fmt.Errorf("vindex %s not found in vschema", name)
+	}
+	return vindexes.CreateVindex(vindex.Type, name, vindex.Params)
+}
+
+func (lv *lookupVerifier) primaryKeyColumns(ctx context.Context, si *topo.ShardInfo, table string) ([]string, error) {
+	master, err := lv.master(ctx, si)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := lv.s.tmc.GetSchema(ctx, master, []string{table}, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, td := range schema.TableDefinitions {
+		if td.Name != table {
+			continue
+		}
+		if len(td.PrimaryKeyColumns) == 0 {
+			return nil, fmt.Errorf("table %s has no primary key", table)
+		}
+		return td.PrimaryKeyColumns, nil
+	}
+	return nil, fmt.Errorf("table %s not found in the schema of shard %s/%s", table, si.Keyspace(), si.ShardName())
+}
+
+// findMissing reads the owner table from one of its shards, and looks up
+// the entries its rows should produce in the lookup table. Rows with a
+// NULL in any of the vindex columns don't produce entries.
+func (lv *lookupVerifier) findMissing(ctx context.Context, si *topo.ShardInfo) error {
+	return lv.readChunks(ctx, si, lv.ownerTable, lv.ownerColumns, lv.ownerPKCols, func(rows [][]sqltypes.Value) error {
+		byShard := make(map[string][]*lookupEntry)
+		for _, row := range rows {
+			le, err := lv.ownerEntry(row)
+			if err != nil {
+				return err
+			}
+			if le == nil {
+				continue
+			}
+			shard, err := lv.lookupShardFor(le)
+			if err != nil {
+				return err
+			}
+			byShard[shard.ShardName()] = append(byShard[shard.ShardName()], le)
+		}
+		for _, shard := range lv.lookupShards {
+			entries := byShard[shard.ShardName()]
+			if len(entries) == 0 {
+				continue
+			}
+			found, err := lv.lookupEntries(ctx, shard, entries)
+			if err != nil {
+				return err
+			}
+			dr := lv.reports[shard.ShardName()]
+			for _, le := range entries {
+				if found[le.key()] {
+					continue
+				}
+				dr.MissingRows++
+				query, err := lv.insertQuery(le)
+				if err != nil {
+					return err
+				}
+				if len(dr.MissingRowsSample) < maxLookupVindexReportSampleRows {
+					dr.MissingRowsSample = append(dr.MissingRowsSample, lv.rowDiff(le, query))
+				}
+				if err := lv.execute(ctx, shard, dr, query); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// findOrphans reads the lookup table from one of its shards, and looks up
+// its entries in the owner table.
+func (lv *lookupVerifier) findOrphans(ctx context.Context, si *topo.ShardInfo) error {
+	dr := lv.reports[si.ShardName()]
+	return lv.readChunks(ctx, si, lv.lookupTable, lv.lookupColumns, lv.lookupPKCols, func(rows [][]sqltypes.Value) error {
+		dr.ProcessedRows += int64(len(rows))
+		var orphans []*lookupEntry
+		byShard := make(map[string][]*lookupEntry)
+		for _, row := range rows {
+			le := &lookupEntry{
+				from: lv.lookupColumns.values(row, lv.fromCols),
+				ksid: lv.lookupColumns.values(row, []string{lv.toCol})[0].ToBytes(),
+			}
+			shard := lv.ownerShardFor(le.ksid)
+			if shard == nil {
+				// No owner row can have this keyspace id.
+				orphans = append(orphans, le)
+				continue
+			}
+			byShard[shard.ShardName()] = append(byShard[shard.ShardName()], le)
+		}
+		for _, shard := range lv.ownerShards {
+			entries := byShard[shard.ShardName()]
+			if len(entries) == 0 {
+				continue
+			}
+			found, err := lv.ownerEntries(ctx, shard, entries)
+			if err != nil {
+				return err
+			}
+			for _, le := range entries {
+				if found[le.key()] {
+					dr.MatchingRows++
+					continue
+				}
+				orphans = append(orphans, le)
+			}
+		}
+		for _, le := range orphans {
+			if lv.repair {
+				// The owner row may have been inserted since the chunk was
+				// looked up: check again right before deleting the entry.
+				if shard := lv.ownerShardFor(le.ksid); shard != nil {
+					found, err := lv.ownerEntries(ctx, shard, []*lookupEntry{le})
+					if err != nil {
+						return err
+					}
+					if found[le.key()] {
+						dr.MatchingRows++
+						continue
+					}
+				}
+			}
+			dr.OrphanedRows++
+			query, err := lv.deleteQuery(le)
+			if err != nil {
+				return err
+			}
+			if len(dr.OrphanedRowsSample) < maxLookupVindexReportSampleRows {
+				dr.OrphanedRowsSample = append(dr.OrphanedRowsSample, lv.rowDiff(le, query))
+			}
+			if err := lv.execute(ctx, si, dr, query); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ownerEntry returns the lookup entry a row of the owner table should
+// produce, or nil if it produces none.
+func (lv *lookupVerifier) ownerEntry(row []sqltypes.Value) (*lookupEntry, error) {
+	from := lv.ownerColumns.values(row, lv.ownerCols)
+	for _, v := range from {
+		if v.IsNull() {
+			return nil, nil
+		}
+	}
+	destinations, err := vindexes.Map(lv.primaryVindex, nil, [][]sqltypes.Value{lv.ownerColumns.values(row, lv.primaryCols)})
+	if err != nil {
+		return nil, err
+	}
+	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+	if !ok {
+		return nil, fmt.Errorf("cannot map row %v of table %s to a keyspace id: %v", row, lv.ownerTable, destinations[0])
+	}
+	return &lookupEntry{from: from, ksid: ksid}, nil
+}
+
+// lookupEntries returns the keys of the entries of the lookup table that
+// have the same 'from' values as entries.
+func (lv *lookupVerifier) lookupEntries(ctx context.Context, si *topo.ShardInfo, entries []*lookupEntry) (map[string]bool, error) {
+	cols := newColumnList(lv.fromCols, []string{lv.toCol})
+	query, err := lv.pointQuery(lv.lookupTable, cols, lv.fromCols, entries)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool)
+	err = lv.streamRows(ctx, si, query, func(row []sqltypes.Value) error {
+		le := &lookupEntry{
+			from: cols.values(row, lv.fromCols),
+			ksid: cols.values(row, []string{lv.toCol})[0].ToBytes(),
+		}
+		found[le.key()] = true
+		return nil
+	})
+	return found, err
+}
+
+// ownerEntries returns the keys of the entries produced by the rows of the
+// owner table that have the same vindex values as entries.
+func (lv *lookupVerifier) ownerEntries(ctx context.Context, si *topo.ShardInfo, entries []*lookupEntry) (map[string]bool, error) {
+	query, err := lv.pointQuery(lv.ownerTable, lv.ownerColumns, lv.ownerCols, entries)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool)
+	err = lv.streamRows(ctx, si, query, func(row []sqltypes.Value) error {
+		le, err := lv.ownerEntry(row)
+		if err != nil || le == nil {
+			return err
+		}
+		found[le.key()] = true
+		return nil
+	})
+	return found, err
+}
+
+// lookupShardFor returns the shard of the lookup table an entry belongs to.
+func (lv *lookupVerifier) lookupShardFor(le *lookupEntry) (*topo.ShardInfo, error) {
+	if lv.lookupVindex == nil {
+		return lv.lookupShards[0], nil
+	}
+	destinations, err := vindexes.Map(lv.lookupVindex, nil, [][]sqltypes.Value{le.from})
+	if err != nil {
+		return nil, err
+	}
+	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+	if !ok {
+		return nil, fmt.Errorf("cannot map %v of table %s to a keyspace id: %v", le.from, lv.lookupTable, destinations[0])
+	}
+	for _, si := range lv.lookupShards {
+		if key.KeyRangeContains(si.KeyRange, ksid) {
+			return si, nil
+		}
+	}
+	return nil, fmt.Errorf("no serving shard of keyspace %s contains keyspace id %x", lv.lookupKeyspace, []byte(ksid))
+}
+
+// ownerShardFor returns the shard of the owner table that holds the rows
+// with the keyspace id, or nil if there is none.
+func (lv *lookupVerifier) ownerShardFor(ksid []byte) *topo.ShardInfo {
+	for _, si := range lv.ownerShards {
+		if key.KeyRangeContains(si.KeyRange, ksid) {
+			return si
+		}
+	}
+	return nil
+}
+
+// readChunks reads the rows of table from the shard in chunks of
+// lv.chunkSize rows, in primary key order, and calls f for every chunk.
+func (lv *lookupVerifier) readChunks(ctx context.Context, si *topo.ShardInfo, table string, cols *columnList, pkCols []string, f func([][]sqltypes.Value) error) error {
+	var last []sqltypes.Value
+	for {
+		query, err := lv.chunkQuery(table, cols, pkCols, last)
+		if err != nil {
+			return err
+		}
+		var rows [][]sqltypes.Value
+		err = lv.streamRows(ctx, si, query, func(row []sqltypes.Value) error {
+			rows = append(rows, row)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := f(rows); err != nil {
+			return err
+		}
+		if len(rows) < lv.chunkSize {
+			return nil
+		}
+		last = cols.values(rows[len(rows)-1], pkCols)
+	}
+}
+
+func (lv *lookupVerifier) chunkQuery(table string, cols *columnList, pkCols []string, last []sqltypes.Value) (string, error) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	writeSelect(buf, table, cols)
+	var binds []*querypb.BindVariable
+	if last != nil {
+		buf.Myprintf(" where ")
+		writeColumnTuple(buf, pkCols)
+		buf.Myprintf(" > ")
+		binds = append(binds, writeValueTuple(buf, last)...)
+	}
+	buf.Myprintf(" order by ")
+	for i, col := range pkCols {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(col))
+	}
+	buf.Myprintf(" limit %s", strconv.Itoa(lv.chunkSize))
+	return sqlparser.ParseAndBind(buf.String(), binds...)
+}
+
+// pointQuery selects the rows of table whose columns have the 'from'
+// values of entries.
+func (lv *lookupVerifier) pointQuery(table string, cols *columnList, columns []string, entries []*lookupEntry) (string, error) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	writeSelect(buf, table, cols)
+	buf.Myprintf(" where ")
+	writeColumnTuple(buf, columns)
+	buf.Myprintf(" in (")
+	var binds []*querypb.BindVariable
+	for i, le := range entries {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		binds = append(binds, writeValueTuple(buf, le.from)...)
+	}
+	buf.Myprintf(")")
+	return sqlparser.ParseAndBind(buf.String(), binds...)
+}
+
+func writeSelect(buf *sqlparser.TrackedBuffer, table string, cols *columnList) {
+	buf.Myprintf("select ")
+	for i, col := range cols.names {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(col))
+	}
+	buf.Myprintf(" from %v", sqlparser.NewTableIdent(table))
+}
+
+// writeColumnTuple writes the columns, as a tuple if there's more than one.
+func writeColumnTuple(buf *sqlparser.TrackedBuffer, columns []string) {
+	if len(columns) == 1 {
+		buf.Myprintf("%v", sqlparser.NewColIdent(columns[0]))
+		return
+	}
+	buf.Myprintf("(")
+	for i, col := range columns {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(col))
+	}
+	buf.Myprintf(")")
+}
+
+// writeValueTuple writes placeholders for the values, as a tuple if there's
+// more than one, and returns their bind variables.
+func writeValueTuple(buf *sqlparser.TrackedBuffer, values []sqltypes.Value) []*querypb.BindVariable {
+	binds := make([]*querypb.BindVariable, 0, len(values))
+	if len(values) > 1 {
+		buf.Myprintf("(")
+	}
+	for i, v := range values {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%s", "%a")
+		binds = append(binds, sqltypes.ValueBindVariable(v))
+	}
+	if len(values) > 1 {
+		buf.Myprintf(")")
+	}
+	return binds
+}
+
+func (lv *lookupVerifier) insertQuery(le *lookupEntry) (string, error) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	// The entry may be inserted concurrently by vtgate: ignore duplicates.
+	buf.Myprintf("insert ignore into %v(", sqlparser.NewTableIdent(lv.lookupTable))
+	for _, col := range lv.fromCols {
+		buf.Myprintf("%v, ", sqlparser.NewColIdent(col))
+	}
+	buf.Myprintf("%v) values (", sqlparser.NewColIdent(lv.toCol))
+	var binds []*querypb.BindVariable
+	for _, v := range le.from {
+		buf.Myprintf("%s, ", "%a")
+		binds = append(binds, sqltypes.ValueBindVariable(v))
+	}
+	buf.Myprintf("%s)", "%a")
+	binds = append(binds, sqltypes.BytesBindVariable(le.ksid))
+	return sqlparser.ParseAndBind(buf.String(), binds...)
+}
+
+func (lv *lookupVerifier) deleteQuery(le *lookupEntry) (string, error) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("delete from %v where ", sqlparser.NewTableIdent(lv.lookupTable))
+	var binds []*querypb.BindVariable
+	for i, col := range lv.fromCols {
+		buf.Myprintf("%v = %s and ", sqlparser.NewColIdent(col), "%a")
+		binds = append(binds, sqltypes.ValueBindVariable(le.from[i]))
+	}
+	buf.Myprintf("%v = %s", sqlparser.NewColIdent(lv.toCol), "%a")
+	binds = append(binds, sqltypes.BytesBindVariable(le.ksid))
+	return sqlparser.ParseAndBind(buf.String(), binds...)
+}
+
+func (lv *lookupVerifier) rowDiff(le *lookupEntry, query string) *vtctldatapb.LookupVindexRowDiff {
+	row := make(map[string]*querypb.Value, len(lv.fromCols)+1)
+	for i, col := range lv.fromCols {
+		row[col] = sqltypes.ValueToProto(le.from[i])
+	}
+	row[lv.toCol] = sqltypes.ValueToProto(sqltypes.MakeTrusted(sqltypes.VarBinary, le.ksid))
+	return &vtctldatapb.LookupVindexRowDiff{Row: row, Query: query}
+}
+
+// execute runs a repair query on the master of the shard if a repair was
+// requested, and counts the repaired row.
+func (lv *lookupVerifier) execute(ctx context.Context, si *topo.ShardInfo, dr *vtctldatapb.LookupVindexReport, query string) error {
+	if !lv.repair {
+		return nil
+	}
+	master, err := lv.master(ctx, si)
+	if err != nil {
+		return err
+	}
+	qr, err := lv.s.tmc.ExecuteFetchAsDba(ctx, master, false, []byte(query), 1, false, false)
+	if err != nil {
+		return vterrors.Wrapf(err, "ExecuteFetchAsDba(%v, %s)", master, query)
+	}
+	if qr.RowsAffected > 0 {
+		dr.RepairedRows++
+	}
+	return nil
+}
+
+func (lv *lookupVerifier) master(ctx context.Context, si *topo.ShardInfo) (*topodatapb.Tablet, error) {
+	if si.MasterAlias == nil {
+		return nil, fmt.Errorf("shard %s/%s has no master", si.Keyspace(), si.ShardName())
+	}
+	master, err := lv.s.ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "GetTablet(%v) failed", si.MasterAlias)
+	}
+	return master.Tablet, nil
+}
+
+// streamRows streams the results of query from the master of the shard,
+// using the same mechanism as VDiff, and calls f for every row.
+func (lv *lookupVerifier) streamRows(ctx context.Context, si *topo.ShardInfo, query string, f func([]sqltypes.Value) error) error {
+	master, err := lv.master(ctx, si)
+	if err != nil {
+		return err
+	}
+	conn, err := tabletconn.GetDialer()(master, grpcclient.FailFast(false))
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+
+	log.Infof("Streaming %q from %v", query, master.Alias)
+	target := &querypb.Target{
+		Keyspace:   si.Keyspace(),
+		Shard:      si.ShardName(),
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	var fields []*querypb.Field
+	return conn.VStreamResults(ctx, target, query, func(vrs *binlogdatapb.VStreamResultsResponse) error {
+		if vrs.Fields != nil {
+			fields = vrs.Fields
+		}
+		result := sqltypes.Proto3ToResult(&querypb.QueryResult{
+			Fields: fields,
+			Rows:   vrs.Rows,
+		})
+		for _, row := range result.Rows {
+			if err := f(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
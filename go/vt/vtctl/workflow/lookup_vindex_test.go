@@ -0,0 +1,334 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/queryservice/fakes"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+const lookupVindexTestProtocol = "LookupVindexTest"
+
+// lookupVindexTablets are the fake tablets of the lookup vindex tests, by uid.
+// They're global for RegisterDialer to work.
+var lookupVindexTablets sync.Map
+
+func init() {
+	tabletconn.RegisterDialer(lookupVindexTestProtocol, func(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (queryservice.QueryService, error) {
+		ft, ok := lookupVindexTablets.Load(tablet.Alias.Uid)
+		if !ok {
+			return nil, fmt.Errorf("no fake tablet %v", tablet.Alias)
+		}
+		return ft.(*fakeTableTablet), nil
+	})
+}
+
+// fakeTable is an in-memory table whose rows are unique by primary key.
+type fakeTable struct {
+	fields []*querypb.Field
+	pk     []string
+	rows   [][]sqltypes.Value
+}
+
+func (ft *fakeTable) column(name string) int {
+	for i, field := range ft.fields {
+		if field.Name == name {
+			return i
+		}
+	}
+	panic(fmt.Sprintf("unknown column %s", name))
+}
+
+// fakeTableTablet serves the few queries the lookup vindex verifier runs
+// against its in-memory tables: it streams selects with an optional IN
+// clause, and executes inserts and deletes.
+type fakeTableTablet struct {
+	queryservice.QueryService
+	tables map[string]*fakeTable
+}
+
+func newFakeTableTablet(uid uint32, tables map[string]*fakeTable) *fakeTableTablet {
+	ft := &fakeTableTablet{QueryService: fakes.ErrorQueryService, tables: tables}
+	lookupVindexTablets.Store(uid, ft)
+	return ft
+}
+
+func literalValue(expr sqlparser.Expr) sqltypes.Value {
+	lit := expr.(*sqlparser.Literal)
+	if lit.Type == sqlparser.IntVal {
+		return sqltypes.NewInt64(mustParseInt(lit.Val))
+	}
+	return sqltypes.NewVarBinary(lit.Val)
+}
+
+func mustParseInt(s string) int64 {
+	var v int64
+	if _, err := fmt.Sscan(s, &v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ft *fakeTableTablet) VStreamResults(ctx context.Context, target *querypb.Target, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return err
+	}
+	sel := stmt.(*sqlparser.Select)
+	table := ft.tables[sqlparser.GetTableName(sel.From[0].(*sqlparser.AliasedTableExpr).Expr).String()]
+	var columns []int
+	var fields []*querypb.Field
+	for _, expr := range sel.SelectExprs {
+		col := table.column(expr.(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name.String())
+		columns = append(columns, col)
+		fields = append(fields, table.fields[col])
+	}
+	match := func(row []sqltypes.Value) bool { return true }
+	if sel.Where != nil {
+		cmp := sel.Where.Expr.(*sqlparser.ComparisonExpr)
+		if cmp.Operator != sqlparser.InOp {
+			// All rows fit in the first chunk.
+			match = func(row []sqltypes.Value) bool { return false }
+		} else {
+			col := table.column(cmp.Left.(*sqlparser.ColName).Name.String())
+			values := make(map[string]bool)
+			for _, expr := range cmp.Right.(sqlparser.ValTuple) {
+				values[literalValue(expr).ToString()] = true
+			}
+			match = func(row []sqltypes.Value) bool { return values[row[col].ToString()] }
+		}
+	}
+	result := &sqltypes.Result{Fields: fields}
+	for _, row := range table.rows {
+		if !match(row) {
+			continue
+		}
+		selected := make([]sqltypes.Value, 0, len(columns))
+		for _, col := range columns {
+			selected = append(selected, row[col])
+		}
+		result.Rows = append(result.Rows, selected)
+	}
+	qr := sqltypes.ResultToProto3(result)
+	return send(&binlogdatapb.VStreamResultsResponse{Fields: qr.Fields, Rows: qr.Rows})
+}
+
+func (ft *fakeTableTablet) execute(query string) (*sqltypes.Result, error) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Insert:
+		table := ft.tables[stmt.Table.Name.String()]
+		row := make([]sqltypes.Value, len(table.fields))
+		for i, expr := range stmt.Rows.(sqlparser.Values)[0] {
+			row[table.column(stmt.Columns[i].String())] = literalValue(expr)
+		}
+		for _, existing := range table.rows {
+			duplicate := true
+			for _, pk := range table.pk {
+				col := table.column(pk)
+				duplicate = duplicate && existing[col].ToString() == row[col].ToString()
+			}
+			if duplicate {
+				if stmt.Ignore {
+					return &sqltypes.Result{}, nil
+				}
+				return nil, fmt.Errorf("duplicate entry for %s", query)
+			}
+		}
+		table.rows = append(table.rows, row)
+		return &sqltypes.Result{RowsAffected: 1}, nil
+	case *sqlparser.Delete:
+		table := ft.tables[sqlparser.GetTableName(stmt.TableExprs[0].(*sqlparser.AliasedTableExpr).Expr).String()]
+		conditions := make(map[int]string)
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if cmp, ok := node.(*sqlparser.ComparisonExpr); ok {
+				conditions[table.column(cmp.Left.(*sqlparser.ColName).Name.String())] = literalValue(cmp.Right).ToString()
+			}
+			return true, nil
+		}, stmt.Where)
+		result := &sqltypes.Result{}
+		var rows [][]sqltypes.Value
+		for _, row := range table.rows {
+			deleted := true
+			for col, value := range conditions {
+				deleted = deleted && row[col].ToString() == value
+			}
+			if deleted {
+				result.RowsAffected++
+				continue
+			}
+			rows = append(rows, row)
+		}
+		table.rows = rows
+		return result, nil
+	}
+	return nil, fmt.Errorf("unsupported query %s", query)
+}
+
+type fakeLookupVindexTMC struct {
+	tmclient.TabletManagerClient
+	tablets map[string]*fakeTableTablet
+}
+
+func (tmc *fakeLookupVindexTMC) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	sd := &tabletmanagerdatapb.SchemaDefinition{}
+	for name, table := range tmc.tablets[topoproto.TabletAliasString(tablet.Alias)].tables {
+		sd.TableDefinitions = append(sd.TableDefinitions, &tabletmanagerdatapb.TableDefinition{Name: name, PrimaryKeyColumns: table.pk})
+	}
+	return sd, nil
+}
+
+func (tmc *fakeLookupVindexTMC) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	qr, err := tmc.tablets[topoproto.TabletAliasString(tablet.Alias)].execute(string(query))
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.ResultToProto3(qr), nil
+}
+
+func addMasterTablet(ctx context.Context, t *testing.T, ts *topo.Server, tmc *fakeLookupVindexTMC, keyspace string, uid uint32, tables map[string]*fakeTable) {
+	t.Helper()
+	alias := &topodatapb.TabletAlias{Cell: "zone1", Uid: uid}
+	require.NoError(t, ts.CreateKeyspace(ctx, keyspace, &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, keyspace, "0"))
+	require.NoError(t, ts.CreateTablet(ctx, &topodatapb.Tablet{Alias: alias, Keyspace: keyspace, Shard: "0", Type: topodatapb.TabletType_MASTER}))
+	_, err := ts.UpdateShardFields(ctx, keyspace, "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = alias
+		return nil
+	})
+	require.NoError(t, err)
+	tmc.tablets[topoproto.TabletAliasString(alias)] = newFakeTableTablet(uid, tables)
+}
+
+func TestVerifyLookupVindex(t *testing.T) {
+	protocol := flag.CommandLine.Lookup("tablet_protocol").Value.String()
+	require.NoError(t, flag.Set("tablet_protocol", lookupVindexTestProtocol))
+	defer flag.Set("tablet_protocol", protocol)
+
+	hash, err := vindexes.CreateVindex("hash", "hash", nil)
+	require.NoError(t, err)
+	ksid := func(id int64) sqltypes.Value {
+		destinations, err := vindexes.Map(hash, nil, [][]sqltypes.Value{{sqltypes.NewInt64(id)}})
+		require.NoError(t, err)
+		return sqltypes.NewVarBinary(string(destinations[0].(key.DestinationKeyspaceID)))
+	}
+
+	newTables := func() (owner, lookup *fakeTable) {
+		owner = &fakeTable{
+			fields: sqltypes.MakeTestFields("id|name", "int64|varbinary"),
+			pk:     []string{"id"},
+			rows: [][]sqltypes.Value{
+				{sqltypes.NewInt64(1), sqltypes.NewVarBinary("a")},
+				{sqltypes.NewInt64(2), sqltypes.NewVarBinary("b")},
+				{sqltypes.NewInt64(3), sqltypes.NULL},
+			},
+		}
+		lookup = &fakeTable{
+			fields: sqltypes.MakeTestFields("name|keyspace_id", "varbinary|varbinary"),
+			pk:     []string{"name"},
+			rows: [][]sqltypes.Value{
+				{sqltypes.NewVarBinary("a"), ksid(1)},
+				// The entry of row 2 has the keyspace id of row 1.
+				{sqltypes.NewVarBinary("b"), ksid(1)},
+				// No row has the name c.
+				{sqltypes.NewVarBinary("c"), ksid(3)},
+			},
+		}
+		return owner, lookup
+	}
+
+	for _, repair := range []bool{false, true} {
+		t.Run(fmt.Sprintf("repair=%v", repair), func(t *testing.T) {
+			ctx := context.Background()
+			ts := memorytopo.NewServer("zone1")
+			tmc := &fakeLookupVindexTMC{tablets: make(map[string]*fakeTableTablet)}
+			owner, lookup := newTables()
+			addMasterTablet(ctx, t, ts, tmc, "ks", 100, map[string]*fakeTable{"t1": owner})
+			addMasterTablet(ctx, t, ts, tmc, "lookup", 200, map[string]*fakeTable{"name_idx": lookup})
+			require.NoError(t, ts.SaveVSchema(ctx, "ks", &vschemapb.Keyspace{
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {Type: "hash"},
+					"name_idx": {
+						Type:   "consistent_lookup_unique",
+						Params: map[string]string{"table": "lookup.name_idx", "from": "name", "to": "keyspace_id"},
+						Owner:  "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}, {Column: "name", Name: "name_idx"}}},
+				},
+			}))
+			require.NoError(t, ts.SaveVSchema(ctx, "lookup", &vschemapb.Keyspace{}))
+
+			resp, err := NewServer(ts, tmc).VerifyLookupVindex(ctx, &vtctldatapb.VerifyLookupVindexRequest{
+				Keyspace: "ks",
+				Vindex:   "name_idx",
+				Repair:   repair,
+			})
+			require.NoError(t, err)
+			report := resp.Reports["0"]
+			require.NotNil(t, report)
+			assert.EqualValues(t, 3, report.ProcessedRows)
+			assert.EqualValues(t, 1, report.MatchingRows)
+			assert.EqualValues(t, 2, report.OrphanedRows)
+			assert.EqualValues(t, 1, report.MissingRows)
+
+			if !repair {
+				assert.EqualValues(t, 0, report.RepairedRows)
+				_, unchanged := newTables()
+				assert.Equal(t, unchanged.rows, lookup.rows)
+				return
+			}
+			assert.EqualValues(t, 3, report.RepairedRows)
+			// The entry with the wrong keyspace id is replaced, not lost.
+			assert.Equal(t, [][]sqltypes.Value{
+				{sqltypes.NewVarBinary("a"), ksid(1)},
+				{sqltypes.NewVarBinary("b"), ksid(2)},
+			}, lookup.rows)
+		})
+	}
+}
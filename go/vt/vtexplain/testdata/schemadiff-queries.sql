@@ -0,0 +1,4 @@
+create table t1 (id bigint(20) unsigned not null, intval bigint(20) unsigned not null default 0, floatval float not null default 0, primary key (id));
+create table t1 (id bigint(20) unsigned not null, intval bigint(20) unsigned not null default 0, floatval float not null default 0, name varchar(64), primary key (id));
+create table t9 (id bigint primary key);
+create view v1 as select * from t1;
@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/jsonutil"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schemadiff"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 
@@ -38,6 +39,10 @@ import (
 
 var (
 	batchInterval = flag.Duration("batch-interval", 10*time.Millisecond, "Interval between logical time slots.")
+
+	// explainSchema is the schema that CREATE TABLE and CREATE VIEW
+	// statements are compared with.
+	explainSchema *schemadiff.Schema
 )
 
 // ExecutorMode controls the mode of operation for the vtexplain simulator
@@ -141,6 +146,10 @@ type Explain struct {
 
 	// list of queries / bind vars sent to each tablet
 	TabletActions map[string]*TabletActions
+
+	// the statements that apply a CREATE TABLE or CREATE VIEW statement
+	// to the schema, as a declarative migration would
+	SchemaDiff []string `json:",omitempty"`
 }
 
 // Init sets up the fake execution environment
@@ -155,6 +164,11 @@ func Init(vSchemaStr, sqlSchema, ksShardMapStr string, opts *Options) error {
 		return fmt.Errorf("parseSchema: %v", err)
 	}
 
+	explainSchema, err = newExplainSchema(parsedDDLs)
+	if err != nil {
+		return fmt.Errorf("newExplainSchema: %v", err)
+	}
+
 	tabletEnv, err := newTabletEnvironment(parsedDDLs, opts)
 	if err != nil {
 		return fmt.Errorf("initTabletEnvironment: %v", err)
@@ -229,6 +243,18 @@ func parseSchema(sqlSchema string, opts *Options) ([]sqlparser.DDLStatement, err
 	return parsedDDLs, nil
 }
 
+// newExplainSchema returns the schema of the CREATE TABLE statements that
+// define their columns.
+func newExplainSchema(ddls []sqlparser.DDLStatement) (*schemadiff.Schema, error) {
+	var stmts []sqlparser.Statement
+	for _, ddl := range ddls {
+		if create, ok := ddl.(*sqlparser.CreateTable); ok && create.TableSpec != nil {
+			stmts = append(stmts, create)
+		}
+	}
+	return schemadiff.NewSchemaFromStatements(stmts)
+}
+
 // Run the explain analysis on the given queries
 func Run(sql string) ([]*Explain, error) {
 	explains := make([]*Explain, 0, 16)
@@ -278,6 +304,13 @@ func Run(sql string) ([]*Explain, error) {
 }
 
 func explain(sql string) (*Explain, error) {
+	if stmt, err := sqlparser.Parse(sql); err == nil {
+		switch stmt.(type) {
+		case *sqlparser.CreateTable, *sqlparser.CreateView:
+			return explainSchemaDiff(sql, stmt)
+		}
+	}
+
 	plans, tabletActions, err := vtgateExecute(sql)
 	if err != nil {
 		return nil, err
@@ -290,6 +323,28 @@ func explain(sql string) (*Explain, error) {
 	}, nil
 }
 
+// explainSchemaDiff explains a CREATE TABLE or CREATE VIEW statement as a
+// declarative migration, which is compared with the schema instead of
+// being sent to the tablets.
+func explainSchemaDiff(sql string, stmt sqlparser.Statement) (*Explain, error) {
+	declared, err := explainSchema.Declare(stmt)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := explainSchema.Diff(declared, nil)
+	if err != nil {
+		return nil, err
+	}
+	e := &Explain{
+		SQL:           sql,
+		TabletActions: map[string]*TabletActions{},
+	}
+	for _, stmt := range diff {
+		e.SchemaDiff = append(e.SchemaDiff, sqlparser.String(stmt))
+	}
+	return e, nil
+}
+
 type outputQuery struct {
 	tablet string
 	Time   int
@@ -304,6 +359,10 @@ func ExplainsAsText(explains []*Explain) string {
 		fmt.Fprintf(&b, "----------------------------------------------------------------------\n")
 		fmt.Fprintf(&b, "%s\n\n", explain.SQL)
 
+		for _, stmt := range explain.SchemaDiff {
+			fmt.Fprintf(&b, "schema diff: %s\n", stmt)
+		}
+
 		queries := make([]outputQuery, 0, 4)
 		for tablet, actions := range explain.TabletActions {
 			for _, q := range actions.MysqlQueries {
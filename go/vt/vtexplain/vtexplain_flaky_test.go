@@ -127,6 +127,7 @@ func TestExplain(t *testing.T) {
 		{"updatesharded", defaultTestOpts()},
 		{"deletesharded", defaultTestOpts()},
 		{"comments", defaultTestOpts()},
+		{"schemadiff", defaultTestOpts()},
 		{"options", &Options{
 			ReplicationMode: "STATEMENT",
 			NumShards:       4,
@@ -423,6 +423,7 @@ func newTabletEnvironment(ddls []sqlparser.DDLStatement, opts *Options) (*tablet
 	}
 
 	indexRows := make([][]sqltypes.Value, 0, 4)
+	var uniqueKeyRows [][]sqltypes.Value
 	for _, ddl := range ddls {
 		table := sqlparser.String(ddl.GetTable().Name)
 
@@ -435,12 +436,17 @@ func newTabletEnvironment(ddls []sqlparser.DDLStatement, opts *Options) (*tablet
 			continue
 		}
 		for _, idx := range ddl.GetTableSpec().Indexes {
-			if !idx.Info.Primary {
-				continue
-			}
-			for _, col := range idx.Columns {
-				row := mysql.ShowPrimaryRow(table, col.Column.String())
-				indexRows = append(indexRows, row)
+			switch {
+			case idx.Info.Primary:
+				for _, col := range idx.Columns {
+					row := mysql.ShowPrimaryRow(table, col.Column.String())
+					indexRows = append(indexRows, row)
+				}
+			case idx.Info.Unique:
+				for _, col := range idx.Columns {
+					row := mysql.ShowUniqueKeyRow(table, idx.Info.Name.String(), col.Column.String())
+					uniqueKeyRows = append(uniqueKeyRows, row)
+				}
 			}
 		}
 
@@ -464,6 +470,10 @@ func newTabletEnvironment(ddls []sqlparser.DDLStatement, opts *Options) (*tablet
 		Fields: mysql.ShowPrimaryFields,
 		Rows:   indexRows,
 	}
+	tEnv.schemaQueries[mysql.BaseShowUniqueKeys] = &sqltypes.Result{
+		Fields: mysql.ShowUniqueKeysFields,
+		Rows:   uniqueKeyRows,
+	}
 
 	return &tEnv, nil
 }
@@ -28,6 +28,11 @@ import (
 
 var _ Primitive = (*DDL)(nil)
 
+var (
+	errTableDataUnverifiable    = vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "table data cannot be verified by direct DDL, use an online DDL strategy")
+	errTableIndexesUnverifiable = vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "table indexes cannot be verified by direct DDL, use an online DDL strategy")
+)
+
 // DDL represents a DDL statement, either normal or online DDL
 type DDL struct {
 	Keyspace *vindexes.Keyspace
@@ -99,6 +104,10 @@ func (ddl *DDL) Execute(vcursor VCursor, bindVars map[string]*query.BindVariable
 	}
 	ddl.OnlineDDL.DDLStrategySetting = ddlStrategySetting
 
+	if err := ddl.checkSchemaPolicy(vcursor); err != nil {
+		return nil, err
+	}
+
 	switch {
 	case ddl.isOnlineSchemaDDL():
 		if !ddl.OnlineDDLEnabled {
@@ -113,6 +122,27 @@ func (ddl *DDL) Execute(vcursor VCursor, bindVars map[string]*query.BindVariable
 	}
 }
 
+// checkSchemaPolicy rejects DDL statements which violate the schema policy of the keyspace.
+// Online DDL is evaluated again by the tablets, where rules which depend on table data or on existing indexes
+// are evaluated, too. Direct DDL is only evaluated here, where neither can be verified.
+func (ddl *DDL) checkSchemaPolicy(vcursor VCursor) error {
+	policy, err := vcursor.GetSchemaPolicy(ddl.Keyspace.Name)
+	if err != nil {
+		return err
+	}
+	var tableHasRows schema.TableHasRowsFunc
+	var tableIndexCount schema.TableIndexCountFunc
+	if !ddl.isOnlineSchemaDDL() {
+		tableHasRows = func(tableName string) (bool, error) {
+			return false, errTableDataUnverifiable
+		}
+		tableIndexCount = func(tableName string) (int, error) {
+			return 0, errTableIndexesUnverifiable
+		}
+	}
+	return policy.Check(ddl.DDL, tableHasRows, tableIndexCount)
+}
+
 // StreamExecute implements the Primitive interface
 func (ddl *DDL) StreamExecute(vcursor VCursor, bindVars map[string]*query.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	results, err := ddl.Execute(vcursor, bindVars, wantfields)
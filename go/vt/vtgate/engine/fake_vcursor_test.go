@@ -250,6 +250,10 @@ func (t *noopVCursor) SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error {
 	panic("unimplemented")
 }
 
+func (t *noopVCursor) GetSchemaPolicy(keyspace string) (*schema.SchemaPolicy, error) {
+	panic("unimplemented")
+}
+
 func (t *noopVCursor) GetDBDDLPluginName() string {
 	panic("unimplemented")
 }
@@ -285,6 +289,8 @@ type loggingVCursor struct {
 	tableRoutes tableRoutes
 	dbDDLPlugin string
 	ksAvailable bool
+
+	schemaPolicy *schema.SchemaPolicy
 }
 
 type tableRoutes struct {
@@ -400,6 +406,13 @@ func (f *loggingVCursor) SubmitOnlineDDL(onlineDDL *schema.OnlineDDL) error {
 	return nil
 }
 
+func (f *loggingVCursor) GetSchemaPolicy(keyspace string) (*schema.SchemaPolicy, error) {
+	if f.schemaPolicy == nil {
+		return &schema.SchemaPolicy{}, nil
+	}
+	return f.schemaPolicy, nil
+}
+
 func (f *loggingVCursor) ExecuteStandalone(query string, bindvars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
 	f.log = append(f.log, fmt.Sprintf("ExecuteStandalone %s %v %s %s", query, printBindVars(bindvars), rs.Target.Keyspace, rs.Target.Shard))
 	return f.nextResult()
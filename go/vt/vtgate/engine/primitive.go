@@ -87,6 +87,9 @@ type (
 
 		SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error
 
+		// GetSchemaPolicy returns the schema policy which DDL statements of the keyspace must satisfy
+		GetSchemaPolicy(keyspace string) (*schema.SchemaPolicy, error)
+
 		Session() SessionActions
 
 		ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error)
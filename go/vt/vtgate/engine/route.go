@@ -183,6 +183,11 @@ const (
 	SelectReference
 	// SelectNone is used for queries that always return empty values
 	SelectNone
+	// SelectRange is for routing a query that has an inequality
+	// or BETWEEN clause using a Ranged Vindex. Requires: A Ranged
+	// Vindex, and two Values for the lower and upper bounds of the
+	// range. A NULL bound means that the range is open on that side.
+	SelectRange
 	// NumRouteOpcodes is the number of opcodes
 	NumRouteOpcodes
 )
@@ -198,6 +203,7 @@ var routeName = map[RouteOpcode]string{
 	SelectDBA:         "SelectDBA",
 	SelectReference:   "SelectReference",
 	SelectNone:        "SelectNone",
+	SelectRange:       "SelectRange",
 }
 
 var (
@@ -265,6 +271,8 @@ func (route *Route) execute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
 	case SelectMultiEqual:
 		rss, bvs, err = route.paramsSelectMultiEqual(vcursor, bindVars)
+	case SelectRange:
+		rss, bvs, err = route.paramsSelectRange(vcursor, bindVars)
 	case SelectNone:
 		rss, bvs, err = nil, nil, nil
 	default:
@@ -339,6 +347,8 @@ func (route *Route) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.
 		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
 	case SelectMultiEqual:
 		rss, bvs, err = route.paramsSelectMultiEqual(vcursor, bindVars)
+	case SelectRange:
+		rss, bvs, err = route.paramsSelectRange(vcursor, bindVars)
 	case SelectNone:
 		rss, bvs, err = nil, nil, nil
 	default:
@@ -669,6 +679,34 @@ func (route *Route) paramsSelectMultiEqual(vcursor VCursor, bindVars map[string]
 	return rss, multiBindVars, nil
 }
 
+func (route *Route) paramsSelectRange(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	ranged, ok := route.Vindex.(vindexes.Ranged)
+	if !ok {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex '%s' does not support range queries", route.Vindex.String())
+	}
+	from, err := route.Values[0].ResolveValue(bindVars)
+	if err != nil {
+		return nil, nil, err
+	}
+	to, err := route.Values[1].ResolveValue(bindVars)
+	if err != nil {
+		return nil, nil, err
+	}
+	destination, err := ranged.MapRange(vcursor, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{destination})
+	if err != nil {
+		return nil, nil, err
+	}
+	multiBindVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range multiBindVars {
+		multiBindVars[i] = bindVars
+	}
+	return rss, multiBindVars, nil
+}
+
 func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
 	// Convert vindexKeys to []*querypb.Value
 	ids := make([]*querypb.Value, len(vindexKeys))
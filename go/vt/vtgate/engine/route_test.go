@@ -676,6 +676,63 @@ func TestSelectLike(t *testing.T) {
 
 }
 
+func TestSelectRange(t *testing.T) {
+	vindex, _ := vindexes.NewNumeric("", nil)
+	sel := NewRoute(
+		SelectRange,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex.(vindexes.SingleColumn)
+	sel.Values = []sqltypes.PlanValue{
+		{Value: sqltypes.NewInt64(1)},
+		{Key: "to"},
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"-20"},
+		results:      []*sqltypes.Result{defaultSelectResult},
+	}
+	bv := map[string]*querypb.BindVariable{"to": sqltypes.Int64BindVariable(10)}
+	result, err := sel.Execute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(0000000000000001-000000000000000b)`,
+		`ExecuteMultiShard ks.-20: dummy_select {to: type:INT64 value:"10"} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	vc.Rewind()
+	result, err = wrapStreamExecute(sel, vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(0000000000000001-000000000000000b)`,
+		`StreamExecuteMulti dummy_select ks.-20: {to: type:INT64 value:"10"} `,
+	})
+	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
+
+	// An empty range routes nowhere.
+	vc.Rewind()
+	bv = map[string]*querypb.BindVariable{"to": sqltypes.Int64BindVariable(0)}
+	result, err = sel.Execute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationNone()`,
+	})
+	expectResult(t, "sel.Execute", result, &sqltypes.Result{})
+
+	// A vindex that is not Ranged cannot be used.
+	hash, _ := vindexes.NewHash("", nil)
+	sel.Vindex = hash.(vindexes.SingleColumn)
+	_, err = sel.Execute(vc, bv, false)
+	require.EqualError(t, err, "vindex '' does not support range queries")
+}
+
 func TestSelectNext(t *testing.T) {
 	sel := NewRoute(
 		SelectNext,
@@ -39,10 +39,12 @@ import (
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/sysvars"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
@@ -98,8 +100,9 @@ type Executor struct {
 	normalize       bool
 	warnShardedOnly bool
 
-	vm            *VSchemaManager
-	schemaTracker SchemaInfo
+	vm             *VSchemaManager
+	schemaTracker  SchemaInfo
+	schemaPolicies *schemaPolicyCache
 
 	// allowScatter will fail planning if set to false and a plan contains any scatter queries
 	allowScatter bool
@@ -134,6 +137,7 @@ func NewExecutor(
 		warnShardedOnly: warnOnShardedOnly,
 		streamSize:      streamSize,
 		schemaTracker:   schemaTracker,
+		schemaPolicies:  newSchemaPolicyCache(*schemaPolicyCacheTTL),
 		allowScatter:    !noScatter,
 	}
 
@@ -1158,6 +1162,11 @@ func (e *Executor) VSchema() *vindexes.VSchema {
 	return e.vschema
 }
 
+// SchemaPolicy returns the schema policy of a keyspace, which is cached for -schema_policy_cache_ttl
+func (e *Executor) SchemaPolicy(ctx context.Context, ts *topo.Server, keyspace string) (*schema.SchemaPolicy, error) {
+	return e.schemaPolicies.get(ctx, ts, keyspace)
+}
+
 // SaveVSchema updates the vschema and stats
 func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 	e.mu.Lock()
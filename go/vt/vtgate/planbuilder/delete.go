@@ -21,6 +21,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
 // buildDeletePlan builds the instructions for a DELETE statement.
@@ -53,6 +54,10 @@ func buildDeletePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 		return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.UnknownTable, "Unknown table '%s' in MULTI DELETE", del.Targets[0].Name.String())
 	}
 
+	if err := checkForeignKeyCascades(vschema, "delete", edel.Table, (*vindexes.ForeignKey).CascadesDelete); err != nil {
+		return nil, err
+	}
+
 	if len(edel.Table.Owned) > 0 {
 		edel.OwnedVindexQuery = generateDMLSubquery(del.Where, del.OrderBy, del.Limit, edel.Table, ksidCol)
 		edel.KsidVindex = ksidVindex
@@ -175,6 +175,24 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 	return edml, ksidVindex, ksidCol, nil
 }
 
+// checkForeignKeyCascades returns an error if a DML on the parent table would
+// cascade to child rows that may live on other shards. MySQL only applies the
+// cascade on the shard of the parent row, so such a DML would silently leave
+// the other child rows untouched.
+func checkForeignKeyCascades(vschema ContextVSchema, dmlType string, parent *vindexes.Table, cascades func(*vindexes.ForeignKey) bool) error {
+	for _, fk := range parent.ChildForeignKeys {
+		if !cascades(fk) {
+			continue
+		}
+		// A child table that can't be found can't be proven to be shard scoped.
+		child, _, _, _, _ := vschema.FindTable(sqlparser.TableName{Name: fk.Table, Qualifier: sqlparser.NewTableIdent(parent.Keyspace.Name)})
+		if !vindexes.ForeignKeyShardScoped(fk, child, parent) {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s on table %s cascades through foreign key %s to table %s across shards", dmlType, parent.Name.String(), fk.Name, fk.Table.String())
+		}
+	}
+	return nil
+}
+
 func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCol string) string {
 	buf := sqlparser.NewTrackedBuffer(nil)
 	buf.Myprintf("select %s", ksidCol)
@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
@@ -230,6 +231,8 @@ func (rp *routePlan) cost() int {
 		return 10
 	case engine.SelectMultiEqual:
 		return 10
+	case engine.SelectRange:
+		return 15
 	case engine.SelectScatter:
 		return 20
 	}
@@ -328,10 +331,27 @@ func (rp *routePlan) searchForNewVindexes(predicates []sqlparser.Expr) (bool, er
 					return false, err
 				}
 				newVindexFound = newVindexFound || found
+			case sqlparser.LessThanOp, sqlparser.LessEqualOp, sqlparser.GreaterThanOp, sqlparser.GreaterEqualOp:
+				found, err := rp.planInequalityOp(node)
+				if err != nil {
+					return false, err
+				}
+				newVindexFound = newVindexFound || found
 
 			default:
 				return false, semantics.Gen4NotSupportedF("%s", sqlparser.String(filter))
 			}
+		case *sqlparser.RangeCond:
+			if node.Operator == sqlparser.BetweenOp && (sqlparser.IsNull(node.From) || sqlparser.IsNull(node.To)) {
+				// nothing is between NULL and something else
+				rp.routeOpCode = engine.SelectNone
+				return false, nil
+			}
+			found, err := rp.planBetweenOp(node)
+			if err != nil {
+				return false, err
+			}
+			newVindexFound = newVindexFound || found
 		case *sqlparser.IsExpr:
 			found, err := rp.planIsExpr(node)
 			if err != nil {
@@ -482,6 +502,120 @@ func (rp *routePlan) planLikeOp(node *sqlparser.ComparisonExpr) (bool, error) {
 	return rp.haveMatchingVindex(node, column, *val, selectEqual, vdx), err
 }
 
+// planInequalityOp uses an inequality as a range with a single bound.
+// The bound is inclusive even for strict inequalities: routing to a
+// few extra keyspace ids is harmless.
+func (rp *routePlan) planInequalityOp(node *sqlparser.ComparisonExpr) (bool, error) {
+	column, ok := node.Left.(*sqlparser.ColName)
+	other := node.Right
+	operator := node.Operator
+	if !ok {
+		column, ok = node.Right.(*sqlparser.ColName)
+		if !ok {
+			return false, nil
+		}
+		other = node.Left
+		switch operator {
+		case sqlparser.LessThanOp:
+			operator = sqlparser.GreaterThanOp
+		case sqlparser.LessEqualOp:
+			operator = sqlparser.GreaterEqualOp
+		case sqlparser.GreaterThanOp:
+			operator = sqlparser.LessThanOp
+		case sqlparser.GreaterEqualOp:
+			operator = sqlparser.LessEqualOp
+		}
+	}
+	switch operator {
+	case sqlparser.LessThanOp, sqlparser.LessEqualOp:
+		return rp.planRangeOp(node, column, &sqlparser.NullVal{}, other)
+	}
+	return rp.planRangeOp(node, column, other, &sqlparser.NullVal{})
+}
+
+func (rp *routePlan) planBetweenOp(node *sqlparser.RangeCond) (bool, error) {
+	if node.Operator != sqlparser.BetweenOp {
+		return false, nil
+	}
+	column, ok := node.Left.(*sqlparser.ColName)
+	if !ok {
+		return false, nil
+	}
+	return rp.planRangeOp(node, column, node.From, node.To)
+}
+
+// planRangeOp offers the range [from, to] to the Ranged vindexes on column.
+// A NULL bound means that the range is open on that side. If the vindex
+// already has a range, the open sides of that range are filled in.
+func (rp *routePlan) planRangeOp(node sqlparser.Expr, column *sqlparser.ColName, from, to sqlparser.Expr) (bool, error) {
+	fromVal, err := makePlanValue(from)
+	if err != nil || fromVal == nil {
+		return false, err
+	}
+	toVal, err := makePlanValue(to)
+	if err != nil || toVal == nil {
+		return false, err
+	}
+
+	newVindexFound := false
+	for _, v := range rp.vindexPreds {
+		if len(v.colVindex.Columns) != 1 || !column.Name.Equal(v.colVindex.Columns[0]) {
+			continue
+		}
+		if !isRangeSafe(v.colVindex.Vindex, rp.columnType(v.colVindex, column)) {
+			continue
+		}
+		switch {
+		case v.foundVindex == nil:
+			v.values = []sqltypes.PlanValue{*fromVal, *toVal}
+			v.predicates = []sqlparser.Expr{node}
+			v.opcode = engine.SelectRange
+			v.foundVindex = v.colVindex.Vindex
+			newVindexFound = true
+		case v.opcode == engine.SelectRange:
+			// Bounds may be bind variables, so they can't be compared at
+			// plan time. The slices are copied because clones share them.
+			values := []sqltypes.PlanValue{v.values[0], v.values[1]}
+			if values[0].IsNull() {
+				values[0] = *fromVal
+			}
+			if values[1].IsNull() {
+				values[1] = *toVal
+			}
+			v.values = values
+			v.predicates = append(v.predicates[:len(v.predicates):len(v.predicates)], node)
+			if rp.routeOpCode == engine.SelectRange && rp.vindex == v.foundVindex {
+				rp.vindexValues = v.values
+				rp.vindexPredicates = v.predicates
+			}
+		}
+	}
+	return newVindexFound, nil
+}
+
+// columnType returns the vschema type of column in the table that owns
+// the column vindex, or NULL_TYPE if the vschema doesn't declare it.
+func (rp *routePlan) columnType(colVindex *vindexes.ColumnVindex, column *sqlparser.ColName) querypb.Type {
+	typ := sqltypes.Null
+	_ = visitTables(rp.tables, func(tbl *routeTable) error {
+		if tbl.vtable == nil {
+			return nil
+		}
+		for _, cv := range tbl.vtable.ColumnVindexes {
+			if cv != colVindex {
+				continue
+			}
+			for _, col := range tbl.vtable.Columns {
+				if col.Name.Equal(column.Name) {
+					typ = col.Type
+				}
+			}
+		}
+		return nil
+	})
+	return typ
+}
+
 func (rp *routePlan) planIsExpr(node *sqlparser.IsExpr) (bool, error) {
 	// we only handle IS NULL correct. IsExpr can contain other expressions as well
 	if node.Right != sqlparser.IsNullOp {
@@ -532,12 +666,16 @@ func (rp *routePlan) haveMatchingVindex(
 ) bool {
 	newVindexFound := false
 	for _, v := range rp.vindexPreds {
-		if v.foundVindex != nil {
+		if v.foundVindex != nil && v.opcode != engine.SelectRange {
 			continue
 		}
 		for _, col := range v.colVindex.Columns {
 			// If the column for the predicate matches any column in the vindex add it to the list
 			if column.Name.Equal(col) {
+				if v.foundVindex != nil {
+					// any other access method on the vindex beats a range
+					v.values, v.predicates, v.foundVindex = nil, nil, nil
+				}
 				v.values = append(v.values, value)
 				v.predicates = append(v.predicates, node)
 				// Vindex is covered if all the columns in the vindex have a associated predicate
@@ -311,6 +311,56 @@ func TestOtherPlanningFromFile(t *testing.T) {
 	testFile(t, "other_admin_cases.txt", testOutputTempDir, vschema, false)
 }
 
+func TestForeignKeyCascades(t *testing.T) {
+	vschema := &vschemaWrapper{
+		v: loadSchema(t, "schema_test.json"),
+	}
+	ks := vschema.v.Keyspaces["user"]
+	newFK := func(name, child, childCol, parentCol, onDelete, onUpdate string) *vindexes.ForeignKey {
+		return &vindexes.ForeignKey{
+			Name:          name,
+			Table:         sqlparser.NewTableIdent(child),
+			Columns:       []sqlparser.ColIdent{sqlparser.NewColIdent(childCol)},
+			ParentTable:   sqlparser.NewTableIdent("user"),
+			ParentColumns: []sqlparser.ColIdent{sqlparser.NewColIdent(parentCol)},
+			OnDelete:      onDelete,
+			OnUpdate:      onUpdate,
+		}
+	}
+	// user_extra shares the primary vindex of user: its rows follow their parent.
+	require.True(t, ks.AddForeignKey(newFK("extra_fk", "user_extra", "user_id", "id", "CASCADE", "CASCADE")))
+	// music is sharded by user_id, but references user through its id column.
+	require.True(t, ks.AddForeignKey(newFK("music_fk", "music", "id", "id", "CASCADE", "RESTRICT")))
+	// user_metadata references a column of user that isn't sharded on.
+	require.True(t, ks.AddForeignKey(newFK("email_fk", "user_metadata", "email", "name", "RESTRICT", "CASCADE")))
+
+	testcases := []struct {
+		query string
+		err   string
+	}{{
+		query: "delete from user where id = 1",
+		err:   "unsupported: delete on table user cascades through foreign key music_fk to table music across shards",
+	}, {
+		query: "update user set name = 'a' where id = 1",
+		err:   "unsupported: update on table user cascades through foreign key email_fk to table user_metadata across shards",
+	}, {
+		// The updated column isn't referenced by any foreign key.
+		query: "update user set val = 2 where id = 1",
+	}, {
+		query: "delete from user_extra where user_id = 1",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			_, err := TestBuilder(tcase.query, vschema)
+			if tcase.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tcase.err)
+		})
+	}
+}
+
 func loadSchema(t testing.TB, filename string) *vindexes.VSchema {
 	formal, err := vindexes.LoadFormal(locateFile(filename))
 	if err != nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package planbuilder
 
 import (
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
@@ -162,6 +163,16 @@ func (rb *route) Wireup(plan logicalPlan, jt *jointab) error {
 			}
 			rb.eroute.Values = []sqltypes.PlanValue{pv}
 			vals.Right = sqlparser.ListArg(engine.ListVarName)
+		case *sqlparser.RangeCond:
+			from, err := rb.procureValues(plan, jt, vals.From)
+			if err != nil {
+				return err
+			}
+			to, err := rb.procureValues(plan, jt, vals.To)
+			if err != nil {
+				return err
+			}
+			rb.eroute.Values = []sqltypes.PlanValue{from, to}
 		case nil:
 			// no-op.
 		default:
@@ -584,14 +595,42 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 				rb.updateRoute(opcode, vindex, values)
 			}
 		}
+	case engine.SelectRange:
+		switch opcode {
+		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectMultiEqual:
+			rb.updateRoute(opcode, vindex, values)
+		case engine.SelectRange:
+			if vindex == rb.eroute.Vindex {
+				rb.condition = mergeRangeConditions(rb.condition.(*sqlparser.RangeCond), values.(*sqlparser.RangeCond))
+				return
+			}
+			if vindex.Cost() < rb.eroute.Vindex.Cost() {
+				rb.updateRoute(opcode, vindex, values)
+			}
+		}
 	case engine.SelectScatter:
 		switch opcode {
-		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectMultiEqual, engine.SelectNone:
+		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectMultiEqual, engine.SelectRange, engine.SelectNone:
 			rb.updateRoute(opcode, vindex, values)
 		}
 	}
 }
 
+// mergeRangeConditions narrows a range with the bounds of another range
+// on the same vindex. Since bounds may be bind variables, they can't be
+// compared at plan time: only the open sides of the current range are
+// filled in.
+func mergeRangeConditions(current, other *sqlparser.RangeCond) *sqlparser.RangeCond {
+	merged := *current
+	if sqlparser.IsNull(merged.From) {
+		merged.From = other.From
+	}
+	if sqlparser.IsNull(merged.To) {
+		merged.To = other.To
+	}
+	return &merged
+}
+
 func (rb *route) updateRoute(opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
 	rb.eroute.Opcode = opcode
 	rb.eroute.Vindex = vindex
@@ -611,13 +650,116 @@ func (rb *route) computePlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcod
 			return rb.computeNotInPlan(node.Right), nil, nil
 		case sqlparser.LikeOp:
 			return rb.computeLikePlan(pb, node)
+		case sqlparser.LessThanOp, sqlparser.LessEqualOp, sqlparser.GreaterThanOp, sqlparser.GreaterEqualOp:
+			return rb.computeInequalityPlan(pb, node)
 		}
+	case *sqlparser.RangeCond:
+		return rb.computeBetweenPlan(pb, node)
 	case *sqlparser.IsExpr:
 		return rb.computeISPlan(pb, node)
 	}
 	return engine.SelectScatter, nil, nil
 }
 
+// computeInequalityPlan computes the plan for an inequality constraint.
+// The bounds of the resulting range are inclusive even for strict
+// inequalities: routing to a few extra keyspace ids is harmless.
+func (rb *route) computeInequalityPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+	left := comparison.Left
+	right := comparison.Right
+	operator := comparison.Operator
+
+	if sqlparser.IsNull(left) || sqlparser.IsNull(right) {
+		return engine.SelectNone, nil, nil
+	}
+
+	vindex = pb.st.Vindex(left, rb)
+	if vindex == nil {
+		left, right = right, left
+		vindex = pb.st.Vindex(left, rb)
+		if vindex == nil {
+			return engine.SelectScatter, nil, nil
+		}
+		switch operator {
+		case sqlparser.LessThanOp:
+			operator = sqlparser.GreaterThanOp
+		case sqlparser.LessEqualOp:
+			operator = sqlparser.GreaterEqualOp
+		case sqlparser.GreaterThanOp:
+			operator = sqlparser.LessThanOp
+		case sqlparser.GreaterEqualOp:
+			operator = sqlparser.LessEqualOp
+		}
+	}
+	if !isRangeSafe(vindex, symtabColumnType(left)) {
+		return engine.SelectScatter, nil, nil
+	}
+	if !rb.exprIsValue(right) {
+		return engine.SelectScatter, nil, nil
+	}
+	switch operator {
+	case sqlparser.LessThanOp, sqlparser.LessEqualOp:
+		return engine.SelectRange, vindex, newRangeCondition(left, &sqlparser.NullVal{}, right)
+	}
+	return engine.SelectRange, vindex, newRangeCondition(left, right, &sqlparser.NullVal{})
+}
+
+// computeBetweenPlan computes the plan for a BETWEEN constraint.
+func (rb *route) computeBetweenPlan(pb *primitiveBuilder, rangeCond *sqlparser.RangeCond) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+	if rangeCond.Operator != sqlparser.BetweenOp {
+		return engine.SelectScatter, nil, nil
+	}
+	if sqlparser.IsNull(rangeCond.From) || sqlparser.IsNull(rangeCond.To) {
+		return engine.SelectNone, nil, nil
+	}
+	vindex = pb.st.Vindex(rangeCond.Left, rb)
+	if vindex == nil {
+		return engine.SelectScatter, nil, nil
+	}
+	if !isRangeSafe(vindex, symtabColumnType(rangeCond.Left)) {
+		return engine.SelectScatter, nil, nil
+	}
+	if !rb.exprIsValue(rangeCond.From) || !rb.exprIsValue(rangeCond.To) {
+		return engine.SelectScatter, nil, nil
+	}
+	return engine.SelectRange, vindex, newRangeCondition(rangeCond.Left, rangeCond.From, rangeCond.To)
+}
+
+// isRangeSafe returns true if the vindex can map a range of values
+// of a column of type typ to a key range.
+func isRangeSafe(vindex vindexes.Vindex, typ querypb.Type) bool {
+	ranged, ok := vindex.(vindexes.Ranged)
+	if !ok {
+		return false
+	}
+	return ranged.IsRangeSafe(typ)
+}
+
+// symtabColumnType returns the vschema type of a column resolved
+// by the symtab, or NULL_TYPE if it's unknown.
+func symtabColumnType(expr sqlparser.Expr) querypb.Type {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return sqltypes.Null
+	}
+	c, ok := col.Metadata.(*column)
+	if !ok {
+		return sqltypes.Null
+	}
+	return c.typ
+}
+
+// newRangeCondition builds the condition used to resolve the Values
+// of a SelectRange route. A NULL bound means the range is open on that side.
+func newRangeCondition(left, from, to sqlparser.Expr) *sqlparser.RangeCond {
+	return &sqlparser.RangeCond{
+		Operator: sqlparser.BetweenOp,
+		Left:     left,
+		From:     from,
+		To:       to,
+	}
+}
+
 // computeLikePlan computes the plan for 'LIKE' constraint
 func (rb *route) computeLikePlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
 
@@ -602,7 +602,7 @@ func tryMerge(a, b joinTree, joinPredicates []sqlparser.Expr, semTable *semantic
 		if aRoute.routeOpCode != bRoute.routeOpCode {
 			return nil
 		}
-	case engine.SelectScatter, engine.SelectEqualUnique:
+	case engine.SelectScatter, engine.SelectEqualUnique, engine.SelectRange:
 		if len(joinPredicates) == 0 {
 			// If we are doing two Scatters, we have to make sure that the
 			// joins are on the correct vindex to allow them to be merged
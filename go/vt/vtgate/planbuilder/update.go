@@ -41,6 +41,23 @@ func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 		return eupd, nil
 	}
 
+	err = checkForeignKeyCascades(vschema, "update", eupd.Table, func(fk *vindexes.ForeignKey) bool {
+		if !fk.CascadesUpdate() {
+			return false
+		}
+		for _, assignment := range upd.Exprs {
+			for _, col := range fk.ParentColumns {
+				if col.Equal(assignment.Name.Name) {
+					return true
+				}
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	cvv, ovq, err := buildChangedVindexesValues(upd, eupd.Table, ksidCol)
 	if err != nil {
 		return nil, err
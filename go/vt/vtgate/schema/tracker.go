@@ -44,8 +44,10 @@ type (
 
 		mu     sync.Mutex
 		tables *tableMap
-		ctx    context.Context
-		signal func() // a function that we'll call whenever we have new schema data
+		// foreignKeys are the foreign keys of each table, indexed by the child table.
+		foreignKeys map[keyspaceStr]map[tableNameStr][]*vindexes.ForeignKey
+		ctx         context.Context
+		signal      func() // a function that we'll call whenever we have new schema data
 
 		// map of keyspace currently tracked
 		tracked      map[keyspaceStr]*updateController
@@ -62,6 +64,7 @@ func NewTracker(ch chan *discovery.TabletHealth) *Tracker {
 		ctx:          context.Background(),
 		ch:           ch,
 		tables:       &tableMap{m: map[keyspaceStr]map[tableNameStr][]vindexes.Column{}},
+		foreignKeys:  map[keyspaceStr]map[tableNameStr][]*vindexes.ForeignKey{},
 		tracked:      map[keyspaceStr]*updateController{},
 		consumeDelay: defaultConsumeDelay,
 	}
@@ -73,9 +76,19 @@ func (t *Tracker) LoadKeyspace(conn queryservice.QueryService, target *querypb.T
 	if err != nil {
 		return err
 	}
+	// Foreign keys are informational: failing to load them must not prevent
+	// the tracking of the columns.
+	fkRes, err := conn.Execute(context.Background(), target, mysql.FetchForeignKeys, nil, 0, 0, nil)
+	if err != nil {
+		log.Warningf("unable to load foreign keys for keyspace %s: %v", target.Keyspace, err)
+	}
 	t.mu.Lock()
 	defer t.mu.Unlock()
 	t.updateTables(target.Keyspace, res)
+	if fkRes != nil {
+		t.foreignKeys[target.Keyspace] = map[tableNameStr][]*vindexes.ForeignKey{}
+		t.updateForeignKeys(target.Keyspace, fkRes)
+	}
 	t.tracked[target.Keyspace].setLoaded(true)
 	log.Infof("finished loading schema for keyspace %s. Found %d tables", target.Keyspace, len(res.Rows))
 	return nil
@@ -141,6 +154,19 @@ func (t *Tracker) GetColumns(ks string, tbl string) []vindexes.Column {
 	return t.tables.get(ks, tbl)
 }
 
+// ForeignKeys returns a map with the foreign keys of all known tables in the keyspace,
+// indexed by the name of the child table.
+func (t *Tracker) ForeignKeys(ks string) map[string][]*vindexes.ForeignKey {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	m := t.foreignKeys[ks]
+	if m == nil {
+		return map[string][]*vindexes.ForeignKey{}
+	}
+	return m
+}
+
 // Tables returns a map with the columns for all known tables in the keyspace
 func (t *Tracker) Tables(ks string) map[string][]vindexes.Column {
 	t.mu.Lock()
@@ -169,6 +195,10 @@ func (t *Tracker) updateSchema(th *discovery.TabletHealth) bool {
 		log.Warningf("error fetching new schema for %v, making them non-authoritative: %v", tablesUpdated, err)
 		return false
 	}
+	fkRes, err := th.Conn.Execute(t.ctx, th.Target, mysql.FetchUpdatedForeignKeys, bv, 0, 0, nil)
+	if err != nil {
+		log.Warningf("error fetching foreign keys for %v: %v", tablesUpdated, err)
+	}
 
 	t.mu.Lock()
 	defer t.mu.Unlock()
@@ -177,11 +207,45 @@ func (t *Tracker) updateSchema(th *discovery.TabletHealth) bool {
 	// so this is the only chance to delete
 	for _, tbl := range tablesUpdated {
 		t.tables.delete(th.Target.Keyspace, tbl)
+		if fkRes != nil {
+			delete(t.foreignKeys[th.Target.Keyspace], tbl)
+		}
 	}
 	t.updateTables(th.Target.Keyspace, res)
+	if fkRes != nil {
+		t.updateForeignKeys(th.Target.Keyspace, fkRes)
+	}
 	return true
 }
 
+// updateForeignKeys adds the foreign keys of res to the keyspace.
+// The rows are ordered by child table, constraint name and ordinal position,
+// so the columns of one foreign key are contiguous.
+func (t *Tracker) updateForeignKeys(keyspace string, res *sqltypes.Result) {
+	m := t.foreignKeys[keyspace]
+	if m == nil {
+		m = map[tableNameStr][]*vindexes.ForeignKey{}
+		t.foreignKeys[keyspace] = m
+	}
+	var fk *vindexes.ForeignKey
+	for _, row := range res.Rows {
+		tbl := row[0].ToString()
+		name := row[1].ToString()
+		if fk == nil || fk.Table.String() != tbl || fk.Name != name {
+			fk = &vindexes.ForeignKey{
+				Name:        name,
+				Table:       sqlparser.NewTableIdent(tbl),
+				ParentTable: sqlparser.NewTableIdent(row[3].ToString()),
+				OnDelete:    row[5].ToString(),
+				OnUpdate:    row[6].ToString(),
+			}
+			m[tbl] = append(m[tbl], fk)
+		}
+		fk.Columns = append(fk.Columns, sqlparser.NewColIdent(row[2].ToString()))
+		fk.ParentColumns = append(fk.ParentColumns, sqlparser.NewColIdent(row[4].ToString()))
+	}
+}
+
 func (t *Tracker) updateTables(keyspace string, res *sqltypes.Result) {
 	for _, row := range res.Rows {
 		tbl := row[0].ToString()
@@ -159,7 +159,7 @@ func TestTracking(t *testing.T) {
 				}
 			}
 
-			sbc.SetResults(results)
+			sbc.SetResults(append(results, &sqltypes.Result{}))
 			sbc.Queries = nil
 
 			wg := sync.WaitGroup{}
@@ -180,7 +180,7 @@ func TestTracking(t *testing.T) {
 
 			require.False(t, waitTimeout(&wg, time.Second), "schema was updated but received no signal")
 
-			require.Equal(t, 1, len(sbc.StringQueries()))
+			require.Equal(t, []string{mysql.FetchTables, mysql.FetchForeignKeys}, sbc.StringQueries())
 
 			_, keyspacePresent := tracker.tracked[target.Keyspace]
 			require.Equal(t, true, keyspacePresent)
@@ -244,7 +244,7 @@ func TestTrackingUnHealthyTablet(t *testing.T) {
 		},
 	}
 
-	sbc.SetResults([]*sqltypes.Result{{}, {}, {}})
+	sbc.SetResults([]*sqltypes.Result{{}, {}, {}, {}, {}, {}})
 	for _, tcase := range tcases {
 		ch <- &discovery.TabletHealth{
 			Conn:    sbc,
@@ -257,7 +257,11 @@ func TestTrackingUnHealthyTablet(t *testing.T) {
 	}
 
 	require.False(t, waitTimeout(&wg, time.Second), "schema was updated but received no signal")
-	require.Equal(t, []string{mysql.FetchTables, mysql.FetchUpdatedTables, mysql.FetchTables}, sbc.StringQueries())
+	require.Equal(t, []string{
+		mysql.FetchTables, mysql.FetchForeignKeys,
+		mysql.FetchUpdatedTables, mysql.FetchUpdatedForeignKeys,
+		mysql.FetchTables, mysql.FetchForeignKeys,
+	}, sbc.StringQueries())
 }
 
 func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
@@ -305,3 +309,58 @@ func TestTrackerGetKeyspaceUpdateController(t *testing.T) {
 	assert.NotNil(t, ks2.reloadKeyspace, "ks2 needs to be initialized")
 	assert.Nil(t, ks3.reloadKeyspace, "ks3 already initialized")
 }
+
+func TestTrackingForeignKeys(t *testing.T) {
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+		Cell:       "aa",
+	}
+	tablet := &topodatapb.Tablet{
+		Keyspace: target.Keyspace,
+		Shard:    target.Shard,
+		Type:     target.TabletType,
+	}
+	sbc := sandboxconn.NewSandboxConn(tablet)
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("table_name|col_name|col_type", "varchar|varchar|varchar"),
+			"parent|id|int",
+			"child|id|int",
+			"child|parent_id|int",
+		),
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"table_name|constraint_name|column_name|referenced_table_name|referenced_column_name|delete_rule|update_rule",
+				"varchar|varchar|varchar|varchar|varchar|varchar|varchar"),
+			"child|fk1|parent_id|parent|id|CASCADE|RESTRICT",
+			"child|fk2|id|parent|id|NO ACTION|NO ACTION",
+		),
+	})
+
+	tracker := NewTracker(nil)
+	require.NoError(t, tracker.AddNewKeyspace(sbc, target))
+
+	want := map[string][]*vindexes.ForeignKey{
+		"child": {{
+			Name:          "fk1",
+			Table:         sqlparser.NewTableIdent("child"),
+			Columns:       []sqlparser.ColIdent{sqlparser.NewColIdent("parent_id")},
+			ParentTable:   sqlparser.NewTableIdent("parent"),
+			ParentColumns: []sqlparser.ColIdent{sqlparser.NewColIdent("id")},
+			OnDelete:      "CASCADE",
+			OnUpdate:      "RESTRICT",
+		}, {
+			Name:          "fk2",
+			Table:         sqlparser.NewTableIdent("child"),
+			Columns:       []sqlparser.ColIdent{sqlparser.NewColIdent("id")},
+			ParentTable:   sqlparser.NewTableIdent("parent"),
+			ParentColumns: []sqlparser.ColIdent{sqlparser.NewColIdent("id")},
+			OnDelete:      "NO ACTION",
+			OnUpdate:      "NO ACTION",
+		}},
+	}
+	utils.MustMatch(t, want, tracker.ForeignKeys("ks"))
+	assert.Empty(t, tracker.ForeignKeys("other"))
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+var schemaPolicyCacheTTL = flag.Duration("schema_policy_cache_ttl", 30*time.Second, "how long vtgate uses the schema policy of a keyspace before reading it from the topo again")
+
+type schemaPolicyEntry struct {
+	policy  *schema.SchemaPolicy
+	expires time.Time
+}
+
+// schemaPolicyCache keeps the schema policies of keyspaces, so that DDL statements do not read the global topo
+type schemaPolicyCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*schemaPolicyEntry
+}
+
+func newSchemaPolicyCache(ttl time.Duration) *schemaPolicyCache {
+	return &schemaPolicyCache{
+		ttl:     ttl,
+		entries: make(map[string]*schemaPolicyEntry),
+	}
+}
+
+// get returns the schema policy of the keyspace, reading it from the topo when it is not cached or has expired.
+// Errors are not cached.
+func (c *schemaPolicyCache) get(ctx context.Context, ts *topo.Server, keyspace string) (*schema.SchemaPolicy, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[keyspace]
+	c.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.policy, nil
+	}
+
+	policy, err := schema.ReadSchemaPolicy(ctx, ts, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[keyspace] = &schemaPolicyEntry{policy: policy, expires: time.Now().Add(c.ttl)}
+	return policy, nil
+}
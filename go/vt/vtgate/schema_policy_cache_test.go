@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+)
+
+func TestSchemaPolicyCache(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.SaveSchemaPolicy(ctx, "ks", []byte(`{"max_indexes": 3}`)))
+
+	cache := newSchemaPolicyCache(time.Hour)
+	policy, err := cache.get(ctx, ts, "ks")
+	require.NoError(t, err)
+	assert.Equal(t, 3, policy.MaxIndexes)
+
+	// the cached policy is used until it expires
+	require.NoError(t, ts.SaveSchemaPolicy(ctx, "ks", []byte(`{"max_indexes": 5}`)))
+	policy, err = cache.get(ctx, ts, "ks")
+	require.NoError(t, err)
+	assert.Equal(t, 3, policy.MaxIndexes)
+
+	cache.entries["ks"].expires = time.Now()
+	policy, err = cache.get(ctx, ts, "ks")
+	require.NoError(t, err)
+	assert.Equal(t, 5, policy.MaxIndexes)
+
+	policy, err = cache.get(ctx, ts, "other")
+	require.NoError(t, err)
+	assert.True(t, policy.IsEmpty())
+}
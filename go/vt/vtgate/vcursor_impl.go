@@ -70,6 +70,7 @@ type iExecute interface {
 	// TODO: remove when resolver is gone
 	ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error)
 	VSchema() *vindexes.VSchema
+	SchemaPolicy(ctx context.Context, ts *topo.Server, keyspace string) (*schema.SchemaPolicy, error)
 }
 
 //VSchemaOperator is an interface to Vschema Operations
@@ -557,6 +558,15 @@ func (vc *vcursorImpl) SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error {
 	return onlineDDl.WriteTopo(vc.ctx, conn, schema.MigrationRequestsPath())
 }
 
+// GetSchemaPolicy implements the VCursor interface
+func (vc *vcursorImpl) GetSchemaPolicy(keyspace string) (*schema.SchemaPolicy, error) {
+	if vc.topoServer == nil {
+		// Without topo access there is no policy to read. Online DDL is still evaluated by the tablets.
+		return &schema.SchemaPolicy{}, nil
+	}
+	return vc.executor.SchemaPolicy(vc.ctx, vc.topoServer, keyspace)
+}
+
 func commentedShardQueries(shardQueries []*querypb.BoundQuery, marginComments sqlparser.MarginComments) []*querypb.BoundQuery {
 	if marginComments.Leading == "" && marginComments.Trailing == "" {
 		return shardQueries
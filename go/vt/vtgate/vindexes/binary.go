@@ -22,11 +22,15 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var (
 	_ SingleColumn = (*Binary)(nil)
 	_ Reversible   = (*Binary)(nil)
+	_ Ranged       = (*Binary)(nil)
 )
 
 // Binary is a vindex that converts binary bits to a keyspace id.
@@ -77,6 +81,33 @@ func (vind *Binary) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination
 	return out, nil
 }
 
+// MapRange maps the range [from, to] to a key range. The bounds are
+// compared as raw bytes, see IsRangeSafe.
+func (vind *Binary) MapRange(_ VCursor, from, to sqltypes.Value) (key.Destination, error) {
+	kr := &topodatapb.KeyRange{}
+	if !from.IsNull() {
+		kr.Start = from.ToBytes()
+	}
+	if !to.IsNull() {
+		// The range is inclusive, but the end of a key range is not.
+		// Appending a zero byte yields the smallest value greater than to.
+		end := make([]byte, to.Len()+1)
+		copy(end, to.ToBytes())
+		kr.End = end
+	}
+	if len(kr.End) != 0 && bytes.Compare(kr.Start, kr.End) >= 0 {
+		return key.DestinationNone{}, nil
+	}
+	return key.DestinationKeyRange{KeyRange: kr}, nil
+}
+
+// IsRangeSafe returns true only for binary column types. Other string
+// columns compare through their collation, which doesn't sort like raw
+// bytes, and an undeclared type can't be trusted either.
+func (*Binary) IsRangeSafe(colType querypb.Type) bool {
+	return sqltypes.IsBinary(colType)
+}
+
 // ReverseMap returns the associated ids for the ksids.
 func (*Binary) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error) {
 	var reverseIds = make([]sqltypes.Value, len(ksids))
@@ -26,6 +26,8 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var binOnlyVindex SingleColumn
@@ -68,6 +70,44 @@ func TestBinaryMap(t *testing.T) {
 	}
 }
 
+func TestBinaryMapRange(t *testing.T) {
+	tcases := []struct {
+		from, to sqltypes.Value
+		out      key.Destination
+	}{{
+		from: sqltypes.NewVarBinary("\x10"),
+		to:   sqltypes.NewVarBinary("\x40"),
+		out: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: []byte("\x10"),
+			End:   []byte("\x40\x00"),
+		}},
+	}, {
+		from: sqltypes.NULL,
+		to:   sqltypes.NewVarBinary("\x40"),
+		out: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			End: []byte("\x40\x00"),
+		}},
+	}, {
+		from: sqltypes.NewVarBinary("\x40"),
+		to:   sqltypes.NewVarBinary("\x10"),
+		out:  key.DestinationNone{},
+	}}
+	for _, tcase := range tcases {
+		got, err := binOnlyVindex.(Ranged).MapRange(nil, tcase.from, tcase.to)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.out, got, "MapRange(%v, %v)", tcase.from, tcase.to)
+	}
+}
+
+func TestBinaryIsRangeSafe(t *testing.T) {
+	ranged := binOnlyVindex.(Ranged)
+	assert.True(t, ranged.IsRangeSafe(sqltypes.VarBinary))
+	assert.True(t, ranged.IsRangeSafe(sqltypes.Blob))
+	assert.False(t, ranged.IsRangeSafe(sqltypes.VarChar))
+	assert.False(t, ranged.IsRangeSafe(sqltypes.Text))
+	assert.False(t, ranged.IsRangeSafe(sqltypes.Null))
+}
+
 func TestBinaryVerify(t *testing.T) {
 	ids := []sqltypes.Value{sqltypes.NewVarBinary("1"), sqltypes.NewVarBinary("2")}
 	ksids := [][]byte{[]byte("1"), []byte("1")}
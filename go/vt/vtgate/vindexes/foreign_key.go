@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// ForeignKey describes a foreign key constraint between two tables
+// of the same keyspace. The actions are the ones reported by
+// information_schema: RESTRICT, NO ACTION, CASCADE, SET NULL or SET DEFAULT.
+type ForeignKey struct {
+	Name          string               `json:"name"`
+	Table         sqlparser.TableIdent `json:"table"`
+	Columns       []sqlparser.ColIdent `json:"columns"`
+	ParentTable   sqlparser.TableIdent `json:"parent_table"`
+	ParentColumns []sqlparser.ColIdent `json:"parent_columns"`
+	OnDelete      string               `json:"on_delete,omitempty"`
+	OnUpdate      string               `json:"on_update,omitempty"`
+}
+
+// CascadesDelete returns true if deleting a parent row modifies
+// the child rows.
+func (fk *ForeignKey) CascadesDelete() bool {
+	return modifiesChildRows(fk.OnDelete)
+}
+
+// CascadesUpdate returns true if updating the parent columns
+// modifies the child rows.
+func (fk *ForeignKey) CascadesUpdate() bool {
+	return modifiesChildRows(fk.OnUpdate)
+}
+
+func modifiesChildRows(action string) bool {
+	switch strings.ToUpper(action) {
+	case "CASCADE", "SET NULL", "SET DEFAULT":
+		return true
+	}
+	return false
+}
+
+// AddForeignKey records the foreign key in its child and parent tables.
+// It returns false if either of the tables is not in the keyspace.
+func (ks *KeyspaceSchema) AddForeignKey(fk *ForeignKey) bool {
+	child := ks.Tables[fk.Table.String()]
+	parent := ks.Tables[fk.ParentTable.String()]
+	if child == nil || parent == nil {
+		return false
+	}
+	child.ForeignKeys = append(child.ForeignKeys, fk)
+	parent.ChildForeignKeys = append(parent.ChildForeignKeys, fk)
+	return true
+}
+
+// ForeignKeyShardScoped returns true if every child row of the foreign key
+// is guaranteed to live on the same shard as its parent row. This is the case
+// if the keyspace is unsharded, if both tables are pinned to the same
+// keyspace id, or if the primary vindexes of both tables are the same vindex
+// and their columns are related by the foreign key.
+func ForeignKeyShardScoped(fk *ForeignKey, child, parent *Table) bool {
+	if child == nil || parent == nil {
+		return false
+	}
+	if !parent.Keyspace.Sharded {
+		return true
+	}
+	if child.Pinned != nil || parent.Pinned != nil {
+		return string(child.Pinned) == string(parent.Pinned)
+	}
+	if len(child.ColumnVindexes) == 0 || len(parent.ColumnVindexes) == 0 {
+		return false
+	}
+	childVindex, parentVindex := child.ColumnVindexes[0], parent.ColumnVindexes[0]
+	if childVindex.Vindex != parentVindex.Vindex || len(childVindex.Columns) != len(parentVindex.Columns) {
+		return false
+	}
+	for i, col := range childVindex.Columns {
+		found := false
+		for j, fkCol := range fk.Columns {
+			if col.Equal(fkCol) && j < len(fk.ParentColumns) && parentVindex.Columns[i].Equal(fk.ParentColumns[j]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestForeignKeyShardScoped(t *testing.T) {
+	ks, err := BuildKeyspaceSchema(&vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash1": {Type: "hash"},
+			"hash2": {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"parent": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash1"}},
+			},
+			"child": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "parent_id", Name: "hash1"}},
+			},
+			"other": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "parent_id", Name: "hash2"}},
+			},
+			"pinned1": {Pinned: "80"},
+			"pinned2": {Pinned: "80"},
+		},
+	}, "ks")
+	require.NoError(t, err)
+
+	newFK := func(child, col, parent string) *ForeignKey {
+		return &ForeignKey{
+			Name:          "fk",
+			Table:         sqlparser.NewTableIdent(child),
+			Columns:       []sqlparser.ColIdent{sqlparser.NewColIdent(col)},
+			ParentTable:   sqlparser.NewTableIdent(parent),
+			ParentColumns: []sqlparser.ColIdent{sqlparser.NewColIdent("id")},
+			OnDelete:      "cascade",
+		}
+	}
+	testcases := []struct {
+		fk   *ForeignKey
+		want bool
+	}{{
+		fk:   newFK("child", "parent_id", "parent"),
+		want: true,
+	}, {
+		fk:   newFK("child", "other_id", "parent"),
+		want: false,
+	}, {
+		fk:   newFK("other", "parent_id", "parent"),
+		want: false,
+	}, {
+		fk:   newFK("pinned1", "parent_id", "pinned2"),
+		want: true,
+	}, {
+		fk:   newFK("pinned1", "parent_id", "parent"),
+		want: false,
+	}}
+	for _, tcase := range testcases {
+		child, parent := ks.Tables[tcase.fk.Table.String()], ks.Tables[tcase.fk.ParentTable.String()]
+		assert.Equal(t, tcase.want, ForeignKeyShardScoped(tcase.fk, child, parent), "%s -> %s", tcase.fk.Table.String(), tcase.fk.ParentTable.String())
+	}
+	assert.True(t, newFK("child", "parent_id", "parent").CascadesDelete())
+	assert.False(t, newFK("child", "parent_id", "parent").CascadesUpdate())
+
+	assert.True(t, ks.AddForeignKey(newFK("child", "parent_id", "parent")))
+	assert.Len(t, ks.Tables["child"].ForeignKeys, 1)
+	assert.Len(t, ks.Tables["parent"].ChildForeignKeys, 1)
+	assert.False(t, ks.AddForeignKey(newFK("child", "parent_id", "absent")))
+}
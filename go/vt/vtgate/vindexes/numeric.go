@@ -20,20 +20,25 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"math"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var (
 	_ SingleColumn = (*Numeric)(nil)
 	_ Reversible   = (*Numeric)(nil)
+	_ Ranged       = (*Numeric)(nil)
 )
 
 // Numeric defines a bit-pattern mapping of a uint64 to the KeyspaceId.
-// It's Unique and Reversible.
+// It's Unique, Reversible and Ranged.
 type Numeric struct {
 	name string
 }
@@ -94,6 +99,44 @@ func (*Numeric) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, er
 	return out, nil
 }
 
+// MapRange maps the range [from, to] to a key range.
+func (*Numeric) MapRange(_ VCursor, from, to sqltypes.Value) (key.Destination, error) {
+	kr := &topodatapb.KeyRange{}
+	if !from.IsNull() {
+		num, err := evalengine.ToUint64(from)
+		if err != nil {
+			// Values that can't be converted (negative numbers,
+			// fractions, etc.) could still match any row.
+			return key.DestinationAllShards{}, nil
+		}
+		var keybytes [8]byte
+		binary.BigEndian.PutUint64(keybytes[:], num)
+		kr.Start = keybytes[:]
+	}
+	if !to.IsNull() {
+		num, err := evalengine.ToUint64(to)
+		if err != nil {
+			return key.DestinationAllShards{}, nil
+		}
+		// The range is inclusive, but the end of a key range is not.
+		if num != math.MaxUint64 {
+			var keybytes [8]byte
+			binary.BigEndian.PutUint64(keybytes[:], num+1)
+			kr.End = keybytes[:]
+		}
+	}
+	if len(kr.End) != 0 && bytes.Compare(kr.Start, kr.End) >= 0 {
+		return key.DestinationNone{}, nil
+	}
+	return key.DestinationKeyRange{KeyRange: kr}, nil
+}
+
+// IsRangeSafe returns false for string columns: MySQL compares them as
+// strings, which doesn't match the numeric order of the keyspace ids.
+func (*Numeric) IsRangeSafe(colType querypb.Type) bool {
+	return !sqltypes.IsText(colType) && !sqltypes.IsBinary(colType)
+}
+
 // ReverseMap returns the associated ids for the ksids.
 func (*Numeric) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error) {
 	var reverseIds = make([]sqltypes.Value, len(ksids))
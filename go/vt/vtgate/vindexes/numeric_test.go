@@ -17,6 +17,7 @@ limitations under the License.
 package vindexes
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
@@ -25,6 +26,8 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var numeric SingleColumn
@@ -74,6 +77,56 @@ func TestNumericMap(t *testing.T) {
 	}
 }
 
+func TestNumericMapRange(t *testing.T) {
+	tcases := []struct {
+		from, to sqltypes.Value
+		out      key.Destination
+	}{{
+		from: sqltypes.NewInt64(1),
+		to:   sqltypes.NewInt64(3),
+		out: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: []byte("\x00\x00\x00\x00\x00\x00\x00\x01"),
+			End:   []byte("\x00\x00\x00\x00\x00\x00\x00\x04"),
+		}},
+	}, {
+		from: sqltypes.NewInt64(1),
+		to:   sqltypes.NULL,
+		out: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: []byte("\x00\x00\x00\x00\x00\x00\x00\x01"),
+		}},
+	}, {
+		from: sqltypes.NULL,
+		to:   sqltypes.NewUint64(math.MaxUint64),
+		out:  key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}},
+	}, {
+		from: sqltypes.NewInt64(3),
+		to:   sqltypes.NewInt64(1),
+		out:  key.DestinationNone{},
+	}, {
+		from: sqltypes.NewInt64(-1),
+		to:   sqltypes.NewInt64(1),
+		out:  key.DestinationAllShards{},
+	}, {
+		from: sqltypes.NewInt64(1),
+		to:   sqltypes.NewFloat64(1.1),
+		out:  key.DestinationAllShards{},
+	}}
+	for _, tcase := range tcases {
+		got, err := numeric.(Ranged).MapRange(nil, tcase.from, tcase.to)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.out, got, "MapRange(%v, %v)", tcase.from, tcase.to)
+	}
+}
+
+func TestNumericIsRangeSafe(t *testing.T) {
+	ranged := numeric.(Ranged)
+	assert.True(t, ranged.IsRangeSafe(sqltypes.Int64))
+	assert.True(t, ranged.IsRangeSafe(sqltypes.Uint64))
+	assert.True(t, ranged.IsRangeSafe(sqltypes.Null))
+	assert.False(t, ranged.IsRangeSafe(sqltypes.VarChar))
+	assert.False(t, ranged.IsRangeSafe(sqltypes.VarBinary))
+}
+
 func TestNumericVerify(t *testing.T) {
 	got, err := numeric.Verify(nil,
 		[]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)},
@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+var (
+	_ MultiColumn = (*TimeBucket)(nil)
+)
+
+func init() {
+	Register("time_bucket", NewTimeBucket)
+}
+
+// TimePeriod is the length of a TimeBucket bucket.
+type TimePeriod int
+
+// These are the supported periods.
+const (
+	PeriodDay = TimePeriod(iota)
+	PeriodWeek
+	PeriodMonth
+)
+
+var timePeriodNames = map[string]TimePeriod{
+	"day":   PeriodDay,
+	"week":  PeriodWeek,
+	"month": PeriodMonth,
+}
+
+// timeBucketBytes is the length of the bucket prefix of a keyspace id.
+const timeBucketBytes = 2
+
+// timeBucketLayouts are the formats accepted for the time column, in
+// addition to integral values which are interpreted as unix timestamps.
+var timeBucketLayouts = []string{
+	"2006-01-02 15:04:05.999999",
+	"2006-01-02",
+}
+
+// TimeBucket is a multi-column unique vindex for append-only tables
+// that are naturally partitioned by time. The first column is a
+// timestamp, which is truncated to a day, week or month bucket. The
+// bucket number is prefixed to the hash of the second column to produce
+// the keyspace id.
+// Because the keyspace ids of a bucket all sort after the ones of
+// previous buckets, the shard that holds the newest bucket can be split
+// at the start of future buckets without splitting any existing bucket.
+type TimeBucket struct {
+	name   string
+	period TimePeriod
+}
+
+// NewTimeBucket creates a TimeBucket vindex.
+// The supplied map requires a period argument whose value can be
+// "day", "week" or "month".
+func NewTimeBucket(name string, m map[string]string) (Vindex, error) {
+	ps, ok := m["period"]
+	if !ok {
+		return nil, fmt.Errorf("time_bucket missing period param")
+	}
+	period, ok := timePeriodNames[ps]
+	if !ok {
+		return nil, fmt.Errorf("period must be day, week or month: %v", ps)
+	}
+	return &TimeBucket{
+		name:   name,
+		period: period,
+	}, nil
+}
+
+// String returns the name of the vindex.
+func (tb *TimeBucket) String() string {
+	return tb.name
+}
+
+// Cost returns the cost of this index as 1.
+func (tb *TimeBucket) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (tb *TimeBucket) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (tb *TimeBucket) NeedsVCursor() bool {
+	return false
+}
+
+// Map satisfies MultiColumn. A row whose values can't be mapped is an
+// error rather than DestinationNone: an unparsable time would otherwise
+// silently turn an insert into a no-op.
+func (tb *TimeBucket) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	destinations := make([]key.Destination, 0, len(rowsColValues))
+	for _, row := range rowsColValues {
+		ksid, err := tb.keyspaceID(row)
+		if err != nil {
+			return nil, err
+		}
+		destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+	}
+	return destinations, nil
+}
+
+// Verify satisfies MultiColumn. Rows that can't be mapped don't match.
+func (tb *TimeBucket) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	result := make([]bool, len(rowsColValues))
+	for i, row := range rowsColValues {
+		ksid, err := tb.keyspaceID(row)
+		if err != nil {
+			continue
+		}
+		result[i] = bytes.Equal(ksid, ksids[i])
+	}
+	return result, nil
+}
+
+func (tb *TimeBucket) keyspaceID(row []sqltypes.Value) ([]byte, error) {
+	if len(row) != 2 {
+		return nil, fmt.Errorf("vindex %s needs 2 column values, got %d", tb.name, len(row))
+	}
+	t, err := parseTimeBucketValue(row[0])
+	if err != nil {
+		return nil, fmt.Errorf("vindex %s: cannot parse time %v: %v", tb.name, row[0].ToString(), err)
+	}
+	prefix, err := tb.BucketPrefix(t)
+	if err != nil {
+		return nil, err
+	}
+	hn, err := evalengine.ToUint64(row[1])
+	if err != nil {
+		return nil, err
+	}
+	return append(prefix, vhash(hn)...), nil
+}
+
+// Bucket returns the number of the bucket t belongs to.
+// Buckets are counted from the unix epoch, in UTC. Weeks start on Monday.
+func (tb *TimeBucket) Bucket(t time.Time) int64 {
+	t = t.UTC()
+	switch tb.period {
+	case PeriodWeek:
+		// 1970-01-01 was a Thursday.
+		return floorDiv(floorDiv(t.Unix(), 86400)+3, 7)
+	case PeriodMonth:
+		return int64(t.Year()-1970)*12 + int64(t.Month()-1)
+	}
+	return floorDiv(t.Unix(), 86400)
+}
+
+// BucketStart returns the time at which the specified bucket starts.
+func (tb *TimeBucket) BucketStart(bucket int64) time.Time {
+	switch tb.period {
+	case PeriodWeek:
+		return time.Unix((bucket*7-3)*86400, 0).UTC()
+	case PeriodMonth:
+		return time.Date(1970+int(floorDiv(bucket, 12)), time.Month(bucket-floorDiv(bucket, 12)*12+1), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Unix(bucket*86400, 0).UTC()
+}
+
+// BucketPrefix returns the keyspace id prefix of the bucket t belongs to.
+// All the keyspace ids of rows in that bucket start with this prefix.
+func (tb *TimeBucket) BucketPrefix(t time.Time) ([]byte, error) {
+	bucket := tb.Bucket(t)
+	if bucket < 0 || bucket > math.MaxUint16 {
+		return nil, fmt.Errorf("time %v is out of range for vindex %s", t, tb.name)
+	}
+	prefix := make([]byte, timeBucketBytes, timeBucketBytes+8)
+	binary.BigEndian.PutUint16(prefix, uint16(bucket))
+	return prefix, nil
+}
+
+func parseTimeBucketValue(v sqltypes.Value) (time.Time, error) {
+	if v.IsIntegral() {
+		n, err := evalengine.ToInt64(v)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(n, 0), nil
+	}
+	var err error
+	for _, layout := range timeBucketLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, v.ToString()); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+func TestTimeBucketMisc(t *testing.T) {
+	tb, err := CreateVindex("time_bucket", "time_bucket", map[string]string{"period": "day"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, tb.Cost())
+	assert.Equal(t, "time_bucket", tb.String())
+	assert.True(t, tb.IsUnique())
+	assert.False(t, tb.NeedsVCursor())
+}
+
+func TestTimeBucketMap(t *testing.T) {
+	vindex, err := CreateVindex("time_bucket", "time_bucket", map[string]string{"period": "day"})
+	require.NoError(t, err)
+	tb := vindex.(MultiColumn)
+	got, err := tb.Map(nil, [][]sqltypes.Value{{
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-06-01 10:00:00")), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-06-01")), sqltypes.NewInt64(1),
+	}, {
+		// Unix timestamp of 2021-06-02 00:00:00.
+		sqltypes.NewInt64(1622592000), sqltypes.NewInt64(1),
+	}})
+	require.NoError(t, err)
+
+	want := []key.Destination{
+		key.DestinationKeyspaceID([]byte("\x49\x5b\x16k@\xb4J\xbaK\xd6")),
+		key.DestinationKeyspaceID([]byte("\x49\x5b\x16k@\xb4J\xbaK\xd6")),
+		key.DestinationKeyspaceID([]byte("\x49\x5c\x16k@\xb4J\xbaK\xd6")),
+	}
+	assert.Equal(t, want, got)
+
+	errcases := []struct {
+		row []sqltypes.Value
+		err string
+	}{{
+		row: []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-06-01"))},
+		err: "vindex time_bucket needs 2 column values, got 1",
+	}, {
+		row: []sqltypes.Value{sqltypes.NewVarChar("abcd"), sqltypes.NewInt64(1)},
+		err: `vindex time_bucket: cannot parse time abcd: parsing time "abcd" as "2006-01-02": cannot parse "abcd" as "2006"`,
+	}, {
+		row: []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Date, []byte("1969-12-31")), sqltypes.NewInt64(1)},
+		err: "time 1969-12-31 00:00:00 +0000 UTC is out of range for vindex time_bucket",
+	}, {
+		row: []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-06-01")), sqltypes.NewVarBinary("abcd")},
+		err: "could not parse value: 'abcd'",
+	}}
+	for _, tcase := range errcases {
+		_, err := tb.Map(nil, [][]sqltypes.Value{tcase.row})
+		assert.EqualError(t, err, tcase.err)
+	}
+}
+
+func TestTimeBucketPeriods(t *testing.T) {
+	ts := time.Date(2021, 6, 2, 10, 0, 0, 0, time.UTC)
+	testcases := []struct {
+		period string
+		bucket int64
+		start  time.Time
+	}{{
+		period: "day",
+		bucket: 18780,
+		start:  time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC),
+	}, {
+		period: "week",
+		bucket: 2683,
+		start:  time.Date(2021, 5, 31, 0, 0, 0, 0, time.UTC),
+	}, {
+		period: "month",
+		bucket: 617,
+		start:  time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.period, func(t *testing.T) {
+			vindex, err := CreateVindex("time_bucket", "time_bucket", map[string]string{"period": tcase.period})
+			require.NoError(t, err)
+			tb := vindex.(*TimeBucket)
+			assert.Equal(t, tcase.bucket, tb.Bucket(ts))
+			assert.Equal(t, tcase.start, tb.BucketStart(tcase.bucket))
+			assert.Equal(t, tcase.bucket, tb.Bucket(tcase.start))
+			assert.Equal(t, tcase.bucket-1, tb.Bucket(tcase.start.Add(-time.Second)))
+		})
+	}
+}
+
+func TestTimeBucketVerify(t *testing.T) {
+	vindex, err := CreateVindex("time_bucket", "time_bucket", map[string]string{"period": "day"})
+	require.NoError(t, err)
+	tb := vindex.(MultiColumn)
+	vals := [][]sqltypes.Value{{
+		// One for match
+		sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-06-01")), sqltypes.NewInt64(1),
+	}, {
+		// One for mismatch
+		sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-06-01")), sqltypes.NewInt64(1),
+	}, {
+		// One invalid value
+		sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-06-01")),
+	}}
+	ksids := [][]byte{
+		[]byte("\x49\x5b\x16k@\xb4J\xbaK\xd6"),
+		[]byte("no match"),
+		[]byte(""),
+	}
+
+	want := []bool{true, false, false}
+	got, err := tb.Verify(nil, vals, ksids)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestTimeBucketCreateErrors(t *testing.T) {
+	_, err := CreateVindex("time_bucket", "time_bucket", map[string]string{"period": "year"})
+	assert.EqualError(t, err, "period must be day, week or month: year")
+	_, err = CreateVindex("time_bucket", "time_bucket", nil)
+	assert.EqualError(t, err, "time_bucket missing period param")
+}
@@ -109,6 +109,24 @@ type Prefixable interface {
 	PrefixVindex() SingleColumn
 }
 
+// A Ranged vindex is one that preserves the order of its input values
+// in the keyspace ids it produces. Such a vindex can map a range of
+// values to a key range, which allows the planner to reduce the fan out
+// for inequality and BETWEEN expressions.
+type Ranged interface {
+	SingleColumn
+	// MapRange maps the inclusive range [from, to] to a key.Destination.
+	// A NULL bound means that the range is open on that side.
+	// If the range cannot be mapped, the vindex must return a
+	// destination that covers all the possible keyspace ids.
+	MapRange(vcursor VCursor, from, to sqltypes.Value) (key.Destination, error)
+	// IsRangeSafe returns true if MySQL orders the values of a column of
+	// the given type the same way as MapRange orders their keyspace ids.
+	// The planner must not route a range on a column for which this
+	// returns false. The type is NULL_TYPE if the vschema does not declare it.
+	IsRangeSafe(colType querypb.Type) bool
+}
+
 // A Lookup vindex is one that needs to lookup
 // a previously stored map to compute the keyspace
 // id from an id. This means that the creation of
@@ -93,6 +93,11 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+	// ForeignKeys are the foreign keys of the table, which references
+	// its parent tables. ChildForeignKeys are the foreign keys of other
+	// tables that reference this table.
+	ForeignKeys      []*ForeignKey `json:"foreign_keys,omitempty"`
+	ChildForeignKeys []*ForeignKey `json:"child_foreign_keys,omitempty"`
 }
 
 // Keyspace contains the keyspcae info for each Table.
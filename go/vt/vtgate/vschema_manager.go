@@ -49,6 +49,7 @@ type VSchemaManager struct {
 // SchemaInfo is an interface to schema tracker.
 type SchemaInfo interface {
 	Tables(ks string) map[string][]vindexes.Column
+	ForeignKeys(ks string) map[string][]*vindexes.ForeignKey
 }
 
 // GetCurrentSrvVschema returns a copy of the latest SrvVschema from the
@@ -202,5 +203,11 @@ func (vm *VSchemaManager) updateFromSchema(vschema *vindexes.VSchema) {
 				vTbl.ColumnListAuthoritative = true
 			}
 		}
+
+		for _, fks := range vm.schema.ForeignKeys(ksName) {
+			for _, fk := range fks {
+				ks.AddForeignKey(fk)
+			}
+		}
 	}
 }
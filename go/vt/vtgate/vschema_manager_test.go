@@ -218,6 +218,35 @@ func TestRebuildVSchema(t *testing.T) {
 	}
 }
 
+func TestVSchemaUpdateForeignKeys(t *testing.T) {
+	fk := &vindexes.ForeignKey{
+		Name:          "fk1",
+		Table:         sqlparser.NewTableIdent("child"),
+		Columns:       []sqlparser.ColIdent{sqlparser.NewColIdent("parent_id")},
+		ParentTable:   sqlparser.NewTableIdent("parent"),
+		ParentColumns: []sqlparser.ColIdent{sqlparser.NewColIdent("id")},
+		OnDelete:      "CASCADE",
+	}
+	// A foreign key to a table unknown to the vschema is ignored.
+	unknownFK := &vindexes.ForeignKey{
+		Name:        "fk2",
+		Table:       sqlparser.NewTableIdent("child"),
+		ParentTable: sqlparser.NewTableIdent("unknown"),
+	}
+
+	vm := &VSchemaManager{}
+	var vs *vindexes.VSchema
+	vm.subscriber = func(vschema *vindexes.VSchema, _ *VSchemaStats) {
+		vs = vschema
+	}
+	vm.schema = &fakeSchema{fks: map[string][]*vindexes.ForeignKey{"child": {fk, unknownFK}}}
+	vm.VSchemaUpdate(makeTestSrvVSchema("ks", false, map[string]*vschemapb.Table{"parent": {}, "child": {}}), nil)
+
+	tables := vs.Keyspaces["ks"].Tables
+	utils.MustMatch(t, []*vindexes.ForeignKey{fk}, tables["child"].ForeignKeys)
+	utils.MustMatch(t, []*vindexes.ForeignKey{fk}, tables["parent"].ChildForeignKeys)
+}
+
 func makeTestVSchema(ks string, sharded bool, tbls map[string]*vindexes.Table) *vindexes.VSchema {
 	kSchema := &vindexes.KeyspaceSchema{
 		Keyspace: &vindexes.Keyspace{
@@ -250,7 +279,8 @@ func makeTestSrvVSchema(ks string, sharded bool, tbls map[string]*vschemapb.Tabl
 }
 
 type fakeSchema struct {
-	t map[string][]vindexes.Column
+	t   map[string][]vindexes.Column
+	fks map[string][]*vindexes.ForeignKey
 }
 
 var _ SchemaInfo = (*fakeSchema)(nil)
@@ -258,3 +288,7 @@ var _ SchemaInfo = (*fakeSchema)(nil)
 func (f *fakeSchema) Tables(string) map[string][]vindexes.Column {
 	return f.t
 }
+
+func (f *fakeSchema) ForeignKeys(string) map[string][]*vindexes.ForeignKey {
+	return f.fks
+}
@@ -93,6 +93,11 @@ func (client *FakeTabletManagerClient) GetPermissions(ctx context.Context, table
 	return &tabletmanagerdatapb.Permissions{}, nil
 }
 
+// GetSchemaVersions is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetSchemaVersions(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GetSchemaVersionsRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	return nil, nil
+}
+
 // LockTables is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) LockTables(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return nil
@@ -262,6 +262,20 @@ func (client *Client) GetPermissions(ctx context.Context, tablet *topodatapb.Tab
 	return response.Permissions, nil
 }
 
+// GetSchemaVersions is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetSchemaVersions(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GetSchemaVersionsRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	response, err := c.GetSchemaVersions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.SchemaVersions, nil
+}
+
 //
 // Various read-write methods
 //
@@ -97,6 +97,17 @@ func (s *server) GetPermissions(ctx context.Context, request *tabletmanagerdatap
 	return response, err
 }
 
+func (s *server) GetSchemaVersions(ctx context.Context, request *tabletmanagerdatapb.GetSchemaVersionsRequest) (response *tabletmanagerdatapb.GetSchemaVersionsResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetSchemaVersions", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetSchemaVersionsResponse{}
+	versions, err := s.tm.GetSchemaVersions(ctx, request)
+	if err == nil {
+		response.SchemaVersions = versions
+	}
+	return response, err
+}
+
 //
 // Various read-write methods
 //
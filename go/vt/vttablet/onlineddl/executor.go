@@ -42,16 +42,19 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/textutil"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemadiff"
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
@@ -59,12 +62,9 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
-
-	mysqldriver "github.com/go-sql-driver/mysql"
-	"github.com/jmoiron/sqlx"
-	"github.com/planetscale/tengo"
 )
 
 var (
@@ -108,6 +108,8 @@ var ghostOverridePath = flag.String("gh-ost-path", "", "override default gh-ost
 var ptOSCOverridePath = flag.String("pt-osc-path", "", "override default pt-online-schema-change binary full path")
 var migrationCheckInterval = flag.Duration("migration_check_interval", 1*time.Minute, "Interval between migration checks")
 var retainOnlineDDLTables = flag.Duration("retain_online_ddl_tables", 24*time.Hour, "How long should vttablet keep an old migrated table before purging it")
+var maxConcurrentMigrations = flag.Int("online_ddl_max_concurrent_migrations", 4, "Maximum number of -allow-concurrent vreplication migrations to run concurrently, on distinct tables")
+var maxCutOverAttempts = flag.Int("online_ddl_max_cut_over_attempts", 10, "How many times should vttablet attempt to cut-over a vreplication migration before failing it")
 var migrationNextCheckIntervals = []time.Duration{1 * time.Second, 5 * time.Second, 10 * time.Second, 20 * time.Second}
 
 const (
@@ -120,7 +122,9 @@ const (
 	rowsCopiedUnknown                        = 0
 	databasePoolSize                         = 3
 	vreplicationCutOverThreshold             = 5 * time.Second
+	defaultCutOverTimeout                    = 2 * vreplicationCutOverThreshold
 	vreplicationTestSuiteWaitSeconds         = 5
+	throttlerAppName                         = "online-ddl:scheduler"
 )
 
 var (
@@ -155,9 +159,24 @@ type Executor struct {
 	vreplMigrationRunning int64
 	ghostMigrationRunning int64
 	ptoscMigrationRunning int64
-	lastMigrationUUID     string
 	tickReentranceFlag    int64
 
+	// ownedRunningMigrations maps UUID to *schema.OnlineDDL, for migrations started by this executor
+	// which have not yet concluded
+	ownedRunningMigrations sync.Map
+
+	throttlerClient *throttle.Client
+
+	// etaEstimators maps UUID to *etaEstimator, for running vreplication migrations. Protected by migrationMutex
+	etaEstimators       map[string]*etaEstimator
+	migrationProgress   *stats.GaugesWithSingleLabel
+	migrationETASeconds *stats.GaugesWithSingleLabel
+	migrationLagSeconds *stats.GaugesWithSingleLabel
+
+	// partitionSchedules is parsed from -online_ddl_partition_schedules
+	partitionSchedules      []*partitionSchedule
+	lastPartitionManagement time.Time
+
 	ticks             *timer.Timer
 	isOpen            bool
 	schemaInitialized bool
@@ -186,7 +205,7 @@ func newGCTableRetainTime() time.Time {
 }
 
 // NewExecutor creates a new gh-ost executor.
-func NewExecutor(env tabletenv.Env, tabletAlias *topodatapb.TabletAlias, ts *topo.Server, tabletTypeFunc func() topodatapb.TabletType) *Executor {
+func NewExecutor(env tabletenv.Env, tabletAlias *topodatapb.TabletAlias, ts *topo.Server, tabletTypeFunc func() topodatapb.TabletType, lagThrottler *throttle.Throttler) *Executor {
 	return &Executor{
 		env:         env,
 		tabletAlias: proto.Clone(tabletAlias).(*topodatapb.TabletAlias),
@@ -195,9 +214,15 @@ func NewExecutor(env tabletenv.Env, tabletAlias *topodatapb.TabletAlias, ts *top
 			Size:               databasePoolSize,
 			IdleTimeoutSeconds: env.Config().OltpReadPool.IdleTimeoutSeconds,
 		}),
-		tabletTypeFunc: tabletTypeFunc,
-		ts:             ts,
-		ticks:          timer.NewTimer(*migrationCheckInterval),
+		tabletTypeFunc:  tabletTypeFunc,
+		ts:              ts,
+		throttlerClient: throttle.NewBackgroundClient(lagThrottler, throttlerAppName, throttle.ThrottleCheckPrimaryWrite),
+		ticks:           timer.NewTimer(*migrationCheckInterval),
+
+		etaEstimators:       map[string]*etaEstimator{},
+		migrationProgress:   env.Exporter().NewGaugesWithSingleLabel("OnlineDDLMigrationProgress", "Estimated progress of running vreplication migrations, in percent", "MigrationUUID"),
+		migrationETASeconds: env.Exporter().NewGaugesWithSingleLabel("OnlineDDLMigrationETASeconds", "Estimated seconds to completion of running vreplication migrations, -1 when unknown", "MigrationUUID"),
+		migrationLagSeconds: env.Exporter().NewGaugesWithSingleLabel("OnlineDDLMigrationVReplicationLagSeconds", "Binlog backlog of running vreplication migrations, in seconds", "MigrationUUID"),
 	}
 }
 
@@ -268,6 +293,11 @@ func (e *Executor) Open() error {
 		// this validates vexecUpdateTemplates
 		return err
 	}
+	partitionSchedules, err := parsePartitionSchedules(*partitionSchedulesFlag)
+	if err != nil {
+		return err
+	}
+	e.partitionSchedules = partitionSchedules
 
 	e.isOpen = true
 
@@ -308,6 +338,44 @@ func (e *Executor) isAnyMigrationRunning() bool {
 	return false
 }
 
+// isOwnedMigration returns true when given migration was started by this executor and has not yet concluded
+func (e *Executor) isOwnedMigration(uuid string) bool {
+	_, ok := e.ownedRunningMigrations.Load(uuid)
+	return ok
+}
+
+// pruneOwnedMigrations forgets owned migrations which are no longer pending
+func (e *Executor) pruneOwnedMigrations(ctx context.Context) error {
+	uuids, err := e.readPendingMigrationsUUIDs(ctx)
+	if err != nil {
+		return err
+	}
+	pending := map[string]bool{}
+	for _, uuid := range uuids {
+		pending[uuid] = true
+	}
+	e.ownedRunningMigrations.Range(func(key, _ interface{}) bool {
+		if uuid := key.(string); !pending[uuid] {
+			e.ownedRunningMigrations.Delete(uuid)
+		}
+		return true
+	})
+	return nil
+}
+
+// isConcurrentMigration returns true when a migration may run alongside other concurrent migrations,
+// on distinct tables. Only vreplication migrations, which explicitly ask for -allow-concurrent, qualify.
+// Revert migrations always run exclusively.
+func isConcurrentMigration(strategySetting *schema.DDLStrategySetting, ddlAction string) bool {
+	if strategySetting.Strategy != schema.DDLStrategyOnline {
+		return false
+	}
+	if ddlAction == schema.RevertActionStr {
+		return false
+	}
+	return strategySetting.IsAllowConcurrent()
+}
+
 func (e *Executor) ghostPanicFlagFileName(uuid string) string {
 	return path.Join(os.TempDir(), fmt.Sprintf("ghost.%s.panic.flag", uuid))
 }
@@ -436,6 +504,40 @@ func (e *Executor) tableExists(ctx context.Context, tableName string) (bool, err
 	return (row != nil), nil
 }
 
+// isView checks if a given table exists and is a view.
+func (e *Executor) isView(ctx context.Context, tableName string) (bool, error) {
+	conn, err := e.pool.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Recycle()
+
+	tableName = strings.ReplaceAll(tableName, `_`, `\_`)
+	parsed := sqlparser.BuildParsedQuery(sqlShowFullTablesLike, tableName)
+	rs, err := conn.Exec(ctx, parsed.Query, 1, true)
+	if err != nil {
+		return false, err
+	}
+	row := rs.Named().Row()
+	if row == nil {
+		return false, nil
+	}
+	return row["Table_type"].ToString() == tmutils.TableView, nil
+}
+
+// isViewMigration checks if the migration is a CREATE VIEW, ALTER VIEW or DROP VIEW
+func isViewMigration(onlineDDL *schema.OnlineDDL) bool {
+	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+	if err != nil {
+		return false
+	}
+	switch ddlStmt.(type) {
+	case *sqlparser.CreateView, *sqlparser.AlterView, *sqlparser.DropView:
+		return true
+	}
+	return false
+}
+
 func (e *Executor) parseAlterOptions(ctx context.Context, onlineDDL *schema.OnlineDDL) string {
 	// Temporary hack (2020-08-11)
 	// Because sqlparser does not do full blown ALTER TABLE parsing,
@@ -551,8 +653,9 @@ func (e *Executor) terminateVReplMigration(ctx context.Context, uuid string) err
 	return nil
 }
 
-// cutOverVReplMigration stops vreplication, then removes the _vt.vreplication entry for the given migration
-func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) error {
+// cutOverVReplMigration stops vreplication, then removes the _vt.vreplication entry for the given migration.
+// Writes to the migrated table are blocked for at most cutOverTimeout while waiting for vreplication to catch up.
+func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream, cutOverTimeout time.Duration) (err error) {
 	// sanity checks:
 	vreplTable, err := getVreplTable(ctx, s)
 	if err != nil {
@@ -626,6 +729,18 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 		if _, err = e.execQuery(ctx, parsed.Query); err != nil {
 			return err
 		}
+		defer func() {
+			if err != nil {
+				// Cut-over failed. We rename the table back, before writes are re-enabled, so that
+				// the next cut-over attempt finds the table in place.
+				parsed := sqlparser.BuildParsedQuery(sqlRenameTable,
+					beforeTableName, onlineDDL.Table,
+				)
+				if _, err := e.execQuery(ctx, parsed.Query); err != nil {
+					log.Errorf("Executor.cutOverVReplMigration: cannot rename %s back to %s: %v", beforeTableName, onlineDDL.Table, err)
+				}
+			}
+		}()
 	}
 	postWritesPos, err := e.primaryPosition(ctx)
 	if err != nil {
@@ -640,7 +755,7 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 	}
 
 	waitForPos := func() error {
-		ctx, cancel := context.WithTimeout(ctx, 2*vreplicationCutOverThreshold)
+		ctx, cancel := context.WithTimeout(ctx, cutOverTimeout)
 		defer cancel()
 		// Wait for target to reach the up-to-date pos
 		if err := tmClient.VReplicationWaitForPos(ctx, tablet.Tablet, int(s.id), mysql.EncodePosition(postWritesPos)); err != nil {
@@ -657,6 +772,13 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 	if _, err := tmClient.VReplicationExec(ctx, tablet.Tablet, binlogplayer.StopVReplication(uint32(s.id), "stopped for online DDL cutover")); err != nil {
 		return err
 	}
+	defer func() {
+		if err != nil {
+			// Cut-over failed after vreplication was stopped. We resume the stream so that
+			// the migration keeps tracking the table and can attempt cut-over again.
+			_, _ = tmClient.VReplicationExec(ctx, tablet.Tablet, binlogplayer.StartVReplication(uint32(s.id)))
+		}
+	}()
 
 	// rename tables atomically (remember, writes on source tables are stopped)
 	{
@@ -773,7 +895,8 @@ func (e *Executor) ExecuteWithVReplication(ctx context.Context, onlineDDL *schem
 	// make sure there's no vreplication workflow running under same name
 	_ = e.terminateVReplMigration(ctx, onlineDDL.UUID)
 
-	if e.isAnyMigrationRunning() {
+	isConcurrent := revertMigration == nil && onlineDDL.StrategySetting().IsAllowConcurrent()
+	if e.isAnyMigrationRunning() && !isConcurrent {
 		return ErrExecutorMigrationAlreadyRunning
 	}
 
@@ -787,8 +910,8 @@ func (e *Executor) ExecuteWithVReplication(ctx context.Context, onlineDDL *schem
 	}
 	defer conn.Close()
 
-	atomic.StoreInt64(&e.vreplMigrationRunning, 1)
-	e.lastMigrationUUID = onlineDDL.UUID
+	atomic.AddInt64(&e.vreplMigrationRunning, 1)
+	e.ownedRunningMigrations.Store(onlineDDL.UUID, onlineDDL)
 	if err := e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusRunning, false, progressPctStarted, etaSecondsUnknown, rowsCopiedUnknown); err != nil {
 		return err
 	}
@@ -1024,7 +1147,7 @@ exit $exit_code
 	}
 
 	atomic.StoreInt64(&e.ghostMigrationRunning, 1)
-	e.lastMigrationUUID = onlineDDL.UUID
+	e.ownedRunningMigrations.Store(onlineDDL.UUID, onlineDDL)
 
 	go func() error {
 		defer atomic.StoreInt64(&e.ghostMigrationRunning, 0)
@@ -1246,7 +1369,7 @@ export MYSQL_PWD
 	}
 
 	atomic.StoreInt64(&e.ptoscMigrationRunning, 1)
-	e.lastMigrationUUID = onlineDDL.UUID
+	e.ownedRunningMigrations.Store(onlineDDL.UUID, onlineDDL)
 
 	go func() error {
 		defer atomic.StoreInt64(&e.ptoscMigrationRunning, 0)
@@ -1334,7 +1457,7 @@ func (e *Executor) readPendingMigrationsUUIDs(ctx context.Context) (uuids []stri
 }
 
 // terminateMigration attempts to interrupt and hard-stop a running migration
-func (e *Executor) terminateMigration(ctx context.Context, onlineDDL *schema.OnlineDDL, lastMigrationUUID string) (foundRunning bool, err error) {
+func (e *Executor) terminateMigration(ctx context.Context, onlineDDL *schema.OnlineDDL) (foundRunning bool, err error) {
 	switch onlineDDL.Strategy {
 	case schema.DDLStrategyOnline:
 		// migration could have started by a different tablet. We need to actively verify if it is running
@@ -1365,7 +1488,7 @@ func (e *Executor) terminateMigration(ctx context.Context, onlineDDL *schema.Onl
 	case schema.DDLStrategyGhost:
 		if atomic.LoadInt64(&e.ghostMigrationRunning) > 0 {
 			// double check: is the running migration the very same one we wish to cancel?
-			if onlineDDL.UUID == lastMigrationUUID {
+			if e.isOwnedMigration(onlineDDL.UUID) {
 				// assuming all goes well in next steps, we can already report that there has indeed been a migration
 				foundRunning = true
 			}
@@ -1406,7 +1529,7 @@ func (e *Executor) CancelMigration(ctx context.Context, uuid string, terminateRu
 	}
 
 	if terminateRunningMigration {
-		migrationFound, err := e.terminateMigration(ctx, onlineDDL, e.lastMigrationUUID)
+		migrationFound, err := e.terminateMigration(ctx, onlineDDL)
 		defer e.updateMigrationMessage(ctx, onlineDDL.UUID, message)
 
 		if migrationFound {
@@ -1459,38 +1582,99 @@ func (e *Executor) CancelPendingMigrations(ctx context.Context, message string)
 	return result, nil
 }
 
-// scheduleNextMigration attemps to schedule a single migration to run next.
-// possibly there's no migrations to run. Possibly there's a migration running right now,
-// in which cases nothing happens.
+// schedulableMigration is a pending migration, as seen by the scheduler
+type schedulableMigration struct {
+	uuid       string
+	table      string
+	status     schema.OnlineDDLStatus
+	concurrent bool
+}
+
+// nextMigrationsToSchedule picks the queued migrations which may be made 'ready', given the list of
+// pending migrations ordered by request time. The rules are:
+//   - A non-concurrent migration runs exclusively: it is only scheduled when no other migration is ready or running,
+//     and while it is ready or running, nothing else is scheduled. It is also a barrier: migrations queued after it
+//     wait for it to be scheduled.
+//   - Concurrent migrations run alongside each other, up to maxConcurrent, but never two on the same table. Migrations
+//     on the same table are scheduled in order of request.
+//   - Beyond the first active migration, further concurrent migrations are only scheduled while the throttler is happy.
+func nextMigrationsToSchedule(pending []*schedulableMigration, maxConcurrent int, isThrottled func() bool) (uuids []string) {
+	activeTables := map[string]bool{}
+	countActive := 0
+	for _, m := range pending {
+		switch m.status {
+		case schema.OnlineDDLStatusReady, schema.OnlineDDLStatusRunning:
+			if !m.concurrent {
+				// An exclusive migration is active. Nothing else may run alongside it.
+				return nil
+			}
+			activeTables[m.table] = true
+			countActive++
+		}
+	}
+	for _, m := range pending {
+		if m.status != schema.OnlineDDLStatusQueued {
+			continue
+		}
+		if !m.concurrent {
+			if countActive == 0 {
+				uuids = append(uuids, m.uuid)
+			}
+			// Whether scheduled now or waiting for active migrations to complete, nothing may jump ahead of this migration
+			return uuids
+		}
+		if activeTables[m.table] {
+			// An earlier migration on this table is still pending. This one waits its turn.
+			continue
+		}
+		if countActive >= maxConcurrent {
+			return uuids
+		}
+		if countActive > 0 && isThrottled() {
+			return uuids
+		}
+		uuids = append(uuids, m.uuid)
+		activeTables[m.table] = true
+		countActive++
+	}
+	return uuids
+}
+
+// scheduleNextMigration attemps to schedule the next migrations to run. Possibly there's no migrations to run.
+// Possibly an exclusive migration is running right now, in which case nothing happens.
 func (e *Executor) scheduleNextMigration(ctx context.Context) error {
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
-	if e.isAnyMigrationRunning() {
-		return ErrExecutorMigrationAlreadyRunning
+	r, err := e.execQuery(ctx, sqlSelectSchedulableMigrations)
+	if err != nil {
+		return err
 	}
-
-	{
-		r, err := e.execQuery(ctx, sqlSelectCountReadyMigrations)
+	var pending []*schedulableMigration
+	for _, row := range r.Named().Rows {
+		strategySetting := schema.NewDDLStrategySetting(schema.DDLStrategy(row["strategy"].ToString()), row["options"].ToString())
+		pending = append(pending, &schedulableMigration{
+			uuid:       row["migration_uuid"].ToString(),
+			table:      row["mysql_table"].ToString(),
+			status:     schema.OnlineDDLStatus(row["migration_status"].ToString()),
+			concurrent: isConcurrentMigration(strategySetting, row["ddl_action"].ToString()),
+		})
+	}
+	isThrottled := func() bool {
+		return !e.throttlerClient.ThrottleCheckOK(ctx)
+	}
+	for _, uuid := range nextMigrationsToSchedule(pending, *maxConcurrentMigrations, isThrottled) {
+		query, err := sqlparser.ParseAndBind(sqlScheduleMigration,
+			sqltypes.StringBindVariable(uuid),
+		)
 		if err != nil {
 			return err
 		}
-
-		row := r.Named().Row()
-		countReady, err := row.ToInt64("count_ready")
-		if err != nil {
+		if _, err := e.execQuery(ctx, query); err != nil {
 			return err
 		}
-
-		if countReady > 0 {
-			// seems like there's already one migration that's good to go
-			return nil
-		}
-	} // Cool, seems like no migration is ready. Let's try and make a single 'queued' migration 'ready'
-
-	_, err := e.execQuery(ctx, sqlScheduleSingleMigration)
-
-	return err
+	}
+	return nil
 }
 
 func (e *Executor) validateMigrationRevertible(ctx context.Context, revertMigration *schema.OnlineDDL) (err error) {
@@ -1501,6 +1685,10 @@ func (e *Executor) validateMigrationRevertible(ctx context.Context, revertMigrat
 	}
 	switch action {
 	case sqlparser.AlterDDLAction:
+		if isViewMigration(revertMigration) {
+			// View migrations are revertible whatever the strategy
+			break
+		}
 		if revertMigration.Strategy != schema.DDLStrategyOnline {
 			return fmt.Errorf("can only revert a %s strategy migration. Migration %s has %s strategy", schema.DDLStrategyOnline, revertMigration.UUID, revertMigration.Strategy)
 		}
@@ -1572,6 +1760,9 @@ func (e *Executor) executeRevert(ctx context.Context, onlineDDL *schema.OnlineDD
 	if err := e.validateMigrationRevertible(ctx, revertMigration); err != nil {
 		return err
 	}
+	if specialPlan := row["special_plan"].ToString(); specialPlan != "" {
+		return fmt.Errorf("cannot revert migration %s: it ran with a %s plan, which is not revertible", revertMigration.UUID, specialPlan)
+	}
 	revertActionStr := row["ddl_action"].ToString()
 	switch revertActionStr {
 	case sqlparser.CreateStr:
@@ -1646,9 +1837,37 @@ func (e *Executor) executeRevert(ctx context.Context, onlineDDL *schema.OnlineDD
 			if err := e.updateDDLAction(ctx, onlineDDL.UUID, sqlparser.AlterStr); err != nil {
 				return err
 			}
-			if err := e.ExecuteWithVReplication(ctx, onlineDDL, revertMigration); err != nil {
+			isView, err := e.isView(ctx, revertMigration.Table)
+			if err != nil {
 				return err
 			}
+			if isView {
+				// We are reverting a view migration. The view's previous definition is the migration's artifact,
+				// and we swap the two. We in turn keep the current definition as artifact, so that the revert is revertible.
+				if err := e.updateMySQLTable(ctx, onlineDDL.UUID, revertMigration.Table); err != nil {
+					return err
+				}
+				artifacts := row["artifacts"].ToString()
+				artifactTables := textutil.SplitDelimitedList(artifacts)
+				if len(artifactTables) != 1 {
+					return fmt.Errorf("cannot run migration %s reverting %s: found %d artifact tables, expected exactly 1", onlineDDL.UUID, revertMigration.UUID, len(artifactTables))
+				}
+				artifactViewName, err := schema.GenerateGCTableName(schema.HoldTableGCState, newGCTableRetainTime())
+				if err != nil {
+					return err
+				}
+				if err := e.updateArtifacts(ctx, onlineDDL.UUID, artifactViewName); err != nil {
+					return err
+				}
+				onlineDDL.SQL = sqlparser.BuildParsedQuery(sqlSwapViews, revertMigration.Table, artifactViewName, artifactTables[0], revertMigration.Table).Query
+				if _, err := e.executeDirectly(ctx, onlineDDL); err != nil {
+					return err
+				}
+			} else {
+				if err := e.ExecuteWithVReplication(ctx, onlineDDL, revertMigration); err != nil {
+					return err
+				}
+			}
 		}
 	default:
 		return fmt.Errorf("cannot run migration %s reverting %s: unexpected action %s", onlineDDL.UUID, revertMigration.UUID, revertActionStr)
@@ -1657,34 +1876,48 @@ func (e *Executor) executeRevert(ctx context.Context, onlineDDL *schema.OnlineDD
 	return nil
 }
 
+// showCreateTable returns the output of SHOW CREATE TABLE for the given table
+func (e *Executor) showCreateTable(conn *dbconnpool.DBConnection, tableName string) (string, error) {
+	parsed := sqlparser.BuildParsedQuery(sqlShowCreateTable, tableName)
+	rs, err := conn.ExecuteFetch(parsed.Query, 1, false)
+	if err != nil {
+		return "", err
+	}
+	if len(rs.Rows) != 1 || len(rs.Rows[0]) < 2 {
+		return "", vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for SHOW CREATE TABLE %s", tableName)
+	}
+	return rs.Rows[0][1].ToString(), nil
+}
+
 // evaluateDeclarativeDiff is called for -declarative CREATE statements, where the table already exists. The function generates a SQL diff, which can be:
-// - empty, in which case the migration is noop and implicitly successful, or
-// - non-empty, in which case the migration turns to be an ALTER
-func (e *Executor) evaluateDeclarativeDiff(ctx context.Context, onlineDDL *schema.OnlineDDL) (alterClause string, err error) {
+// - nil, in which case the migration is noop and implicitly successful, or
+// - non-nil, in which case the migration turns to be an ALTER
+func (e *Executor) evaluateDeclarativeDiff(ctx context.Context, onlineDDL *schema.OnlineDDL) (alter *sqlparser.AlterTable, err error) {
 
 	// Modify the CREATE TABLE statement to indicate a different, made up table name, known as the "comparison table"
 	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	comparisonTableName, err := schema.GenerateGCTableName(schema.HoldTableGCState, newGCTableRetainTime())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer conn.Close()
 
 	{
-		// Create the comparison table
+		// Create the comparison table. MySQL normalizes the declared table, and we read it back
+		// in the same form as the existing table.
 		ddlStmt.SetTable("", comparisonTableName)
 		modifiedCreateSQL := sqlparser.String(ddlStmt)
 
 		if _, err := conn.ExecuteFetch(modifiedCreateSQL, 0, false); err != nil {
-			return "", err
+			return nil, err
 		}
 
 		defer func() {
@@ -1694,84 +1927,235 @@ func (e *Executor) evaluateDeclarativeDiff(ctx context.Context, onlineDDL *schem
 			// Nothing bad happens for not checking the error code. The table is GC/HOLD. If we
 			// can't drop it now, it still gets collected later by tablegc mechanism
 		}()
+	}
 
+	// Compare the existing (to be potentially migrated) table with the declared (newly created) table
+	existingCreateSQL, err := e.showCreateTable(conn, onlineDDL.Table)
+	if err != nil {
+		return nil, err
+	}
+	comparisonCreateSQL, err := e.showCreateTable(conn, comparisonTableName)
+	if err != nil {
+		return nil, err
 	}
+	existingStmt, err := schemadiff.ParseEntity(existingCreateSQL)
+	if err != nil {
+		return nil, err
+	}
+	comparisonStmt, err := schemadiff.ParseEntity(comparisonCreateSQL)
+	if err != nil {
+		return nil, err
+	}
+	existingTable, ok := existingStmt.(*sqlparser.CreateTable)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s is not a table", onlineDDL.Table)
+	}
+	comparisonTable, ok := comparisonStmt.(*sqlparser.CreateTable)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "%s is not a table", comparisonTableName)
+	}
+	// We created the comparison table in same schema as original table, but under different name (because obviously we can't have
+	// two tables with identical name in same schema). We compare it under the name of the original table.
+	comparisonTable.Table = existingTable.Table
+	return schemadiff.DiffCreateTables(existingTable, comparisonTable, nil)
+}
 
-	// Compare the existing (to be potentially migrated) table with the declared (newly created) table:
-	// all things are tengo related
-	if err := func() error {
-		variables, err := e.readMySQLVariables(ctx)
-		if err != nil {
-			return err
-		}
-		flavor := tengo.ParseFlavor(variables.version, variables.versionComment)
+// showCreateView returns the output of SHOW CREATE VIEW for the given view
+func (e *Executor) showCreateView(conn *dbconnpool.DBConnection, viewName string) (string, error) {
+	parsed := sqlparser.BuildParsedQuery(sqlShowCreateView, viewName)
+	rs, err := conn.ExecuteFetch(parsed.Query, 1, false)
+	if err != nil {
+		return "", err
+	}
+	if len(rs.Rows) != 1 || len(rs.Rows[0]) < 2 {
+		return "", vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for SHOW CREATE VIEW %s", viewName)
+	}
+	return rs.Rows[0][1].ToString(), nil
+}
 
-		// Create a temporary account for tengo to use
-		onlineDDLPassword, err := e.createOnlineDDLUser(ctx)
-		if err != nil {
-			return err
-		}
-		defer e.dropOnlineDDLUser(ctx)
+// evaluateDeclarativeViewDiff is the equivalent of evaluateDeclarativeDiff for -declarative CREATE VIEW statements,
+// where the view already exists. It returns nil if the views are the same, or else the ALTER VIEW statement to run.
+func (e *Executor) evaluateDeclarativeViewDiff(ctx context.Context, onlineDDL *schema.OnlineDDL) (alter *sqlparser.AlterView, err error) {
+	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+	if err != nil {
+		return nil, err
+	}
+	comparisonViewName, err := schema.GenerateGCTableName(schema.HoldTableGCState, newGCTableRetainTime())
+	if err != nil {
+		return nil, err
+	}
 
-		// tengo requires sqlx.DB
-		cfg := mysqldriver.NewConfig()
-		cfg.User = onlineDDLUser
-		cfg.Passwd = onlineDDLPassword
-		cfg.Net = "tcp"
-		cfg.Addr = fmt.Sprintf("%s:%d", variables.host, variables.port)
-		cfg.DBName = e.dbName
-		cfg.ParseTime = true
-		cfg.InterpolateParams = true
-		cfg.Timeout = 1 * time.Second
-		mysqlDSN := cfg.FormatDSN()
-
-		db, err := sqlx.Open("mysql", mysqlDSN)
-		if err != nil {
-			return err
-		}
-		defer db.Close()
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
 
-		// Read existing table
-		existingTable, err := tengo.QuerySchemaTable(ctx, db, e.dbName, onlineDDL.Table, flavor)
-		if err != nil {
-			return err
-		}
-		// Read comparison table
-		comparisonTable, err := tengo.QuerySchemaTable(ctx, db, e.dbName, comparisonTableName, flavor)
-		if err != nil {
-			return err
-		}
-		// We created the comparison tablein same schema as original table, but under different name (because obviously we can't have
-		// two tables with identical name in same schema). It is our preference to create the table in the same schema.
-		// unfortunately, tengo does not allow comparing tables with different names. After asking tengo to read table info, we cheat
-		// and override the name of the table:
-		comparisonTable.Name = existingTable.Name
-		// We also override `.CreateStatement` (output of SHOW CREATE TABLE), because tengo has a validation, where if it doesn't
-		// find any ALTER changes, then the CreateStatement-s must be identical (or else it errors with UnsupportedDiffError)
-		comparisonTable.CreateStatement, err = schema.ReplaceTableNameInCreateTableStatement(comparisonTable.CreateStatement, existingTable.Name)
-		if err != nil {
-			return err
-		}
-		// Diff the two tables
-		diff := tengo.NewAlterTable(existingTable, comparisonTable)
-		if diff == nil {
-			// No change. alterClause remains empty
-			return nil
-		}
-		mods := tengo.StatementModifiers{
-			AllowUnsafe: true,
-			NextAutoInc: tengo.NextAutoIncIfIncreased,
+	{
+		// Create the comparison view, so that MySQL normalizes its query the same way it did the existing view's.
+		ddlStmt.SetTable("", comparisonViewName)
+		if _, err := conn.ExecuteFetch(sqlparser.String(ddlStmt), 0, false); err != nil {
+			return nil, err
 		}
-		alterClause, err = diff.Clauses(mods)
-		if err != nil {
-			return err
+
+		defer func() {
+			parsed := sqlparser.BuildParsedQuery(sqlDropView, comparisonViewName)
+			_, _ = conn.ExecuteFetch(parsed.Query, 0, false)
+			// If we can't drop the view now, it still gets collected later by tablegc mechanism
+		}()
+	}
+
+	existingCreateSQL, err := e.showCreateView(conn, onlineDDL.Table)
+	if err != nil {
+		return nil, err
+	}
+	comparisonCreateSQL, err := e.showCreateView(conn, comparisonViewName)
+	if err != nil {
+		return nil, err
+	}
+	existingStmt, err := schemadiff.ParseEntity(existingCreateSQL)
+	if err != nil {
+		return nil, err
+	}
+	comparisonStmt, err := schemadiff.ParseEntity(comparisonCreateSQL)
+	if err != nil {
+		return nil, err
+	}
+	existingView, ok := existingStmt.(*sqlparser.CreateView)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s is not a view", onlineDDL.Table)
+	}
+	comparisonView, ok := comparisonStmt.(*sqlparser.CreateView)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "%s is not a view", comparisonViewName)
+	}
+	comparisonView.ViewName = existingView.ViewName
+	return schemadiff.DiffCreateViews(existingView, comparisonView)
+}
+
+// executeAlterView runs an ALTER VIEW, or a CREATE OR REPLACE VIEW of an existing view. Rather than modifying the view
+// in place, we create the new view under a temporary name and swap it with the existing view in a single RENAME TABLE.
+// The existing view is kept under a GC lifecycle name and recorded as artifact, which makes the migration revertible.
+func (e *Executor) executeAlterView(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+	if err != nil {
+		return err
+	}
+	var view *sqlparser.CreateView
+	switch ddlStmt := ddlStmt.(type) {
+	case *sqlparser.CreateView:
+		view = ddlStmt
+	case *sqlparser.AlterView:
+		view = &sqlparser.CreateView{
+			Algorithm:   ddlStmt.Algorithm,
+			Definer:     ddlStmt.Definer,
+			Security:    ddlStmt.Security,
+			Columns:     ddlStmt.Columns,
+			Select:      ddlStmt.Select,
+			CheckOption: ddlStmt.CheckOption,
 		}
-		return nil
-	}(); err != nil {
-		return "", err
+	default:
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected statement in view migration %s: %s", onlineDDL.UUID, onlineDDL.SQL)
+	}
+	// The new view gets a GC lifecycle name, too: should we fail before the swap, tablegc collects it.
+	newViewName, err := schema.GenerateGCTableName(schema.HoldTableGCState, newGCTableRetainTime())
+	if err != nil {
+		return err
+	}
+	artifactViewName, err := schema.GenerateGCTableName(schema.HoldTableGCState, newGCTableRetainTime())
+	if err != nil {
+		return err
+	}
+	view.SetTable("", newViewName)
+	view.IsReplace = false
+	view.Comments = nil
+
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecuteFetch(sqlparser.String(view), 0, false); err != nil {
+		return err
+	}
+	if err := e.updateArtifacts(ctx, onlineDDL.UUID, artifactViewName); err != nil {
+		return err
+	}
+	onlineDDL.SQL = sqlparser.BuildParsedQuery(sqlSwapViews, onlineDDL.Table, artifactViewName, newViewName, onlineDDL.Table).Query
+	_, err = e.executeDirectly(ctx, onlineDDL)
+	return err
+}
+
+// isInstantDDLMigration checks whether an ALTER TABLE migration can run as an instant DDL on the backend MySQL server
+func (e *Executor) isInstantDDLMigration(ctx context.Context, onlineDDL *schema.OnlineDDL) (bool, error) {
+	variables, err := e.readMySQLVariables(ctx)
+	if err != nil {
+		return false, err
+	}
+	if atLeast, err := mysqlVersionAtLeast(variables.version, instantDDLMinVersion); err != nil || !atLeast {
+		return false, err
+	}
+	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+	if err != nil {
+		return false, err
+	}
+	alterTable, ok := ddlStmt.(*sqlparser.AlterTable)
+	if !ok {
+		return false, nil
+	}
+
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return false, err
 	}
+	defer conn.Close()
 
-	return alterClause, nil
+	createSQL, err := e.showCreateTable(conn, onlineDDL.Table)
+	if err != nil {
+		return false, err
+	}
+	createStmt, err := schemadiff.ParseEntity(createSQL)
+	if err != nil {
+		return false, err
+	}
+	createTable, ok := createStmt.(*sqlparser.CreateTable)
+	if !ok {
+		return false, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s is not a table", onlineDDL.Table)
+	}
+	return isInstantDDLEligible(createTable, alterTable), nil
+}
+
+// executeAlterInstant runs an ALTER TABLE migration directly on the backend MySQL server, with ALGORITHM=INSTANT.
+// Such a migration completes at once, but is not revertible. Should MySQL refuse the instant change, the migration
+// is restored to its original statement and runs with vreplication instead.
+func (e *Executor) executeAlterInstant(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	originalSQL := onlineDDL.SQL
+	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+	if err != nil {
+		return err
+	}
+	alterTable, ok := ddlStmt.(*sqlparser.AlterTable)
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected statement in instant DDL migration %s: %s", onlineDDL.UUID, onlineDDL.SQL)
+	}
+	if err := e.updateSpecialPlan(ctx, onlineDDL.UUID, instantDDLSpecialPlan); err != nil {
+		return err
+	}
+	alterTable.Comments = nil
+	alterTable.AlterOptions = append(alterTable.AlterOptions, sqlparser.AlgorithmValue("INSTANT"))
+	onlineDDL.SQL = sqlparser.String(alterTable)
+	_, err = e.executeDirectly(ctx, onlineDDL)
+	if err == nil || !isInstantDDLRejected(err) {
+		return err
+	}
+	log.Infof("executeAlterInstant: MySQL rejected instant DDL for migration %s, falling back to vreplication: %v", onlineDDL.UUID, err)
+	onlineDDL.SQL = originalSQL
+	if err := e.updateSpecialPlan(ctx, onlineDDL.UUID, ""); err != nil {
+		return err
+	}
+	_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, fmt.Sprintf("instant DDL rejected, running with vreplication: %v", err))
+	return e.ExecuteWithVReplication(ctx, onlineDDL, nil)
 }
 
 // executeMigration executes a single migration. It analyzes the migration type:
@@ -1794,6 +2178,10 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 	if err != nil {
 		return failMigration(err)
 	}
+	// Migrations submitted via topo do not go through SubmitMigration, so we evaluate the schema policy here, too
+	if err := e.checkSchemaPolicy(ctx, onlineDDL); err != nil {
+		return failMigration(err)
+	}
 
 	if onlineDDL.StrategySetting().IsDeclarative() {
 		switch ddlAction {
@@ -1839,23 +2227,33 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 				return failMigration(err)
 			}
 			if exists {
-				alterClause, err := e.evaluateDeclarativeDiff(ctx, onlineDDL)
-				if err != nil {
-					return failMigration(err)
+				var alter sqlparser.DDLStatement
+				if isViewMigration(onlineDDL) {
+					if alterView, err := e.evaluateDeclarativeViewDiff(ctx, onlineDDL); err != nil {
+						return failMigration(err)
+					} else if alterView != nil {
+						alter = alterView
+					}
+				} else {
+					if alterTable, err := e.evaluateDeclarativeDiff(ctx, onlineDDL); err != nil {
+						return failMigration(err)
+					} else if alterTable != nil {
+						alter = alterTable
+					}
 				}
-				if alterClause == "" {
+				if alter == nil {
 					// No diff! We mark this CREATE as implicitly sucessful
 					_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusComplete, false, progressPctFull, etaSecondsNow, rowsCopiedUnknown)
 					_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, "no change")
 					return nil
 				}
-				// alterClause is non empty. We convert this migration into an ALTER
+				// alter is non nil. We convert this migration into an ALTER
 				if err := e.updateDDLAction(ctx, onlineDDL.UUID, sqlparser.AlterStr); err != nil {
 					return failMigration(err)
 				}
 				ddlAction = sqlparser.AlterDDLAction
-				onlineDDL.SQL = fmt.Sprintf("ALTER TABLE `%s` %s", onlineDDL.Table, alterClause)
-				_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, alterClause)
+				onlineDDL.SQL = sqlparser.String(alter)
+				_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, onlineDDL.SQL)
 			} else {
 				{
 					// table does not exist, so this declarative CREATE turns out to really be an actual CREATE. No further action is needed here.
@@ -1921,6 +2319,27 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 			e.migrationMutex.Lock()
 			defer e.migrationMutex.Unlock()
 
+			ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+			if err != nil {
+				return failMigration(err)
+			}
+			if createView, ok := ddlStmt.(*sqlparser.CreateView); ok && createView.IsReplace {
+				// CREATE OR REPLACE VIEW of an existing view is really an ALTER VIEW
+				exists, err := e.tableExists(ctx, onlineDDL.Table)
+				if err != nil {
+					return failMigration(err)
+				}
+				if exists {
+					if err := e.updateDDLAction(ctx, onlineDDL.UUID, sqlparser.AlterStr); err != nil {
+						return failMigration(err)
+					}
+					if err := e.executeAlterView(ctx, onlineDDL); err != nil {
+						return failMigration(err)
+					}
+					return nil
+				}
+			}
+
 			sentryArtifactTableName, err := schema.GenerateGCTableName(schema.HoldTableGCState, newGCTableRetainTime())
 			if err != nil {
 				return failMigration(err)
@@ -1930,10 +2349,6 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 			if err := e.updateArtifacts(ctx, onlineDDL.UUID, sentryArtifactTableName); err != nil {
 				return err
 			}
-			ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
-			if err != nil {
-				return failMigration(err)
-			}
 			if ddlStmt.GetIfNotExists() {
 				// This is a CREATE TABLE IF NOT EXISTS
 				// We want to know if the table actually exists before running this migration.
@@ -1956,6 +2371,49 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 			return nil
 		}()
 	case sqlparser.AlterDDLAction:
+		if isViewMigration(onlineDDL) {
+			// Views are migrated the same way whatever the strategy
+			go func() {
+				e.migrationMutex.Lock()
+				defer e.migrationMutex.Unlock()
+
+				if err := e.executeAlterView(ctx, onlineDDL); err != nil {
+					failMigration(err)
+				}
+			}()
+			return nil
+		}
+		if alterTable, err := partitionManagementAlter(onlineDDL); err != nil {
+			return failMigration(err)
+		} else if alterTable != nil {
+			// Partition management runs the same way whatever the strategy
+			go func() {
+				e.migrationMutex.Lock()
+				defer e.migrationMutex.Unlock()
+
+				if err := e.executePartitionManagement(ctx, onlineDDL, alterTable); err != nil {
+					failMigration(err)
+				}
+			}()
+			return nil
+		}
+		if onlineDDL.StrategySetting().IsFastOverRevertible() {
+			isInstantDDL, err := e.isInstantDDLMigration(ctx, onlineDDL)
+			if err != nil {
+				return failMigration(err)
+			}
+			if isInstantDDL {
+				go func() {
+					e.migrationMutex.Lock()
+					defer e.migrationMutex.Unlock()
+
+					if err := e.executeAlterInstant(ctx, onlineDDL); err != nil {
+						failMigration(err)
+					}
+				}()
+				return nil
+			}
+		}
 		switch onlineDDL.Strategy {
 		case schema.DDLStrategyOnline:
 			go func() {
@@ -2006,16 +2464,17 @@ func (e *Executor) runNextMigration(ctx context.Context) error {
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
-	if e.isAnyMigrationRunning() {
-		return ErrExecutorMigrationAlreadyRunning
-	}
-
-	r, err := e.execQuery(ctx, sqlSelectReadyMigration)
+	r, err := e.execQuery(ctx, sqlSelectReadyMigrations)
 	if err != nil {
 		return err
 	}
 	named := r.Named()
-	for i, row := range named.Rows {
+	for _, row := range named.Rows {
+		uuid := row["migration_uuid"].ToString()
+		if e.isOwnedMigration(uuid) {
+			// Already started by this executor, and pending its transition to 'running'
+			continue
+		}
 		onlineDDL := &schema.OnlineDDL{
 			Keyspace: row["keyspace"].ToString(),
 			Table:    row["mysql_table"].ToString(),
@@ -2034,12 +2493,12 @@ func (e *Executor) runNextMigration(ctx context.Context) error {
 				onlineDDL.SQL = sqlparser.String(ddlStmt)
 			}
 		}
-		e.executeMigration(ctx, onlineDDL)
-		// the query should only ever return a single row at the most
-		// but let's make it also explicit here that we only run a single migration
-		if i == 0 {
-			break
+		if !isConcurrentMigration(onlineDDL.StrategySetting(), row["ddl_action"].ToString()) && e.isAnyMigrationRunning() {
+			// The scheduler only readies an exclusive migration when nothing else runs; we double check
+			return ErrExecutorMigrationAlreadyRunning
 		}
+		e.ownedRunningMigrations.Store(onlineDDL.UUID, onlineDDL)
+		e.executeMigration(ctx, onlineDDL)
 	}
 	return nil
 }
@@ -2158,7 +2617,7 @@ func (e *Executor) isVReplMigrationReadyToCutOver(ctx context.Context, s *VReplS
 		}
 	}
 	{
-		// Both time_updated and transaction_timestamp must be in close priximity to each
+		// Both time_updated and transaction_timestamp must be in close proximity to each
 		// other and to the time now, otherwise that means we're lagging and it's not a good time
 		// to cut-over
 		durationDiff := func(t1, t2 time.Time) time.Duration {
@@ -2168,15 +2627,13 @@ func (e *Executor) isVReplMigrationReadyToCutOver(ctx context.Context, s *VReplS
 			}
 			return diff
 		}
-		timeNow := time.Now()
-		timeUpdated := time.Unix(s.timeUpdated, 0)
-		if durationDiff(timeNow, timeUpdated) > vreplicationCutOverThreshold {
+		if s.lag() > vreplicationCutOverThreshold {
 			return false, nil
 		}
 		// Let's look at transaction timestamp. This gets written by any ongoing
 		// writes on the server (whether on this table or any other table)
 		transactionTimestamp := time.Unix(s.transactionTimestamp, 0)
-		if durationDiff(timeNow, transactionTimestamp) > vreplicationCutOverThreshold {
+		if durationDiff(time.Now(), transactionTimestamp) > vreplicationCutOverThreshold {
 			return false, nil
 		}
 	}
@@ -2207,6 +2664,58 @@ func (e *Executor) isVReplMigrationReadyToCutOver(ctx context.Context, s *VReplS
 	return true, nil
 }
 
+// isCutOverAllowed checks whether a migration, which is ready to cut-over, may do so at given time.
+// A migration is held back when the user postponed its completion (until ALTER VITESS_MIGRATION ... COMPLETE),
+// or when its strategy restricts the cut-over to a window of time that does not include given time.
+func isCutOverAllowed(strategySetting *schema.DDLStrategySetting, postponeCompletion bool, now time.Time) (bool, error) {
+	if postponeCompletion {
+		return false, nil
+	}
+	window, err := strategySetting.CutOverWindow()
+	if err != nil {
+		return false, err
+	}
+	if window != nil && !window.Contains(now) {
+		return false, nil
+	}
+	return true, nil
+}
+
+// cutOverMigrationWithRetries attempts to cut-over a ready vreplication migration. A failed attempt leaves the
+// migration running, to be attempted again on next review, until online_ddl_max_cut_over_attempts is exhausted,
+// at which point the migration is failed.
+func (e *Executor) cutOverMigrationWithRetries(ctx context.Context, s *VReplStream, strategySetting *schema.DDLStrategySetting, cutOverAttempts int64) error {
+	cutOverTimeout, err := strategySetting.CutOverTimeout()
+	if err != nil {
+		return err
+	}
+	if cutOverTimeout == 0 {
+		cutOverTimeout = defaultCutOverTimeout
+	}
+	if err := e.incrementCutOverAttempts(ctx, s.workflow); err != nil {
+		return err
+	}
+	cutOverErr := e.cutOverVReplMigration(ctx, s, cutOverTimeout)
+	if cutOverErr == nil {
+		return nil
+	}
+	cutOverAttempts++
+	log.Errorf("Executor.cutOverMigrationWithRetries: migration %s cut-over attempt %d failed: %v", s.workflow, cutOverAttempts, cutOverErr)
+	if cutOverAttempts < int64(*maxCutOverAttempts) {
+		_ = e.updateMigrationMessage(ctx, s.workflow, fmt.Sprintf("cut-over attempt %d failed: %v", cutOverAttempts, cutOverErr))
+		e.triggerNextCheckInterval()
+		return nil
+	}
+	if err := e.terminateVReplMigration(ctx, s.workflow); err != nil {
+		return err
+	}
+	if err := e.updateMigrationStatus(ctx, s.workflow, schema.OnlineDDLStatusFailed); err != nil {
+		return err
+	}
+	_ = e.updateMigrationTimestamp(ctx, "completed_timestamp", s.workflow)
+	return e.updateMigrationMessage(ctx, s.workflow, fmt.Sprintf("cut-over failed after %d attempts: %v", cutOverAttempts, cutOverErr))
+}
+
 // isVReplMigrationRunning sees if there is a VReplication migration actively running
 func (e *Executor) isVReplMigrationRunning(ctx context.Context, uuid string) (isRunning bool, s *VReplStream, err error) {
 	s, err = e.readVReplStream(ctx, uuid, true)
@@ -2226,8 +2735,8 @@ func (e *Executor) isVReplMigrationRunning(ctx context.Context, uuid string) (is
 	return false, s, nil
 }
 
-// reviewRunningMigrations iterates migrations in 'running' state. Normally there's only one running, which was
-// spawned by this tablet; but vreplication migrations could also resume from failure.
+// reviewRunningMigrations iterates migrations in 'running' state. These were normally spawned by this tablet,
+// and there may be several concurrent vreplication migrations; but vreplication migrations could also resume from failure.
 func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning int, cancellable []string, err error) {
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
@@ -2238,10 +2747,13 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 	}
 	// we identify running vreplication migrations in this function
 	atomic.StoreInt64(&e.vreplMigrationRunning, 0)
+	runningVReplMigrations := map[string]bool{}
 	for _, row := range r.Named().Rows {
 		uuid := row["migration_uuid"].ToString()
 		strategy := schema.DDLStrategy(row["strategy"].ToString())
 		strategySettings := schema.NewDDLStrategySetting(strategy, row["options"].ToString())
+		postponeCompletion := row.AsInt64("postpone_completion", 0) != 0
+		cutOverAttempts := row.AsInt64("cutover_attempts", 0)
 		elapsedSeconds := row.AsInt64("elapsed_seconds", 0)
 
 		switch strategy {
@@ -2257,15 +2769,15 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 					e.triggerNextCheckInterval()
 				}
 				if running {
-					// This VRepl migration may have started from outside this tablet, so
-					// vreplMigrationRunning could be zero. Whatever the case is, we're under
-					// migrationMutex lock and it's now safe to ensure vreplMigrationRunning is 1
-					atomic.StoreInt64(&e.vreplMigrationRunning, 1)
+					// This VRepl migration may have started from outside this tablet. Whatever the case is, we're under
+					// migrationMutex lock and it's now safe to count it in vreplMigrationRunning
+					atomic.AddInt64(&e.vreplMigrationRunning, 1)
 					_ = e.updateMigrationTimestamp(ctx, "liveness_timestamp", uuid)
 
 					_ = e.updateRowsCopied(ctx, uuid, s.rowsCopied)
-					_ = e.updateMigrationProgressByRowsCopied(ctx, uuid, s.rowsCopied)
-					_ = e.updateMigrationETASecondsByProgress(ctx, uuid)
+					_ = e.updateMigrationVReplLagSeconds(ctx, uuid, int64(s.lag().Seconds()))
+					_ = e.updateVReplMigrationProgress(ctx, uuid, s, row["mysql_table"].ToString(), row.AsInt64("table_rows", 0))
+					runningVReplMigrations[uuid] = true
 
 					isReady, err := e.isVReplMigrationReadyToCutOver(ctx, s)
 					if err != nil {
@@ -2278,9 +2790,16 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 							isReady = false
 						}
 					}
+					_ = e.updateMigrationReadyToComplete(ctx, uuid, isReady)
 					if isReady {
-						if err := e.cutOverVReplMigration(ctx, s); err != nil {
-							return countRunnning, cancellable, err
+						cutOverAllowed, err := isCutOverAllowed(strategySettings, postponeCompletion, time.Now())
+						if err != nil {
+							_ = e.updateMigrationMessage(ctx, uuid, err.Error())
+						}
+						if cutOverAllowed {
+							if err := e.cutOverMigrationWithRetries(ctx, s, strategySettings, cutOverAttempts); err != nil {
+								return countRunnning, cancellable, err
+							}
 						}
 					}
 				}
@@ -2296,10 +2815,8 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 				if running {
 					_ = e.updateMigrationTimestamp(ctx, "liveness_timestamp", uuid)
 				}
-				if uuid != e.lastMigrationUUID {
-					// This executor can only spawn one migration at a time. And that
-					// migration is identified by e.lastMigrationUUID.
-					// If we find a _running_ migration that does not have this UUID, it _must_
+				if !e.isOwnedMigration(uuid) {
+					// If we find a _running_ migration that was not started by this executor, it _must_
 					// mean the migration was started by a former vttablet (ie vttablet crashed and restarted)
 					cancellable = append(cancellable, uuid)
 				}
@@ -2307,14 +2824,17 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 		}
 		countRunnning++
 
-		if uuid != e.lastMigrationUUID {
-			// This executor can only run one migration at a time. And that
-			// migration is identified by e.lastMigrationUUID.
-			// If we find a _running_ migration that does not have this UUID, it _must_
+		if !e.isOwnedMigration(uuid) {
+			// This executor tracks the migrations it runs in ownedRunningMigrations.
+			// If we find a _running_ migration that was not started by this executor, it _must_
 			// mean the migration was started by a former vttablet (ie vttablet crashed and restarted)
 			cancellable = append(cancellable, uuid)
 		}
 	}
+	e.forgetMigrationsProgress(runningVReplMigrations)
+	if err := e.pruneOwnedMigrations(ctx); err != nil {
+		return countRunnning, cancellable, err
+	}
 	return countRunnning, cancellable, err
 }
 
@@ -2481,6 +3001,7 @@ func (e *Executor) onMigrationCheckTick() {
 	if err := e.gcArtifacts(ctx); err != nil {
 		log.Error(err)
 	}
+	e.managePartitions(ctx)
 }
 
 func (e *Executor) updateMigrationStartedTimestamp(ctx context.Context, uuid string) error {
@@ -2590,6 +3111,18 @@ func (e *Executor) updateDDLAction(ctx context.Context, uuid string, actionStr s
 	return err
 }
 
+func (e *Executor) updateSpecialPlan(ctx context.Context, uuid string, specialPlan string) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateSpecialPlan,
+		sqltypes.StringBindVariable(specialPlan),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
 func (e *Executor) updateMigrationMessage(ctx context.Context, uuid string, message string) error {
 	query, err := sqlparser.ParseAndBind(sqlUpdateMessage,
 		sqltypes.StringBindVariable(message),
@@ -2644,9 +3177,13 @@ func (e *Executor) updateMigrationProgress(ctx context.Context, uuid string, pro
 	return err
 }
 
-func (e *Executor) updateMigrationProgressByRowsCopied(ctx context.Context, uuid string, rowsCopied int64) error {
-	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationProgressByRowsCopied,
-		sqltypes.Int64BindVariable(rowsCopied),
+func (e *Executor) updateMigrationReadyToComplete(ctx context.Context, uuid string, isReady bool) error {
+	var readyToComplete int64
+	if isReady {
+		readyToComplete = 1
+	}
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationReadyToComplete,
+		sqltypes.Int64BindVariable(readyToComplete),
 		sqltypes.StringBindVariable(uuid),
 	)
 	if err != nil {
@@ -2656,8 +3193,20 @@ func (e *Executor) updateMigrationProgressByRowsCopied(ctx context.Context, uuid
 	return err
 }
 
-func (e *Executor) updateMigrationETASecondsByProgress(ctx context.Context, uuid string) error {
-	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationETASecondsByProgress,
+func (e *Executor) updateMigrationVReplLagSeconds(ctx context.Context, uuid string, lagSeconds int64) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationVReplLagSeconds,
+		sqltypes.Int64BindVariable(lagSeconds),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
+func (e *Executor) incrementCutOverAttempts(ctx context.Context, uuid string) error {
+	query, err := sqlparser.ParseAndBind(sqlIncrementCutOverAttempts,
 		sqltypes.StringBindVariable(uuid),
 	)
 	if err != nil {
@@ -2723,6 +3272,8 @@ func (e *Executor) RetryMigration(ctx context.Context, uuid string) (result *sql
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
+	// A retried migration starts afresh, and is no longer owned by a former run
+	e.ownedRunningMigrations.Delete(uuid)
 	query, err := sqlparser.ParseAndBind(sqlRetryMigration,
 		sqltypes.StringBindVariable(e.TabletAliasString()),
 		sqltypes.StringBindVariable(uuid),
@@ -2733,6 +3284,29 @@ func (e *Executor) RetryMigration(ctx context.Context, uuid string) (result *sql
 	return e.execQuery(ctx, query)
 }
 
+// CompleteMigration clears the postponed completion of given migration, allowing it to cut-over
+// as soon as it is ready to. Cut-over window restrictions, if any, still apply.
+func (e *Executor) CompleteMigration(ctx context.Context, uuid string) (result *sqltypes.Result, err error) {
+	if !e.isOpen {
+		return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "online ddl is disabled")
+	}
+	if !schema.IsOnlineDDLUUID(uuid) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "Not a valid migration ID in COMPLETE: %s", uuid)
+	}
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	query, err := sqlparser.ParseAndBind(sqlClearPostponeCompletion,
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer e.triggerNextCheckInterval()
+
+	return e.execQuery(ctx, query)
+}
+
 // SubmitMigration inserts a new migration request
 func (e *Executor) SubmitMigration(
 	ctx context.Context,
@@ -2749,6 +3323,13 @@ func (e *Executor) SubmitMigration(
 	if err != nil {
 		return nil, err
 	}
+	postponeCompletion, err := validateStrategySetting(onlineDDL.StrategySetting())
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Error submitting migration %s: %v", sqlparser.String(stmt), err)
+	}
+	if err := e.checkSchemaPolicy(ctx, onlineDDL); err != nil {
+		return nil, err
+	}
 
 	query, err := sqlparser.ParseAndBind(sqlInsertMigration,
 		sqltypes.StringBindVariable(onlineDDL.UUID),
@@ -2763,6 +3344,7 @@ func (e *Executor) SubmitMigration(
 		sqltypes.StringBindVariable(onlineDDL.RequestContext),
 		sqltypes.StringBindVariable(string(schema.OnlineDDLStatusQueued)),
 		sqltypes.StringBindVariable(e.TabletAliasString()),
+		sqltypes.Int64BindVariable(postponeCompletion),
 	)
 	if err != nil {
 		return nil, err
@@ -2806,6 +3388,28 @@ func (e *Executor) SubmitMigration(
 	return e.execQuery(ctx, query)
 }
 
+// validateStrategySetting validates the cut-over and concurrency related options of given strategy, which are only
+// supported by vreplication migrations, and returns the value for the postpone_completion column
+func validateStrategySetting(setting *schema.DDLStrategySetting) (postponeCompletion int64, err error) {
+	window, err := setting.CutOverWindow()
+	if err != nil {
+		return 0, err
+	}
+	timeout, err := setting.CutOverTimeout()
+	if err != nil {
+		return 0, err
+	}
+	if setting.Strategy != schema.DDLStrategyOnline {
+		if setting.IsPostponeCompletion() || setting.IsAllowConcurrent() || window != nil || timeout != 0 {
+			return 0, fmt.Errorf("postpone-completion, allow-concurrent, cut-over-window and cut-over-timeout are only supported by the %s strategy", schema.DDLStrategyOnline)
+		}
+	}
+	if setting.IsPostponeCompletion() {
+		postponeCompletion = 1
+	}
+	return postponeCompletion, nil
+}
+
 // onSchemaMigrationStatus is called when a status is set/changed for a running migration
 func (e *Executor) onSchemaMigrationStatus(ctx context.Context,
 	uuid string, status schema.OnlineDDLStatus, dryRun bool, progressPct float64, etaSeconds int64, rowsCopied int64) (err error) {
@@ -2914,6 +3518,15 @@ func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *queryp
 		vx.ReplaceInsertColumnVal("shard", vx.ToStringVal(e.shard))
 		vx.ReplaceInsertColumnVal("mysql_schema", vx.ToStringVal(e.dbName))
 		vx.AddOrReplaceInsertColumnVal("tablet", vx.ToStringVal(e.TabletAliasString()))
+		{
+			strategy, _ := vx.ColumnStringVal(vx.InsertCols, "strategy")
+			options, _ := vx.ColumnStringVal(vx.InsertCols, "options")
+			postponeCompletion, err := validateStrategySetting(schema.NewDDLStrategySetting(schema.DDLStrategy(strategy), options))
+			if err != nil {
+				return nil, err
+			}
+			vx.AddOrReplaceInsertColumnVal("postpone_completion", sqlparser.NewIntLiteral(strconv.FormatInt(postponeCompletion, 10)))
+		}
 		e.triggerNextCheckInterval()
 		return response(e.execQuery(ctx, vx.Query))
 	case *sqlparser.Update:
@@ -2938,6 +3551,15 @@ func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *queryp
 		switch statusVal {
 		case retryMigrationHint:
 			return response(e.retryMigrationWhere(ctx, sqlparser.String(stmt.Where.Expr)))
+		case completeMigrationHint:
+			uuid, err := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
+			if err != nil {
+				return nil, err
+			}
+			if !schema.IsOnlineDDLUUID(uuid) {
+				return nil, fmt.Errorf("Not an Online DDL UUID: %s", uuid)
+			}
+			return response(e.CompleteMigration(ctx, uuid))
 		case cancelMigrationHint:
 			uuid, err := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
 			if err != nil {
@@ -2954,8 +3576,8 @@ func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *queryp
 			}
 			return response(e.CancelPendingMigrations(ctx, "cancel-all by user"))
 		default:
-			return nil, fmt.Errorf("Unexpected value for migration_status: %v. Supported values are: %s, %s",
-				statusVal, retryMigrationHint, cancelMigrationHint)
+			return nil, fmt.Errorf("Unexpected value for migration_status: %v. Supported values are: %s, %s, %s",
+				statusVal, retryMigrationHint, completeMigrationHint, cancelMigrationHint)
 		}
 	default:
 		return nil, fmt.Errorf("No handler for this query: %s", vx.Query)
@@ -19,3 +19,257 @@ Functionality of this Executor is tested in go/test/endtoend/onlineddl/...
 */
 
 package onlineddl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestIsCutOverAllowed(t *testing.T) {
+	noon := time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC)
+	midnight := time.Date(2021, 7, 1, 0, 30, 0, 0, time.UTC)
+	tt := []struct {
+		options            string
+		postponeCompletion bool
+		now                time.Time
+		expectAllowed      bool
+	}{
+		{
+			now:           noon,
+			expectAllowed: true,
+		},
+		{
+			options:            "-postpone-completion",
+			postponeCompletion: true,
+			now:                noon,
+		},
+		{
+			// user issued ALTER VITESS_MIGRATION ... COMPLETE, which cleared postpone_completion
+			options:       "-postpone-completion",
+			now:           noon,
+			expectAllowed: true,
+		},
+		{
+			options: "-cut-over-window=22:00-04:00",
+			now:     noon,
+		},
+		{
+			options:       "-cut-over-window=22:00-04:00",
+			now:           midnight,
+			expectAllowed: true,
+		},
+		{
+			options:            "-cut-over-window=22:00-04:00",
+			postponeCompletion: true,
+			now:                midnight,
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.options, func(t *testing.T) {
+			setting := schema.NewDDLStrategySetting(schema.DDLStrategyOnline, ts.options)
+			allowed, err := isCutOverAllowed(setting, ts.postponeCompletion, ts.now)
+			require.NoError(t, err)
+			assert.Equal(t, ts.expectAllowed, allowed)
+		})
+	}
+}
+
+func TestValidateStrategySetting(t *testing.T) {
+	postponeCompletion, err := validateStrategySetting(schema.NewDDLStrategySetting(schema.DDLStrategyOnline, "-postpone-completion -cut-over-timeout=30s"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), postponeCompletion)
+
+	postponeCompletion, err = validateStrategySetting(schema.NewDDLStrategySetting(schema.DDLStrategyGhost, ""))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), postponeCompletion)
+
+	_, err = validateStrategySetting(schema.NewDDLStrategySetting(schema.DDLStrategyGhost, "-postpone-completion"))
+	assert.Error(t, err)
+
+	_, err = validateStrategySetting(schema.NewDDLStrategySetting(schema.DDLStrategyPTOSC, "-allow-concurrent"))
+	assert.Error(t, err)
+
+	_, err = validateStrategySetting(schema.NewDDLStrategySetting(schema.DDLStrategyOnline, "-cut-over-window=9-17"))
+	assert.Error(t, err)
+}
+
+func TestNextMigrationsToSchedule(t *testing.T) {
+	queued := func(uuid, table string, concurrent bool) *schedulableMigration {
+		return &schedulableMigration{uuid: uuid, table: table, status: schema.OnlineDDLStatusQueued, concurrent: concurrent}
+	}
+	running := func(uuid, table string, concurrent bool) *schedulableMigration {
+		return &schedulableMigration{uuid: uuid, table: table, status: schema.OnlineDDLStatusRunning, concurrent: concurrent}
+	}
+	notThrottled := func() bool { return false }
+	throttled := func() bool { return true }
+
+	tt := []struct {
+		name        string
+		pending     []*schedulableMigration
+		isThrottled func() bool
+		expect      []string
+	}{
+		{
+			name:        "empty",
+			isThrottled: notThrottled,
+		},
+		{
+			name:        "single exclusive",
+			pending:     []*schedulableMigration{queued("a", "t1", false), queued("b", "t2", false)},
+			isThrottled: notThrottled,
+			expect:      []string{"a"},
+		},
+		{
+			name:        "exclusive running",
+			pending:     []*schedulableMigration{running("a", "t1", false), queued("b", "t2", true)},
+			isThrottled: notThrottled,
+		},
+		{
+			name:        "concurrent on distinct tables",
+			pending:     []*schedulableMigration{running("a", "t1", true), queued("b", "t2", true), queued("c", "t3", true)},
+			isThrottled: notThrottled,
+			expect:      []string{"b", "c"},
+		},
+		{
+			name:        "concurrent on same table",
+			pending:     []*schedulableMigration{running("a", "t1", true), queued("b", "t1", true), queued("c", "t2", true), queued("d", "t2", true)},
+			isThrottled: notThrottled,
+			expect:      []string{"c"},
+		},
+		{
+			name:        "max concurrent",
+			pending:     []*schedulableMigration{queued("a", "t1", true), queued("b", "t2", true), queued("c", "t3", true), queued("d", "t4", true)},
+			isThrottled: notThrottled,
+			expect:      []string{"a", "b", "c"},
+		},
+		{
+			name:        "exclusive is a barrier",
+			pending:     []*schedulableMigration{running("a", "t1", true), queued("b", "t2", false), queued("c", "t3", true)},
+			isThrottled: notThrottled,
+		},
+		{
+			name:        "exclusive waits for concurrent scheduled before it",
+			pending:     []*schedulableMigration{queued("a", "t1", true), queued("b", "t2", false)},
+			isThrottled: notThrottled,
+			expect:      []string{"a"},
+		},
+		{
+			name:        "throttled",
+			pending:     []*schedulableMigration{queued("a", "t1", true), queued("b", "t2", true)},
+			isThrottled: throttled,
+			expect:      []string{"a"},
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			uuids := nextMigrationsToSchedule(ts.pending, 3, ts.isThrottled)
+			assert.Equal(t, ts.expect, uuids)
+		})
+	}
+}
+
+func TestIsConcurrentMigration(t *testing.T) {
+	assert.True(t, isConcurrentMigration(schema.NewDDLStrategySetting(schema.DDLStrategyOnline, "-allow-concurrent"), "alter"))
+	assert.False(t, isConcurrentMigration(schema.NewDDLStrategySetting(schema.DDLStrategyOnline, ""), "alter"))
+	assert.False(t, isConcurrentMigration(schema.NewDDLStrategySetting(schema.DDLStrategyOnline, "-allow-concurrent"), schema.RevertActionStr))
+	assert.False(t, isConcurrentMigration(schema.NewDDLStrategySetting(schema.DDLStrategyGhost, "-allow-concurrent"), "alter"))
+}
+
+// cutOverTestTMClient is a tablet manager client for cut-over tests, which
+// only implements the calls made by the cut-over.
+type cutOverTestTMClient struct {
+	tmclient.TabletManagerClient
+}
+
+func (client *cutOverTestTMClient) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return nil
+}
+
+func (client *cutOverTestTMClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	return &querypb.QueryResult{}, nil
+}
+
+func TestCutOverMigrationWithRetries(t *testing.T) {
+	tmclient.RegisterTabletManagerClientFactory(t.Name(), func() tmclient.TabletManagerClient {
+		return &cutOverTestTMClient{}
+	})
+	defer func(protocol string) {
+		*tmclient.TabletManagerProtocol = protocol
+	}(*tmclient.TabletManagerProtocol)
+	*tmclient.TabletManagerProtocol = t.Name()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "ks", "0"))
+	tabletAlias := &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}
+	require.NoError(t, ts.CreateTablet(ctx, &topodatapb.Tablet{
+		Alias:    tabletAlias,
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_MASTER,
+	}))
+
+	db := fakesqldb.New(t)
+	defer db.Close()
+	cfg := tabletenv.NewDefaultConfig()
+	params, _ := db.ConnParams().MysqlParams()
+	cp := *params
+	cfg.DB = dbconfigs.NewTestDBConfigs(cp, cp, "")
+	env := tabletenv.NewEnv(cfg, t.Name())
+	e := NewExecutor(env, tabletAlias, ts, func() topodatapb.TabletType { return topodatapb.TabletType_MASTER }, nil)
+	e.keyspace = "ks"
+	e.shard = "0"
+	e.pool.Open(cfg.DB.AppWithDB(), cfg.DB.DbaWithDB(), cfg.DB.AppDebugWithDB())
+	defer e.pool.Close()
+
+	uuid := "6ba19ec4_3cb5_11ec_8d3d_0242ac130003"
+	db.AddQueryPattern("select.*from _vt.schema_migrations.*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("migration_uuid|keyspace|mysql_table|strategy|options", "varchar|varchar|varchar|varchar|varchar"),
+		uuid+"|ks|t|online|-vreplication-test-suite",
+	))
+	db.AddQueryPattern("update _vt.schema_migrations.*", &sqltypes.Result{})
+	renameQuery := "RENAME TABLE `t` TO `t_before`"
+	db.AddQuery(renameQuery, &sqltypes.Result{})
+	restoreQuery := "RENAME TABLE `t_before` TO `t`"
+	db.AddQuery(restoreQuery, &sqltypes.Result{})
+
+	s := &VReplStream{
+		id:       1,
+		workflow: uuid,
+		bls: &binlogdatapb.BinlogSource{
+			Filter: &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "_vrepl_t"}}},
+		},
+	}
+	strategySetting := schema.NewDDLStrategySetting(schema.DDLStrategyOnline, "-vreplication-test-suite")
+
+	// The primary position can't be read, so cut-over attempts fail after the table is renamed. Each failed
+	// attempt renames the table back, and leaves the migration to be attempted again.
+	for attempt := 1; attempt <= 2; attempt++ {
+		err := e.cutOverMigrationWithRetries(ctx, s, strategySetting, int64(attempt-1))
+		require.NoError(t, err)
+		assert.Equal(t, attempt, db.GetQueryCalledNum(renameQuery))
+		assert.Equal(t, attempt, db.GetQueryCalledNum(restoreQuery))
+	}
+
+	// Writes are allowed again after each attempt.
+	si, err := ts.GetShard(ctx, "ks", "0")
+	require.NoError(t, err)
+	assert.Nil(t, si.GetTabletControl(topodatapb.TabletType_MASTER))
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+const (
+	// instantDDLSpecialPlan is recorded in schema_migrations.special_plan for migrations that run with ALGORITHM=INSTANT
+	instantDDLSpecialPlan = "instant-ddl"
+)
+
+var (
+	mysqlVersionRegexp = regexp.MustCompile(`^([0-9]+)[.]([0-9]+)[.]([0-9]+)`)
+	// instantDDLMinVersion is the first MySQL version to support ALGORITHM=INSTANT
+	instantDDLMinVersion = []int{8, 0, 12}
+	// instantDDLRejectedErrorCodes are the MySQL errors with which an ALTER TABLE ... ALGORITHM=INSTANT is refused
+	// by the server, e.g. because the table has reached the maximum number of row versions. These do not fail the
+	// migration, which then runs with vreplication instead.
+	instantDDLRejectedErrorCodes = []int{
+		mysql.ERTooBigRowSize,
+		1845, // ER_ALTER_OPERATION_NOT_SUPPORTED
+		1846, // ER_ALTER_OPERATION_NOT_SUPPORTED_REASON
+		4080, // ER_INNODB_MAX_ROW_VERSION
+		4092, // ER_INNODB_INSTANT_ADD_NOT_SUPPORTED_MAX_SIZE
+	}
+)
+
+// isInstantDDLRejected returns true when the given error is MySQL refusing to run an ALTER TABLE with ALGORITHM=INSTANT
+func isInstantDDLRejected(err error) bool {
+	merr, ok := err.(*mysql.SQLError)
+	if !ok {
+		return false
+	}
+	for _, code := range instantDDLRejectedErrorCodes {
+		if merr.Num == code {
+			return true
+		}
+	}
+	return false
+}
+
+// mysqlVersionAtLeast returns true when the given @@version is equal to or greater than the given major, minor and patch
+func mysqlVersionAtLeast(version string, atLeast []int) (bool, error) {
+	submatch := mysqlVersionRegexp.FindStringSubmatch(version)
+	if len(submatch) == 0 {
+		return false, fmt.Errorf("could not parse MySQL version: %s", version)
+	}
+	for i, expected := range atLeast {
+		actual, err := strconv.Atoi(submatch[i+1])
+		if err != nil {
+			return false, err
+		}
+		if actual != expected {
+			return actual > expected, nil
+		}
+	}
+	return true, nil
+}
+
+// isInstantDDLEligible checks whether an ALTER TABLE can run with ALGORITHM=INSTANT on the given table, based on
+// the table's CREATE TABLE statement. We only consider changes that MySQL 8.0 can apply instantly with no exceptions
+// to the rule:
+// - adding columns at the end of the table, unless they are STORED generated columns or AUTO_INCREMENT
+// - setting or dropping a column's default value
+// Tables with FULLTEXT indexes or with ROW_FORMAT=COMPRESSED do not support instant ADD COLUMN.
+func isInstantDDLEligible(createTable *sqlparser.CreateTable, alterTable *sqlparser.AlterTable) bool {
+	if !alterTable.FullyParsed || alterTable.PartitionSpec != nil || len(alterTable.AlterOptions) == 0 {
+		return false
+	}
+	addsColumns := false
+	for _, option := range alterTable.AlterOptions {
+		switch option := option.(type) {
+		case *sqlparser.AddColumns:
+			if option.First != nil || option.After != nil {
+				return false
+			}
+			for _, col := range option.Columns {
+				if col.Type.Options == nil {
+					continue
+				}
+				if col.Type.Options.Autoincrement {
+					return false
+				}
+				if col.Type.Options.As != nil && col.Type.Options.Storage == sqlparser.StoredStorage {
+					return false
+				}
+				if col.Type.Options.KeyOpt != sqlparser.ColKeyNone || col.Type.Options.Reference != nil {
+					// implicitly adds an index or a constraint
+					return false
+				}
+			}
+			addsColumns = true
+		case *sqlparser.AlterColumn:
+		default:
+			return false
+		}
+	}
+	if !addsColumns {
+		return true
+	}
+	if createTable.TableSpec == nil {
+		return false
+	}
+	for _, index := range createTable.TableSpec.Indexes {
+		if index.Info.Fulltext {
+			return false
+		}
+	}
+	for _, option := range createTable.TableSpec.Options {
+		if strings.EqualFold(option.Name, "ROW_FORMAT") && strings.EqualFold(option.String, "COMPRESSED") {
+			return false
+		}
+	}
+	return true
+}
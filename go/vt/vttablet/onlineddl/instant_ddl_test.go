@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestMySQLVersionAtLeast(t *testing.T) {
+	tt := []struct {
+		version string
+		atLeast bool
+		isError bool
+	}{
+		{version: "8.0.12", atLeast: true},
+		{version: "8.0.23-log", atLeast: true},
+		{version: "8.1.0", atLeast: true},
+		{version: "10.5.8-MariaDB", atLeast: true},
+		{version: "8.0.11", atLeast: false},
+		{version: "5.7.31-log", atLeast: false},
+		{version: "unknown", isError: true},
+	}
+	for _, ts := range tt {
+		t.Run(ts.version, func(t *testing.T) {
+			atLeast, err := mysqlVersionAtLeast(ts.version, instantDDLMinVersion)
+			if ts.isError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, ts.atLeast, atLeast)
+		})
+	}
+}
+
+func TestIsInstantDDLEligible(t *testing.T) {
+	tt := []struct {
+		create   string
+		alter    string
+		eligible bool
+	}{
+		{
+			create:   "create table t (id int primary key)",
+			alter:    "alter table t add column i int",
+			eligible: true,
+		},
+		{
+			create:   "create table t (id int primary key)",
+			alter:    "alter table t add column i int not null default 0, add column j varchar(32)",
+			eligible: true,
+		},
+		{
+			create:   "create table t (id int primary key, i int)",
+			alter:    "alter table t alter column i set default 7",
+			eligible: true,
+		},
+		{
+			create:   "create table t (id int primary key, i int)",
+			alter:    "alter table t add column v int as (i + 1) virtual",
+			eligible: true,
+		},
+		{
+			create: "create table t (id int primary key, i int)",
+			alter:  "alter table t add column v int as (i + 1) stored",
+		},
+		{
+			create: "create table t (id int primary key)",
+			alter:  "alter table t add column i int after id",
+		},
+		{
+			create: "create table t (id int primary key)",
+			alter:  "alter table t add column i int first",
+		},
+		{
+			create: "create table t (id int primary key)",
+			alter:  "alter table t add column i int unique key",
+		},
+		{
+			create: "create table t (id int primary key, i int)",
+			alter:  "alter table t add key i_idx (i)",
+		},
+		{
+			create: "create table t (id int primary key, i int)",
+			alter:  "alter table t modify column i bigint",
+		},
+		{
+			create: "create table t (id int primary key, i int)",
+			alter:  "alter table t engine=innodb",
+		},
+		{
+			create: "create table t (id int primary key, txt text, fulltext key txt_idx (txt))",
+			alter:  "alter table t add column i int",
+		},
+		{
+			create: "create table t (id int primary key) row_format=compressed",
+			alter:  "alter table t add column i int",
+		},
+		{
+			create:   "create table t (id int primary key, i int) row_format=compressed",
+			alter:    "alter table t alter column i drop default",
+			eligible: true,
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.alter, func(t *testing.T) {
+			createStmt, err := sqlparser.Parse(ts.create)
+			require.NoError(t, err)
+			createTable, ok := createStmt.(*sqlparser.CreateTable)
+			require.True(t, ok)
+
+			alterStmt, err := sqlparser.Parse(ts.alter)
+			require.NoError(t, err)
+			alterTable, ok := alterStmt.(*sqlparser.AlterTable)
+			require.True(t, ok)
+
+			assert.Equal(t, ts.eligible, isInstantDDLEligible(createTable, alterTable))
+		})
+	}
+}
+
+func TestIsInstantDDLRejected(t *testing.T) {
+	assert.True(t, isInstantDDLRejected(mysql.NewSQLError(1846, mysql.SSUnknownSQLState, "ALGORITHM=INSTANT is not supported. Reason: Need to rebuild the table. Try ALGORITHM=COPY/INPLACE.")))
+	assert.True(t, isInstantDDLRejected(mysql.NewSQLError(4080, mysql.SSUnknownSQLState, "Maximum row versions reached for table t")))
+	assert.False(t, isInstantDDLRejected(mysql.NewSQLError(mysql.ERDupFieldName, mysql.SSUnknownSQLState, "Duplicate column name 'i'")))
+	assert.False(t, isInstantDDLRejected(errors.New("connection refused")))
+	assert.False(t, isInstantDDLRejected(nil))
+}
@@ -0,0 +1,421 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/log"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var partitionSchedulesFlag = flag.String("online_ddl_partition_schedules", "", "Comma separated list of table:ahead:retain partition schedules. For each listed RANGE partitioned table, vttablet maintains 'ahead' future partitions and drops all but the last 'retain' expired partitions")
+var partitionManagementInterval = flag.Duration("online_ddl_partition_management_interval", time.Hour, "Interval between checks of the -online_ddl_partition_schedules tables")
+
+const (
+	// partitionManagementSpecialPlan is recorded in schema_migrations.special_plan for migrations that add or drop partitions
+	partitionManagementSpecialPlan = "partition-management"
+	// partitionManagementContext is the migration_context of migrations submitted by the partition scheduler
+	partitionManagementContext = "vttablet:partition-management"
+	rangePartitionMethod       = "RANGE"
+)
+
+// partitionSchedule declares how a RANGE partitioned table rotates its partitions
+type partitionSchedule struct {
+	table string
+	// ahead is the number of partitions to maintain beyond the partition which holds the current value
+	ahead int
+	// retain is the number of expired partitions to keep. Older partitions are dropped.
+	retain int
+}
+
+// rangePartition is a partition of a RANGE partitioned table, with an integral upper boundary
+type rangePartition struct {
+	name       string
+	boundary   int64
+	isMaxValue bool
+}
+
+// parsePartitionSchedules parses a comma separated list of table:ahead:retain entries
+func parsePartitionSchedules(schedules string) (parsed []*partitionSchedule, err error) {
+	for _, entry := range strings.Split(schedules, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		tokens := strings.Split(entry, ":")
+		if len(tokens) != 3 || tokens[0] == "" {
+			return nil, fmt.Errorf("invalid partition schedule: %s. Expected table:ahead:retain", entry)
+		}
+		ahead, err := strconv.Atoi(tokens[1])
+		if err != nil || ahead < 1 {
+			return nil, fmt.Errorf("invalid ahead value in partition schedule: %s. Expected a positive integer", entry)
+		}
+		retain, err := strconv.Atoi(tokens[2])
+		if err != nil || retain < 0 {
+			return nil, fmt.Errorf("invalid retain value in partition schedule: %s. Expected a non negative integer", entry)
+		}
+		parsed = append(parsed, &partitionSchedule{table: tokens[0], ahead: ahead, retain: retain})
+	}
+	return parsed, nil
+}
+
+// planPartitionRotation computes the partitions to add and to drop, so that the given partitions, which are sorted
+// by boundary, satisfy the schedule given the current value of the partitioning expression. The interval of
+// new partitions is that of the last two existing partitions.
+func planPartitionRotation(schedule *partitionSchedule, partitions []*rangePartition, currentValue int64) (add []*rangePartition, drop []string, err error) {
+	var bounded []*rangePartition
+	for _, partition := range partitions {
+		if !partition.isMaxValue {
+			bounded = append(bounded, partition)
+		}
+	}
+	existingNames := map[string]bool{}
+	var expired []*rangePartition
+	countCurrentAndAhead := 0
+	for _, partition := range partitions {
+		existingNames[partition.name] = true
+		switch {
+		case partition.isMaxValue:
+		case partition.boundary <= currentValue:
+			expired = append(expired, partition)
+		default:
+			countCurrentAndAhead++
+		}
+	}
+	if missing := schedule.ahead + 1 - countCurrentAndAhead; missing > 0 {
+		if len(bounded) != len(partitions) {
+			return nil, nil, fmt.Errorf("cannot add partitions to %s, which has a MAXVALUE partition", schedule.table)
+		}
+		if len(bounded) < 2 {
+			return nil, nil, fmt.Errorf("cannot infer partition interval of %s, which has less than two partitions", schedule.table)
+		}
+		last := bounded[len(bounded)-1]
+		interval := last.boundary - bounded[len(bounded)-2].boundary
+		if interval <= 0 {
+			return nil, nil, fmt.Errorf("cannot infer partition interval of %s", schedule.table)
+		}
+		boundary := last.boundary
+		if boundary <= currentValue {
+			// No rows exist beyond the last boundary, so we may skip over intervals which have already expired
+			boundary += (currentValue - boundary) / interval * interval
+		}
+		for missing > 0 {
+			boundary += interval
+			if boundary > currentValue {
+				missing--
+			}
+			name := fmt.Sprintf("p%d", boundary)
+			if existingNames[name] {
+				return nil, nil, fmt.Errorf("cannot add partition %s to %s: partition name already exists", name, schedule.table)
+			}
+			add = append(add, &rangePartition{name: name, boundary: boundary})
+		}
+	}
+	countDrop := len(expired) - schedule.retain
+	if countDrop > len(partitions)+len(add)-1 {
+		// MySQL does not allow dropping all partitions of a table
+		countDrop = len(partitions) + len(add) - 1
+	}
+	for i := 0; i < countDrop; i++ {
+		drop = append(drop, expired[i].name)
+	}
+	return add, drop, nil
+}
+
+// partitionManagementAlter returns the ALTER TABLE statement of a migration which exclusively adds
+// or drops partitions, or nil if the migration is not such
+func partitionManagementAlter(onlineDDL *schema.OnlineDDL) (*sqlparser.AlterTable, error) {
+	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+	if err != nil {
+		return nil, err
+	}
+	alterTable, ok := ddlStmt.(*sqlparser.AlterTable)
+	if !ok || !isPartitionManagementAlter(alterTable) {
+		return nil, nil
+	}
+	return alterTable, nil
+}
+
+// isPartitionManagementAlter returns true when an ALTER TABLE statement does nothing but ADD PARTITION or DROP PARTITION
+func isPartitionManagementAlter(alterTable *sqlparser.AlterTable) bool {
+	if alterTable.PartitionSpec == nil || len(alterTable.AlterOptions) > 0 || alterTable.PartitionOption != nil {
+		return false
+	}
+	switch alterTable.PartitionSpec.Action {
+	case sqlparser.AddAction, sqlparser.DropAction:
+		return true
+	}
+	return false
+}
+
+// executePartitionManagement runs an ADD PARTITION or DROP PARTITION migration. Adding a RANGE partition is a
+// metadata operation, and runs directly. Data of dropped partitions is first exchanged into GC tables, which
+// the table garbage collector purges in a throttled manner, and the then empty partitions are dropped.
+func (e *Executor) executePartitionManagement(ctx context.Context, onlineDDL *schema.OnlineDDL, alterTable *sqlparser.AlterTable) error {
+	if err := e.updateSpecialPlan(ctx, onlineDDL.UUID, partitionManagementSpecialPlan); err != nil {
+		return err
+	}
+	if alterTable.PartitionSpec.Action == sqlparser.DropAction {
+		if err := e.exchangeDroppedPartitions(ctx, onlineDDL, alterTable.PartitionSpec.Names); err != nil {
+			return err
+		}
+	}
+	alterTable.Comments = nil
+	onlineDDL.SQL = sqlparser.String(alterTable)
+	_, err := e.executeDirectly(ctx, onlineDDL)
+	return err
+}
+
+// exchangeDroppedPartitions moves the data of each given partition into a new table, named as a table in HOLD
+// state of the table GC lifecycle. Each such table is recorded as a migration artifact.
+func (e *Executor) exchangeDroppedPartitions(ctx context.Context, onlineDDL *schema.OnlineDDL, partitionNames sqlparser.Partitions) error {
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusRunning, false, progressPctStarted, etaSecondsUnknown, rowsCopiedUnknown)
+	for _, partitionName := range partitionNames {
+		artifactTableName, err := schema.GenerateGCTableName(schema.HoldTableGCState, newGCTableRetainTime())
+		if err != nil {
+			return err
+		}
+		if err := e.updateArtifacts(ctx, onlineDDL.UUID, artifactTableName); err != nil {
+			return err
+		}
+		queries := []string{
+			sqlparser.BuildParsedQuery(sqlCreateTableLike, artifactTableName, onlineDDL.Table).Query,
+			sqlparser.BuildParsedQuery(sqlAlterTableRemovePartitioning, artifactTableName).Query,
+			sqlparser.BuildParsedQuery(sqlAlterTableExchangePartition, onlineDDL.Table, partitionName.String(), artifactTableName).Query,
+		}
+		for _, query := range queries {
+			if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readRangePartitions reads the partitions of a RANGE partitioned table, along with the partitioning expression.
+// It returns an empty list if the table is not RANGE partitioned.
+func (e *Executor) readRangePartitions(ctx context.Context, tableName string) (partitions []*rangePartition, expression string, err error) {
+	query, err := sqlparser.ParseAndBind(sqlSelectTablePartitions,
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(tableName),
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	r, err := e.execQuery(ctx, query)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, row := range r.Named().Rows {
+		if row.AsString("partition_method", "") != rangePartitionMethod {
+			return nil, "", nil
+		}
+		expression = row.AsString("partition_expression", "")
+		partition := &rangePartition{name: row.AsString("partition_name", "")}
+		description := row.AsString("partition_description", "")
+		if strings.EqualFold(description, "MAXVALUE") {
+			partition.isMaxValue = true
+		} else if partition.boundary, err = strconv.ParseInt(description, 10, 64); err != nil {
+			return nil, "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "unsupported non integral boundary of partition %s in %s: %s", partition.name, tableName, description)
+		}
+		partitions = append(partitions, partition)
+	}
+	return partitions, expression, nil
+}
+
+// readCurrentPartitioningValue evaluates a partitioning expression as of now, by substituting the columns
+// in the expression with NOW()
+func (e *Executor) readCurrentPartitioningValue(ctx context.Context, expression string) (int64, error) {
+	stmt, err := sqlparser.Parse("select " + expression)
+	if err != nil {
+		return 0, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.SelectExprs) != 1 {
+		return 0, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "unsupported partitioning expression: %s", expression)
+	}
+	aliased, ok := sel.SelectExprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return 0, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "unsupported partitioning expression: %s", expression)
+	}
+	expr := sqlparser.Rewrite(aliased.Expr, func(cursor *sqlparser.Cursor) bool {
+		if _, ok := cursor.Node().(*sqlparser.ColName); ok {
+			cursor.Replace(&sqlparser.FuncExpr{Name: sqlparser.NewColIdent("now")})
+		}
+		return true
+	}, nil)
+	parsed := sqlparser.BuildParsedQuery(sqlSelectPartitioningValue, sqlparser.String(expr))
+	r, err := e.execQuery(ctx, parsed.Query)
+	if err != nil {
+		return 0, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "could not evaluate partitioning expression: %s", expression)
+	}
+	return row.ToInt64("current_value")
+}
+
+// countPendingPartitionManagementMigrations counts the partition scheduler's migrations on given table which are yet to complete
+func (e *Executor) countPendingPartitionManagementMigrations(ctx context.Context, tableName string) (int64, error) {
+	query, err := sqlparser.ParseAndBind(sqlSelectCountPendingMigrationsByContext,
+		sqltypes.StringBindVariable(partitionManagementContext),
+		sqltypes.StringBindVariable(tableName),
+	)
+	if err != nil {
+		return 0, err
+	}
+	r, err := e.execQuery(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return 0, nil
+	}
+	return row.ToInt64("count_pending")
+}
+
+// submitPartitionManagementMigration queues an online migration for given ALTER TABLE statement,
+// on behalf of the partition scheduler
+func (e *Executor) submitPartitionManagementMigration(ctx context.Context, alterTable *sqlparser.AlterTable) error {
+	tableName := alterTable.Table.Name.String()
+	onlineDDL, err := schema.NewOnlineDDL(e.keyspace, tableName, sqlparser.String(alterTable), schema.NewDDLStrategySetting(schema.DDLStrategyOnline, ""), partitionManagementContext)
+	if err != nil {
+		return err
+	}
+	query, err := sqlparser.ParseAndBind(sqlInsertMigration,
+		sqltypes.StringBindVariable(onlineDDL.UUID),
+		sqltypes.StringBindVariable(e.keyspace),
+		sqltypes.StringBindVariable(e.shard),
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(onlineDDL.Table),
+		sqltypes.StringBindVariable(onlineDDL.SQL),
+		sqltypes.StringBindVariable(string(onlineDDL.Strategy)),
+		sqltypes.StringBindVariable(onlineDDL.Options),
+		sqltypes.StringBindVariable(sqlparser.AlterStr),
+		sqltypes.StringBindVariable(onlineDDL.RequestContext),
+		sqltypes.StringBindVariable(string(schema.OnlineDDLStatusQueued)),
+		sqltypes.StringBindVariable(e.TabletAliasString()),
+		sqltypes.Int64BindVariable(0),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
+// managePartitionSchedule submits migrations which add and drop partitions of a single scheduled table
+func (e *Executor) managePartitionSchedule(ctx context.Context, schedule *partitionSchedule) error {
+	countPending, err := e.countPendingPartitionManagementMigrations(ctx, schedule.table)
+	if err != nil {
+		return err
+	}
+	if countPending > 0 {
+		// Previously submitted migrations have yet to run. We will revisit this table on the next check.
+		return nil
+	}
+	partitions, expression, err := e.readRangePartitions(ctx, schedule.table)
+	if err != nil {
+		return err
+	}
+	if len(partitions) == 0 {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "table %s is not RANGE partitioned", schedule.table)
+	}
+	currentValue, err := e.readCurrentPartitioningValue(ctx, expression)
+	if err != nil {
+		return err
+	}
+	add, drop, err := planPartitionRotation(schedule, partitions, currentValue)
+	if err != nil {
+		return err
+	}
+	table := sqlparser.TableName{Name: sqlparser.NewTableIdent(schedule.table)}
+	// ADD PARTITION only supports a single partition definition, hence a migration per new partition
+	for _, partition := range add {
+		alterTable := &sqlparser.AlterTable{
+			Table: table,
+			PartitionSpec: &sqlparser.PartitionSpec{
+				Action: sqlparser.AddAction,
+				Definitions: []*sqlparser.PartitionDefinition{{
+					Name:  sqlparser.NewColIdent(partition.name),
+					Limit: sqlparser.NewIntLiteral(strconv.FormatInt(partition.boundary, 10)),
+				}},
+			},
+		}
+		if err := e.submitPartitionManagementMigration(ctx, alterTable); err != nil {
+			return err
+		}
+	}
+	if len(drop) > 0 {
+		var names sqlparser.Partitions
+		for _, name := range drop {
+			names = append(names, sqlparser.NewColIdent(name))
+		}
+		alterTable := &sqlparser.AlterTable{
+			Table: table,
+			PartitionSpec: &sqlparser.PartitionSpec{
+				Action: sqlparser.DropAction,
+				Names:  names,
+			},
+		}
+		if err := e.submitPartitionManagementMigration(ctx, alterTable); err != nil {
+			return err
+		}
+	}
+	if len(add)+len(drop) > 0 {
+		log.Infof("Executor.managePartitionSchedule: %s: adding %d partitions, dropping %d partitions", schedule.table, len(add), len(drop))
+		e.triggerNextCheckInterval()
+	}
+	return nil
+}
+
+// managePartitions checks the tables listed in -online_ddl_partition_schedules, and submits migrations
+// to rotate their partitions where needed. It runs at most once per -online_ddl_partition_management_interval.
+func (e *Executor) managePartitions(ctx context.Context) {
+	if len(e.partitionSchedules) == 0 {
+		return
+	}
+	if time.Since(e.lastPartitionManagement) < *partitionManagementInterval {
+		return
+	}
+	e.lastPartitionManagement = time.Now()
+
+	for _, schedule := range e.partitionSchedules {
+		if err := e.managePartitionSchedule(ctx, schedule); err != nil {
+			log.Errorf("Executor.managePartitions: %s: %v", schedule.table, err)
+		}
+	}
+}
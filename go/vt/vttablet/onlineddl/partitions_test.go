@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestParsePartitionSchedules(t *testing.T) {
+	tt := []struct {
+		schedules string
+		expected  []*partitionSchedule
+		isError   bool
+	}{
+		{schedules: ""},
+		{
+			schedules: "events:7:30",
+			expected:  []*partitionSchedule{{table: "events", ahead: 7, retain: 30}},
+		},
+		{
+			schedules: "events:7:30, metrics:24:0",
+			expected: []*partitionSchedule{
+				{table: "events", ahead: 7, retain: 30},
+				{table: "metrics", ahead: 24, retain: 0},
+			},
+		},
+		{schedules: "events", isError: true},
+		{schedules: "events:7", isError: true},
+		{schedules: ":7:30", isError: true},
+		{schedules: "events:0:30", isError: true},
+		{schedules: "events:7:-1", isError: true},
+		{schedules: "events:a:30", isError: true},
+	}
+	for _, ts := range tt {
+		t.Run(ts.schedules, func(t *testing.T) {
+			schedules, err := parsePartitionSchedules(ts.schedules)
+			if ts.isError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, ts.expected, schedules)
+		})
+	}
+}
+
+func TestPlanPartitionRotation(t *testing.T) {
+	partitionsUpTo := func(boundaries ...int64) (partitions []*rangePartition) {
+		for _, boundary := range boundaries {
+			partitions = append(partitions, &rangePartition{name: fmt.Sprintf("p%d", len(partitions)), boundary: boundary})
+		}
+		return partitions
+	}
+	tt := []struct {
+		name         string
+		schedule     *partitionSchedule
+		partitions   []*rangePartition
+		currentValue int64
+		add          []int64
+		drop         []string
+		isError      bool
+	}{
+		{
+			name:         "up to date",
+			schedule:     &partitionSchedule{table: "t", ahead: 2, retain: 1},
+			partitions:   partitionsUpTo(10, 20, 30, 40),
+			currentValue: 15,
+		},
+		{
+			name:         "add ahead",
+			schedule:     &partitionSchedule{table: "t", ahead: 2, retain: 1},
+			partitions:   partitionsUpTo(10, 20, 30),
+			currentValue: 25,
+			add:          []int64{40, 50},
+			drop:         []string{"p0"},
+		},
+		{
+			name:         "drop expired",
+			schedule:     &partitionSchedule{table: "t", ahead: 1, retain: 1},
+			partitions:   partitionsUpTo(10, 20, 30, 40, 50),
+			currentValue: 35,
+			drop:         []string{"p0", "p1"},
+		},
+		{
+			name:         "retain none",
+			schedule:     &partitionSchedule{table: "t", ahead: 1, retain: 0},
+			partitions:   partitionsUpTo(10, 20, 30, 40, 50),
+			currentValue: 35,
+			drop:         []string{"p0", "p1", "p2"},
+		},
+		{
+			name:         "skip expired intervals",
+			schedule:     &partitionSchedule{table: "t", ahead: 1, retain: 0},
+			partitions:   partitionsUpTo(10, 20),
+			currentValue: 75,
+			add:          []int64{80, 90},
+			drop:         []string{"p0", "p1"},
+		},
+		{
+			name:         "current value on boundary",
+			schedule:     &partitionSchedule{table: "t", ahead: 1, retain: 5},
+			partitions:   partitionsUpTo(10, 20),
+			currentValue: 20,
+			add:          []int64{30, 40},
+		},
+		{
+			name:         "maxvalue partition",
+			schedule:     &partitionSchedule{table: "t", ahead: 2, retain: 1},
+			partitions:   append(partitionsUpTo(10, 20), &rangePartition{name: "pmax", isMaxValue: true}),
+			currentValue: 15,
+			isError:      true,
+		},
+		{
+			name:         "maxvalue partition, no additions needed",
+			schedule:     &partitionSchedule{table: "t", ahead: 1, retain: 0},
+			partitions:   append(partitionsUpTo(10, 20, 30), &rangePartition{name: "pmax", isMaxValue: true}),
+			currentValue: 15,
+			drop:         []string{"p0"},
+		},
+		{
+			name:         "single partition",
+			schedule:     &partitionSchedule{table: "t", ahead: 2, retain: 1},
+			partitions:   partitionsUpTo(10),
+			currentValue: 5,
+			isError:      true,
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			add, drop, err := planPartitionRotation(ts.schedule, ts.partitions, ts.currentValue)
+			if ts.isError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var addBoundaries []int64
+			for _, partition := range add {
+				addBoundaries = append(addBoundaries, partition.boundary)
+			}
+			assert.Equal(t, ts.add, addBoundaries)
+			assert.Equal(t, ts.drop, drop)
+		})
+	}
+}
+
+func TestIsPartitionManagementAlter(t *testing.T) {
+	tt := []struct {
+		alter    string
+		expected bool
+	}{
+		{alter: "alter table t add partition (partition p3 values less than (30))", expected: true},
+		{alter: "alter table t drop partition p1, p2", expected: true},
+		{alter: "alter table t add column i int"},
+		{alter: "alter table t truncate partition p1"},
+		{alter: "alter table t partition by range (id) (partition p1 values less than (10))"},
+	}
+	for _, ts := range tt {
+		t.Run(ts.alter, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(ts.alter)
+			require.NoError(t, err)
+			alterTable, ok := stmt.(*sqlparser.AlterTable)
+			require.True(t, ok)
+			assert.Equal(t, ts.expected, isPartitionManagementAlter(alterTable))
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// tableHasRows returns true when the given table exists and has at least one row
+func (e *Executor) tableHasRows(ctx context.Context, tableName string) (bool, error) {
+	exists, err := e.tableExists(ctx, tableName)
+	if err != nil || !exists {
+		return false, err
+	}
+	parsed := sqlparser.BuildParsedQuery(sqlSelectAnyRow, tableName)
+	rs, err := e.execQuery(ctx, parsed.Query)
+	if err != nil {
+		return false, err
+	}
+	return len(rs.Rows) > 0, nil
+}
+
+// tableIndexCount returns the number of indexes of the given table, which is zero if the table does not exist
+func (e *Executor) tableIndexCount(ctx context.Context, tableName string) (int, error) {
+	query, err := sqlparser.ParseAndBind(sqlSelectCountIndexes,
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(tableName),
+	)
+	if err != nil {
+		return 0, err
+	}
+	rs, err := e.execQuery(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	row := rs.Named().Row()
+	if row == nil {
+		return 0, nil
+	}
+	return int(row.AsInt64("num_indexes", 0)), nil
+}
+
+// checkSchemaPolicy evaluates the keyspace's schema policy, as found in the topo, on the statement of a migration.
+// Revert migrations are not evaluated, since they restore a schema which was already accepted.
+func (e *Executor) checkSchemaPolicy(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	ddlAction, err := onlineDDL.GetAction()
+	if err != nil {
+		return err
+	}
+	if ddlAction == sqlparser.RevertDDLAction {
+		return nil
+	}
+	policy, err := schema.ReadSchemaPolicy(ctx, e.ts, e.keyspace)
+	if err != nil {
+		return err
+	}
+	if policy.IsEmpty() {
+		return nil
+	}
+	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+	if err != nil {
+		return err
+	}
+	tableHasRows := func(tableName string) (bool, error) {
+		return e.tableHasRows(ctx, tableName)
+	}
+	tableIndexCount := func(tableName string) (int, error) {
+		return e.tableIndexCount(ctx, tableName)
+	}
+	return policy.Check(ddlStmt, tableHasRows, tableIndexCount)
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+const (
+	// etaSmoothingFactor is the weight given to the most recent rate of progress, over the accumulated rate
+	etaSmoothingFactor = 0.3
+)
+
+// etaEstimator smooths the rate of progress of a migration across consecutive reviews, and estimates
+// the time remaining until the migration completes its row copy
+type etaEstimator struct {
+	lastProgress float64
+	lastTime     time.Time
+	rate         float64 // percent per second
+	hasRate      bool
+}
+
+// estimate records given progress, and returns the estimated number of seconds to completion,
+// or etaSecondsUnknown when the rate of progress is yet unknown
+func (est *etaEstimator) estimate(progress float64, now time.Time) int64 {
+	if progress >= progressPctFull {
+		return etaSecondsNow
+	}
+	if est.lastTime.IsZero() {
+		est.lastProgress = progress
+		est.lastTime = now
+		return etaSecondsUnknown
+	}
+	if elapsed := now.Sub(est.lastTime).Seconds(); elapsed > 0 {
+		// progress may occasionally regress, since it is an estimate. We do not consider negative rates.
+		rate := math.Max(0, (progress-est.lastProgress)/elapsed)
+		if est.hasRate {
+			rate = etaSmoothingFactor*rate + (1-etaSmoothingFactor)*est.rate
+		}
+		est.rate = rate
+		est.hasRate = true
+		est.lastProgress = progress
+		est.lastTime = now
+	}
+	if est.rate <= 0 {
+		return etaSecondsUnknown
+	}
+	return int64(math.Ceil((progressPctFull - progress) / est.rate))
+}
+
+// progressByRowsCopied estimates progress based on number of rows copied vs. the estimated number of table rows
+func progressByRowsCopied(rowsCopied int64, tableRows int64) float64 {
+	if tableRows <= 0 {
+		return progressPctFull
+	}
+	return math.Min(progressPctFull, progressPctFull*float64(rowsCopied)/float64(tableRows))
+}
+
+// progressByRange estimates progress based on the position of a value within a [min, max] range
+func progressByRange(value, minValue, maxValue float64) float64 {
+	if maxValue <= minValue {
+		return progressPctFull
+	}
+	progress := progressPctFull * (value - minValue) / (maxValue - minValue)
+	return math.Max(progressPctStarted, math.Min(progressPctFull, progress))
+}
+
+// readCopyStateLastPK reads the last primary key copied by given vreplication stream. copyComplete is true
+// when the stream has no copy state, i.e. it has completed its row copy. lastPK is nil if copy has not begun.
+func (e *Executor) readCopyStateLastPK(ctx context.Context, vreplID int64) (lastPK *sqltypes.Result, copyComplete bool, err error) {
+	query, err := sqlparser.ParseAndBind(sqlReadCopyStateLastPK,
+		sqltypes.Int64BindVariable(vreplID),
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	r, err := e.execQuery(ctx, query)
+	if err != nil {
+		return nil, false, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return nil, true, nil
+	}
+	lastPKText := row.AsString("lastpk", "")
+	if lastPKText == "" {
+		return nil, false, nil
+	}
+	var qr querypb.QueryResult
+	if err := prototext.Unmarshal([]byte(lastPKText), &qr); err != nil {
+		return nil, false, err
+	}
+	lastPK = sqltypes.Proto3ToResult(&qr)
+	if len(lastPK.Fields) == 0 || len(lastPK.Rows) == 0 || len(lastPK.Rows[0]) == 0 {
+		return nil, false, nil
+	}
+	return lastPK, false, nil
+}
+
+// estimateProgressByIntegralPK estimates progress by placing the last copied value of an integral primary key column
+// within the MIN/MAX range of that column
+func (e *Executor) estimateProgressByIntegralPK(ctx context.Context, tableName string, column string, lastValue sqltypes.Value) (progress float64, ok bool, err error) {
+	parsed := sqlparser.BuildParsedQuery(sqlSelectColumnMinMax, column, column, tableName)
+	r, err := e.execQuery(ctx, parsed.Query)
+	if err != nil {
+		return 0, false, err
+	}
+	row := r.Named().Row()
+	if row == nil || row["min_value"].IsNull() || row["max_value"].IsNull() {
+		return 0, false, nil
+	}
+	value, err := strconv.ParseFloat(lastValue.ToString(), 64)
+	if err != nil {
+		return 0, false, nil
+	}
+	minValue, err := strconv.ParseFloat(row["min_value"].ToString(), 64)
+	if err != nil {
+		return 0, false, nil
+	}
+	maxValue, err := strconv.ParseFloat(row["max_value"].ToString(), 64)
+	if err != nil {
+		return 0, false, nil
+	}
+	return progressByRange(value, minValue, maxValue), true, nil
+}
+
+// estimateProgressByIndexStats estimates progress of a non-integral primary key, by asking the optimizer, which uses
+// index statistics, how many rows are found up to the last copied value, as compared with the total table rows
+func (e *Executor) estimateProgressByIndexStats(ctx context.Context, tableName string, column string, lastValue sqltypes.Value, tableRows int64) (progress float64, ok bool, err error) {
+	if tableRows <= 0 {
+		return 0, false, nil
+	}
+	parsed := sqlparser.BuildParsedQuery(sqlExplainCountRowsUpTo, tableName, column, ":last_value")
+	bindVars := map[string]*querypb.BindVariable{
+		"last_value": sqltypes.ValueBindVariable(lastValue),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	r, err := e.execQuery(ctx, bound)
+	if err != nil {
+		return 0, false, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return 0, false, nil
+	}
+	estimatedRows := row.AsInt64("rows", -1)
+	if estimatedRows < 0 {
+		return 0, false, nil
+	}
+	return progressByRowsCopied(estimatedRows, tableRows), true, nil
+}
+
+// estimateVReplCopyProgress estimates the row copy progress of a vreplication migration, in percent.
+// The estimate is based on the last primary key value copied, as found in _vt.copy_state, and compared with
+// the range of values in the source table. We fall back to comparing rows copied with the estimated table rows.
+func (e *Executor) estimateVReplCopyProgress(ctx context.Context, s *VReplStream, tableName string, tableRows int64) (progress float64, copyComplete bool, err error) {
+	lastPK, copyComplete, err := e.readCopyStateLastPK(ctx, s.id)
+	if err != nil {
+		return 0, false, err
+	}
+	if copyComplete {
+		return progressPctFull, true, nil
+	}
+	if lastPK == nil {
+		// copy has not yet begun
+		return progressPctStarted, false, nil
+	}
+	column := lastPK.Fields[0].Name
+	lastValue := lastPK.Rows[0][0]
+	var ok bool
+	if lastValue.IsIntegral() {
+		progress, ok, err = e.estimateProgressByIntegralPK(ctx, tableName, column, lastValue)
+	} else {
+		progress, ok, err = e.estimateProgressByIndexStats(ctx, tableName, column, lastValue, tableRows)
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if !ok {
+		progress = progressByRowsCopied(s.rowsCopied, tableRows)
+	}
+	// We're still copying, so there's some way to go
+	return math.Min(progress, progressPctFull-1), false, nil
+}
+
+// updateVReplMigrationProgress estimates and records the progress and ETA of a running vreplication migration.
+// While copying rows, ETA is derived from the smoothed rate of progress. Once row copy is complete,
+// what remains is the binlog backlog, which we estimate by the vreplication lag.
+func (e *Executor) updateVReplMigrationProgress(ctx context.Context, uuid string, s *VReplStream, tableName string, tableRows int64) error {
+	progress, copyComplete, err := e.estimateVReplCopyProgress(ctx, s, tableName, tableRows)
+	if err != nil {
+		return err
+	}
+	var etaSeconds int64
+	if copyComplete {
+		etaSeconds = etaSecondsNow
+		if lag := s.lag(); lag > vreplicationCutOverThreshold {
+			etaSeconds = int64(lag.Seconds())
+		}
+	} else {
+		estimator, ok := e.etaEstimators[uuid]
+		if !ok {
+			estimator = &etaEstimator{}
+			e.etaEstimators[uuid] = estimator
+		}
+		etaSeconds = estimator.estimate(progress, time.Now())
+	}
+	e.migrationProgress.Set(uuid, int64(progress))
+	e.migrationETASeconds.Set(uuid, etaSeconds)
+	e.migrationLagSeconds.Set(uuid, int64(s.lag().Seconds()))
+
+	if err := e.updateMigrationProgress(ctx, uuid, progress); err != nil {
+		return err
+	}
+	return e.updateMigrationETASeconds(ctx, uuid, etaSeconds)
+}
+
+// forgetMigrationsProgress clears progress estimation and metrics of migrations which are no longer running
+func (e *Executor) forgetMigrationsProgress(running map[string]bool) {
+	for uuid := range e.etaEstimators {
+		if !running[uuid] {
+			delete(e.etaEstimators, uuid)
+		}
+	}
+	for uuid := range e.migrationProgress.Counts() {
+		if !running[uuid] {
+			e.migrationProgress.Reset(uuid)
+			e.migrationETASeconds.Reset(uuid)
+			e.migrationLagSeconds.Reset(uuid)
+		}
+	}
+}
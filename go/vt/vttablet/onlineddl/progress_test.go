@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressByRange(t *testing.T) {
+	assert.Equal(t, 0.0, progressByRange(1, 1, 101))
+	assert.Equal(t, 50.0, progressByRange(51, 1, 101))
+	assert.Equal(t, 100.0, progressByRange(101, 1, 101))
+	assert.Equal(t, 100.0, progressByRange(200, 1, 101))
+	assert.Equal(t, 0.0, progressByRange(-5, 1, 101))
+	assert.Equal(t, 100.0, progressByRange(7, 7, 7))
+}
+
+func TestProgressByRowsCopied(t *testing.T) {
+	assert.Equal(t, 25.0, progressByRowsCopied(250, 1000))
+	assert.Equal(t, 100.0, progressByRowsCopied(1200, 1000))
+	assert.Equal(t, 100.0, progressByRowsCopied(10, 0))
+}
+
+func TestETAEstimator(t *testing.T) {
+	now := time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC)
+	est := &etaEstimator{}
+
+	// first sample: no rate yet
+	assert.Equal(t, int64(etaSecondsUnknown), est.estimate(10, now))
+
+	// 10% in 10 seconds: 1% per second, 80% to go
+	now = now.Add(10 * time.Second)
+	assert.Equal(t, int64(80), est.estimate(20, now))
+
+	// no progress in 10 seconds: smoothed rate drops to 0.7% per second
+	now = now.Add(10 * time.Second)
+	assert.Equal(t, int64(115), est.estimate(20, now))
+
+	// regression is not considered a negative rate: smoothed rate drops to 0.49% per second
+	now = now.Add(10 * time.Second)
+	assert.Equal(t, int64(166), est.estimate(19, now))
+
+	assert.Equal(t, int64(etaSecondsNow), est.estimate(100, now))
+
+	stalled := &etaEstimator{}
+	stalled.estimate(0, now)
+	assert.Equal(t, int64(etaSecondsUnknown), stalled.estimate(0, now.Add(time.Minute)))
+}
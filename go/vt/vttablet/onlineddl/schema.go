@@ -58,6 +58,11 @@ const (
 	alterSchemaMigrationsTableETASeconds         = "ALTER TABLE _vt.schema_migrations add column eta_seconds bigint NOT NULL DEFAULT -1"
 	alterSchemaMigrationsTableRowsCopied         = "ALTER TABLE _vt.schema_migrations add column rows_copied bigint unsigned NOT NULL DEFAULT 0"
 	alterSchemaMigrationsTableTableRows          = "ALTER TABLE _vt.schema_migrations add column table_rows bigint NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableSpecialPlan        = "ALTER TABLE _vt.schema_migrations add column special_plan text NOT NULL"
+	alterSchemaMigrationsTablePostponeCompletion = "ALTER TABLE _vt.schema_migrations add column postpone_completion tinyint unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableReadyToComplete    = "ALTER TABLE _vt.schema_migrations add column ready_to_complete tinyint unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableCutOverAttempts    = "ALTER TABLE _vt.schema_migrations add column cutover_attempts int unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableVReplLagSeconds    = "ALTER TABLE _vt.schema_migrations add column vreplication_lag_seconds bigint unsigned NOT NULL DEFAULT 0"
 
 	sqlInsertMigration = `INSERT IGNORE INTO _vt.schema_migrations (
 		migration_uuid,
@@ -72,20 +77,19 @@ const (
 		requested_timestamp,
 		migration_context,
 		migration_status,
-		tablet
+		tablet,
+		postpone_completion
 	) VALUES (
-		%a, %a, %a, %a, %a, %a, %a, %a, %a, FROM_UNIXTIME(NOW()), %a, %a, %a
+		%a, %a, %a, %a, %a, %a, %a, %a, %a, FROM_UNIXTIME(NOW()), %a, %a, %a, %a
 	)`
 
-	sqlScheduleSingleMigration = `UPDATE _vt.schema_migrations
+	sqlScheduleMigration = `UPDATE _vt.schema_migrations
 		SET
 			migration_status='ready',
 			ready_timestamp=NOW()
 		WHERE
 			migration_status='queued'
-		ORDER BY
-			requested_timestamp ASC
-		LIMIT 1
+			AND migration_uuid=%a
 	`
 	sqlUpdateMySQLTable = `UPDATE _vt.schema_migrations
 			SET mysql_table=%a
@@ -152,29 +156,34 @@ const (
 		WHERE
 			migration_uuid=%a
 	`
-	sqlUpdateMigrationTableRows = `UPDATE _vt.schema_migrations
-			SET table_rows=%a
+	sqlUpdateSpecialPlan = `UPDATE _vt.schema_migrations
+			SET special_plan=%a
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationReadyToComplete = `UPDATE _vt.schema_migrations
+			SET ready_to_complete=%a
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationVReplLagSeconds = `UPDATE _vt.schema_migrations
+			SET vreplication_lag_seconds=%a
 		WHERE
 			migration_uuid=%a
 	`
-	sqlUpdateMigrationProgressByRowsCopied = `UPDATE _vt.schema_migrations
-			SET
-				progress=CASE
-					WHEN table_rows=0 THEN 100
-					ELSE LEAST(100, 100*%a/table_rows)
-				END
+	sqlIncrementCutOverAttempts = `UPDATE _vt.schema_migrations
+			SET cutover_attempts=cutover_attempts+1
 		WHERE
 			migration_uuid=%a
 	`
-	sqlUpdateMigrationETASecondsByProgress = `UPDATE _vt.schema_migrations
-			SET
-				eta_seconds=CASE
-					WHEN progress=0 THEN -1
-					WHEN table_rows=0 THEN 0
-					ELSE GREATEST(0,
-						TIMESTAMPDIFF(SECOND, started_timestamp, NOW())*((100/progress)-1)
-					)
-				END
+	sqlClearPostponeCompletion = `UPDATE _vt.schema_migrations
+			SET postpone_completion=0
+		WHERE
+			migration_uuid=%a
+			AND postpone_completion != 0
+	`
+	sqlUpdateMigrationTableRows = `UPDATE _vt.schema_migrations
+			SET table_rows=%a
 		WHERE
 			migration_uuid=%a
 	`
@@ -188,7 +197,9 @@ const (
 			started_timestamp=NULL,
 			liveness_timestamp=NULL,
 			completed_timestamp=NULL,
-			cleanup_timestamp=NULL
+			cleanup_timestamp=NULL,
+			ready_to_complete=0,
+			cutover_attempts=0
 		WHERE
 			migration_status IN ('failed', 'cancelled')
 			AND (%s)
@@ -204,7 +215,9 @@ const (
 			started_timestamp=NULL,
 			liveness_timestamp=NULL,
 			completed_timestamp=NULL,
-			cleanup_timestamp=NULL
+			cleanup_timestamp=NULL,
+			ready_to_complete=0,
+			cutover_attempts=0
 		WHERE
 			migration_status IN ('failed', 'cancelled')
 			AND migration_uuid=%a
@@ -216,8 +229,12 @@ const (
 	`
 	sqlSelectRunningMigrations = `SELECT
 			migration_uuid,
+			mysql_table,
 			strategy,
 			options,
+			table_rows,
+			postpone_completion,
+			cutover_attempts,
 			timestampdiff(second, started_timestamp, now()) as elapsed_seconds
 		FROM _vt.schema_migrations
 		WHERE
@@ -235,11 +252,19 @@ const (
 			completed_timestamp DESC
 		LIMIT 1
 	`
-	sqlSelectCountReadyMigrations = `SELECT
-			count(*) as count_ready
+	sqlSelectSchedulableMigrations = `SELECT
+			migration_uuid,
+			mysql_table,
+			strategy,
+			options,
+			ddl_action,
+			migration_status
 		FROM _vt.schema_migrations
 		WHERE
-			migration_status='ready'
+			migration_status IN ('queued', 'ready', 'running')
+		ORDER BY
+			requested_timestamp ASC,
+			id ASC
 	`
 	sqlSelectStaleMigrations = `SELECT
 			migration_uuid
@@ -254,6 +279,14 @@ const (
 		WHERE
 			migration_status IN ('queued', 'ready', 'running')
 	`
+	sqlSelectCountPendingMigrationsByContext = `SELECT
+			COUNT(*) AS count_pending
+		FROM _vt.schema_migrations
+		WHERE
+			migration_context=%a
+			AND mysql_table=%a
+			AND migration_status IN ('queued', 'ready', 'running')
+	`
 	sqlSelectUncollectedArtifacts = `SELECT
 			migration_uuid,
 			artifacts
@@ -284,12 +317,13 @@ const (
 			ddl_action,
 			artifacts,
 			tablet,
-			migration_context
+			migration_context,
+			special_plan
 		FROM _vt.schema_migrations
 		WHERE
 			migration_uuid=%a
 	`
-	sqlSelectReadyMigration = `SELECT
+	sqlSelectReadyMigrations = `SELECT
 			id,
 			migration_uuid,
 			keyspace,
@@ -310,11 +344,14 @@ const (
 			ddl_action,
 			artifacts,
 			tablet,
-			migration_context
+			migration_context,
+			special_plan
 		FROM _vt.schema_migrations
 		WHERE
 			migration_status='ready'
-		LIMIT 1
+		ORDER BY
+			ready_timestamp ASC,
+			id ASC
 	`
 	sqlSelectPTOSCMigrationTriggers = `SELECT
 			TRIGGER_SCHEMA as trigger_schema,
@@ -351,6 +388,13 @@ const (
 			TABLE_SCHEMA=%a AND TABLE_NAME=%a
 			AND REFERENCED_TABLE_NAME IS NOT NULL
 		`
+	sqlSelectCountIndexes = `
+		SELECT
+			COUNT(DISTINCT INDEX_NAME) AS num_indexes
+		FROM INFORMATION_SCHEMA.STATISTICS
+		WHERE
+			TABLE_SCHEMA=%a AND TABLE_NAME=%a
+		`
 	sqlSelectUniqueKeys = `
 	SELECT
 		COLUMNS.TABLE_SCHEMA as table_schema,
@@ -409,14 +453,18 @@ const (
 		END,
 		COUNT_COLUMN_IN_INDEX
 	`
-	sqlDropTrigger       = "DROP TRIGGER IF EXISTS `%a`.`%a`"
-	sqlShowTablesLike    = "SHOW TABLES LIKE '%a'"
-	sqlCreateTableLike   = "CREATE TABLE `%a` LIKE `%a`"
-	sqlDropTable         = "DROP TABLE `%a`"
-	sqlAlterTableOptions = "ALTER TABLE `%a` %s"
-	sqlShowColumnsFrom   = "SHOW COLUMNS FROM `%a`"
-	sqlShowTableStatus   = "SHOW TABLE STATUS LIKE '%a'"
-	sqlGetAutoIncrement  = `
+	sqlDropTrigger        = "DROP TRIGGER IF EXISTS `%a`.`%a`"
+	sqlShowTablesLike     = "SHOW TABLES LIKE '%a'"
+	sqlShowFullTablesLike = "SHOW FULL TABLES LIKE '%a'"
+	sqlCreateTableLike    = "CREATE TABLE `%a` LIKE `%a`"
+	sqlDropTable          = "DROP TABLE `%a`"
+	sqlDropView           = "DROP VIEW IF EXISTS `%a`"
+	sqlAlterTableOptions  = "ALTER TABLE `%a` %s"
+	sqlShowColumnsFrom    = "SHOW COLUMNS FROM `%a`"
+	sqlShowTableStatus    = "SHOW TABLE STATUS LIKE '%a'"
+	sqlShowCreateTable    = "SHOW CREATE TABLE `%a`"
+	sqlShowCreateView     = "SHOW CREATE VIEW `%a`"
+	sqlGetAutoIncrement   = `
 		SELECT
 			AUTO_INCREMENT
 		FROM INFORMATION_SCHEMA.TABLES
@@ -443,18 +491,45 @@ const (
 		WHERE
 			workflow=%a
 		`
-	sqlReadCountCopyState = `SELECT
+	sqlReadCopyStateLastPK = `SELECT
+			lastpk
+		FROM
+			_vt.copy_state
+		WHERE vrepl_id=%a
+		LIMIT 1
+		`
+	sqlSelectColumnMinMax   = "SELECT MIN(`%s`) AS min_value, MAX(`%s`) AS max_value FROM `%s`"
+	sqlExplainCountRowsUpTo = "EXPLAIN SELECT 1 FROM `%s` WHERE `%s` <= %a"
+	sqlSelectAnyRow         = "SELECT 1 FROM `%a` LIMIT 1"
+	sqlReadCountCopyState   = `SELECT
 			count(*) as cnt
 		FROM
 			_vt.copy_state
 		WHERE vrepl_id=%a
 		`
-	sqlSwapTables  = "RENAME TABLE `%a` TO `%a`, `%a` TO `%a`, `%a` TO `%a`"
-	sqlRenameTable = "RENAME TABLE `%a` TO `%a`"
+	sqlSelectTablePartitions = `SELECT
+			PARTITION_NAME AS partition_name,
+			PARTITION_METHOD AS partition_method,
+			PARTITION_EXPRESSION AS partition_expression,
+			PARTITION_DESCRIPTION AS partition_description
+		FROM INFORMATION_SCHEMA.PARTITIONS
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+			AND PARTITION_NAME IS NOT NULL
+		ORDER BY PARTITION_ORDINAL_POSITION
+		`
+	sqlSelectPartitioningValue      = "SELECT %s AS current_value"
+	sqlAlterTableRemovePartitioning = "ALTER TABLE `%a` REMOVE PARTITIONING"
+	sqlAlterTableExchangePartition  = "ALTER TABLE `%a` EXCHANGE PARTITION `%a` WITH TABLE `%a`"
+	sqlSwapTables                   = "RENAME TABLE `%a` TO `%a`, `%a` TO `%a`, `%a` TO `%a`"
+	sqlSwapViews                    = "RENAME TABLE `%a` TO `%a`, `%a` TO `%a`"
+	sqlRenameTable                  = "RENAME TABLE `%a` TO `%a`"
 )
 
 const (
 	retryMigrationHint     = "retry"
+	completeMigrationHint  = "complete"
 	cancelMigrationHint    = "cancel"
 	cancelAllMigrationHint = "cancel-all"
 )
@@ -491,4 +566,9 @@ var ApplyDDL = []string{
 	alterSchemaMigrationsTableETASeconds,
 	alterSchemaMigrationsTableRowsCopied,
 	alterSchemaMigrationsTableTableRows,
+	alterSchemaMigrationsTableSpecialPlan,
+	alterSchemaMigrationsTablePostponeCompletion,
+	alterSchemaMigrationsTableReadyToComplete,
+	alterSchemaMigrationsTableCutOverAttempts,
+	alterSchemaMigrationsTableVReplLagSeconds,
 }
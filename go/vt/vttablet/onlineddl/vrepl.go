@@ -29,6 +29,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
@@ -58,6 +59,15 @@ type VReplStream struct {
 	bls                  *binlogdatapb.BinlogSource
 }
 
+// lag returns how far behind this stream's last update is, compared with the time now
+func (s *VReplStream) lag() time.Duration {
+	lag := time.Since(time.Unix(s.timeUpdated, 0))
+	if lag < 0 {
+		lag = -lag
+	}
+	return lag
+}
+
 // VRepl is an online DDL helper for VReplication based migrations (ddl_strategy="online")
 type VRepl struct {
 	workflow     string
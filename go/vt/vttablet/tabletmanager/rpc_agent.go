@@ -44,6 +44,8 @@ type RPCTM interface {
 
 	GetPermissions(ctx context.Context) (*tabletmanagerdatapb.Permissions, error)
 
+	GetSchemaVersions(ctx context.Context, req *tabletmanagerdatapb.GetSchemaVersionsRequest) ([]*tabletmanagerdatapb.SchemaVersion, error)
+
 	// Various read-write methods
 
 	SetReadOnly(ctx context.Context, rdonly bool) error
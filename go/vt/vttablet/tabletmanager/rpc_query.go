@@ -18,14 +18,65 @@ package tabletmanager
 
 import (
 	"context"
+	"strings"
 
 	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/audit"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
+// auditedStatements are the audited statements of a query that a
+// tabletmanager RPC executes outside of the query service.
+type auditedStatements []*audit.Statement
+
+// auditStatements begins the audit of the statements of sql. It fails if
+// they are audited and the audit log can't be written.
+func (tm *TabletManager) auditStatements(ctx context.Context, sql string) (auditedStatements, error) {
+	if tm.QueryServiceControl == nil || tm.QueryServiceControl.AuditLogger() == nil {
+		return nil, nil
+	}
+	pieces, err := sqlparser.SplitStatementToPieces(sql)
+	if err != nil {
+		pieces = []string{sql}
+	}
+	tablet := tm.Tablet()
+	var statements auditedStatements
+	for _, piece := range pieces {
+		record := &audit.Record{
+			Keyspace:        tablet.Keyspace,
+			Shard:           tablet.Shard,
+			SQL:             strings.TrimSpace(piece),
+			Source:          audit.SourceTabletManager,
+			EffectiveCaller: callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx)),
+			ImmediateCaller: callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx)),
+		}
+		if ci, ok := callinfo.FromContext(ctx); ok {
+			record.ClientAddr = ci.RemoteAddr()
+		}
+		statement, err := tm.QueryServiceControl.AuditLogger().BeginStatement(record)
+		if err != nil {
+			return nil, err
+		}
+		if statement != nil {
+			statements = append(statements, statement)
+		}
+	}
+	return statements, nil
+}
+
+// end records the execution of the statements.
+func (as auditedStatements) end(result *sqltypes.Result, err error) {
+	for _, statement := range as {
+		statement.End(result, err)
+	}
+}
+
 // ExecuteFetchAsDba will execute the given query, possibly disabling binlogs and reload schema.
 func (tm *TabletManager) ExecuteFetchAsDba(ctx context.Context, query []byte, dbName string, maxrows int, disableBinlogs bool, reloadSchema bool) (*querypb.QueryResult, error) {
 	// get a connection
@@ -50,7 +101,12 @@ func (tm *TabletManager) ExecuteFetchAsDba(ctx context.Context, query []byte, db
 	}
 
 	// run the query
+	statements, err := tm.auditStatements(ctx, string(query))
+	if err != nil {
+		return nil, err
+	}
 	result, err := conn.ExecuteFetch(string(query), maxrows, true /*wantFields*/)
+	statements.end(result, err)
 
 	// re-enable binlogs if necessary
 	if disableBinlogs && !conn.IsClosed() {
@@ -87,7 +143,12 @@ func (tm *TabletManager) ExecuteFetchAsAllPrivs(ctx context.Context, query []byt
 	}
 
 	// run the query
+	statements, err := tm.auditStatements(ctx, string(query))
+	if err != nil {
+		return nil, err
+	}
 	result, err := conn.ExecuteFetch(string(query), maxrows, true /*wantFields*/)
+	statements.end(result, err)
 
 	if err == nil && reloadSchema {
 		reloadErr := tm.QueryServiceControl.ReloadSchema(ctx)
@@ -106,7 +167,12 @@ func (tm *TabletManager) ExecuteFetchAsApp(ctx context.Context, query []byte, ma
 		return nil, err
 	}
 	defer conn.Recycle()
+	statements, err := tm.auditStatements(ctx, string(query))
+	if err != nil {
+		return nil, err
+	}
 	result, err := conn.ExecuteFetch(string(query), maxrows, true /*wantFields*/)
+	statements.end(result, err)
 	return sqltypes.ResultToProto3(result), err
 }
 
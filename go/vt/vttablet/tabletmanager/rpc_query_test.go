@@ -17,7 +17,12 @@ limitations under the License.
 package tabletmanager
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
 	"testing"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -27,9 +32,14 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/audit"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/vttablet/tabletservermock"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 func TestTabletManager_ExecuteFetchAsDba(t *testing.T) {
@@ -50,3 +60,46 @@ func TestTabletManager_ExecuteFetchAsDba(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "use ` escap``e me `;select 42", db.QueryLog())
 }
+
+func TestTabletManager_ExecuteFetchAsDbaAudit(t *testing.T) {
+	ctx := context.Background()
+	cp := mysql.ConnParams{}
+	db := fakesqldb.New(t)
+	db.AddQueryPattern(".*", &sqltypes.Result{RowsAffected: 1})
+	daemon := fakemysqldaemon.NewFakeMysqlDaemon(db)
+
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	config := tabletenv.NewDefaultConfig()
+	config.Audit.File = path.Join(dir, "audit.jsonl")
+	auditor := audit.NewLogger(tabletenv.NewEnv(config, "AuditTest"))
+	require.NoError(t, auditor.Open())
+
+	qsc := tabletservermock.NewController()
+	qsc.Auditor = auditor
+	tm := &TabletManager{
+		BatchCtx:            ctx,
+		MysqlDaemon:         daemon,
+		DBConfigs:           dbconfigs.NewTestDBConfigs(cp, cp, "db"),
+		QueryServiceControl: qsc,
+	}
+	tm.tmState = newTMState(tm, &topodatapb.Tablet{Keyspace: "ks", Shard: "0"})
+
+	_, err = tm.ExecuteFetchAsDba(ctx, []byte("select 42; delete from t1 where id = 1"), "db", 10, false, false)
+	require.NoError(t, err)
+	auditor.Close()
+
+	data, err := ioutil.ReadFile(config.Audit.File)
+	require.NoError(t, err)
+	n, err := audit.Verify(bytes.NewBuffer(data))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+	record := &audit.Record{}
+	require.NoError(t, json.Unmarshal(data, record))
+	assert.Equal(t, tabletenv.AuditClassDML, record.Class)
+	assert.Equal(t, "delete from t1 where id = 1", record.SQL)
+	assert.Equal(t, []string{"t1"}, record.Tables)
+	assert.Equal(t, "ks", record.Keyspace)
+	assert.Equal(t, audit.SourceTabletManager, record.Source)
+}
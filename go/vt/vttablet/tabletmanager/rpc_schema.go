@@ -83,7 +83,12 @@ func (tm *TabletManager) ApplySchema(ctx context.Context, change *tmutils.Schema
 	dbName := topoproto.TabletDbName(tm.Tablet())
 
 	// apply the change
+	statements, err := tm.auditStatements(ctx, change.SQL)
+	if err != nil {
+		return nil, err
+	}
 	scr, err := tm.MysqlDaemon.ApplySchemaChange(ctx, dbName, change)
+	statements.end(nil, err)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+const (
+	sqlSchemaVersionColumns              = "id, pos, ddl, time_updated"
+	sqlSelectSchemaVersionsByID          = "select %s from _vt.schema_version where id in (%s) order by id asc"
+	sqlSelectSchemaVersionsAfter         = "select %s from _vt.schema_version where id > %d order by id asc limit %d"
+	sqlSelectSchemaVersionAtTime         = "select %s from _vt.schema_version where time_updated <= %d order by id desc limit 1"
+	sqlSelectSchemaVersionPositionsBelow = "select id, pos from _vt.schema_version where id < %d order by id desc limit %d"
+)
+
+// schemaVersionsPageSize is the number of _vt.schema_version rows read per query when scanning the table
+var schemaVersionsPageSize = 1000
+
+// GetSchemaVersions returns the schema versions tracked in _vt.schema_version. Only the rows the request
+// selects are read: the version at a position or time, the versions with the given ids, or else all versions.
+func (tm *TabletManager) GetSchemaVersions(ctx context.Context, req *tabletmanagerdatapb.GetSchemaVersionsRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	columns := sqlSchemaVersionColumns
+	if req.IncludeSchema {
+		columns += ", schemax"
+	}
+	switch {
+	case req.Position != "":
+		id, err := tm.schemaVersionIDAtPosition(ctx, req.Position)
+		if err != nil || id == 0 {
+			return nil, err
+		}
+		return tm.fetchSchemaVersions(ctx, fmt.Sprintf(sqlSelectSchemaVersionsByID, columns, strconv.FormatInt(id, 10)))
+	case req.Time != nil:
+		return tm.fetchSchemaVersions(ctx, fmt.Sprintf(sqlSelectSchemaVersionAtTime, columns, protoutil.TimeFromProto(req.Time).Unix()))
+	case len(req.Ids) > 0:
+		ids := make([]string, 0, len(req.Ids))
+		for _, id := range req.Ids {
+			ids = append(ids, strconv.FormatInt(id, 10))
+		}
+		return tm.fetchSchemaVersions(ctx, fmt.Sprintf(sqlSelectSchemaVersionsByID, columns, strings.Join(ids, ", ")))
+	}
+
+	var versions []*tabletmanagerdatapb.SchemaVersion
+	var lastID int64
+	for {
+		page, err := tm.fetchSchemaVersions(ctx, fmt.Sprintf(sqlSelectSchemaVersionsAfter, columns, lastID, schemaVersionsPageSize))
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, page...)
+		if len(page) < schemaVersionsPageSize {
+			return versions, nil
+		}
+		lastID = page[len(page)-1].Id
+	}
+}
+
+// schemaVersionIDAtPosition returns the id of the most recent schema version contained in the given position,
+// or 0 if the position predates all versions. Versions are recorded in order of position, so they are scanned
+// backwards from the latest one, without reading their schemas.
+func (tm *TabletManager) schemaVersionIDAtPosition(ctx context.Context, position string) (int64, error) {
+	pos, err := mysql.DecodePosition(position)
+	if err != nil {
+		return 0, err
+	}
+	belowID := int64(math.MaxInt64)
+	for {
+		qr, err := tm.MysqlDaemon.FetchSuperQuery(ctx, fmt.Sprintf(sqlSelectSchemaVersionPositionsBelow, belowID, schemaVersionsPageSize))
+		if err != nil {
+			return 0, err
+		}
+		for _, row := range qr.Named().Rows {
+			id, err := row.ToInt64("id")
+			if err != nil {
+				return 0, err
+			}
+			versionPos, err := mysql.DecodePosition(row.AsString("pos", ""))
+			if err != nil {
+				return 0, err
+			}
+			if pos.AtLeast(versionPos) {
+				return id, nil
+			}
+			belowID = id
+		}
+		if len(qr.Rows) < schemaVersionsPageSize {
+			return 0, nil
+		}
+	}
+}
+
+func (tm *TabletManager) fetchSchemaVersions(ctx context.Context, query string) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	qr, err := tm.MysqlDaemon.FetchSuperQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return parseSchemaVersions(qr)
+}
+
+// parseSchemaVersions reads rows of the _vt.schema_version table. The schemax column is optional.
+func parseSchemaVersions(qr *sqltypes.Result) (versions []*tabletmanagerdatapb.SchemaVersion, err error) {
+	for _, row := range qr.Named().Rows {
+		version := &tabletmanagerdatapb.SchemaVersion{
+			Position: row.AsString("pos", ""),
+			Ddl:      row.AsString("ddl", ""),
+		}
+		if version.Id, err = row.ToInt64("id"); err != nil {
+			return nil, err
+		}
+		timeUpdated, err := row.ToInt64("time_updated")
+		if err != nil {
+			return nil, err
+		}
+		version.TimeUpdated = protoutil.TimeToProto(time.Unix(timeUpdated, 0))
+		if schemax, ok := row["schemax"]; ok {
+			version.Schema = &binlogdatapb.MinimalSchema{}
+			if err := proto.Unmarshal(schemax.ToBytes(), version.Schema); err != nil {
+				return nil, err
+			}
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
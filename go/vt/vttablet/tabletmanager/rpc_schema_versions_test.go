@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestGetSchemaVersions(t *testing.T) {
+	defer func(pageSize int) { schemaVersionsPageSize = pageSize }(schemaVersionsPageSize)
+	schemaVersionsPageSize = 2
+
+	schema := &binlogdatapb.MinimalSchema{Tables: []*binlogdatapb.MinimalTable{{Name: "t1"}, {Name: "t2"}}}
+	blob, err := proto.Marshal(schema)
+	require.NoError(t, err)
+
+	versionFields := sqltypes.MakeTestFields("id|pos|ddl|time_updated", "int64|varbinary|varbinary|int64")
+	schemaFields := sqltypes.MakeTestFields("id|pos|ddl|time_updated|schemax", "int64|varbinary|varbinary|int64|blob")
+	positionFields := sqltypes.MakeTestFields("id|pos", "int64|varbinary")
+
+	daemon := fakemysqldaemon.NewFakeMysqlDaemon(nil)
+	daemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"select id, pos, ddl, time_updated from _vt.schema_version where id > 0 order by id asc limit 2": sqltypes.MakeTestResult(versionFields,
+			"1|MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-10||1600000000",
+			"2|MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-20|create table t2 (id int)|1600000100",
+		),
+		"select id, pos, ddl, time_updated from _vt.schema_version where id > 2 order by id asc limit 2": sqltypes.MakeTestResult(versionFields,
+			"3|MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-30|alter table t2 add index (id)|1600000200",
+		),
+		"select id, pos from _vt.schema_version where id < 9223372036854775807 order by id desc limit 2": sqltypes.MakeTestResult(positionFields,
+			"3|MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-30",
+			"2|MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-20",
+		),
+		"select id, pos from _vt.schema_version where id < 2 order by id desc limit 2": sqltypes.MakeTestResult(positionFields,
+			"1|MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-10",
+		),
+		"select id, pos, ddl, time_updated, schemax from _vt.schema_version where id in (1) order by id asc": sqltypes.MakeTestResult(schemaFields,
+			"1|MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-10||1600000000|"+string(blob),
+		),
+		"select id, pos, ddl, time_updated, schemax from _vt.schema_version where id in (2) order by id asc": sqltypes.MakeTestResult(schemaFields,
+			"2|MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-20|create table t2 (id int)|1600000100|"+string(blob),
+		),
+		"select id, pos, ddl, time_updated, schemax from _vt.schema_version where time_updated <= 1600000150 order by id desc limit 1": sqltypes.MakeTestResult(schemaFields,
+			"2|MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-20|create table t2 (id int)|1600000100|"+string(blob),
+		),
+	}
+	tm := &TabletManager{MysqlDaemon: daemon}
+	ctx := context.Background()
+
+	versions, err := tm.GetSchemaVersions(ctx, &tabletmanagerdatapb.GetSchemaVersionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, versions, 3)
+	assert.Equal(t, "alter table t2 add index (id)", versions[2].Ddl)
+	assert.Equal(t, time.Unix(1600000100, 0).UTC(), protoutil.TimeFromProto(versions[1].TimeUpdated).UTC())
+	assert.Nil(t, versions[1].Schema)
+
+	tcases := []struct {
+		name       string
+		req        *tabletmanagerdatapb.GetSchemaVersionsRequest
+		expectedID int64
+	}{{
+		name: "before the first version",
+		req:  &tabletmanagerdatapb.GetSchemaVersionsRequest{Position: "MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-5"},
+	}, {
+		name:       "at the first version",
+		req:        &tabletmanagerdatapb.GetSchemaVersionsRequest{Position: "MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-19", IncludeSchema: true},
+		expectedID: 1,
+	}, {
+		name:       "at the second version",
+		req:        &tabletmanagerdatapb.GetSchemaVersionsRequest{Position: "MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-20", IncludeSchema: true},
+		expectedID: 2,
+	}, {
+		name:       "at a time",
+		req:        &tabletmanagerdatapb.GetSchemaVersionsRequest{Time: protoutil.TimeToProto(time.Unix(1600000150, 0)), IncludeSchema: true},
+		expectedID: 2,
+	}, {
+		name:       "by id",
+		req:        &tabletmanagerdatapb.GetSchemaVersionsRequest{Ids: []int64{1}, IncludeSchema: true},
+		expectedID: 1,
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			versions, err := tm.GetSchemaVersions(ctx, tcase.req)
+			require.NoError(t, err)
+			if tcase.expectedID == 0 {
+				assert.Empty(t, versions)
+				return
+			}
+			require.Len(t, versions, 1)
+			assert.Equal(t, tcase.expectedID, versions[0].Id)
+			assert.True(t, proto.Equal(schema, versions[0].Schema))
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/audit"
 )
 
 // vdbClient is a wrapper on binlogplayer.DBClient.
@@ -36,6 +37,8 @@ type vdbClient struct {
 	InTransaction bool
 	startTime     time.Time
 	queries       []string
+	// auditor audits the statements, if set.
+	auditor *audit.Logger
 }
 
 func newVDBClient(dbclient binlogplayer.DBClient, stats *binlogplayer.Stats) *vdbClient {
@@ -88,7 +91,18 @@ func (vc *vdbClient) ExecuteFetch(query string, maxrows int) (*sqltypes.Result,
 	} else {
 		vc.queries = append(vc.queries, query)
 	}
-	return vc.DBClient.ExecuteFetch(query, maxrows)
+	return vc.executeFetch(query, maxrows)
+}
+
+// executeFetch executes the query, and audits it if it's audited.
+func (vc *vdbClient) executeFetch(query string, maxrows int) (*sqltypes.Result, error) {
+	statement, err := vc.auditor.BeginStatement(&audit.Record{SQL: query, Source: audit.SourceVReplication})
+	if err != nil {
+		return nil, err
+	}
+	qr, err := vc.DBClient.ExecuteFetch(query, maxrows)
+	statement.End(qr, err)
+	return qr, err
 }
 
 // Execute is ExecuteFetch without the maxrows.
@@ -130,7 +144,7 @@ func (vc *vdbClient) Retry() (*sqltypes.Result, error) {
 			continue
 		}
 		// Number of rows should never exceed relayLogMaxItems.
-		result, err := vc.DBClient.ExecuteFetch(q, *relayLogMaxItems)
+		result, err := vc.executeFetch(q, *relayLogMaxItems)
 		if err != nil {
 			return nil, err
 		}
@@ -112,13 +112,17 @@ func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreame
 		log.Warningf("the supplied value for vreplication_heartbeat_update_interval:%d seconds is larger than the maximum allowed:%d seconds, vreplication will fallback to %d",
 			*vreplicationHeartbeatUpdateInterval, vreplicationMinimumHeartbeatUpdateInterval, vreplicationMinimumHeartbeatUpdateInterval)
 	}
+	vdbClient := newVDBClient(dbClient, stats)
+	if vre != nil {
+		vdbClient.auditor = vre.auditor
+	}
 	return &vreplicator{
 		vre:             vre,
 		id:              id,
 		source:          source,
 		sourceVStreamer: sourceVStreamer,
 		stats:           stats,
-		dbClient:        newVDBClient(dbClient, stats),
+		dbClient:        vdbClient,
 		mysqld:          mysqld,
 	}
 }
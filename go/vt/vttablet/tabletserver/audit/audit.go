@@ -0,0 +1,414 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit writes the audit log of a tablet: an append-only file of
+// hash-chained json records of the DMLs and DDLs it executes.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// maxLineSize is the maximum size of a record that can be read back.
+const maxLineSize = 256 * 1024 * 1024
+
+// ClassDropped is the class of the record that is written when the
+// log is closed, if records were dropped since the last record, and
+// when the log recovers from a write error, with the number of records
+// that were lost.
+const ClassDropped = "dropped"
+
+// retryInterval is the interval at which a log that can't be written
+// is retried.
+const retryInterval = 5 * time.Second
+
+// logFile is the file of the audit log.
+type logFile interface {
+	io.WriteCloser
+	Stat() (os.FileInfo, error)
+	Truncate(size int64) error
+}
+
+// Logger appends the records of the audited statements to the audit log.
+// Records are buffered, and written by a single goroutine that chains them.
+type Logger struct {
+	file    string
+	tables  []string
+	classes map[string]bool
+	size    int
+	drop    bool
+
+	records *stats.CountersWithSingleLabel
+	dropped *stats.Counter
+	errors  *stats.Counter
+
+	openFile      func(name string) (logFile, error)
+	retryInterval time.Duration
+
+	// mu protects queue, which is nil when the logger is closed.
+	mu    sync.RWMutex
+	queue chan *Record
+	done  chan struct{}
+	// pendingDropped is the number of records dropped since the last
+	// written record. It's accessed atomically.
+	pendingDropped int64
+
+	// failMu protects failure, the error that keeps the log from being
+	// written. It's cleared when the log recovers.
+	failMu  sync.Mutex
+	failure error
+}
+
+// NewLogger creates a new Logger.
+func NewLogger(env tabletenv.Env) *Logger {
+	config := env.Config().Audit
+	l := &Logger{
+		file:    config.File,
+		tables:  config.Tables,
+		classes: make(map[string]bool),
+		size:    config.BufferSize,
+		drop:    config.OverflowPolicy == tabletenv.AuditOverflowDrop,
+		records: env.Exporter().NewCountersWithSingleLabel("AuditRecords", "Audit log records written, by statement class", "Class"),
+		dropped: env.Exporter().NewCounter("AuditDropped", "Audit log records dropped because the buffer was full"),
+		errors:  env.Exporter().NewCounter("AuditErrors", "Errors writing the audit log"),
+		openFile: func(name string) (logFile, error) {
+			return os.OpenFile(name, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+		},
+		retryInterval: retryInterval,
+	}
+	for _, class := range config.Classes {
+		l.classes[class] = true
+	}
+	return l
+}
+
+// Open opens the audit log, and continues the chain of its last record.
+// It fails if the last record of an existing log can't be read.
+func (l *Logger) Open() error {
+	if l.file == "" {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.queue != nil {
+		return nil
+	}
+	last, err := readLastRecord(l.file)
+	if err != nil {
+		return fmt.Errorf("audit log %s: %v", l.file, err)
+	}
+	f, err := l.openFile(l.file)
+	if err != nil {
+		return fmt.Errorf("audit log %s: %v", l.file, err)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("audit log %s: %v", l.file, err)
+	}
+	l.setFailure(nil)
+	l.queue = make(chan *Record, l.size)
+	l.done = make(chan struct{})
+	w := &logWriter{l: l, f: f, w: bufio.NewWriter(f), size: fi.Size(), seq: last.Seq, hash: last.Hash}
+	go w.run(l.queue, l.done)
+	return nil
+}
+
+// Err returns the error that keeps the audit log from being written, or
+// nil. Audited statements must be rejected while it's set: the log is
+// retried periodically, and a record with the number of lost records is
+// written when it recovers.
+func (l *Logger) Err() error {
+	l.failMu.Lock()
+	defer l.failMu.Unlock()
+	return l.failure
+}
+
+func (l *Logger) setFailure(err error) {
+	l.failMu.Lock()
+	defer l.failMu.Unlock()
+	l.failure = err
+}
+
+// Close waits for the buffered records to be written, and closes the log.
+func (l *Logger) Close() {
+	l.mu.Lock()
+	queue, done := l.queue, l.done
+	l.queue = nil
+	l.mu.Unlock()
+	if queue == nil {
+		return
+	}
+	close(queue)
+	<-done
+}
+
+// Audits returns true if the statements of the class that access
+// the tables must be audited.
+func (l *Logger) Audits(class string, tables []string) bool {
+	if l.file == "" {
+		return false
+	}
+	if len(l.classes) != 0 && !l.classes[class] {
+		return false
+	}
+	if len(l.tables) == 0 {
+		return true
+	}
+	for _, table := range tables {
+		for _, val := range l.tables {
+			if table == val || (strings.HasSuffix(val, "%") && strings.HasPrefix(table, val[:len(val)-1])) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Record appends a record to the audit log. If the buffer is full, it
+// blocks until there is space, or drops the record, depending on the
+// overflow policy. Records are dropped if the log is closed.
+func (l *Logger) Record(record *Record) {
+	record.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.queue == nil {
+		return
+	}
+	if !l.drop {
+		l.queue <- record
+		return
+	}
+	select {
+	case l.queue <- record:
+	default:
+		atomic.AddInt64(&l.pendingDropped, 1)
+		l.dropped.Add(1)
+	}
+}
+
+// logWriter writes the records of a Logger. The records are buffered,
+// and only chained to the records that were written to the file: when a
+// write fails, the file is truncated to its last complete record, and
+// the log fails until a record of the lost records can be written.
+type logWriter struct {
+	l *Logger
+	f logFile
+	w *bufio.Writer
+
+	// size, seq and hash are those of the file as of the last flush,
+	// and bufferedSeq and bufferedHash those of the last buffered record.
+	size         int64
+	seq          int64
+	hash         string
+	bufferedSize int64
+	bufferedSeq  int64
+	bufferedHash string
+	// bufferedClasses counts the buffered records by class, and
+	// bufferedDropped is the number of dropped records they count.
+	// lost is the number of records that were lost since the log
+	// failed, including the dropped ones.
+	bufferedClasses map[string]int64
+	bufferedDropped int64
+	lost            int64
+	failed          bool
+}
+
+func (lw *logWriter) run(queue chan *Record, done chan struct{}) {
+	defer close(done)
+	lw.resetBuffer()
+	ticker := time.NewTicker(lw.l.retryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case record, ok := <-queue:
+			if !ok {
+				lw.close()
+				return
+			}
+			lw.write(record)
+			if len(queue) == 0 {
+				lw.flush()
+			}
+		case <-ticker.C:
+			if lw.failed {
+				lw.recover()
+			}
+		}
+	}
+}
+
+func (lw *logWriter) close() {
+	if lw.failed {
+		lw.recover()
+	} else if atomic.LoadInt64(&lw.l.pendingDropped) != 0 {
+		lw.write(&Record{Time: time.Now().UTC().Format(time.RFC3339Nano), Class: ClassDropped})
+		lw.flush()
+	}
+	if err := lw.f.Close(); err != nil {
+		lw.l.errors.Add(1)
+		log.Errorf("Audit log %s: cannot close: %v", lw.l.file, err)
+	}
+}
+
+// write buffers a record. Records are lost while the log fails.
+func (lw *logWriter) write(record *Record) {
+	if lw.failed {
+		lw.lost++
+		return
+	}
+	record.Dropped = atomic.SwapInt64(&lw.l.pendingDropped, 0)
+	data, err := record.chain(lw.bufferedSeq, lw.bufferedHash)
+	if err != nil {
+		lw.l.errors.Add(1)
+		log.Errorf("Audit log %s: cannot encode record: %v", lw.l.file, err)
+		atomic.AddInt64(&lw.l.pendingDropped, record.Dropped)
+		return
+	}
+	data = append(data, '\n')
+	lw.bufferedSize += int64(len(data))
+	lw.bufferedSeq, lw.bufferedHash = record.Seq, record.Hash
+	lw.bufferedClasses[record.Class]++
+	lw.bufferedDropped += record.Dropped
+	if _, err := lw.w.Write(data); err != nil {
+		lw.fail(err)
+	}
+}
+
+// flush writes the buffered records to the file.
+func (lw *logWriter) flush() {
+	if lw.failed {
+		return
+	}
+	if err := lw.w.Flush(); err != nil {
+		lw.fail(err)
+		return
+	}
+	lw.size += lw.bufferedSize
+	lw.seq, lw.hash = lw.bufferedSeq, lw.bufferedHash
+	for class, count := range lw.bufferedClasses {
+		lw.l.records.Add(class, count)
+	}
+	lw.resetBuffer()
+}
+
+func (lw *logWriter) resetBuffer() {
+	lw.bufferedSize, lw.bufferedDropped = 0, 0
+	lw.bufferedSeq, lw.bufferedHash = lw.seq, lw.hash
+	lw.bufferedClasses = make(map[string]int64)
+}
+
+// fail discards the buffered records, so that the chain continues from
+// the last record of the file. Any part of them that was written to the
+// file is removed when the log recovers, which it fails until.
+func (lw *logWriter) fail(err error) {
+	lw.l.errors.Add(1)
+	log.Errorf("Audit log %s: cannot write records, audited statements are rejected until it recovers: %v", lw.l.file, err)
+	// The records of lost records are not lost records themselves.
+	for class, count := range lw.bufferedClasses {
+		if class != ClassDropped {
+			lw.lost += count
+		}
+	}
+	lw.lost += lw.bufferedDropped
+	lw.w.Reset(lw.f)
+	lw.resetBuffer()
+	lw.failed = true
+	lw.l.setFailure(fmt.Errorf("audit log %s cannot be written: %v", lw.l.file, err))
+}
+
+// recover tries to write a record of the records that were lost since
+// the log failed, and ends the failure if it succeeds.
+func (lw *logWriter) recover() {
+	if err := lw.f.Truncate(lw.size); err != nil {
+		lw.l.errors.Add(1)
+		log.Errorf("Audit log %s: cannot truncate to its last record: %v", lw.l.file, err)
+		return
+	}
+	lw.failed = false
+	lost := lw.lost
+	lw.lost = 0
+	atomic.AddInt64(&lw.l.pendingDropped, lost)
+	lw.write(&Record{Time: time.Now().UTC().Format(time.RFC3339Nano), Class: ClassDropped})
+	lw.flush()
+	if lw.failed {
+		return
+	}
+	log.Infof("Audit log %s: recovered, %d records were lost", lw.l.file, lost)
+	lw.l.setFailure(nil)
+}
+
+// readLastRecord returns the last record of an audit log,
+// or an empty record if the log doesn't exist or is empty.
+func readLastRecord(file string) (*Record, error) {
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return &Record{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the file backwards, until the line that precedes the last one.
+	const chunkSize = 64 * 1024
+	var tail []byte
+	for offset := fi.Size(); offset > 0; {
+		n := int64(chunkSize)
+		if n > offset {
+			n = offset
+		}
+		offset -= n
+		chunk := make([]byte, n)
+		if _, err := f.ReadAt(chunk, offset); err != nil && err != io.EOF {
+			return nil, err
+		}
+		tail = append(chunk, tail...)
+		if i := bytes.LastIndexByte(bytes.TrimSuffix(tail, []byte("\n")), '\n'); i >= 0 {
+			tail = tail[i+1:]
+			break
+		}
+		if len(tail) > maxLineSize {
+			return nil, fmt.Errorf("last record exceeds %d bytes", maxLineSize)
+		}
+	}
+	tail = bytes.TrimSuffix(tail, []byte("\n"))
+	if len(tail) == 0 {
+		return &Record{}, nil
+	}
+	record := &Record{}
+	if err := json.Unmarshal(tail, record); err != nil {
+		return nil, fmt.Errorf("cannot read the last record, the log must be verified and repaired: %v", err)
+	}
+	return record, nil
+}
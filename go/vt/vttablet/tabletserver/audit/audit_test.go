@@ -0,0 +1,244 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func newTestLogger(t *testing.T, configure func(config *tabletenv.AuditConfig)) (*Logger, string) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	config := tabletenv.NewDefaultConfig()
+	config.Audit.File = path.Join(dir, "audit.jsonl")
+	if configure != nil {
+		configure(&config.Audit)
+	}
+	return NewLogger(tabletenv.NewEnv(config, "AuditTest")), config.Audit.File
+}
+
+func readRecords(t *testing.T, file string) []*Record {
+	data, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	var records []*Record
+	for _, line := range strings.Split(strings.TrimSuffix(string(data), "\n"), "\n") {
+		record := &Record{}
+		require.NoError(t, json.Unmarshal([]byte(line), record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestLoggerChainsRecords(t *testing.T) {
+	l, file := newTestLogger(t, nil)
+	require.NoError(t, l.Open())
+	l.Record(&Record{Class: tabletenv.AuditClassDML, Plan: "Insert", Tables: []string{"t"}, SQL: "insert into t values (1)", RowsAffected: 1})
+	l.Record(&Record{Class: tabletenv.AuditClassDDL, Plan: "DDL", Tables: []string{"t"}, SQL: "alter table t add column b int"})
+	l.Close()
+
+	// The chain continues when the log is reopened.
+	require.NoError(t, l.Open())
+	l.Record(&Record{Class: tabletenv.AuditClassDML, Plan: "Delete", Tables: []string{"t"}, SQL: "delete from t", Error: "denied"})
+	l.Close()
+
+	records := readRecords(t, file)
+	require.Len(t, records, 3)
+	for i, record := range records {
+		assert.Equal(t, int64(i+1), record.Seq)
+		if i == 0 {
+			assert.Empty(t, record.PrevHash)
+			continue
+		}
+		assert.Equal(t, records[i-1].Hash, record.PrevHash)
+	}
+	assert.Equal(t, "alter table t add column b int", records[1].SQL)
+
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+	n, err := Verify(f)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+}
+
+func TestVerifyDetectsTampering(t *testing.T) {
+	l, file := newTestLogger(t, nil)
+	require.NoError(t, l.Open())
+	for i := 0; i < 3; i++ {
+		l.Record(&Record{Class: tabletenv.AuditClassDML, Plan: "Update", SQL: "update t set a = 1", RowsAffected: 10})
+	}
+	l.Close()
+	data, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 3)
+
+	testcases := []struct {
+		name string
+		log  string
+		err  string
+	}{{
+		name: "modified",
+		log:  lines[0] + strings.Replace(lines[1], `"rows_affected":10`, `"rows_affected":1`, 1) + lines[2],
+		err:  "line 2: hash",
+	}, {
+		name: "deleted",
+		log:  lines[0] + lines[2],
+		err:  "line 2: seq 3 does not follow 1",
+	}, {
+		name: "reordered",
+		log:  lines[1] + lines[0] + lines[2],
+		err:  "line 1: seq 2 does not follow 0",
+	}, {
+		name: "truncated",
+		log:  lines[0] + lines[1][:10],
+		err:  "line 2: invalid record",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := Verify(bytes.NewBufferString(tcase.log))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tcase.err)
+		})
+	}
+
+	// A log that ends with a partial record can't be reopened.
+	require.NoError(t, ioutil.WriteFile(file, []byte(lines[0]+lines[1][:10]), 0600))
+	assert.Contains(t, l.Open().Error(), "cannot read the last record")
+}
+
+func TestLoggerDropsRecords(t *testing.T) {
+	l, file := newTestLogger(t, func(config *tabletenv.AuditConfig) {
+		config.BufferSize = 1
+		config.OverflowPolicy = tabletenv.AuditOverflowDrop
+	})
+	require.NoError(t, l.Open())
+	// Fill the buffer without a writer.
+	l.mu.Lock()
+	queue := l.queue
+	l.queue = make(chan *Record, 1)
+	l.mu.Unlock()
+	startingDropped := l.dropped.Get()
+	l.Record(&Record{Class: tabletenv.AuditClassDML, SQL: "insert into t values (1)"})
+	l.Record(&Record{Class: tabletenv.AuditClassDML, SQL: "insert into t values (2)"})
+	l.Record(&Record{Class: tabletenv.AuditClassDML, SQL: "insert into t values (3)"})
+	assert.Equal(t, int64(2), l.dropped.Get()-startingDropped)
+
+	l.mu.Lock()
+	queue <- <-l.queue
+	l.queue = queue
+	l.mu.Unlock()
+	l.Close()
+
+	records := readRecords(t, file)
+	require.Len(t, records, 1)
+	assert.Equal(t, "insert into t values (1)", records[0].SQL)
+	assert.Equal(t, int64(2), records[0].Dropped)
+}
+
+func TestLoggerAudits(t *testing.T) {
+	l, _ := newTestLogger(t, func(config *tabletenv.AuditConfig) {
+		config.Tables = []string{"orders", "customer%"}
+		config.Classes = []string{tabletenv.AuditClassDDL}
+	})
+	assert.True(t, l.Audits(tabletenv.AuditClassDDL, []string{"orders"}))
+	assert.True(t, l.Audits(tabletenv.AuditClassDDL, []string{"items", "customer_info"}))
+	assert.False(t, l.Audits(tabletenv.AuditClassDDL, []string{"items"}))
+	assert.False(t, l.Audits(tabletenv.AuditClassDML, []string{"orders"}))
+
+	l, _ = newTestLogger(t, nil)
+	assert.True(t, l.Audits(tabletenv.AuditClassDML, nil))
+
+	l, _ = newTestLogger(t, func(config *tabletenv.AuditConfig) { config.File = "" })
+	assert.False(t, l.Audits(tabletenv.AuditClassDML, []string{"orders"}))
+	require.NoError(t, l.Open())
+	l.Record(&Record{Class: tabletenv.AuditClassDML})
+	l.Close()
+}
+
+// failingFile fails the writes to a file, after writing half of them,
+// while failing is set.
+type failingFile struct {
+	*os.File
+	failing int32
+}
+
+func (f *failingFile) Write(p []byte) (int, error) {
+	if atomic.LoadInt32(&f.failing) == 0 {
+		return f.File.Write(p)
+	}
+	n, _ := f.File.Write(p[:len(p)/2])
+	return n, errors.New("no space left on device")
+}
+
+func TestLoggerFailsClosed(t *testing.T) {
+	l, file := newTestLogger(t, nil)
+	f := &failingFile{}
+	l.openFile = func(name string) (logFile, error) {
+		var err error
+		f.File, err = os.OpenFile(name, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+		return f, err
+	}
+	l.retryInterval = 10 * time.Millisecond
+	require.NoError(t, l.Open())
+	l.Record(&Record{Class: tabletenv.AuditClassDML, SQL: "insert into t values (1)"})
+	assert.Eventually(t, func() bool {
+		fi, err := os.Stat(file)
+		return err == nil && fi.Size() > 0
+	}, 5*time.Second, time.Millisecond)
+
+	atomic.StoreInt32(&f.failing, 1)
+	l.Record(&Record{Class: tabletenv.AuditClassDML, SQL: "insert into t values (2)"})
+	assert.Eventually(t, func() bool { return l.Err() != nil }, 5*time.Second, time.Millisecond)
+	assert.Contains(t, l.Err().Error(), "no space left on device")
+	// A record that is queued while the log fails is lost too.
+	l.Record(&Record{Class: tabletenv.AuditClassDML, SQL: "insert into t values (3)"})
+
+	atomic.StoreInt32(&f.failing, 0)
+	assert.Eventually(t, func() bool { return l.Err() == nil }, 5*time.Second, time.Millisecond)
+	l.Record(&Record{Class: tabletenv.AuditClassDML, SQL: "insert into t values (4)"})
+	l.Close()
+
+	records := readRecords(t, file)
+	require.Len(t, records, 3)
+	assert.Equal(t, "insert into t values (1)", records[0].SQL)
+	assert.Equal(t, ClassDropped, records[1].Class)
+	assert.Equal(t, int64(2), records[1].Dropped)
+	assert.Equal(t, "insert into t values (4)", records[2].SQL)
+
+	lf, err := os.Open(file)
+	require.NoError(t, err)
+	defer lf.Close()
+	n, err := Verify(lf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+}
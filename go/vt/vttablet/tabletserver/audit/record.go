@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Record is a line of the audit log. Records are chained: the Hash of a
+// record is the SHA-256 of its json encoding without Hash, which includes
+// the Hash of the previous record as PrevHash. The PrevHash of the first
+// record of a log is empty, and Seq increases by one from 1.
+type Record struct {
+	Seq      int64    `json:"seq"`
+	Time     string   `json:"time"`
+	Keyspace string   `json:"keyspace,omitempty"`
+	Shard    string   `json:"shard,omitempty"`
+	Class    string   `json:"class"`
+	Plan     string   `json:"plan"`
+	Tables   []string `json:"tables,omitempty"`
+	SQL      string   `json:"sql"`
+	// BindVars are the values of the bind variables of SQL, as literals.
+	BindVars map[string]string `json:"bind_vars,omitempty"`
+	// Source is where a statement that was not executed by the query
+	// executor of the tablet comes from, e.g. vreplication.
+	Source          string `json:"source,omitempty"`
+	EffectiveCaller string `json:"effective_caller,omitempty"`
+	ImmediateCaller string `json:"immediate_caller,omitempty"`
+	ClientAddr      string `json:"client_addr,omitempty"`
+	TransactionID   int64  `json:"transaction_id,omitempty"`
+	RowsAffected    uint64 `json:"rows_affected"`
+	Error           string `json:"error,omitempty"`
+	// Dropped is the number of records that were dropped
+	// because the buffer was full since the previous record.
+	Dropped  int64  `json:"dropped,omitempty"`
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash,omitempty"`
+}
+
+// chain sets the Seq, PrevHash and Hash of the record that follows
+// the record prevSeq, whose hash is prevHash, and returns its encoding.
+func (r *Record) chain(prevSeq int64, prevHash string) ([]byte, error) {
+	r.Seq = prevSeq + 1
+	r.PrevHash = prevHash
+	r.Hash = ""
+	hash, err := r.computeHash()
+	if err != nil {
+		return nil, err
+	}
+	r.Hash = hash
+	return json.Marshal(r)
+}
+
+func (r *Record) computeHash() (string, error) {
+	unhashed := *r
+	unhashed.Hash = ""
+	data, err := json.Marshal(&unhashed)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Verify checks the integrity of the chain of an audit log,
+// and returns its number of records.
+func Verify(r io.Reader) (int64, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	var prev Record
+	var line int64
+	for scanner.Scan() {
+		line++
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return line - 1, fmt.Errorf("line %d: invalid record: %v", line, err)
+		}
+		if record.Seq != prev.Seq+1 {
+			return line - 1, fmt.Errorf("line %d: seq %d does not follow %d", line, record.Seq, prev.Seq)
+		}
+		if record.PrevHash != prev.Hash {
+			return line - 1, fmt.Errorf("line %d: prev_hash %s does not match the hash of the previous record %s", line, record.PrevHash, prev.Hash)
+		}
+		hash, err := record.computeHash()
+		if err != nil {
+			return line - 1, fmt.Errorf("line %d: %v", line, err)
+		}
+		if record.Hash != hash {
+			return line - 1, fmt.Errorf("line %d: hash %s does not match the content of the record %s", line, record.Hash, hash)
+		}
+		prev = record
+	}
+	if err := scanner.Err(); err != nil {
+		return line, err
+	}
+	return line, nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The sources of the statements that are not executed by the query
+// executor of the tablet, whose records have no source.
+const (
+	SourceTabletManager = "tabletmanager"
+	SourceVReplication  = "vreplication"
+)
+
+// sidecarDBName is the database of the tables the tablet maintains for
+// itself. Statements that only access it are not audited.
+const sidecarDBName = "_vt"
+
+// Statement is the execution of an audited statement that is not executed
+// by the query executor of the tablet. A nil Statement is not audited.
+type Statement struct {
+	l      *Logger
+	record *Record
+}
+
+// BeginStatement classifies a statement that is executed outside of the
+// query executor, like the statements of vreplication, from the SQL of the
+// record, and returns the Statement whose execution must be ended with End,
+// or nil if it's not audited. If it's audited and the log can't be written,
+// it returns an error, and the statement must not be executed.
+func (l *Logger) BeginStatement(record *Record) (*Statement, error) {
+	if l == nil || l.file == "" {
+		return nil, nil
+	}
+	var class string
+	stmtType := sqlparser.Preview(record.SQL)
+	switch stmtType {
+	case sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
+		class = tabletenv.AuditClassDML
+	case sqlparser.StmtDDL, sqlparser.StmtRevert:
+		class = tabletenv.AuditClassDDL
+	default:
+		return nil, nil
+	}
+	if len(l.classes) != 0 && !l.classes[class] {
+		return nil, nil
+	}
+	tables, ok := statementTables(record.SQL)
+	if !ok || !l.Audits(class, tables) {
+		return nil, nil
+	}
+	if err := l.Err(); err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "%v, the statement cannot be audited", err)
+	}
+	record.Class = class
+	record.Tables = tables
+	if record.Plan == "" {
+		record.Plan = stmtType.String()
+	}
+	return &Statement{l: l, record: record}, nil
+}
+
+// End records the execution of the statement.
+func (s *Statement) End(result *sqltypes.Result, err error) {
+	if s == nil {
+		return
+	}
+	if result != nil {
+		s.record.RowsAffected = result.RowsAffected
+	}
+	if err != nil {
+		s.record.Error = err.Error()
+	}
+	s.l.Record(s.record)
+}
+
+// statementTables returns the tables a statement accesses, and false if
+// it only accesses the sidecar database. Statements that can't be parsed
+// are audited without tables.
+func statementTables(sql string) ([]string, bool) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, true
+	}
+	var tables []string
+	seen := make(map[string]bool)
+	sidecar := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if tableName, ok := node.(sqlparser.TableName); ok && !tableName.IsEmpty() {
+			if tableName.Qualifier.String() == sidecarDBName {
+				sidecar = true
+				return true, nil
+			}
+			if name := tableName.Name.String(); !seen[name] {
+				seen[name] = true
+				tables = append(tables, name)
+			}
+		}
+		return true, nil
+	}, stmt)
+	return tables, len(tables) != 0 || !sidecar
+}
+
+// BindVars returns the bind variables of a statement as SQL literals.
+func BindVars(bindVars map[string]*querypb.BindVariable) map[string]string {
+	if len(bindVars) == 0 {
+		return nil
+	}
+	literals := make(map[string]string, len(bindVars))
+	for name, bv := range bindVars {
+		var buf strings.Builder
+		sqlparser.EncodeValue(&buf, bv)
+		literals[name] = buf.String()
+	}
+	return literals
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestBeginStatement(t *testing.T) {
+	l, file := newTestLogger(t, func(config *tabletenv.AuditConfig) {
+		config.Tables = []string{"orders"}
+	})
+	require.NoError(t, l.Open())
+
+	for _, sql := range []string{
+		"select * from orders",
+		"insert into items(id) values (1)",
+		"update _vt.vreplication set state = 'Running' where id = 1",
+		"begin",
+	} {
+		statement, err := l.BeginStatement(&Record{SQL: sql, Source: SourceVReplication})
+		require.NoError(t, err)
+		assert.Nil(t, statement, sql)
+	}
+
+	statement, err := l.BeginStatement(&Record{SQL: "insert into orders(id) values (1)", Source: SourceVReplication})
+	require.NoError(t, err)
+	require.NotNil(t, statement)
+	statement.End(&sqltypes.Result{RowsAffected: 1}, nil)
+	statement, err = l.BeginStatement(&Record{SQL: "alter table orders add column total int", Source: SourceTabletManager})
+	require.NoError(t, err)
+	require.NotNil(t, statement)
+	statement.End(nil, errors.New("duplicate column"))
+	l.Close()
+
+	records := readRecords(t, file)
+	require.Len(t, records, 2)
+	assert.Equal(t, tabletenv.AuditClassDML, records[0].Class)
+	assert.Equal(t, "INSERT", records[0].Plan)
+	assert.Equal(t, []string{"orders"}, records[0].Tables)
+	assert.Equal(t, SourceVReplication, records[0].Source)
+	assert.Equal(t, uint64(1), records[0].RowsAffected)
+	assert.Equal(t, tabletenv.AuditClassDDL, records[1].Class)
+	assert.Equal(t, []string{"orders"}, records[1].Tables)
+	assert.Equal(t, SourceTabletManager, records[1].Source)
+	assert.Equal(t, "duplicate column", records[1].Error)
+
+	// Statements are not audited without a log.
+	var nilLogger *Logger
+	statement, err = nilLogger.BeginStatement(&Record{SQL: "insert into orders(id) values (1)"})
+	require.NoError(t, err)
+	assert.Nil(t, statement)
+	statement.End(nil, nil)
+}
+
+func TestBeginStatementFailsClosed(t *testing.T) {
+	l, _ := newTestLogger(t, nil)
+	require.NoError(t, l.Open())
+	defer l.Close()
+	l.setFailure(errors.New("disk full"))
+
+	statement, err := l.BeginStatement(&Record{SQL: "delete from orders where id = 1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be audited")
+	assert.Nil(t, statement)
+	// Statements that are not audited are executed.
+	_, err = l.BeginStatement(&Record{SQL: "select * from orders"})
+	require.NoError(t, err)
+}
+
+func TestBindVars(t *testing.T) {
+	assert.Nil(t, BindVars(nil))
+	assert.Equal(t, map[string]string{
+		"id":   "1",
+		"name": `'it\'s'`,
+	}, BindVars(map[string]*querypb.BindVariable{
+		"id":   sqltypes.Int64BindVariable(1),
+		"name": sqltypes.StringBindVariable("it's"),
+	}))
+}
@@ -23,7 +23,7 @@ func (cached *TabletPlan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(128)
+		size += int64(208)
 	}
 	// field Plan *vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder.Plan
 	size += cached.Plan.CachedSize(true)
@@ -45,5 +45,63 @@ func (cached *TabletPlan) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field RowFilters []*vitess.io/vitess/go/vt/vttablet/tabletserver.TableRowFilter
+	{
+		size += int64(cap(cached.RowFilters)) * int64(8)
+		for _, elem := range cached.RowFilters {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field ColumnACLs []*vitess.io/vitess/go/vt/vttablet/tabletserver.TableColumnACL
+	{
+		size += int64(cap(cached.ColumnACLs)) * int64(8)
+		for _, elem := range cached.ColumnACLs {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field restrictedTables map[string]*vitess.io/vitess/go/vt/vttablet/tabletserver/schema.Table
+	if cached.restrictedTables != nil {
+		size += int64(48)
+		for k, v := range cached.restrictedTables {
+			size += int64(len(k))
+			size += v.CachedSize(true)
+		}
+	}
+	// field restrictedQueries map[string]*vitess.io/vitess/go/vt/sqlparser.ParsedQuery
+	if cached.restrictedQueries != nil {
+		size += int64(48)
+		for k, v := range cached.restrictedQueries {
+			size += int64(len(k))
+			size += v.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *TableColumnACL) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field ColumnACLResult *vitess.io/vitess/go/vt/tableacl.ColumnACLResult
+	size += cached.ColumnACLResult.CachedSize(true)
+	// field Table string
+	size += int64(len(cached.Table))
+	return size
+}
+func (cached *TableRowFilter) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field RowFilterResult *vitess.io/vitess/go/vt/tableacl.RowFilterResult
+	size += cached.RowFilterResult.CachedSize(true)
+	// field Table string
+	size += int64(len(cached.Table))
 	return size
 }
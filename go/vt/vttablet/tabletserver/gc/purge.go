@@ -0,0 +1,410 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/log"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/withddl"
+)
+
+const (
+	// purgeBatchSizeMin is the initial, and minimal, number of rows purged in a single DELETE
+	purgeBatchSizeMin = 50
+	// purgeBatchSizeMax is the maximal number of rows purged in a single DELETE
+	purgeBatchSizeMax = 10000
+	// purgeChunkTargetDuration is the time a single DELETE should take. Batch size grows while DELETEs are faster than that.
+	purgeChunkTargetDuration = 500 * time.Millisecond
+)
+
+const (
+	sqlCreateSidecarDB          = "CREATE DATABASE IF NOT EXISTS _vt"
+	sqlCreatePurgeProgressTable = `CREATE TABLE IF NOT EXISTS _vt.table_gc_purge (
+		table_name varchar(128) NOT NULL,
+		last_pk blob,
+		rows_purged bigint unsigned NOT NULL DEFAULT 0,
+		table_rows bigint unsigned NOT NULL DEFAULT 0,
+		started_timestamp timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_timestamp timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		PRIMARY KEY (table_name)
+	) ENGINE=InnoDB`
+	sqlReadPurgeProgress = `select
+			last_pk,
+			rows_purged,
+			table_rows,
+			unix_timestamp(started_timestamp) as started_timestamp
+		from _vt.table_gc_purge
+		where table_name=%a
+	`
+	sqlInsertPurgeProgress = "insert ignore into _vt.table_gc_purge (table_name, table_rows) values (%a, %a)"
+	sqlUpdatePurgeProgress = "update _vt.table_gc_purge set last_pk=%a, rows_purged=%a where table_name=%a"
+	sqlDeletePurgeProgress = "delete from _vt.table_gc_purge where table_name=%a"
+	sqlSelectPKColumns     = `select
+			COLUMN_NAME as column_name
+		from information_schema.STATISTICS
+		where
+			TABLE_SCHEMA=%a
+			and TABLE_NAME=%a
+			and INDEX_NAME='PRIMARY'
+		order by SEQ_IN_INDEX
+	`
+	sqlSelectTableRows = `select
+			TABLE_ROWS as table_rows
+		from information_schema.TABLES
+		where
+			TABLE_SCHEMA=%a
+			and TABLE_NAME=%a
+	`
+	sqlSelectAnyRow = "select 1 from %s limit 1"
+)
+
+var purgeWithDDL = withddl.New([]string{
+	sqlCreateSidecarDB,
+	sqlCreatePurgeProgressTable,
+})
+
+// PurgeProgress describes the progress of purging a single table
+type PurgeProgress struct {
+	Table      string
+	RowsPurged int64
+	// TableRows is the estimated number of rows in the table as purge began
+	TableRows  int64
+	Progress   float64
+	ETASeconds int64
+	BatchSize  int64
+	StartedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// tablePurger purges a single table in chunks. When the table has a primary key, each chunk is a range of
+// the primary key, which avoids rescanning deleted rows. The last purged primary key value is persisted in
+// _vt.table_gc_purge, such that the purge resumes from that point after a restart.
+type tablePurger struct {
+	conn      *dbconnpool.DBConnection
+	tableName string
+	pkColumns []string
+	// lastPK is a single row result of the last purged primary key value, or nil when purge starts from the beginning
+	lastPK    *sqltypes.Result
+	batchSize int64
+	progress  *PurgeProgress
+}
+
+// nextPurgeBatchSize adapts the purge batch size to the throttler's feedback and to the duration of the last chunk:
+// it halves the batch size when throttled or when a chunk takes too long, and otherwise doubles it
+func nextPurgeBatchSize(batchSize int64, throttled bool, chunkDuration time.Duration) int64 {
+	if throttled || chunkDuration > purgeChunkTargetDuration {
+		batchSize = batchSize / 2
+	} else {
+		batchSize = batchSize * 2
+	}
+	if batchSize < purgeBatchSizeMin {
+		return purgeBatchSizeMin
+	}
+	if batchSize > purgeBatchSizeMax {
+		return purgeBatchSizeMax
+	}
+	return batchSize
+}
+
+// estimatePurgeProgress returns the purge progress in percent, and the estimated number of seconds to completion,
+// or -1 when the rate of purge is yet unknown
+func estimatePurgeProgress(rowsPurged, tableRows int64, elapsed time.Duration) (progress float64, etaSeconds int64) {
+	if tableRows <= 0 {
+		return 0, -1
+	}
+	progress = math.Min(100, 100*float64(rowsPurged)/float64(tableRows))
+	if rowsPurged <= 0 || elapsed <= 0 {
+		return progress, -1
+	}
+	remainingRows := math.Max(0, float64(tableRows-rowsPurged))
+	rate := float64(rowsPurged) / elapsed.Seconds()
+	return progress, int64(math.Ceil(remainingRows / rate))
+}
+
+// pkTuple formats a list of escaped column names or encoded values as a row constructor
+func pkTuple(items []string) string {
+	return fmt.Sprintf("(%s)", strings.Join(items, ", "))
+}
+
+func escapedColumns(columns []string) (escaped []string) {
+	for _, column := range columns {
+		escaped = append(escaped, sqlparser.String(sqlparser.NewColIdent(column)))
+	}
+	return escaped
+}
+
+func encodedValues(row []sqltypes.Value) (encoded []string) {
+	for _, value := range row {
+		var b strings.Builder
+		value.EncodeSQLStringBuilder(&b)
+		encoded = append(encoded, b.String())
+	}
+	return encoded
+}
+
+// buildPurgeUpperBoundQuery returns a query which reads the primary key value found batchSize rows past lastPK
+func buildPurgeUpperBoundQuery(tableName string, pkColumns []string, lastPK []sqltypes.Value, batchSize int64) string {
+	columns := escapedColumns(pkColumns)
+	var b strings.Builder
+	fmt.Fprintf(&b, "select %s from %s", strings.Join(columns, ", "), sqlparser.String(sqlparser.NewTableIdent(tableName)))
+	if lastPK != nil {
+		fmt.Fprintf(&b, " where %s > %s", pkTuple(columns), pkTuple(encodedValues(lastPK)))
+	}
+	fmt.Fprintf(&b, " order by %s limit 1 offset %d", strings.Join(columns, ", "), batchSize-1)
+	return b.String()
+}
+
+// buildPurgeRangeQuery returns a DELETE statement for the rows in the primary key range (lastPK, upperPK].
+// A nil lastPK stands for the beginning of the table, and a nil upperPK for its end.
+func buildPurgeRangeQuery(tableName string, pkColumns []string, lastPK, upperPK []sqltypes.Value) string {
+	columns := pkTuple(escapedColumns(pkColumns))
+	var conditions []string
+	if lastPK != nil {
+		conditions = append(conditions, fmt.Sprintf("%s > %s", columns, pkTuple(encodedValues(lastPK))))
+	}
+	if upperPK != nil {
+		conditions = append(conditions, fmt.Sprintf("%s <= %s", columns, pkTuple(encodedValues(upperPK))))
+	}
+	query := fmt.Sprintf("delete from %s", sqlparser.String(sqlparser.NewTableIdent(tableName)))
+	if len(conditions) > 0 {
+		query = fmt.Sprintf("%s where %s", query, strings.Join(conditions, " and "))
+	}
+	return query
+}
+
+// encodeLastPK encodes a single row result as prototext, the same way vreplication encodes _vt.copy_state.lastpk
+func encodeLastPK(lastPK *sqltypes.Result) (string, error) {
+	if lastPK == nil {
+		return "", nil
+	}
+	b, err := prototext.Marshal(sqltypes.ResultToProto3(lastPK))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// decodeLastPK decodes a persisted last primary key value, and validates it against the table's primary key columns.
+// It returns nil if there's no valid value.
+func decodeLastPK(encoded string, pkColumns []string) (*sqltypes.Result, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+	var qr querypb.QueryResult
+	if err := prototext.Unmarshal([]byte(encoded), &qr); err != nil {
+		return nil, err
+	}
+	lastPK := sqltypes.Proto3ToResult(&qr)
+	if len(lastPK.Rows) != 1 || len(lastPK.Fields) != len(pkColumns) {
+		return nil, nil
+	}
+	for i, field := range lastPK.Fields {
+		if !strings.EqualFold(field.Name, pkColumns[i]) {
+			return nil, nil
+		}
+	}
+	return lastPK, nil
+}
+
+// newTablePurger reads the primary key of a table, and resumes from persisted progress, if any
+func (collector *TableGC) newTablePurger(ctx context.Context, conn *dbconnpool.DBConnection, tableName string) (*tablePurger, error) {
+	purger := &tablePurger{
+		conn:      conn,
+		tableName: tableName,
+		batchSize: purgeBatchSizeMin,
+	}
+	query, err := sqlparser.ParseAndBind(sqlSelectPKColumns,
+		sqltypes.StringBindVariable(collector.dbName),
+		sqltypes.StringBindVariable(tableName),
+	)
+	if err != nil {
+		return nil, err
+	}
+	r, err := conn.ExecuteFetch(query, math.MaxInt32, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range r.Named().Rows {
+		purger.pkColumns = append(purger.pkColumns, row.AsString("column_name", ""))
+	}
+
+	query, err = sqlparser.ParseAndBind(sqlSelectTableRows,
+		sqltypes.StringBindVariable(collector.dbName),
+		sqltypes.StringBindVariable(tableName),
+	)
+	if err != nil {
+		return nil, err
+	}
+	r, err = conn.ExecuteFetch(query, 1, true)
+	if err != nil {
+		return nil, err
+	}
+	var tableRows int64
+	if row := r.Named().Row(); row != nil {
+		tableRows = row.AsInt64("table_rows", 0)
+	}
+
+	// Progress is written with the purge connection, which may have binary logging disabled. Progress
+	// then stays local to this server, as do the purged rows.
+	query, err = sqlparser.ParseAndBind(sqlInsertPurgeProgress,
+		sqltypes.StringBindVariable(tableName),
+		sqltypes.Int64BindVariable(tableRows),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := purgeWithDDL.Exec(ctx, query, conn.ExecuteFetch); err != nil {
+		return nil, err
+	}
+	query, err = sqlparser.ParseAndBind(sqlReadPurgeProgress, sqltypes.StringBindVariable(tableName))
+	if err != nil {
+		return nil, err
+	}
+	r, err = purgeWithDDL.Exec(ctx, query, conn.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	purger.progress = &PurgeProgress{
+		Table:      tableName,
+		TableRows:  tableRows,
+		ETASeconds: -1,
+		StartedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	if row := r.Named().Row(); row != nil {
+		purger.progress.RowsPurged = row.AsInt64("rows_purged", 0)
+		purger.progress.TableRows = row.AsInt64("table_rows", tableRows)
+		purger.progress.StartedAt = time.Unix(row.AsInt64("started_timestamp", time.Now().Unix()), 0)
+		if len(purger.pkColumns) > 0 {
+			purger.lastPK, err = decodeLastPK(row.AsString("last_pk", ""), purger.pkColumns)
+			if err != nil {
+				log.Errorf("TableGC: ignoring invalid purge progress of %s: %v", tableName, err)
+				purger.lastPK = nil
+			}
+		}
+	}
+	purger.progress.BatchSize = purger.batchSize
+	purger.progress.Progress, purger.progress.ETASeconds = estimatePurgeProgress(purger.progress.RowsPurged, purger.progress.TableRows, time.Since(purger.progress.StartedAt))
+	return purger, nil
+}
+
+func (purger *tablePurger) lastPKRow() []sqltypes.Value {
+	if purger.lastPK == nil {
+		return nil
+	}
+	return purger.lastPK.Rows[0]
+}
+
+// purgeChunk deletes a single chunk of rows. It returns done=true when the table is empty.
+func (purger *tablePurger) purgeChunk() (rowsAffected int64, done bool, err error) {
+	if len(purger.pkColumns) == 0 {
+		// No primary key. We can only delete rows in arbitrary order.
+		parsed := sqlparser.BuildParsedQuery(sqlPurgeTable, purger.tableName)
+		res, err := purger.conn.ExecuteFetch(fmt.Sprintf("%s limit %d", parsed.Query, purger.batchSize), 1, true)
+		if err != nil {
+			return 0, false, err
+		}
+		return int64(res.RowsAffected), res.RowsAffected == 0, nil
+	}
+
+	upperBound, err := purger.conn.ExecuteFetch(buildPurgeUpperBoundQuery(purger.tableName, purger.pkColumns, purger.lastPKRow(), purger.batchSize), 1, true)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(upperBound.Rows) > 0 {
+		res, err := purger.conn.ExecuteFetch(buildPurgeRangeQuery(purger.tableName, purger.pkColumns, purger.lastPKRow(), upperBound.Rows[0]), 1, true)
+		if err != nil {
+			return 0, false, err
+		}
+		purger.lastPK = upperBound
+		return int64(res.RowsAffected), false, nil
+	}
+	// This is the last range
+	res, err := purger.conn.ExecuteFetch(buildPurgeRangeQuery(purger.tableName, purger.pkColumns, purger.lastPKRow(), nil), 1, true)
+	if err != nil {
+		return 0, false, err
+	}
+	// Validate the table is indeed empty. It may not be, if purge resumed from progress persisted before a failover.
+	anyRow, err := purger.conn.ExecuteFetch(sqlparser.BuildParsedQuery(sqlSelectAnyRow, sqlparser.String(sqlparser.NewTableIdent(purger.tableName))).Query, 1, false)
+	if err != nil {
+		return 0, false, err
+	}
+	purger.lastPK = nil
+	return int64(res.RowsAffected), len(anyRow.Rows) == 0, nil
+}
+
+// persistProgress records the last purged primary key value and number of purged rows
+func (purger *tablePurger) persistProgress(ctx context.Context) error {
+	lastPK, err := encodeLastPK(purger.lastPK)
+	if err != nil {
+		return err
+	}
+	query, err := sqlparser.ParseAndBind(sqlUpdatePurgeProgress,
+		sqltypes.StringBindVariable(lastPK),
+		sqltypes.Int64BindVariable(purger.progress.RowsPurged),
+		sqltypes.StringBindVariable(purger.tableName),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = purgeWithDDL.Exec(ctx, query, purger.conn.ExecuteFetch)
+	return err
+}
+
+// clearProgress removes the persisted progress of a fully purged table
+func (purger *tablePurger) clearProgress(ctx context.Context) error {
+	query, err := sqlparser.ParseAndBind(sqlDeletePurgeProgress, sqltypes.StringBindVariable(purger.tableName))
+	if err != nil {
+		return err
+	}
+	_, err = purgeWithDDL.Exec(ctx, query, purger.conn.ExecuteFetch)
+	return err
+}
+
+// setPurgeProgress publishes the progress of a purging table, in status and in stats
+func (collector *TableGC) setPurgeProgress(progress *PurgeProgress) {
+	collector.purgeMutex.Lock()
+	defer collector.purgeMutex.Unlock()
+
+	p := *progress
+	collector.purgeProgress[progress.Table] = &p
+	collector.purgeProgressPct.Set(progress.Table, int64(progress.Progress))
+	collector.purgeETASeconds.Set(progress.Table, progress.ETASeconds)
+	collector.purgedRows.Set(progress.Table, progress.RowsPurged)
+}
+
+// clearPurgeProgress removes the progress of a table which is no longer purging
+func (collector *TableGC) clearPurgeProgress(tableName string) {
+	collector.purgeMutex.Lock()
+	defer collector.purgeMutex.Unlock()
+
+	delete(collector.purgeProgress, tableName)
+	collector.purgeProgressPct.Reset(tableName)
+	collector.purgeETASeconds.Reset(tableName)
+	collector.purgedRows.Reset(tableName)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestNextPurgeBatchSize(t *testing.T) {
+	tt := []struct {
+		batchSize int64
+		throttled bool
+		duration  time.Duration
+		expect    int64
+	}{
+		{batchSize: 50, duration: 10 * time.Millisecond, expect: 100},
+		{batchSize: 100, duration: 10 * time.Millisecond, expect: 200},
+		{batchSize: 8000, duration: 10 * time.Millisecond, expect: purgeBatchSizeMax},
+		{batchSize: 200, throttled: true, expect: 100},
+		{batchSize: 50, throttled: true, expect: purgeBatchSizeMin},
+		{batchSize: 400, duration: 2 * time.Second, expect: 200},
+		{batchSize: 60, duration: 2 * time.Second, expect: purgeBatchSizeMin},
+	}
+	for _, tc := range tt {
+		assert.Equal(t, tc.expect, nextPurgeBatchSize(tc.batchSize, tc.throttled, tc.duration))
+	}
+}
+
+func TestEstimatePurgeProgress(t *testing.T) {
+	tt := []struct {
+		rowsPurged int64
+		tableRows  int64
+		elapsed    time.Duration
+		progress   float64
+		eta        int64
+	}{
+		{rowsPurged: 0, tableRows: 0, elapsed: time.Minute, progress: 0, eta: -1},
+		{rowsPurged: 0, tableRows: 1000, elapsed: time.Minute, progress: 0, eta: -1},
+		{rowsPurged: 250, tableRows: 1000, elapsed: 10 * time.Second, progress: 25, eta: 30},
+		{rowsPurged: 500, tableRows: 1000, elapsed: 0, progress: 50, eta: -1},
+		{rowsPurged: 1200, tableRows: 1000, elapsed: 10 * time.Second, progress: 100, eta: 0},
+	}
+	for _, tc := range tt {
+		progress, eta := estimatePurgeProgress(tc.rowsPurged, tc.tableRows, tc.elapsed)
+		assert.Equal(t, tc.progress, progress)
+		assert.Equal(t, tc.eta, eta)
+	}
+}
+
+func TestBuildPurgeQueries(t *testing.T) {
+	tableName := "_vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410"
+	pkColumns := []string{"id", "name"}
+	lastPK := []sqltypes.Value{sqltypes.NewInt64(7), sqltypes.NewVarChar("a'b")}
+	upperPK := []sqltypes.Value{sqltypes.NewInt64(12), sqltypes.NewVarChar("c")}
+
+	assert.Equal(t,
+		"select id, `name` from _vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410 order by id, `name` limit 1 offset 99",
+		buildPurgeUpperBoundQuery(tableName, pkColumns, nil, 100),
+	)
+	assert.Equal(t,
+		"select id, `name` from _vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410 where (id, `name`) > (7, 'a\\'b') order by id, `name` limit 1 offset 49",
+		buildPurgeUpperBoundQuery(tableName, pkColumns, lastPK, 50),
+	)
+	assert.Equal(t,
+		"delete from _vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410 where (id, `name`) <= (12, 'c')",
+		buildPurgeRangeQuery(tableName, pkColumns, nil, upperPK),
+	)
+	assert.Equal(t,
+		"delete from _vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410 where (id, `name`) > (7, 'a\\'b') and (id, `name`) <= (12, 'c')",
+		buildPurgeRangeQuery(tableName, pkColumns, lastPK, upperPK),
+	)
+	assert.Equal(t,
+		"delete from _vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410 where (id, `name`) > (7, 'a\\'b')",
+		buildPurgeRangeQuery(tableName, pkColumns, lastPK, nil),
+	)
+}
+
+func TestEncodeDecodeLastPK(t *testing.T) {
+	lastPK := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"), "7|abc")
+
+	encoded, err := encodeLastPK(lastPK)
+	require.NoError(t, err)
+	assert.NotEmpty(t, encoded)
+
+	decoded, err := decodeLastPK(encoded, []string{"id", "name"})
+	require.NoError(t, err)
+	require.NotNil(t, decoded)
+	assert.Equal(t, lastPK.Rows, decoded.Rows)
+
+	// primary key has since changed
+	decoded, err = decodeLastPK(encoded, []string{"id"})
+	require.NoError(t, err)
+	assert.Nil(t, decoded)
+
+	decoded, err = decodeLastPK("", []string{"id", "name"})
+	require.NoError(t, err)
+	assert.Nil(t, decoded)
+
+	encoded, err = encodeLastPK(nil)
+	require.NoError(t, err)
+	assert.Empty(t, encoded)
+}
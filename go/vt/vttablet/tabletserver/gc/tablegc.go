@@ -28,9 +28,11 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -57,12 +59,19 @@ var purgeReentranceInterval = flag.Duration("gc_purge_check_interval", 1*time.Mi
 var gcLifecycle = flag.String("table_gc_lifecycle", "hold,purge,evac,drop", "States for a DROP TABLE garbage collection cycle. Default is 'hold,purge,evac,drop', use any subset ('drop' implcitly always included)")
 
 var (
-	sqlPurgeTable       = `delete from %a limit 50`
-	sqlShowVtTables     = `show tables like '\_vt\_%'`
+	sqlPurgeTable       = `delete from %a`
+	sqlShowVtTables     = `show full tables like '\_vt\_%'`
 	sqlDropTable        = "drop table if exists `%a`"
+	sqlDropView         = "drop view if exists `%a`"
 	purgeReentranceFlag int64
 )
 
+// gcTable is a table or a view that is due to be dropped
+type gcTable struct {
+	tableName string
+	isView    bool
+}
+
 // transitionRequest encapsulates a request to transition a table to next state
 type transitionRequest struct {
 	fromTableName string
@@ -103,12 +112,17 @@ type TableGC struct {
 	tickers [](*timer.SuspendableTicker)
 
 	purgingTables          map[string]bool
-	dropTablesChan         chan string
+	purgeProgress          map[string]*PurgeProgress
+	dropTablesChan         chan *gcTable
 	transitionRequestsChan chan *transitionRequest
 	purgeRequestsChan      chan bool
 	// lifecycleStates indicates what states a GC table goes through. The user can set
 	// this with -table_gc_lifecycle, such that some states can be skipped.
 	lifecycleStates map[schema.TableGCState]bool
+
+	purgeProgressPct *stats.GaugesWithSingleLabel
+	purgeETASeconds  *stats.GaugesWithSingleLabel
+	purgedRows       *stats.GaugesWithSingleLabel
 }
 
 // GCStatus published some status valus from the collector
@@ -120,6 +134,8 @@ type GCStatus struct {
 	IsOpen    bool
 
 	purgingTables []string
+
+	Purges []*PurgeProgress
 }
 
 // NewTableGC creates a table collector
@@ -140,9 +156,14 @@ func NewTableGC(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topoda
 		tickers: [](*timer.SuspendableTicker){},
 
 		purgingTables:          map[string]bool{},
-		dropTablesChan:         make(chan string),
+		purgeProgress:          map[string]*PurgeProgress{},
+		dropTablesChan:         make(chan *gcTable),
 		transitionRequestsChan: make(chan *transitionRequest),
 		purgeRequestsChan:      make(chan bool),
+
+		purgeProgressPct: env.Exporter().NewGaugesWithSingleLabel("TableGCPurgeProgress", "Estimated purge progress of a GC table, in percent", "Table"),
+		purgeETASeconds:  env.Exporter().NewGaugesWithSingleLabel("TableGCPurgeETASeconds", "Estimated seconds until purge of a GC table completes", "Table"),
+		purgedRows:       env.Exporter().NewGaugesWithSingleLabel("TableGCPurgedRows", "Number of rows purged from a GC table", "Table"),
 	}
 
 	return collector
@@ -260,10 +281,10 @@ func (collector *TableGC) Operate(ctx context.Context) {
 					}
 				}()
 			}
-		case dropTableName := <-collector.dropTablesChan:
+		case dropTable := <-collector.dropTablesChan:
 			{
-				if err := collector.dropTable(ctx, dropTableName); err != nil {
-					log.Errorf("TableGC: error dropping table %s: %+v", dropTableName, err)
+				if err := collector.dropTable(ctx, dropTable); err != nil {
+					log.Errorf("TableGC: error dropping table %s: %+v", dropTable.tableName, err)
 				}
 			}
 		case transition := <-collector.transitionRequestsChan:
@@ -368,6 +389,7 @@ func (collector *TableGC) checkTables(ctx context.Context) error {
 
 	for _, row := range res.Rows {
 		tableName := row[0].ToString()
+		isView := row[1].ToString() == tmutils.TableView
 
 		shouldTransition, state, uuid, err := collector.shouldTransitionTable(tableName)
 
@@ -382,6 +404,12 @@ func (collector *TableGC) checkTables(ctx context.Context) error {
 
 		log.Infof("TableGC: will operate on table %s", tableName)
 
+		if isView {
+			// Views, such as those renamed away by online DDL, hold no data. There's nothing to purge
+			// or evacuate, and they are dropped as soon as their hold period expires.
+			go func() { collector.dropTablesChan <- &gcTable{tableName: tableName, isView: true} }()
+			continue
+		}
 		if state == schema.HoldTableGCState {
 			// Hold period expired. Moving to next state
 			collector.submitTransitionRequest(ctx, state, tableName, uuid)
@@ -396,7 +424,7 @@ func (collector *TableGC) checkTables(ctx context.Context) error {
 		}
 		if state == schema.DropTableGCState {
 			// This table needs to be dropped immediately.
-			go func() { collector.dropTablesChan <- tableName }()
+			go func() { collector.dropTablesChan <- &gcTable{tableName: tableName} }()
 		}
 	}
 
@@ -466,21 +494,40 @@ func (collector *TableGC) purge(ctx context.Context) (tableName string, err erro
 		}
 	}()
 
-	log.Infof("TableGC: purge begin for %s", tableName)
+	purger, err := collector.newTablePurger(ctx, conn, tableName)
+	if err != nil {
+		return tableName, err
+	}
+	defer collector.clearPurgeProgress(tableName)
+
+	log.Infof("TableGC: purge begin for %s, from %d purged rows", tableName, purger.progress.RowsPurged)
 	for {
 		if !collector.throttlerClient.ThrottleCheckOKOrWait(ctx) {
+			purger.batchSize = nextPurgeBatchSize(purger.batchSize, true, 0)
+			if ctx.Err() != nil {
+				return tableName, ctx.Err()
+			}
 			continue
 		}
 		// OK, we're clear to go!
 
 		// Issue a DELETE
-		parsed := sqlparser.BuildParsedQuery(sqlPurgeTable, tableName)
-		res, err := conn.ExecuteFetch(parsed.Query, 1, true)
+		chunkStartTime := time.Now()
+		rowsAffected, done, err := purger.purgeChunk()
 		if err != nil {
 			return tableName, err
 		}
-		if res.RowsAffected == 0 {
+		purger.progress.RowsPurged += rowsAffected
+		purger.progress.UpdatedAt = time.Now()
+		purger.progress.BatchSize = purger.batchSize
+		purger.progress.Progress, purger.progress.ETASeconds = estimatePurgeProgress(purger.progress.RowsPurged, purger.progress.TableRows, time.Since(purger.progress.StartedAt))
+		collector.setPurgeProgress(purger.progress)
+
+		if done {
 			// The table is now empty!
+			if err := purger.clearProgress(ctx); err != nil {
+				log.Errorf("TableGC: error clearing purge progress of %s: %+v", tableName, err)
+			}
 			// we happen to know at this time that the table is in PURGE state,
 			// I mean, that's why we're here. We can hard code that.
 			_, _, uuid, _, _ := schema.AnalyzeGCTableName(tableName)
@@ -488,16 +535,20 @@ func (collector *TableGC) purge(ctx context.Context) (tableName string, err erro
 			collector.removePurgingTable(tableName)
 			// finished with this table. Maybe more tables are looking to be purged.
 			// Trigger another call to purge(), instead of waiting a full purgeReentranceInterval cycle
-			log.Infof("TableGC: purge complete for %s", tableName)
+			log.Infof("TableGC: purge complete for %s, %d rows purged", tableName, purger.progress.RowsPurged)
 			time.AfterFunc(time.Second, func() { collector.purgeRequestsChan <- true })
 			return tableName, nil
 		}
+		if err := purger.persistProgress(ctx); err != nil {
+			return tableName, err
+		}
+		purger.batchSize = nextPurgeBatchSize(purger.batchSize, false, time.Since(chunkStartTime))
 	}
 }
 
-// dropTable runs an actual DROP TABLE statement, and marks the end of the line for the
+// dropTable runs an actual DROP TABLE (or DROP VIEW) statement, and marks the end of the line for the
 // tables' GC lifecycle.
-func (collector *TableGC) dropTable(ctx context.Context, tableName string) error {
+func (collector *TableGC) dropTable(ctx context.Context, table *gcTable) error {
 	if atomic.LoadInt64(&collector.isPrimary) == 0 {
 		return nil
 	}
@@ -508,14 +559,18 @@ func (collector *TableGC) dropTable(ctx context.Context, tableName string) error
 	}
 	defer conn.Recycle()
 
-	parsed := sqlparser.BuildParsedQuery(sqlDropTable, tableName)
+	dropStatement := sqlDropTable
+	if table.isView {
+		dropStatement = sqlDropView
+	}
+	parsed := sqlparser.BuildParsedQuery(dropStatement, table.tableName)
 
-	log.Infof("TableGC: dropping table: %s", tableName)
+	log.Infof("TableGC: dropping table: %s", table.tableName)
 	_, err = conn.Exec(ctx, parsed.Query, 1, true)
 	if err != nil {
 		return err
 	}
-	log.Infof("TableGC: dropped table: %s", tableName)
+	log.Infof("TableGC: dropped table: %s", table.tableName)
 	return nil
 }
 
@@ -612,6 +667,10 @@ func (collector *TableGC) Status() *GCStatus {
 	for tableName := range collector.purgingTables {
 		status.purgingTables = append(status.purgingTables, tableName)
 	}
+	for _, progress := range collector.purgeProgress {
+		p := *progress
+		status.Purges = append(status.Purges, &p)
+	}
 
 	return status
 }
@@ -46,8 +46,8 @@ func (mh messageHeap) Len() int {
 }
 
 func (mh messageHeap) Less(i, j int) bool {
-	// Lower epoch is more important.
-	// If epochs match, newer messages are more important.
+	// Lower priority is more important.
+	// If priorities match, newer messages are more important.
 	return mh[i].Priority < mh[j].Priority ||
 		(mh[i].Priority == mh[j].Priority && mh[i].TimeNext > mh[j].TimeNext)
 }
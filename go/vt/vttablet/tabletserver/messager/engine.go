@@ -39,6 +39,7 @@ type TabletService interface {
 	tabletenv.Env
 	PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error)
 	PurgeMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error)
+	DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (movedIDs []string, err error)
 }
 
 // VStreamer defines  the functions of VStreamer
@@ -134,6 +135,18 @@ func (me *Engine) GenerateAckQuery(name string, ids []string) (string, map[strin
 	return query, bv, nil
 }
 
+// AckMessages acks the messages of the table, or reschedules them if they are recurring.
+// The queries are run with execute, which must run them in a single transaction.
+func (me *Engine) AckMessages(name string, ids []string, execute func(query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error)) (int64, error) {
+	me.mu.Lock()
+	mm := me.managers[name]
+	me.mu.Unlock()
+	if mm == nil {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	return mm.AckMessages(ids, execute)
+}
+
 // GeneratePostponeQuery returns the query and bind vars for postponing a message.
 func (me *Engine) GeneratePostponeQuery(name string, ids []string) (string, map[string]*querypb.BindVariable, error) {
 	me.mu.Lock()
@@ -146,6 +159,22 @@ func (me *Engine) GeneratePostponeQuery(name string, ids []string) (string, map[
 	return query, bv, nil
 }
 
+// DeadLetterMessages moves the messages of the table that exceeded max_retries to
+// the dead letter table, and returns the ids of the moved messages. The queries are
+// run with execute, which must run them in a single transaction.
+func (me *Engine) DeadLetterMessages(name string, ids []string, execute func(query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error)) ([]string, error) {
+	me.mu.Lock()
+	mm := me.managers[name]
+	me.mu.Unlock()
+	if mm == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	if !mm.HasDeadLetterTable() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "message table %s has no dead letter table", name)
+	}
+	return mm.DeadLetterMessages(ids, execute)
+}
+
 // GeneratePurgeQuery returns the query and bind vars for purging messages.
 func (me *Engine) GeneratePurgeQuery(name string, timeCutoff int64) (string, map[string]*querypb.BindVariable, error) {
 	me.mu.Lock()
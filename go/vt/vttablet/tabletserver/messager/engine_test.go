@@ -167,6 +167,14 @@ func TestEngineGenerate(t *testing.T) {
 	if _, _, err := engine.GeneratePurgeQuery("t2", 0); err == nil || err.Error() != want {
 		t.Errorf("engine.GeneratePurgeQuery(invalid): %v, want %s", err, want)
 	}
+
+	if _, err := engine.DeadLetterMessages("t2", []string{"1"}, nil); err == nil || err.Error() != want {
+		t.Errorf("engine.DeadLetterMessages(invalid): %v, want %s", err, want)
+	}
+	want = "message table t1 has no dead letter table"
+	if _, err := engine.DeadLetterMessages("t1", []string{"1"}, nil); err == nil || err.Error() != want {
+		t.Errorf("engine.DeadLetterMessages(no dead letter table): %v, want %s", err, want)
+	}
 }
 
 func newTestEngine(db *fakesqldb.DB) *Engine {
@@ -50,6 +50,11 @@ var (
 		[]string{"TableName", "Metric"})
 )
 
+// DeadLetteredColumn is the extra column streamed for the messages of tables
+// that have a dead letter table. It's 1 for the messages that were moved to
+// the dead letter table, and 0 otherwise.
+const DeadLetteredColumn = "vt_dead_lettered"
+
 type messageReceiver struct {
 	ctx     context.Context
 	errChan chan error
@@ -161,6 +166,29 @@ type receiverWithStatus struct {
 // The Purge thread
 // This thread is mostly independent. It wakes up periodically
 // to delete old rows that were successfully acked.
+//
+// Priorities
+// The cache is ordered by the priority column of the messages, lower values
+// first, and the poller reads the pending messages in the same order. So, the
+// messages of a tablet are sent by priority. vtgate merges the streams of the
+// shards as they come: there's no ordering across shards.
+//
+// Dead letters
+// If the table has a max_retries, the send loop does not send the
+// messages that were already sent more than max_retries times.
+// Instead, they are moved to the dead letter table in a single
+// transaction. This is done asynchronously, like postpones, and
+// limited by the same semaphore. The messages of such tables are streamed
+// with an extra vt_dead_lettered column, which is 0 for the messages sent.
+// Once messages are moved, the send loop reports them to the clients with
+// vt_dead_lettered set to 1, so that the clients know they will not be
+// sent again.
+//
+// Recurring messages
+// If the table has the recurrence and time_scheduled columns, a message
+// with a recurrence is rescheduled to its next firing when it's acked,
+// instead of being marked as acked. The firings that were missed while
+// the message was not acked are handled according to the catch-up policy.
 type messageManager struct {
 	tsv TabletService
 	vs  VStreamer
@@ -171,6 +199,8 @@ type messageManager struct {
 	purgeAfter   time.Duration
 	minBackoff   time.Duration
 	maxBackoff   time.Duration
+	maxRetries   int64
+	catchup      string
 	batchSize    int
 	pollerTicks  *timer.Timer
 	purgeTicks   *timer.Timer
@@ -178,13 +208,16 @@ type messageManager struct {
 
 	mu     sync.Mutex
 	isOpen bool
-	// cond waits on curReceiver == -1 || cache.IsEmpty():
-	// No current receivers available or cache is empty.
+	// cond waits on curReceiver == -1 || (cache.IsEmpty() && len(deadLettered) == 0):
+	// No current receivers available or nothing to send.
 	cond            sync.Cond
 	cache           *cache
 	receivers       []*receiverWithStatus
 	curReceiver     int
 	messagesPending bool
+	// deadLettered are the messages moved to the dead letter table
+	// which were not yet reported to the receivers.
+	deadLettered [][]sqltypes.Value
 
 	// streamMu keeps the cache and database consistent with each other.
 	// Specifically:
@@ -214,6 +247,11 @@ type messageManager struct {
 	ackQuery                  *sqlparser.ParsedQuery
 	postponeQuery             *sqlparser.ParsedQuery
 	purgeQuery                *sqlparser.ParsedQuery
+	deadLetterReadQuery       *sqlparser.ParsedQuery
+	deadLetterInsertQuery     *sqlparser.ParsedQuery
+	deadLetterDeleteQuery     *sqlparser.ParsedQuery
+	readRecurrenceQuery       *sqlparser.ParsedQuery
+	rescheduleQuery           *sqlparser.ParsedQuery
 }
 
 // newMessageManager creates a new message manager.
@@ -231,6 +269,8 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		purgeAfter:      table.MessageInfo.PurgeAfterDuration,
 		minBackoff:      table.MessageInfo.MinBackoff,
 		maxBackoff:      table.MessageInfo.MaxBackoff,
+		maxRetries:      int64(table.MessageInfo.MaxRetries),
+		catchup:         table.MessageInfo.RecurrenceCatchup,
 		batchSize:       table.MessageInfo.BatchSize,
 		cache:           newCache(table.MessageInfo.CacheSize),
 		pollerTicks:     timer.NewTimer(table.MessageInfo.PollInterval),
@@ -259,6 +299,37 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 
 	mm.postponeQuery = buildPostponeQuery(mm.name, mm.minBackoff, mm.maxBackoff)
 
+	if table.MessageInfo.DeadLetterTable != "" {
+		// The messages are copied with all their columns, including
+		// the ones that are hidden from the subscribers.
+		allColumnList := buildColumnList(table.Fields)
+		deadLetterTable := sqlparser.NewTableIdent(table.MessageInfo.DeadLetterTable)
+		mm.fieldResult = &sqltypes.Result{
+			Fields: append(append([]*querypb.Field(nil), table.MessageInfo.Fields...), &querypb.Field{
+				Name: DeadLetteredColumn,
+				Type: sqltypes.Int64,
+			}),
+		}
+		mm.deadLetterReadQuery = sqlparser.BuildParsedQuery(
+			"select id from %v where id in %a and epoch > %a and time_acked is null for update",
+			mm.name, "::ids", ":max_retries")
+		mm.deadLetterInsertQuery = sqlparser.BuildParsedQuery(
+			"insert into %v(%s) select %s from %v where id in %a and epoch > %a and time_acked is null",
+			deadLetterTable, allColumnList, allColumnList, mm.name, "::ids", ":max_retries")
+		mm.deadLetterDeleteQuery = sqlparser.BuildParsedQuery(
+			"delete from %v where id in %a and epoch > %a and time_acked is null",
+			mm.name, "::ids", ":max_retries")
+	}
+
+	if table.MessageInfo.Recurring {
+		mm.readRecurrenceQuery = sqlparser.BuildParsedQuery(
+			"select id, recurrence, time_scheduled from %v where id in %a and time_acked is null for update",
+			mm.name, "::ids")
+		mm.rescheduleQuery = sqlparser.BuildParsedQuery(
+			"update %v set time_next = %a, time_scheduled = %a, epoch = 0 where id = %a and time_acked is null",
+			mm.name, ":time_next", ":time_next", ":id")
+	}
+
 	return mm
 }
 
@@ -311,8 +382,13 @@ func buildPostponeQuery(name sqlparser.TableIdent, minBackoff, maxBackoff time.D
 // buildSelectColumnList is a convenience function that
 // builds a 'select' list for the user-defined columns.
 func buildSelectColumnList(t *schema.Table) string {
+	return buildColumnList(t.MessageInfo.Fields)
+}
+
+// buildColumnList builds a column list for the fields.
+func buildColumnList(fields []*querypb.Field) string {
 	buf := sqlparser.NewTrackedBuffer(nil)
-	for i, c := range t.MessageInfo.Fields {
+	for i, c := range fields {
 		// Column names may have to be escaped.
 		if i == 0 {
 			buf.Myprintf("%v", sqlparser.NewColIdent(c.Name))
@@ -357,6 +433,7 @@ func (mm *messageManager) Close() {
 	mm.receivers = nil
 	MessageStats.Set([]string{mm.name.String(), "ClientCount"}, 0)
 	mm.cache.Clear()
+	mm.deadLettered = nil
 	// This broadcast will cause runSend to exit.
 	mm.cond.Broadcast()
 	mm.mu.Unlock()
@@ -491,6 +568,7 @@ func (mm *messageManager) runSend() {
 		mm.mu.Lock()
 
 		var rows [][]sqltypes.Value
+		var ids []string
 		for {
 			if !mm.isOpen {
 				return
@@ -508,32 +586,50 @@ func (mm *messageManager) runSend() {
 				go mm.pollerTicks.Trigger()
 			}
 
-			// If there are no receivers or cache is empty, we wait.
-			if mm.curReceiver == -1 || mm.cache.IsEmpty() {
+			// If there are no receivers or nothing to send, we wait.
+			if mm.curReceiver == -1 || (mm.cache.IsEmpty() && len(mm.deadLettered) == 0) {
 				mm.cond.Wait()
 				continue
 			}
 
+			// Report the dead lettered messages first.
+			n := len(mm.deadLettered)
+			if n > mm.batchSize {
+				n = mm.batchSize
+			}
+			rows = append(rows, mm.deadLettered[:n]...)
+			mm.deadLettered = mm.deadLettered[n:]
+
 			// Fetch rows from cache.
 			lateCount := int64(0)
-			for i := 0; i < mm.batchSize; i++ {
+			var deadRows [][]sqltypes.Value
+			for i := len(rows); i < mm.batchSize; i++ {
 				mr := mm.cache.Pop()
 				if mr == nil {
 					break
 				}
+				if mm.maxRetries > 0 && mr.Epoch > mm.maxRetries {
+					deadRows = append(deadRows, mr.Row)
+					continue
+				}
 				if mr.Epoch >= 1 {
 					lateCount++
 				}
-				rows = append(rows, mr.Row)
+				ids = append(ids, mr.Row[0].ToString())
+				rows = append(rows, mm.streamedRow(mr.Row, 0))
 			}
 			MessageStats.Add([]string{mm.name.String(), "Delayed"}, lateCount)
+			if deadRows != nil {
+				mm.wg.Add(1)
+				go mm.deadLetter(deadRows)
+			}
 
 			// If we have rows to send, break out of this loop.
 			if rows != nil {
 				break
 			}
 		}
-		MessageStats.Add([]string{mm.name.String(), "Sent"}, int64(len(rows)))
+		MessageStats.Add([]string{mm.name.String(), "Sent"}, int64(len(ids)))
 		// If we're here, there is a current receiver, and messages
 		// to send. Reserve the receiver and find the next one.
 		receiver := mm.receivers[mm.curReceiver]
@@ -542,21 +638,28 @@ func (mm *messageManager) runSend() {
 
 		// Send the message asynchronously.
 		mm.wg.Add(1)
-		go mm.send(receiver, &sqltypes.Result{Rows: rows})
+		go mm.send(receiver, &sqltypes.Result{Rows: rows}, ids)
+	}
+}
+
+// streamedRow returns the row of a message as it's streamed. For tables with
+// a dead letter table, it adds the vt_dead_lettered column.
+func (mm *messageManager) streamedRow(row []sqltypes.Value, deadLettered int64) []sqltypes.Value {
+	if mm.deadLetterReadQuery == nil {
+		return row
 	}
+	// Copy the row, which is shared with the cache.
+	return append(row[:len(row):len(row)], sqltypes.NewInt64(deadLettered))
 }
 
-func (mm *messageManager) send(receiver *receiverWithStatus, qr *sqltypes.Result) {
+// send sends the rows to the receiver, and postpones the messages of ids, which
+// exclude the dead lettered messages that are reported.
+func (mm *messageManager) send(receiver *receiverWithStatus, qr *sqltypes.Result, ids []string) {
 	defer func() {
 		mm.tsv.LogError()
 		mm.wg.Done()
 	}()
 
-	ids := make([]string, len(qr.Rows))
-	for i, row := range qr.Rows {
-		ids[i] = row[0].ToString()
-	}
-
 	defer func() {
 		// Hold streamMu to prevent the ids from being discarded
 		// if poller is active. Otherwise, it could have read a
@@ -585,6 +688,9 @@ func (mm *messageManager) send(receiver *receiverWithStatus, qr *sqltypes.Result
 		// big", we'll end up spamming non-stop.
 		log.Errorf("Error sending messages: %v: %v", qr, err)
 	}
+	if len(ids) == 0 {
+		return
+	}
 	mm.postpone(mm.tsv, mm.name.String(), mm.ackWaitTime, ids)
 }
 
@@ -603,6 +709,65 @@ func (mm *messageManager) postpone(tsv TabletService, name string, ackWaitTime t
 	}
 }
 
+// deadLetter moves the messages that exceeded max_retries to the dead letter table,
+// and queues the moved ones to be reported to the receivers.
+// If the move fails, the messages are left in the message table, and the poller
+// will eventually retry.
+func (mm *messageManager) deadLetter(rows [][]sqltypes.Value) {
+	defer func() {
+		mm.tsv.LogError()
+		mm.wg.Done()
+	}()
+
+	ids := make([]string, len(rows))
+	for i, row := range rows {
+		ids[i] = row[0].ToString()
+	}
+
+	defer func() {
+		// Like in send, hold streamMu to prevent the poller
+		// from requeuing a snapshot of the moved rows.
+		mm.streamMu.Lock()
+		defer mm.streamMu.Unlock()
+		mm.cache.Discard(ids)
+	}()
+
+	if !mm.postponeSema.Acquire() {
+		// Unreachable.
+		return
+	}
+	defer mm.postponeSema.Release()
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), mm.ackWaitTime)
+	defer cancel()
+	movedIDs, err := mm.tsv.DeadLetterMessages(ctx, nil, mm.name.String(), ids)
+	if err != nil {
+		MessageStats.Add([]string{mm.name.String(), "DeadLetterFailed"}, 1)
+		log.Errorf("Unable to move messages of %v to the dead letter table: %v", mm.name, err)
+		return
+	}
+	MessageStats.Add([]string{mm.name.String(), "DeadLettered"}, int64(len(movedIDs)))
+	if len(movedIDs) == 0 {
+		return
+	}
+
+	// Messages that were acked in the meantime are not moved, and not reported.
+	moved := make(map[string]bool, len(movedIDs))
+	for _, id := range movedIDs {
+		moved[id] = true
+	}
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+	if !mm.isOpen || len(mm.receivers) == 0 {
+		return
+	}
+	for _, row := range rows {
+		if moved[row[0].ToString()] {
+			mm.deadLettered = append(mm.deadLettered, mm.streamedRow(row, 1))
+		}
+	}
+	mm.cond.Broadcast()
+}
+
 func (mm *messageManager) startVStream() {
 	mm.streamMu.Lock()
 	defer mm.streamMu.Unlock()
@@ -816,8 +981,8 @@ func purge(tsv TabletService, name string, purgeAfter, purgeInterval time.Durati
 	}
 }
 
-// GenerateAckQuery returns the query and bind vars for acking a message.
-func (mm *messageManager) GenerateAckQuery(ids []string) (string, map[string]*querypb.BindVariable) {
+// buildIDsBindVariable builds the tuple bind variable of the message ids.
+func buildIDsBindVariable(ids []string) *querypb.BindVariable {
 	idbvs := &querypb.BindVariable{
 		Type:   querypb.Type_TUPLE,
 		Values: make([]*querypb.Value, 0, len(ids)),
@@ -828,31 +993,104 @@ func (mm *messageManager) GenerateAckQuery(ids []string) (string, map[string]*qu
 			Value: []byte(id),
 		})
 	}
+	return idbvs
+}
+
+// GenerateAckQuery returns the query and bind vars for acking a message.
+func (mm *messageManager) GenerateAckQuery(ids []string) (string, map[string]*querypb.BindVariable) {
 	return mm.ackQuery.Query, map[string]*querypb.BindVariable{
 		"time_acked": sqltypes.Int64BindVariable(time.Now().UnixNano()),
-		"ids":        idbvs,
+		"ids":        buildIDsBindVariable(ids),
 	}
 }
 
-// GeneratePostponeQuery returns the query and bind vars for postponing a message.
-func (mm *messageManager) GeneratePostponeQuery(ids []string) (string, map[string]*querypb.BindVariable) {
-	idbvs := &querypb.BindVariable{
-		Type:   querypb.Type_TUPLE,
-		Values: make([]*querypb.Value, 0, len(ids)),
-	}
-	for _, id := range ids {
-		idbvs.Values = append(idbvs.Values, &querypb.Value{
-			Type:  querypb.Type_VARBINARY,
-			Value: []byte(id),
+// AckMessages acks the messages, and returns the number of messages acked.
+// Recurring messages are rescheduled instead. The queries are run
+// with execute, which must run them in a single transaction.
+func (mm *messageManager) AckMessages(ids []string, execute func(query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error)) (int64, error) {
+	ackIDs := ids
+	count := int64(0)
+	if mm.readRecurrenceQuery != nil {
+		qr, err := execute(mm.readRecurrenceQuery.Query, map[string]*querypb.BindVariable{
+			"ids": buildIDsBindVariable(ids),
 		})
+		if err != nil {
+			return 0, err
+		}
+		now := time.Now()
+		ackIDs = nil
+		rescheduled, missed := int64(0), int64(0)
+		for _, row := range qr.Rows {
+			id := row[0].ToString()
+			next, rowMissed, ok := mm.nextFiring(id, row[1], row[2], now)
+			if !ok {
+				ackIDs = append(ackIDs, id)
+				continue
+			}
+			updated, err := execute(mm.rescheduleQuery.Query, map[string]*querypb.BindVariable{
+				"time_next": sqltypes.Int64BindVariable(next.UnixNano()),
+				"id":        sqltypes.ValueBindVariable(row[0]),
+			})
+			if err != nil {
+				return 0, err
+			}
+			count += int64(updated.RowsAffected)
+			rescheduled += int64(updated.RowsAffected)
+			missed += rowMissed
+		}
+		// The stats may be off if the transaction fails to commit.
+		MessageStats.Add([]string{mm.name.String(), "Rescheduled"}, rescheduled)
+		MessageStats.Add([]string{mm.name.String(), "MissedFirings"}, missed)
+		if len(ackIDs) == 0 {
+			return count, nil
+		}
+	}
+	query, bindVars := mm.GenerateAckQuery(ackIDs)
+	qr, err := execute(query, bindVars)
+	if err != nil {
+		return 0, err
 	}
+	return count + int64(qr.RowsAffected), nil
+}
 
+// nextFiring returns the next firing of a recurring message, and the number of
+// firings that were missed. It returns false if the message must be acked, because
+// it has no recurrence, or it has an invalid recurrence, or it does not fire any more.
+func (mm *messageManager) nextFiring(id string, spec, timeScheduled sqltypes.Value, now time.Time) (time.Time, int64, bool) {
+	if spec.IsNull() || spec.ToString() == "" {
+		return time.Time{}, 0, false
+	}
+	r, err := parseRecurrence(spec.ToString())
+	if err != nil {
+		MessageStats.Add([]string{mm.name.String(), "InvalidRecurrence"}, 1)
+		log.Errorf("Acking message %s of %v: %v", id, mm.name, err)
+		return time.Time{}, 0, false
+	}
+	scheduled := now
+	if !timeScheduled.IsNull() {
+		v, err := evalengine.ToInt64(timeScheduled)
+		if err != nil {
+			MessageStats.Add([]string{mm.name.String(), "InvalidRecurrence"}, 1)
+			log.Errorf("Acking message %s of %v: invalid time_scheduled: %v", id, mm.name, err)
+			return time.Time{}, 0, false
+		}
+		scheduled = time.Unix(0, v)
+	}
+	next, missed := reschedule(r, mm.catchup, scheduled, now)
+	if next.IsZero() {
+		return time.Time{}, 0, false
+	}
+	return next, missed, true
+}
+
+// GeneratePostponeQuery returns the query and bind vars for postponing a message.
+func (mm *messageManager) GeneratePostponeQuery(ids []string) (string, map[string]*querypb.BindVariable) {
 	bvs := map[string]*querypb.BindVariable{
 		"time_now":    sqltypes.Int64BindVariable(time.Now().UnixNano()),
 		"wait_time":   sqltypes.Int64BindVariable(int64(mm.ackWaitTime)),
 		"min_backoff": sqltypes.Int64BindVariable(int64(mm.minBackoff)),
 		"jitter":      sqltypes.Float64BindVariable(.666666 + rand.Float64()*.666666),
-		"ids":         idbvs,
+		"ids":         buildIDsBindVariable(ids),
 	}
 
 	if mm.maxBackoff > 0 {
@@ -869,6 +1107,42 @@ func (mm *messageManager) GeneratePurgeQuery(timeCutoff int64) (string, map[stri
 	}
 }
 
+// HasDeadLetterTable returns true if the table has a dead letter table.
+func (mm *messageManager) HasDeadLetterTable() bool {
+	return mm.deadLetterReadQuery != nil
+}
+
+// DeadLetterMessages moves the messages that exceeded max_retries to the dead letter
+// table, and returns the ids of the moved messages. The queries are run with execute,
+// which must run them in a single transaction.
+func (mm *messageManager) DeadLetterMessages(ids []string, execute func(query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error)) ([]string, error) {
+	qr, err := execute(mm.deadLetterReadQuery.Query, map[string]*querypb.BindVariable{
+		"ids":         buildIDsBindVariable(ids),
+		"max_retries": sqltypes.Int64BindVariable(mm.maxRetries),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+	movedIDs := make([]string, len(qr.Rows))
+	for i, row := range qr.Rows {
+		movedIDs[i] = row[0].ToString()
+	}
+	bvs := map[string]*querypb.BindVariable{
+		"ids":         buildIDsBindVariable(movedIDs),
+		"max_retries": sqltypes.Int64BindVariable(mm.maxRetries),
+	}
+	if _, err := execute(mm.deadLetterInsertQuery.Query, bvs); err != nil {
+		return nil, err
+	}
+	if _, err := execute(mm.deadLetterDeleteQuery.Query, bvs); err != nil {
+		return nil, err
+	}
+	return movedIDs, nil
+}
+
 // BuildMessageRow builds a MessageRow for a db row.
 func BuildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
 	mr := &MessageRow{Row: row[4:]}
@@ -22,6 +22,7 @@ import (
 	"io"
 	"reflect"
 	"runtime"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -33,6 +34,7 @@ import (
 	"context"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
@@ -96,6 +98,21 @@ func newMMTableWithBackoff() *schema.Table {
 	}
 }
 
+func newMMTableWithDeadLetter() *schema.Table {
+	table := newMMTable()
+	table.Fields = []*querypb.Field{
+		{Name: "id", Type: sqltypes.VarBinary},
+		{Name: "priority", Type: sqltypes.Int64},
+		{Name: "time_next", Type: sqltypes.Int64},
+		{Name: "epoch", Type: sqltypes.Int64},
+		{Name: "time_acked", Type: sqltypes.Int64},
+		{Name: "message", Type: sqltypes.VarBinary},
+	}
+	table.MessageInfo.MaxRetries = 2
+	table.MessageInfo.DeadLetterTable = "foo_dlq"
+	return table
+}
+
 func newMMRow(id int64) *querypb.Row {
 	return sqltypes.RowToProto3([]sqltypes.Value{
 		sqltypes.NewInt64(1),
@@ -299,6 +316,82 @@ func TestMessageManagerSend(t *testing.T) {
 	<-r1.ch
 }
 
+func TestMessageManagerDeadLetter(t *testing.T) {
+	tsv := newFakeTabletServer()
+	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTableWithDeadLetter(), sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	wantFields := &sqltypes.Result{
+		Fields: append(append([]*querypb.Field(nil), testFields...), &querypb.Field{Name: "vt_dead_lettered", Type: sqltypes.Int64}),
+	}
+	utils.MustMatch(t, wantFields, <-r1.ch, "fields")
+
+	ch := make(chan string, 20)
+	tsv.SetChannel(ch)
+	// The message was already sent 3 times: once, and retried twice.
+	mm.Add(&MessageRow{Epoch: 3, Row: []sqltypes.Value{sqltypes.NewVarBinary("1"), sqltypes.NULL}})
+	if got, want := <-ch, "deadletter"; got != want {
+		t.Errorf("DeadLetter: %s, want %v", got, want)
+	}
+	// The move is reported to the receiver, and the message isn't postponed.
+	want := &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary("1"),
+			sqltypes.NULL,
+			sqltypes.NewInt64(1),
+		}},
+	}
+	if got := <-r1.ch; !reflect.DeepEqual(got, want) {
+		t.Errorf("Received: %v, want %v", got, want)
+	}
+
+	// A message within its retries is still sent.
+	mm.Add(&MessageRow{Epoch: 2, Row: []sqltypes.Value{sqltypes.NewVarBinary("2"), sqltypes.NULL}})
+	want = &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary("2"),
+			sqltypes.NULL,
+			sqltypes.NewInt64(0),
+		}},
+	}
+	if got := <-r1.ch; !reflect.DeepEqual(got, want) {
+		t.Errorf("Received: %v, want %v", got, want)
+	}
+	if got, want := <-ch, "postpone"; got != want {
+		t.Errorf("Postpone: %s, want %v", got, want)
+	}
+	assert.EqualValues(t, 1, tsv.deadLetterCount.Get())
+}
+
+func TestMessageManagerPriority(t *testing.T) {
+	tsv := newFakeTabletServer()
+	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	// The receiver stays busy until the postpone of its message returns.
+	ch := make(chan string)
+	tsv.SetChannel(ch)
+	mm.Add(&MessageRow{Priority: 5, Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}})
+	assert.Equal(t, "1", (<-r1.ch).Rows[0][0].ToString())
+
+	mm.Add(&MessageRow{Priority: 3, Row: []sqltypes.Value{sqltypes.NewVarBinary("2")}})
+	mm.Add(&MessageRow{Priority: 1, Row: []sqltypes.Value{sqltypes.NewVarBinary("3")}})
+	mm.Add(&MessageRow{Priority: 2, Row: []sqltypes.Value{sqltypes.NewVarBinary("4")}})
+	<-ch
+	for _, want := range []string{"3", "4", "2"} {
+		assert.Equal(t, want, (<-r1.ch).Rows[0][0].ToString())
+		<-ch
+	}
+}
+
 func TestMessageManagerPostponeThrottle(t *testing.T) {
 	tsv := newFakeTabletServer()
 	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
@@ -790,6 +883,91 @@ func TestMMGenerate(t *testing.T) {
 	}
 }
 
+func TestMMDeadLetterMessages(t *testing.T) {
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
+	assert.False(t, mm.HasDeadLetterTable())
+
+	mm = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTableWithDeadLetter(), sync2.NewSemaphore(1, 0))
+	assert.True(t, mm.HasDeadLetterTable())
+	var queries []string
+	var bindVars []map[string]*querypb.BindVariable
+	execute := func(query string, bv map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+		queries = append(queries, query)
+		bindVars = append(bindVars, bv)
+		if strings.HasPrefix(query, "select") {
+			// message 2 was acked in the meantime
+			return sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "varbinary"), "1"), nil
+		}
+		return &sqltypes.Result{RowsAffected: 1}, nil
+	}
+	movedIDs, err := mm.DeadLetterMessages([]string{"1", "2"}, execute)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1"}, movedIDs)
+	assert.Equal(t, []string{
+		"select id from foo where id in ::ids and epoch > :max_retries and time_acked is null for update",
+		"insert into foo_dlq(id, priority, time_next, epoch, time_acked, message) select id, priority, time_next, epoch, time_acked, message from foo where id in ::ids and epoch > :max_retries and time_acked is null",
+		"delete from foo where id in ::ids and epoch > :max_retries and time_acked is null",
+	}, queries)
+	utils.MustMatch(t, map[string]*querypb.BindVariable{
+		"ids":         sqltypes.TestBindVariable([]interface{}{"1", "2"}),
+		"max_retries": sqltypes.Int64BindVariable(2),
+	}, bindVars[0], "did not match")
+	utils.MustMatch(t, map[string]*querypb.BindVariable{
+		"ids":         sqltypes.TestBindVariable([]interface{}{"1"}),
+		"max_retries": sqltypes.Int64BindVariable(2),
+	}, bindVars[2], "did not match")
+
+	// Nothing is moved if all the messages were acked.
+	queries = nil
+	execute = func(query string, bv map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+		queries = append(queries, query)
+		return &sqltypes.Result{}, nil
+	}
+	movedIDs, err = mm.DeadLetterMessages([]string{"2"}, execute)
+	require.NoError(t, err)
+	assert.Nil(t, movedIDs)
+	assert.Len(t, queries, 1)
+}
+
+func TestMMAckMessages(t *testing.T) {
+	var queries []string
+	execute := func(query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+		queries = append(queries, query)
+		if query == "select id, recurrence, time_scheduled from foo where id in ::ids and time_acked is null for update" {
+			return sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("id|recurrence|time_scheduled", "varbinary|varchar|int64"),
+				"1|@every 1h|null",
+				"2|null|null",
+				"3|bad|null",
+			), nil
+		}
+		return &sqltypes.Result{RowsAffected: 1}, nil
+	}
+
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
+	count, err := mm.AckMessages([]string{"1", "2"}, execute)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	assert.Equal(t, []string{
+		"update foo set time_acked = :time_acked, time_next = null where id in ::ids and time_acked is null",
+	}, queries)
+
+	table := newMMTable()
+	table.MessageInfo.Recurring = true
+	table.MessageInfo.RecurrenceCatchup = schema.RecurrenceCatchupSkip
+	mm = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), table, sync2.NewSemaphore(1, 0))
+	queries = nil
+	count, err = mm.AckMessages([]string{"1", "2", "3"}, execute)
+	assert.NoError(t, err)
+	// Message 1 is rescheduled, and the other two are acked.
+	assert.EqualValues(t, 2, count)
+	assert.Equal(t, []string{
+		"select id, recurrence, time_scheduled from foo where id in ::ids and time_acked is null for update",
+		"update foo set time_next = :time_next, time_scheduled = :time_next, epoch = 0 where id = :id and time_acked is null",
+		"update foo set time_acked = :time_acked, time_next = null where id in ::ids and time_acked is null",
+	}, queries)
+}
+
 func TestMMGenerateWithBackoff(t *testing.T) {
 	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTableWithBackoff(), sync2.NewSemaphore(1, 0))
 	mm.Open()
@@ -827,8 +1005,9 @@ func TestMMGenerateWithBackoff(t *testing.T) {
 
 type fakeTabletServer struct {
 	tabletenv.Env
-	postponeCount sync2.AtomicInt64
-	purgeCount    sync2.AtomicInt64
+	postponeCount   sync2.AtomicInt64
+	purgeCount      sync2.AtomicInt64
+	deadLetterCount sync2.AtomicInt64
 
 	mu sync.Mutex
 	ch chan string
@@ -871,6 +1050,17 @@ func (fts *fakeTabletServer) PurgeMessages(ctx context.Context, target *querypb.
 	return 0, nil
 }
 
+func (fts *fakeTabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (movedIDs []string, err error) {
+	fts.deadLetterCount.Add(1)
+	fts.mu.Lock()
+	ch := fts.ch
+	fts.mu.Unlock()
+	if ch != nil {
+		ch <- "deadletter"
+	}
+	return ids, nil
+}
+
 type fakeVStreamer struct {
 	streamInvocations sync2.AtomicInt64
 	mu                sync.Mutex
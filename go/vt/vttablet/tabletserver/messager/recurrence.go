@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messager
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+)
+
+// maxMissedFirings caps the number of missed firings that are counted
+// when a recurring message is acked late.
+const maxMissedFirings = 1000
+
+// recurrence is the schedule of a recurring message.
+type recurrence interface {
+	// next returns the first firing strictly after t,
+	// or the zero time if there is none.
+	next(t time.Time) time.Time
+}
+
+// intervalRecurrence fires at a fixed interval.
+type intervalRecurrence time.Duration
+
+func (ir intervalRecurrence) next(t time.Time) time.Time {
+	return t.Add(time.Duration(ir))
+}
+
+// cronRecurrence fires according to a standard 5-field cron expression:
+// minute, hour, day of month, month and day of week, in UTC.
+// Each field is a bit mask of its allowed values.
+type cronRecurrence struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar are set if the day of month or the day of week
+	// are unrestricted. If both are restricted, a day matches if
+	// either matches, as in cron.
+	domStar, dowStar bool
+}
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// parseRecurrence parses the recurrence of a message, which is either
+// "@every <duration>", a descriptor like "@daily", or a cron expression.
+func parseRecurrence(spec string) (recurrence, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "@every ") {
+		d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid recurrence %q: %v", spec, err)
+		}
+		if d < time.Second {
+			return nil, fmt.Errorf("invalid recurrence %q: the interval must be at least 1s", spec)
+		}
+		return intervalRecurrence(d), nil
+	}
+	expr := spec
+	if strings.HasPrefix(spec, "@") {
+		var ok bool
+		if expr, ok = cronDescriptors[spec]; !ok {
+			return nil, fmt.Errorf("invalid recurrence %q: unknown descriptor", spec)
+		}
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid recurrence %q: a cron expression has 5 fields", spec)
+	}
+	cr := &cronRecurrence{
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}
+	var err error
+	if cr.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("invalid recurrence %q: minute: %v", spec, err)
+	}
+	if cr.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("invalid recurrence %q: hour: %v", spec, err)
+	}
+	if cr.dom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("invalid recurrence %q: day of month: %v", spec, err)
+	}
+	if cr.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("invalid recurrence %q: month: %v", spec, err)
+	}
+	if cr.dow, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("invalid recurrence %q: day of week: %v", spec, err)
+	}
+	// Both 0 and 7 are Sunday.
+	if cr.dow&(1<<7) != 0 {
+		cr.dow |= 1
+	}
+	return cr, nil
+}
+
+// parseCronField parses a comma separated list of values, ranges (a-b)
+// and steps (*/n or a-b/n) into a bit mask.
+func parseCronField(field string, min, max int) (uint64, error) {
+	var mask uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if i := strings.Index(part, "/"); i != -1 {
+			var err error
+			rng = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+		}
+		low, high := min, max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			bounds := strings.SplitN(rng, "-", 2)
+			var err1, err2 error
+			low, err1 = strconv.Atoi(bounds[0])
+			high, err2 = strconv.Atoi(bounds[1])
+			if err1 != nil || err2 != nil {
+				return 0, fmt.Errorf("invalid range %q", part)
+			}
+		default:
+			v, err := strconv.Atoi(rng)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q", part)
+			}
+			low, high = v, v
+			if step != 1 {
+				high = max
+			}
+		}
+		if low < min || high > max || low > high {
+			return 0, fmt.Errorf("%q is out of range [%d, %d]", part, min, max)
+		}
+		for v := low; v <= high; v += step {
+			mask |= 1 << uint(v)
+		}
+	}
+	return mask, nil
+}
+
+func (cr *cronRecurrence) next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	// A matching time is always found within 5 years, unless the
+	// expression never matches, like on February 30th.
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if cr.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !cr.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if cr.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if cr.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (cr *cronRecurrence) dayMatches(t time.Time) bool {
+	domMatch := cr.dom&(1<<uint(t.Day())) != 0
+	dowMatch := cr.dow&(1<<uint(t.Weekday())) != 0
+	if cr.domStar || cr.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// reschedule returns the next firing of a recurring message that was
+// scheduled at the given time and acked now, according to the catch-up
+// policy, and the number of firings that were missed. It returns the zero
+// time if the message does not fire any more.
+func reschedule(r recurrence, catchup string, scheduled, now time.Time) (next time.Time, missed int64) {
+	next = r.next(scheduled)
+	for !next.IsZero() && !next.After(now) && missed < maxMissedFirings {
+		missed++
+		next = r.next(next)
+	}
+	if missed == 0 {
+		return next, 0
+	}
+	switch catchup {
+	case schema.RecurrenceCatchupAll:
+		// Every missed firing is delivered, starting with the first one.
+		return r.next(scheduled), 0
+	case schema.RecurrenceCatchupOnce:
+		// The missed firings are delivered once, right away.
+		return now, missed - 1
+	default:
+		return r.next(now), missed
+	}
+}
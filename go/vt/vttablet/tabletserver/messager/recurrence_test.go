@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	// Friday.
+	base := time.Date(2021, 1, 1, 10, 30, 15, 0, time.UTC)
+	tcases := []struct {
+		spec string
+		next time.Time
+		err  string
+	}{{
+		spec: "@every 90s",
+		next: base.Add(90 * time.Second),
+	}, {
+		spec: "*/15 * * * *",
+		next: time.Date(2021, 1, 1, 10, 45, 0, 0, time.UTC),
+	}, {
+		spec: "0 9-17 * * 1-5",
+		next: time.Date(2021, 1, 1, 11, 0, 0, 0, time.UTC),
+	}, {
+		spec: "0 9 * * 1,3",
+		next: time.Date(2021, 1, 4, 9, 0, 0, 0, time.UTC),
+	}, {
+		spec: "@daily",
+		next: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+	}, {
+		spec: "@monthly",
+		next: time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
+	}, {
+		// Either the 15th or a Sunday.
+		spec: "0 0 15 * 7",
+		next: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+	}, {
+		spec: "0 0 30 2 *",
+		next: time.Time{},
+	}, {
+		spec: "@every 1ms",
+		err:  `invalid recurrence "@every 1ms": the interval must be at least 1s`,
+	}, {
+		spec: "@often",
+		err:  `invalid recurrence "@often": unknown descriptor`,
+	}, {
+		spec: "* * *",
+		err:  `invalid recurrence "* * *": a cron expression has 5 fields`,
+	}, {
+		spec: "60 * * * *",
+		err:  `invalid recurrence "60 * * * *": minute: "60" is out of range [0, 59]`,
+	}, {
+		spec: "*/0 * * * *",
+		err:  `invalid recurrence "*/0 * * * *": minute: invalid step in "*/0"`,
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.spec, func(t *testing.T) {
+			r, err := parseRecurrence(tcase.spec)
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.next, r.next(base))
+		})
+	}
+}
+
+func TestReschedule(t *testing.T) {
+	r, err := parseRecurrence("@hourly")
+	require.NoError(t, err)
+	scheduled := time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	// Acked in time.
+	now := scheduled.Add(5 * time.Minute)
+	for _, catchup := range []string{schema.RecurrenceCatchupSkip, schema.RecurrenceCatchupOnce, schema.RecurrenceCatchupAll} {
+		next, missed := reschedule(r, catchup, scheduled, now)
+		assert.Equal(t, scheduled.Add(time.Hour), next, catchup)
+		assert.Zero(t, missed, catchup)
+	}
+
+	// Acked after missing the firings of 11:00 and 12:00.
+	now = scheduled.Add(150 * time.Minute)
+	next, missed := reschedule(r, schema.RecurrenceCatchupSkip, scheduled, now)
+	assert.Equal(t, scheduled.Add(3*time.Hour), next)
+	assert.EqualValues(t, 2, missed)
+
+	next, missed = reschedule(r, schema.RecurrenceCatchupOnce, scheduled, now)
+	assert.Equal(t, now, next)
+	assert.EqualValues(t, 1, missed)
+
+	next, missed = reschedule(r, schema.RecurrenceCatchupAll, scheduled, now)
+	assert.Equal(t, scheduled.Add(time.Hour), next)
+	assert.Zero(t, missed)
+}
@@ -71,6 +71,7 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 		buf := sqlparser.NewTrackedBuffer(nil)
 		buf.Myprintf("%v", upd.Where)
 		plan.WhereClause = buf.ParsedQuery()
+		plan.RowKey = analyzeRowKey(upd.Where, plan.Table)
 	}
 
 	// Situations when we pass-through:
@@ -100,6 +101,7 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 		buf := sqlparser.NewTrackedBuffer(nil)
 		buf.Myprintf("%v", del.Where)
 		plan.WhereClause = buf.ParsedQuery()
+		plan.RowKey = analyzeRowKey(del.Where, plan.Table)
 	}
 
 	if PassthroughDMLs || plan.Table == nil || del.Limit != nil {
@@ -113,6 +115,69 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 	return plan, nil
 }
 
+// analyzeRowKey returns the equality predicates of the WHERE clause on the
+// columns of the primary key or, failing that, of the first unique key whose
+// columns are all restricted. The predicates are ordered by key column, so
+// that DMLs on the same row get the same key regardless of how their WHERE
+// clauses are written. It returns nil if there is no such key.
+func analyzeRowKey(where *sqlparser.Where, table *schema.Table) *sqlparser.ParsedQuery {
+	if table == nil {
+		return nil
+	}
+	values := make(map[int]sqlparser.Expr)
+	for _, filter := range sqlparser.SplitAndExpression(nil, where.Expr) {
+		comp, ok := filter.(*sqlparser.ComparisonExpr)
+		if !ok || comp.Operator != sqlparser.EqualOp {
+			continue
+		}
+		col, val := comp.Left, comp.Right
+		if _, ok := col.(*sqlparser.ColName); !ok {
+			col, val = val, col
+		}
+		colName, ok := col.(*sqlparser.ColName)
+		if !ok || !isRowKeyValue(val) {
+			continue
+		}
+		if index := table.FindColumn(colName.Name); index >= 0 {
+			values[index] = val
+		}
+	}
+	keys := append([][]int{table.PKColumns}, table.UniqueKeys...)
+	for _, key := range keys {
+		if len(key) == 0 {
+			continue
+		}
+		exprs := make([]sqlparser.Expr, 0, len(key))
+		for _, index := range key {
+			val, ok := values[index]
+			if !ok {
+				break
+			}
+			exprs = append(exprs, &sqlparser.ComparisonExpr{
+				Operator: sqlparser.EqualOp,
+				Left:     sqlparser.NewColName(table.Fields[index].Name),
+				Right:    val,
+			})
+		}
+		if len(exprs) == len(key) {
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("%v", sqlparser.NewWhere(sqlparser.WhereClause, sqlparser.AndExpressions(exprs...)))
+			return buf.ParsedQuery()
+		}
+	}
+	return nil
+}
+
+// isRowKeyValue returns true if the expression is a single value
+// that can identify a row.
+func isRowKeyValue(expr sqlparser.Expr) bool {
+	switch expr.(type) {
+	case *sqlparser.Literal, sqlparser.Argument:
+		return true
+	}
+	return false
+}
+
 func analyzeInsert(ins *sqlparser.Insert, tables map[string]*schema.Table) (plan *Plan, err error) {
 	plan = &Plan{
 		PlanID:    PlanInsert,
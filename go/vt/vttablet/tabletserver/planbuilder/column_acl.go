@@ -0,0 +1,324 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+)
+
+// ColumnRestriction restricts the access to a column.
+type ColumnRestriction struct {
+	// Name is the name of the column acl of the restriction.
+	Name string
+	// Mask returns the expression that replaces the column in the
+	// projections of SELECTs. If nil, the column is denied.
+	Mask func(col *sqlparser.ColName) sqlparser.Expr
+}
+
+// ColumnAccessDeniedError is returned when a query references
+// a column that its restrictions deny.
+type ColumnAccessDeniedError struct {
+	Table       string
+	Column      string
+	Restriction string
+}
+
+func (e *ColumnAccessDeniedError) Error() string {
+	return fmt.Sprintf("column acl %s denies access to %s.%s", e.Restriction, e.Table, e.Column)
+}
+
+// columnResolver resolves the columns of a statement to the
+// restrictions of the tables the statement references.
+type columnResolver struct {
+	restrictions map[string]map[string]*ColumnRestriction
+	// scopes are the scopes of the columns of the statement.
+	scopes map[*sqlparser.ColName]*columnScope
+}
+
+// columnScope holds the tables of a SELECT, or of the table
+// expressions of a DML, with the scope that encloses it.
+type columnScope struct {
+	parent *columnScope
+	// qualifiers maps the qualifiers of the tables to their names. The
+	// qualifiers of derived tables map to an empty name.
+	qualifiers map[string]string
+	// tables are the names of the tables, in the order of the scope.
+	tables []string
+}
+
+func newColumnScope(parent *columnScope, from sqlparser.TableExprs) *columnScope {
+	scope := &columnScope{
+		parent:     parent,
+		qualifiers: make(map[string]string),
+	}
+	for _, expr := range from {
+		for _, expr := range aliasedTableExprs(expr) {
+			if tableName, ok := expr.Expr.(sqlparser.TableName); ok {
+				scope.add(rowFilterQualifier(expr, tableName).Name.String(), tableName.Name.String())
+				continue
+			}
+			scope.qualifiers[expr.As.String()] = ""
+		}
+	}
+	return scope
+}
+
+func (scope *columnScope) add(qualifier, table string) {
+	scope.qualifiers[qualifier] = table
+	scope.tables = append(scope.tables, table)
+}
+
+func newColumnResolver(statement sqlparser.Statement, restrictions map[string]map[string]*ColumnRestriction) *columnResolver {
+	cr := &columnResolver{
+		restrictions: restrictions,
+		scopes:       make(map[*sqlparser.ColName]*columnScope),
+	}
+	var scope *columnScope
+	switch statement := statement.(type) {
+	case *sqlparser.Select:
+		scope = newColumnScope(nil, statement.From)
+	case *sqlparser.Update:
+		scope = newColumnScope(nil, statement.TableExprs)
+	case *sqlparser.Delete:
+		scope = newColumnScope(nil, statement.TableExprs)
+	case *sqlparser.Insert:
+		scope = newColumnScope(nil, nil)
+		scope.add(statement.Table.Name.String(), statement.Table.Name.String())
+	default:
+		scope = newColumnScope(nil, nil)
+	}
+	cr.collect(statement, scope)
+	return cr
+}
+
+// collect records the scopes of the columns of a node. Each SELECT
+// nested in the node opens a scope of its own.
+func (cr *columnResolver) collect(node sqlparser.SQLNode, scope *columnScope) {
+	_ = sqlparser.Walk(func(child sqlparser.SQLNode) (bool, error) {
+		switch child := child.(type) {
+		case *sqlparser.Select:
+			if child != node {
+				cr.collect(child, newColumnScope(scope, child.From))
+				return false, nil
+			}
+		case *sqlparser.ColName:
+			cr.scopes[child] = scope
+		}
+		return true, nil
+	}, node)
+}
+
+// resolve returns the table and the restriction of a column, if it has one.
+// Qualified columns are resolved to the innermost scope that defines their
+// qualifier. Unqualified columns are resolved to the first table of their
+// enclosing scopes, innermost first, that restricts a column of their name:
+// their resolution is conservative, since the columns of the tables that
+// have no restrictions are unknown.
+func (cr *columnResolver) resolve(col *sqlparser.ColName) (string, *ColumnRestriction) {
+	scope := cr.scopes[col]
+	if !col.Qualifier.IsEmpty() {
+		for ; scope != nil; scope = scope.parent {
+			if table, ok := scope.qualifiers[col.Qualifier.Name.String()]; ok {
+				return table, cr.restrictions[table][col.Name.Lowered()]
+			}
+		}
+		return "", nil
+	}
+	for ; scope != nil; scope = scope.parent {
+		for _, table := range scope.tables {
+			if r := cr.restrictions[table][col.Name.Lowered()]; r != nil {
+				return table, r
+			}
+		}
+	}
+	return "", nil
+}
+
+// applyColumnRestrictions applies the restrictions of the columns of the
+// tables of a statement. The restricted columns that are projected by
+// SELECTs are replaced by their mask, unless they are denied. Any other
+// reference to a restricted column is denied. The '*' of the SELECTs of
+// restricted tables are expanded to the columns of the tables in tables,
+// without their denied columns.
+func applyColumnRestrictions(statement sqlparser.Statement, restrictions map[string]map[string]*ColumnRestriction, tables map[string]*schema.Table) error {
+	if ins, ok := statement.(*sqlparser.Insert); ok {
+		table := ins.Table.Name.String()
+		if len(ins.Columns) == 0 && len(restrictions[table]) != 0 {
+			return &ColumnAccessDeniedError{Table: table, Column: "*", Restriction: firstRestriction(restrictions[table])}
+		}
+		for _, column := range ins.Columns {
+			if r := restrictions[table][column.Lowered()]; r != nil {
+				return &ColumnAccessDeniedError{Table: table, Column: column.String(), Restriction: r.Name}
+			}
+		}
+	}
+
+	// Expand the stars, and find the columns that are projected as is.
+	projections := make(map[*sqlparser.ColName]*sqlparser.AliasedExpr)
+	var projected []*sqlparser.ColName
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		sel, ok := node.(*sqlparser.Select)
+		if !ok {
+			return true, nil
+		}
+		var exprs sqlparser.SelectExprs
+		for _, expr := range sel.SelectExprs {
+			if star, ok := expr.(*sqlparser.StarExpr); ok {
+				expanded, err := expandStar(star, sel.From, restrictions, tables)
+				if err != nil {
+					return false, err
+				}
+				exprs = append(exprs, expanded...)
+				continue
+			}
+			exprs = append(exprs, expr)
+		}
+		sel.SelectExprs = exprs
+		for _, expr := range sel.SelectExprs {
+			if aliased, ok := expr.(*sqlparser.AliasedExpr); ok {
+				if col, ok := aliased.Expr.(*sqlparser.ColName); ok {
+					projections[col] = aliased
+					projected = append(projected, col)
+				}
+			}
+		}
+		return true, nil
+	}, statement)
+	if err != nil {
+		return err
+	}
+
+	// The resolver is built once the stars are expanded, to resolve the
+	// columns they expand to.
+	cr := newColumnResolver(statement, restrictions)
+	err = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		col, ok := node.(*sqlparser.ColName)
+		if !ok {
+			return true, nil
+		}
+		if _, ok := projections[col]; ok {
+			return true, nil
+		}
+		if table, r := cr.resolve(col); r != nil {
+			return false, &ColumnAccessDeniedError{Table: table, Column: col.Name.String(), Restriction: r.Name}
+		}
+		return true, nil
+	}, statement)
+	if err != nil {
+		return err
+	}
+
+	for _, col := range projected {
+		table, r := cr.resolve(col)
+		if r == nil {
+			continue
+		}
+		if r.Mask == nil {
+			return &ColumnAccessDeniedError{Table: table, Column: col.Name.String(), Restriction: r.Name}
+		}
+		aliased := projections[col]
+		aliased.Expr = r.Mask(col)
+		if aliased.As.IsEmpty() {
+			aliased.As = col.Name
+		}
+	}
+	return nil
+}
+
+// expandStar returns the columns of a '*' of a SELECT, or the '*' itself
+// if none of the tables it expands to have restricted columns.
+func expandStar(star *sqlparser.StarExpr, from sqlparser.TableExprs, restrictions map[string]map[string]*ColumnRestriction, tables map[string]*schema.Table) (sqlparser.SelectExprs, error) {
+	var exprs []*sqlparser.AliasedTableExpr
+	for _, expr := range from {
+		exprs = append(exprs, aliasedTableExprs(expr)...)
+	}
+	restricted := ""
+	var matched []*sqlparser.AliasedTableExpr
+	for _, expr := range exprs {
+		tableName, isTable := expr.Expr.(sqlparser.TableName)
+		if !star.TableName.IsEmpty() {
+			qualifier := expr.As
+			if isTable && qualifier.IsEmpty() {
+				qualifier = tableName.Name
+			}
+			if qualifier.String() != star.TableName.Name.String() {
+				continue
+			}
+		}
+		matched = append(matched, expr)
+		if isTable && restricted == "" && len(restrictions[tableName.Name.String()]) != 0 {
+			restricted = tableName.Name.String()
+		}
+	}
+	if restricted == "" {
+		return sqlparser.SelectExprs{star}, nil
+	}
+
+	var expanded sqlparser.SelectExprs
+	for _, expr := range matched {
+		tableName, isTable := expr.Expr.(sqlparser.TableName)
+		if !isTable {
+			// The columns of derived tables are unknown.
+			return nil, &ColumnAccessDeniedError{Table: restricted, Column: "*", Restriction: firstRestriction(restrictions[restricted])}
+		}
+		table := tables[tableName.Name.String()]
+		if table == nil {
+			return nil, &ColumnAccessDeniedError{Table: restricted, Column: "*", Restriction: firstRestriction(restrictions[restricted])}
+		}
+		qualifier := rowFilterQualifier(expr, tableName)
+		for _, field := range table.Fields {
+			col := sqlparser.NewColIdent(field.Name)
+			if r := restrictions[tableName.Name.String()][col.Lowered()]; r != nil && r.Mask == nil {
+				continue
+			}
+			expanded = append(expanded, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: col, Qualifier: qualifier}})
+		}
+	}
+	return expanded, nil
+}
+
+// aliasedTableExprs returns the tables of a table expression, in order.
+func aliasedTableExprs(expr sqlparser.TableExpr) []*sqlparser.AliasedTableExpr {
+	switch expr := expr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		return []*sqlparser.AliasedTableExpr{expr}
+	case *sqlparser.ParenTableExpr:
+		var exprs []*sqlparser.AliasedTableExpr
+		for _, expr := range expr.Exprs {
+			exprs = append(exprs, aliasedTableExprs(expr)...)
+		}
+		return exprs
+	case *sqlparser.JoinTableExpr:
+		return append(aliasedTableExprs(expr.LeftExpr), aliasedTableExprs(expr.RightExpr)...)
+	}
+	return nil
+}
+
+// firstRestriction returns the name of a restriction of a table, to report
+// the denials of its '*'.
+func firstRestriction(columns map[string]*ColumnRestriction) string {
+	name := ""
+	for _, r := range columns {
+		if name == "" || r.Name < name {
+			name = r.Name
+		}
+	}
+	return name
+}
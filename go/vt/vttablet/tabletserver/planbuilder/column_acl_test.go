@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestBuildRestrictedQueryColumns(t *testing.T) {
+	hash := func(col *sqlparser.ColName) sqlparser.Expr {
+		return &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("sha2"), Exprs: sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: col}}}
+	}
+	stmt, err := sqlparser.Parse("select 1 from dual where tenant_id = :tenant")
+	require.NoError(t, err)
+	restrictions := &Restrictions{
+		Columns: map[string]map[string]*ColumnRestriction{
+			"customer": {
+				"email": {Name: "pii", Mask: hash},
+				"ssn":   {Name: "secrets"},
+			},
+		},
+		Tables: map[string]*schema.Table{
+			"customer": {
+				Name: sqlparser.NewTableIdent("customer"),
+				Fields: []*querypb.Field{
+					{Name: "id"}, {Name: "Email"}, {Name: "ssn"}, {Name: "tenant_id"},
+				},
+			},
+		},
+	}
+	rowFiltered := &Restrictions{
+		RowFilters: map[string]sqlparser.Expr{"customer": stmt.(*sqlparser.Select).Where.Expr},
+		Columns:    restrictions.Columns,
+		Tables:     restrictions.Tables,
+	}
+
+	testcases := []struct {
+		planID       PlanType
+		query        string
+		restrictions *Restrictions
+		want         string
+		err          string
+	}{{
+		planID: PlanSelect,
+		query:  "select id, email from customer",
+		want:   "select id, sha2(email) as email from customer limit :#maxLimit",
+	}, {
+		planID: PlanSelectStream,
+		query:  "select c.email as mail, o.id from customer as c join orders as o on c.id = o.customer_id",
+		want:   "select sha2(c.email) as mail, o.id from customer as c join orders as o on c.id = o.customer_id",
+	}, {
+		planID: PlanSelect,
+		query:  "select * from customer",
+		want:   "select customer.id, sha2(customer.Email) as Email, customer.tenant_id from customer limit :#maxLimit",
+	}, {
+		planID: PlanSelect,
+		query:  "select o.*, c.* from customer as c join orders as o",
+		want:   "select o.*, c.id, sha2(c.Email) as Email, c.tenant_id from customer as c join orders as o limit :#maxLimit",
+	}, {
+		planID: PlanSelect,
+		query:  "select * from orders",
+		want:   "select * from orders limit :#maxLimit",
+	}, {
+		planID: PlanSelect,
+		query:  "select count(*) from customer",
+		want:   "select count(*) from customer limit :#maxLimit",
+	}, {
+		planID: PlanSelect,
+		query:  "select x from (select email as x from customer) as t",
+		want:   "select x from (select sha2(email) as x from customer) as t limit :#maxLimit",
+	}, {
+		planID:       PlanSelect,
+		query:        "select * from customer",
+		restrictions: rowFiltered,
+		want:         "select customer.id, sha2(customer.Email) as Email, customer.tenant_id from customer where customer.tenant_id = :tenant limit :#maxLimit",
+	}, {
+		planID: PlanSelect,
+		query:  "select ssn from customer",
+		err:    "column acl secrets denies access to customer.ssn",
+	}, {
+		planID: PlanSelect,
+		query:  "select id from customer where email = 'a@b.c'",
+		err:    "column acl pii denies access to customer.email",
+	}, {
+		planID: PlanSelect,
+		query:  "select lower(c.email) from customer as c",
+		err:    "column acl pii denies access to customer.email",
+	}, {
+		planID: PlanSelect,
+		query:  "select id from orders where customer_id in (select id from customer order by ssn)",
+		err:    "column acl secrets denies access to customer.ssn",
+	}, {
+		planID: PlanSelect,
+		query:  "select t.ssn from customer t where exists (select 1 from orders t)",
+		err:    "column acl secrets denies access to customer.ssn",
+	}, {
+		planID: PlanSelect,
+		query:  "select c.ssn from customer as c join orders as o on exists (select 1 from orders as c)",
+		err:    "column acl secrets denies access to customer.ssn",
+	}, {
+		planID: PlanSelect,
+		query:  "select id from orders as t where exists (select 1 from customer as t where t.ssn = 1)",
+		err:    "column acl secrets denies access to customer.ssn",
+	}, {
+		planID: PlanSelect,
+		query:  "select t.ssn from orders as t where exists (select 1 from customer as t)",
+		want:   "select t.ssn from orders as t where exists (select 1 from customer as t) limit :#maxLimit",
+	}, {
+		planID: PlanSelect,
+		query:  "select * from customer join (select 1 from dual) as t",
+		err:    "column acl pii denies access to customer.*",
+	}, {
+		planID: PlanSelect,
+		query:  "select * from customer_info",
+		restrictions: &Restrictions{
+			Columns: map[string]map[string]*ColumnRestriction{"customer_info": {"ssn": {Name: "secrets"}}},
+		},
+		err: "column acl secrets denies access to customer_info.*",
+	}, {
+		planID: PlanUpdate,
+		query:  "update customer set email = 'x' where id = 1",
+		err:    "column acl pii denies access to customer.email",
+	}, {
+		planID: PlanUpdate,
+		query:  "update customer set tenant_id = 2 where id = 1",
+		want:   "update customer set tenant_id = 2 where id = 1",
+	}, {
+		planID: PlanInsert,
+		query:  "insert into customer(id, tenant_id) values (1, 2)",
+		want:   "insert into customer(id, tenant_id) values (1, 2)",
+	}, {
+		planID: PlanInsert,
+		query:  "insert into customer(id, SSN) values (1, 2)",
+		err:    "column acl secrets denies access to customer.SSN",
+	}, {
+		planID: PlanInsert,
+		query:  "insert into customer values (1, 2, 3, 4)",
+		err:    "column acl pii denies access to customer.*",
+	}, {
+		planID:       PlanInsert,
+		query:        "insert into customer(id) values (1)",
+		restrictions: rowFiltered,
+		err:          "row filters do not allow Insert",
+	}, {
+		planID: PlanOtherRead,
+		query:  "describe customer",
+		err:    "column acls do not allow OtherRead",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tcase.query)
+			require.NoError(t, err)
+			r := tcase.restrictions
+			if r == nil {
+				r = restrictions
+			}
+			got, err := BuildRestrictedQuery(tcase.planID, stmt, r)
+			if tcase.err != "" {
+				require.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, got.Query)
+		})
+	}
+}
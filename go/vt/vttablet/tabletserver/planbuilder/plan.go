@@ -167,6 +167,13 @@ type Plan struct {
 	// to serialize e.g. UPDATEs going to the same row.
 	WhereClause *sqlparser.ParsedQuery
 
+	// RowKey is set for DMLs whose WHERE clause pins down the columns of
+	// the primary key or of a unique key with equality predicates. It
+	// contains only these predicates, so that the hot row protection can
+	// serialize DMLs going to the same row even if the rest of their
+	// WHERE clauses differ.
+	RowKey *sqlparser.ParsedQuery
+
 	// FullStmt can be used when the query does not operate on tables
 	FullStmt sqlparser.Statement
 }
@@ -34,6 +34,8 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 // MarshalJSON returns a JSON of the given Plan.
@@ -233,6 +235,56 @@ func TestStreamPlan(t *testing.T) {
 	}
 }
 
+func TestRowKey(t *testing.T) {
+	table := &schema.Table{
+		Name: sqlparser.NewTableIdent("t"),
+		Fields: []*querypb.Field{
+			{Name: "id"},
+			{Name: "eid"},
+			{Name: "email"},
+			{Name: "val"},
+		},
+		PKColumns:  []int{0},
+		UniqueKeys: [][]int{{1, 2}},
+	}
+	testSchema := map[string]*schema.Table{"t": table}
+	tcases := []struct {
+		sql    string
+		rowKey string
+	}{{
+		sql:    "update t set val = 1 where id = 1",
+		rowKey: " where id = 1",
+	}, {
+		sql:    "update t set val = 1 where val = 2 and :id = id",
+		rowKey: " where id = :id",
+	}, {
+		sql:    "delete from t where email = 'a@b' and val > 1 and eid = :eid",
+		rowKey: " where eid = :eid and email = 'a@b'",
+	}, {
+		sql: "update t set val = 1 where email = 'a@b'",
+	}, {
+		sql: "update t set val = 1 where id = 1 or id = 2",
+	}, {
+		sql: "update t set val = 1 where id in (1, 2)",
+	}, {
+		sql: "update t set val = 1 where id = val",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.sql, func(t *testing.T) {
+			statement, err := sqlparser.Parse(tcase.sql)
+			require.NoError(t, err)
+			plan, err := Build(statement, testSchema, false, "dbName")
+			require.NoError(t, err)
+			if tcase.rowKey == "" {
+				require.Nil(t, plan.RowKey)
+				return
+			}
+			require.NotNil(t, plan.RowKey)
+			require.Equal(t, tcase.rowKey, plan.RowKey.Query)
+		})
+	}
+}
+
 func TestMessageStreamingPlan(t *testing.T) {
 	testSchema := loadSchema("schema_test.json")
 	plan, err := BuildMessageStreaming("msg", testSchema)
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Restrictions are the row filters and the column restrictions
+// of the tables of a query for a caller.
+type Restrictions struct {
+	// RowFilters maps table names to the predicate that their rows must match.
+	RowFilters map[string]sqlparser.Expr
+	// Columns maps table names and lowercased column names
+	// to the restrictions of the columns.
+	Columns map[string]map[string]*ColumnRestriction
+	// Tables are the schemas of the tables of Columns,
+	// used to expand the '*' of their SELECTs.
+	Tables map[string]*schema.Table
+}
+
+// BuildRestrictedQuery returns the query to execute for a plan of the
+// statement, once the restrictions are applied to it. The statement is
+// modified. Column restrictions are applied before row filters, whose
+// predicates can then reference restricted columns.
+//
+// SELECTs, single-table UPDATEs and DELETEs can be restricted. INSERTs can
+// only be restricted by column restrictions. Other statements are denied.
+func BuildRestrictedQuery(planID PlanType, statement sqlparser.Statement, restrictions *Restrictions) (*sqlparser.ParsedQuery, error) {
+	switch statement.(type) {
+	case *sqlparser.Select, *sqlparser.Union:
+		if planID != PlanSelect && planID != PlanSelectImpossible && planID != PlanSelectStream {
+			return nil, restrictions.denied(planID)
+		}
+	case *sqlparser.Update, *sqlparser.Delete:
+	case *sqlparser.Insert:
+		if planID != PlanInsert || len(restrictions.RowFilters) != 0 {
+			return nil, restrictions.denied(planID)
+		}
+	default:
+		return nil, restrictions.denied(planID)
+	}
+
+	if len(restrictions.Columns) != 0 {
+		if err := applyColumnRestrictions(statement, restrictions.Columns, restrictions.Tables); err != nil {
+			return nil, err
+		}
+	}
+	if len(restrictions.RowFilters) != 0 {
+		if err := applyRowFilters(statement, restrictions.RowFilters); err != nil {
+			return nil, err
+		}
+	}
+
+	switch planID {
+	case PlanSelect, PlanSelectImpossible:
+		return GenerateLimitQuery(statement.(sqlparser.SelectStatement)), nil
+	case PlanUpdateLimit:
+		statement.(*sqlparser.Update).Limit = execLimit
+	case PlanDeleteLimit:
+		statement.(*sqlparser.Delete).Limit = execLimit
+	}
+	return GenerateFullQuery(statement), nil
+}
+
+func (r *Restrictions) denied(planID PlanType) error {
+	if len(r.RowFilters) != 0 {
+		return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "row filters do not allow %s", planID)
+	}
+	return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "column acls do not allow %s", planID)
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// applyRowFilters restricts the tables of a statement to the rows that
+// match their predicate in predicates. The predicates must not contain
+// subqueries, and their columns must be unqualified.
+//
+// Predicates are ANDed to the WHERE clause of the SELECT, UPDATE or DELETE
+// that reads the table, or to the ON condition of the outer join for which
+// the table is on the inner side. The DMLs through which rows could escape
+// the predicates are denied: multi-table DMLs and UPDATEs of the columns of
+// the predicates.
+func applyRowFilters(statement sqlparser.Statement, predicates map[string]sqlparser.Expr) error {
+	switch stmt := statement.(type) {
+	case *sqlparser.Update:
+		tableName, alias, err := rowFilterDMLTable(stmt.TableExprs, predicates)
+		if err != nil {
+			return err
+		}
+		if predicate, ok := predicates[tableName]; ok {
+			for _, expr := range stmt.Exprs {
+				if rowFilterReferences(predicate, expr.Name.Name) {
+					return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "row filters do not allow updating column %s", expr.Name.Name.String())
+				}
+			}
+			stmt.Where = addRowFilter(stmt.Where, qualifyRowFilter(predicate, alias))
+		}
+	case *sqlparser.Delete:
+		if len(stmt.Targets) != 0 {
+			return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "row filters do not allow multi-table deletes")
+		}
+		tableName, alias, err := rowFilterDMLTable(stmt.TableExprs, predicates)
+		if err != nil {
+			return err
+		}
+		if predicate, ok := predicates[tableName]; ok {
+			stmt.Where = addRowFilter(stmt.Where, qualifyRowFilter(predicate, alias))
+		}
+	}
+
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if sel, ok := node.(*sqlparser.Select); ok {
+			for _, expr := range sel.From {
+				if err := filterTableExpr(expr, predicates, sel.AddWhere); err != nil {
+					return false, err
+				}
+			}
+		}
+		return true, nil
+	}, statement)
+}
+
+// rowFilterDMLTable returns the name and the qualifier of the table
+// of a single-table DML. It fails for multi-table DMLs that access
+// a table of predicates.
+func rowFilterDMLTable(exprs sqlparser.TableExprs, predicates map[string]sqlparser.Expr) (string, sqlparser.TableName, error) {
+	if len(exprs) == 1 {
+		if aliased, ok := exprs[0].(*sqlparser.AliasedTableExpr); ok {
+			if tableName, ok := aliased.Expr.(sqlparser.TableName); ok {
+				return tableName.Name.String(), rowFilterQualifier(aliased, tableName), nil
+			}
+		}
+	}
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if tableName, ok := node.(sqlparser.TableName); ok {
+			if _, ok := predicates[tableName.Name.String()]; ok {
+				return false, vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "row filters do not allow multi-table DMLs on %s", tableName.Name.String())
+			}
+		}
+		return true, nil
+	}, exprs)
+	return "", sqlparser.TableName{}, err
+}
+
+// filterTableExpr adds the predicates of the tables of expr with addFilter,
+// or to the ON condition of the outer joins for which they are on the inner side.
+func filterTableExpr(expr sqlparser.TableExpr, predicates map[string]sqlparser.Expr, addFilter func(sqlparser.Expr)) error {
+	switch expr := expr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		// Derived tables are filtered as the selects they contain.
+		if tableName, ok := expr.Expr.(sqlparser.TableName); ok {
+			if predicate, ok := predicates[tableName.Name.String()]; ok {
+				addFilter(qualifyRowFilter(predicate, rowFilterQualifier(expr, tableName)))
+			}
+		}
+	case *sqlparser.ParenTableExpr:
+		for _, expr := range expr.Exprs {
+			if err := filterTableExpr(expr, predicates, addFilter); err != nil {
+				return err
+			}
+		}
+	case *sqlparser.JoinTableExpr:
+		outer, inner := expr.LeftExpr, expr.RightExpr
+		switch expr.Join {
+		case sqlparser.LeftJoinType, sqlparser.NaturalLeftJoinType:
+		case sqlparser.RightJoinType, sqlparser.NaturalRightJoinType:
+			outer, inner = inner, outer
+		default:
+			if err := filterTableExpr(expr.LeftExpr, predicates, addFilter); err != nil {
+				return err
+			}
+			return filterTableExpr(expr.RightExpr, predicates, addFilter)
+		}
+		if err := filterTableExpr(outer, predicates, addFilter); err != nil {
+			return err
+		}
+		var err error
+		if filterErr := filterTableExpr(inner, predicates, func(predicate sqlparser.Expr) {
+			if expr.Condition.Using != nil || expr.Join == sqlparser.NaturalLeftJoinType || expr.Join == sqlparser.NaturalRightJoinType {
+				err = vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "row filters do not allow outer joins without an ON condition")
+				return
+			}
+			if expr.Condition.On == nil {
+				expr.Condition.On = predicate
+				return
+			}
+			expr.Condition.On = &sqlparser.AndExpr{Left: expr.Condition.On, Right: predicate}
+		}); filterErr != nil {
+			return filterErr
+		}
+		return err
+	}
+	return nil
+}
+
+func rowFilterQualifier(expr *sqlparser.AliasedTableExpr, tableName sqlparser.TableName) sqlparser.TableName {
+	if !expr.As.IsEmpty() {
+		return sqlparser.TableName{Name: expr.As}
+	}
+	return tableName
+}
+
+// qualifyRowFilter returns a copy of the predicate with its columns qualified.
+func qualifyRowFilter(predicate sqlparser.Expr, qualifier sqlparser.TableName) sqlparser.Expr {
+	return sqlparser.Rewrite(sqlparser.CloneExpr(predicate), func(cursor *sqlparser.Cursor) bool {
+		if col, ok := cursor.Node().(*sqlparser.ColName); ok {
+			col.Qualifier = qualifier
+		}
+		return true
+	}, nil).(sqlparser.Expr)
+}
+
+func addRowFilter(where *sqlparser.Where, predicate sqlparser.Expr) *sqlparser.Where {
+	if where == nil {
+		return sqlparser.NewWhere(sqlparser.WhereClause, predicate)
+	}
+	where.Expr = &sqlparser.AndExpr{Left: where.Expr, Right: predicate}
+	return where
+}
+
+// rowFilterReferences returns true if the predicate references the column.
+func rowFilterReferences(predicate sqlparser.Expr, column sqlparser.ColIdent) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok && col.Name.Equal(column) {
+			found = true
+		}
+		return !found, nil
+	}, predicate)
+	return found
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestBuildRestrictedQueryRowFilters(t *testing.T) {
+	predicates := map[string]sqlparser.Expr{}
+	for table, predicate := range map[string]string{
+		"a": "tenant_id = :tenant",
+		"b": "region in ('us', 'ca') and tenant_id = lower(:tenant)",
+	} {
+		stmt, err := sqlparser.Parse("select 1 from dual where " + predicate)
+		require.NoError(t, err)
+		predicates[table] = stmt.(*sqlparser.Select).Where.Expr
+	}
+
+	testcases := []struct {
+		planID PlanType
+		query  string
+		want   string
+		err    string
+	}{{
+		planID: PlanSelect,
+		query:  "select * from a",
+		want:   "select * from a where a.tenant_id = :tenant limit :#maxLimit",
+	}, {
+		planID: PlanSelectStream,
+		query:  "select * from a as x where id = 1",
+		want:   "select * from a as x where id = 1 and x.tenant_id = :tenant",
+	}, {
+		planID: PlanSelect,
+		query:  "select * from c",
+		want:   "select * from c limit :#maxLimit",
+	}, {
+		planID: PlanSelect,
+		query:  "select * from a join b on a.id = b.id",
+		want:   "select * from a join b on a.id = b.id where a.tenant_id = :tenant and (b.region in ('us', 'ca') and b.tenant_id = lower(:tenant)) limit :#maxLimit",
+	}, {
+		planID: PlanSelect,
+		query:  "select * from a left join b on a.id = b.id",
+		want:   "select * from a left join b on a.id = b.id and (b.region in ('us', 'ca') and b.tenant_id = lower(:tenant)) where a.tenant_id = :tenant limit :#maxLimit",
+	}, {
+		planID: PlanSelect,
+		query:  "select * from a right join c on a.id = c.id",
+		want:   "select * from a right join c on a.id = c.id and a.tenant_id = :tenant limit :#maxLimit",
+	}, {
+		planID: PlanSelect,
+		query:  "select * from a left join b using (id)",
+		err:    "row filters do not allow outer joins without an ON condition",
+	}, {
+		planID: PlanSelect,
+		query:  "select * from c where id in (select id from a) union select id from (select id from b) as t",
+		want:   "select * from c where id in (select id from a where a.tenant_id = :tenant) union select id from (select id from b where b.region in ('us', 'ca') and b.tenant_id = lower(:tenant)) as t limit :#maxLimit",
+	}, {
+		planID: PlanUpdate,
+		query:  "update a set name = 'x' where id = 1",
+		want:   "update a set `name` = 'x' where id = 1 and a.tenant_id = :tenant",
+	}, {
+		planID: PlanUpdateLimit,
+		query:  "update a set name = 'x'",
+		want:   "update a set `name` = 'x' where a.tenant_id = :tenant limit :#maxLimit",
+	}, {
+		planID: PlanUpdate,
+		query:  "update a set tenant_id = 2 where id = 1",
+		err:    "row filters do not allow updating column tenant_id",
+	}, {
+		planID: PlanUpdate,
+		query:  "update c set name = 'x' where id in (select id from a)",
+		want:   "update c set `name` = 'x' where id in (select id from a where a.tenant_id = :tenant)",
+	}, {
+		planID: PlanUpdate,
+		query:  "update a join c on a.id = c.id set c.name = 'x'",
+		err:    "row filters do not allow multi-table DMLs on a",
+	}, {
+		planID: PlanDelete,
+		query:  "delete from b where id = 1",
+		want:   "delete from b where id = 1 and (b.region in ('us', 'ca') and b.tenant_id = lower(:tenant))",
+	}, {
+		planID: PlanDeleteLimit,
+		query:  "delete from a",
+		want:   "delete from a where a.tenant_id = :tenant limit :#maxLimit",
+	}, {
+		planID: PlanDelete,
+		query:  "delete a from a join c on a.id = c.id",
+		err:    "row filters do not allow multi-table deletes",
+	}, {
+		planID: PlanInsert,
+		query:  "insert into a(id) values (1)",
+		err:    "row filters do not allow Insert",
+	}, {
+		planID: PlanNextval,
+		query:  "select next value from a",
+		err:    "row filters do not allow Nextval",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tcase.query)
+			require.NoError(t, err)
+			got, err := BuildRestrictedQuery(tcase.planID, stmt, &Restrictions{RowFilters: predicates})
+			if tcase.err != "" {
+				require.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, got.Query)
+		})
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -40,6 +42,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	tacl "vitess.io/vitess/go/vt/tableacl/acl"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/audit"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
@@ -60,6 +63,18 @@ type TabletPlan struct {
 	Fields     []*querypb.Field
 	Rules      *rules.Rules
 	Authorized []*tableacl.ACLResult
+	// RowFilters are the tableacl row filters of the tables of the query.
+	RowFilters []*TableRowFilter
+	// ColumnACLs are the tableacl column acls of the tables of the query.
+	ColumnACLs []*TableColumnACL
+
+	// restrictedTables are the schemas of the tables of ColumnACLs.
+	restrictedTables map[string]*schema.Table
+	// restrictedQueries caches the queries built for combinations of
+	// RowFilters and ColumnACLs. It's keyed by the tables and names of
+	// the filters and acls.
+	restrictionMu     sync.Mutex
+	restrictedQueries map[string]*sqlparser.ParsedQuery
 
 	QueryCount   uint64
 	Time         uint64
@@ -67,6 +82,9 @@ type TabletPlan struct {
 	RowsAffected uint64
 	RowsReturned uint64
 	ErrorCount   uint64
+	// ConsolidatedCount is the number of executions
+	// that waited for the result of an identical query.
+	ConsolidatedCount uint64
 }
 
 // AddStats updates the stats for the current TabletPlan.
@@ -90,6 +108,16 @@ func (ep *TabletPlan) Stats() (queryCount uint64, duration, mysqlTime time.Durat
 	return
 }
 
+// AddConsolidation records an execution that waited for the result of an identical query.
+func (ep *TabletPlan) AddConsolidation() {
+	atomic.AddUint64(&ep.ConsolidatedCount, 1)
+}
+
+// Consolidations returns the number of executions that waited for the result of an identical query.
+func (ep *TabletPlan) Consolidations() uint64 {
+	return atomic.LoadUint64(&ep.ConsolidatedCount)
+}
+
 // buildAuthorized builds 'Authorized', which is the runtime part for 'Permissions'.
 func (ep *TabletPlan) buildAuthorized() {
 	ep.Authorized = make([]*tableacl.ACLResult, len(ep.Permissions))
@@ -98,6 +126,109 @@ func (ep *TabletPlan) buildAuthorized() {
 	}
 }
 
+// TableRowFilter is a tableacl row filter that applies to a table of a query.
+type TableRowFilter struct {
+	*tableacl.RowFilterResult
+	Table string
+}
+
+// TableColumnACL is a tableacl column acl that applies to a table of a query.
+type TableColumnACL struct {
+	*tableacl.ColumnACLResult
+	Table string
+}
+
+// buildRestrictions builds 'RowFilters' and 'ColumnACLs' for the tables in 'Permissions'.
+func (ep *TabletPlan) buildRestrictions(tables map[string]*schema.Table) {
+	seen := make(map[string]bool)
+	for _, perm := range ep.Permissions {
+		if seen[perm.TableName] {
+			continue
+		}
+		seen[perm.TableName] = true
+		for _, rf := range tableacl.RowFilters(perm.TableName) {
+			ep.RowFilters = append(ep.RowFilters, &TableRowFilter{RowFilterResult: rf, Table: perm.TableName})
+		}
+		for _, ca := range tableacl.ColumnACLs(perm.TableName) {
+			ep.ColumnACLs = append(ep.ColumnACLs, &TableColumnACL{ColumnACLResult: ca, Table: perm.TableName})
+			if table, ok := tables[perm.TableName]; ok {
+				if ep.restrictedTables == nil {
+					ep.restrictedTables = make(map[string]*schema.Table)
+				}
+				ep.restrictedTables[perm.TableName] = table
+			}
+		}
+	}
+}
+
+// restrictedQuery returns the query of the plan restricted by the
+// row filters and the column acls.
+func (ep *TabletPlan) restrictedQuery(filters []*TableRowFilter, columnACLs []*TableColumnACL) (*sqlparser.ParsedQuery, error) {
+	keys := make([]string, 0, len(filters)+len(columnACLs))
+	for _, rf := range filters {
+		keys = append(keys, "row:"+rf.Table+"."+rf.Name)
+	}
+	for _, ca := range columnACLs {
+		keys = append(keys, "column:"+ca.Table+"."+ca.Name)
+	}
+	key := strings.Join(keys, ",")
+
+	ep.restrictionMu.Lock()
+	defer ep.restrictionMu.Unlock()
+	if query, ok := ep.restrictedQueries[key]; ok {
+		return query, nil
+	}
+	restrictions := &planbuilder.Restrictions{Tables: ep.restrictedTables}
+	for _, rf := range filters {
+		if restrictions.RowFilters == nil {
+			restrictions.RowFilters = make(map[string]sqlparser.Expr)
+		}
+		if predicate, ok := restrictions.RowFilters[rf.Table]; ok {
+			restrictions.RowFilters[rf.Table] = &sqlparser.AndExpr{Left: predicate, Right: rf.Predicate}
+			continue
+		}
+		restrictions.RowFilters[rf.Table] = rf.Predicate
+	}
+	for _, ca := range columnACLs {
+		if restrictions.Columns == nil {
+			restrictions.Columns = make(map[string]map[string]*planbuilder.ColumnRestriction)
+		}
+		columns := restrictions.Columns[ca.Table]
+		if columns == nil {
+			columns = make(map[string]*planbuilder.ColumnRestriction)
+			restrictions.Columns[ca.Table] = columns
+		}
+		for _, column := range ca.Columns {
+			// Denials take precedence over masks.
+			if r, ok := columns[column]; ok && r.Mask == nil {
+				continue
+			}
+			r := &planbuilder.ColumnRestriction{Name: ca.Name}
+			if ca.Masked() {
+				r.Mask = ca.MaskExpr
+			}
+			columns[column] = r
+		}
+	}
+	// The statement is parsed again because it gets modified.
+	var statement sqlparser.Statement
+	if ep.Original != "" {
+		var err error
+		if statement, err = sqlparser.Parse(ep.Original); err != nil {
+			return nil, err
+		}
+	}
+	query, err := planbuilder.BuildRestrictedQuery(ep.PlanID, statement, restrictions)
+	if err != nil {
+		return nil, err
+	}
+	if ep.restrictedQueries == nil {
+		ep.restrictedQueries = make(map[string]*sqlparser.ParsedQuery)
+	}
+	ep.restrictedQueries[key] = query
+	return query, nil
+}
+
 //_______________________________________________
 
 // QueryEngine implements the core functionality of tabletserver.
@@ -131,6 +262,8 @@ type QueryEngine struct {
 	// that we start more than one transaction per hot row (range).
 	// For implementation details, please see BeginExecute() in tabletserver.go.
 	txSerializer *txserializer.TxSerializer
+	// auditor appends the DMLs and DDLs to the audit log.
+	auditor *audit.Logger
 
 	// Vars
 	maxResultSize    sync2.AtomicInt64
@@ -147,10 +280,15 @@ type QueryEngine struct {
 	strictTransTables bool
 
 	consolidatorMode            sync2.AtomicString
+	consolidatorMaxWaiters      sync2.AtomicInt64
+	consolidatorMaxResultSize   sync2.AtomicInt64
 	enableQueryPlanFieldCaching bool
 
 	// stats
 	queryCounts, queryTimes, queryRowCounts, queryErrorCounts *stats.CountersWithMultiLabels
+	// consolidatorRejections counts the queries that could have been
+	// consolidated, but were executed on their own, by reason.
+	consolidatorRejections *stats.CountersWithSingleLabel
 
 	// Loggers
 	accessCheckerLogger *logutil.ThrottledLogger
@@ -178,12 +316,16 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.conns = connpool.NewPool(env, "ConnPool", config.OltpReadPool)
 	qe.streamConns = connpool.NewPool(env, "StreamConnPool", config.OlapReadPool)
 	qe.consolidatorMode.Set(config.Consolidator)
+	qe.consolidatorMaxWaiters.Set(config.ConsolidatorMaxWaiters)
+	qe.consolidatorMaxResultSize.Set(config.ConsolidatorMaxResultSize)
 	qe.enableQueryPlanFieldCaching = config.CacheResultFields
 	qe.consolidator = sync2.NewConsolidator()
 	if config.ConsolidatorStreamTotalSize > 0 && config.ConsolidatorStreamQuerySize > 0 {
 		qe.streamConsolidator = NewStreamConsolidator(config.ConsolidatorStreamTotalSize, config.ConsolidatorStreamQuerySize, returnStreamResult)
+		qe.streamConsolidator.SetMaxFollowers(config.ConsolidatorMaxWaiters)
 	}
 	qe.txSerializer = txserializer.New(env)
+	qe.auditor = audit.NewLogger(env)
 
 	qe.strictTableACL = config.StrictTableACL
 	qe.enableTableACLDryRun = config.EnableTableACLDryRun
@@ -226,6 +368,10 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.queryTimes = env.Exporter().NewCountersWithMultiLabels("QueryTimesNs", "query times in ns", []string{"Table", "Plan"})
 	qe.queryRowCounts = env.Exporter().NewCountersWithMultiLabels("QueryRowCounts", "query row counts", []string{"Table", "Plan"})
 	qe.queryErrorCounts = env.Exporter().NewCountersWithMultiLabels("QueryErrorCounts", "query error counts", []string{"Table", "Plan"})
+	qe.consolidatorRejections = env.Exporter().NewCountersWithSingleLabel("ConsolidatorRejections", "Queries that were executed on their own instead of waiting for an identical query", "Reason")
+	if qe.streamConsolidator != nil {
+		env.Exporter().NewCounterFunc("StreamConsolidatorFollowersRejected", "Streaming queries that were executed on their own because the identical stream had too many followers", qe.streamConsolidator.FollowersRejected)
+	}
 
 	env.Exporter().HandleFunc("/debug/hotrows", qe.txSerializer.ServeHTTP)
 	env.Exporter().HandleFunc("/debug/tablet_plans", qe.handleHTTPQueryPlans)
@@ -261,6 +407,11 @@ func (qe *QueryEngine) Open() error {
 		return err
 	}
 
+	if err := qe.auditor.Open(); err != nil {
+		qe.conns.Close()
+		return err
+	}
+
 	qe.streamConns.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
 	qe.se.RegisterNotifier("qe", qe.schemaChanged)
 	qe.isOpen = true
@@ -279,6 +430,7 @@ func (qe *QueryEngine) Close() {
 	qe.plans.Clear()
 	qe.tables = make(map[string]*schema.Table)
 	qe.streamConns.Close()
+	qe.auditor.Close()
 	qe.conns.Close()
 	qe.isOpen = false
 	log.Info("Query Engine: closed")
@@ -313,6 +465,7 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	plan := &TabletPlan{Plan: splan, Original: sql}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
+	plan.buildRestrictions(qe.tables)
 	if plan.PlanID.IsSelect() {
 		if !skipQueryPlanCache && qe.enableQueryPlanFieldCaching && plan.FieldQuery != nil {
 			conn, err := qe.conns.Get(ctx)
@@ -351,6 +504,7 @@ func (qe *QueryEngine) GetStreamPlan(sql string, isReservedConn bool) (*TabletPl
 	plan := &TabletPlan{Plan: splan, Original: sql}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
+	plan.buildRestrictions(qe.tables)
 	return plan, nil
 }
 
@@ -365,6 +519,7 @@ func (qe *QueryEngine) GetMessageStreamPlan(name string) (*TabletPlan, error) {
 	plan := &TabletPlan{Plan: splan}
 	plan.Rules = qe.queryRuleSources.FilterByPlan("stream from "+name, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
+	plan.buildRestrictions(qe.tables)
 	return plan, nil
 }
 
@@ -443,6 +598,8 @@ type perQueryStats struct {
 	RowsAffected uint64
 	RowsReturned uint64
 	ErrorCount   uint64
+
+	ConsolidatedCount uint64
 }
 
 func (qe *QueryEngine) handleHTTPQueryPlans(response http.ResponseWriter, request *http.Request) {
@@ -480,6 +637,7 @@ func (qe *QueryEngine) handleHTTPQueryStats(response http.ResponseWriter, reques
 		pqstats.Table = plan.TableName().String()
 		pqstats.Plan = plan.PlanID
 		pqstats.QueryCount, pqstats.Time, pqstats.MysqlTime, pqstats.RowsAffected, pqstats.RowsReturned, pqstats.ErrorCount = plan.Stats()
+		pqstats.ConsolidatedCount = plan.Consolidations()
 
 		qstats = append(qstats, pqstats)
 		return true
@@ -554,6 +712,47 @@ func (qe *QueryEngine) handleHTTPConsolidations(response http.ResponseWriter, re
 		}
 		response.Write([]byte(fmt.Sprintf("%v: %s\n", v.Count, query)))
 	}
+
+	// The fingerprint of a query is its normalized form, which is the key of its plan.
+	fingerprints := qe.consolidationsByFingerprint()
+	response.Write([]byte(fmt.Sprintf("\nBy fingerprint: %d\n", len(fingerprints))))
+	for _, v := range fingerprints {
+		query := v.Query
+		if *streamlog.RedactDebugUIQueries {
+			query, _ = sqlparser.RedactSQLQuery(query)
+		}
+		response.Write([]byte(fmt.Sprintf("%v: %s\n", v.Count, sqlparser.TruncateForUI(query))))
+	}
+	response.Write([]byte("\nRejections:\n"))
+	rejections := qe.consolidatorRejections.Counts()
+	var reasons []string
+	for reason := range rejections {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	for _, reason := range reasons {
+		response.Write([]byte(fmt.Sprintf("%s: %v\n", reason, rejections[reason])))
+	}
+	if qe.streamConsolidator != nil {
+		response.Write([]byte(fmt.Sprintf("StreamFollowers: %v\n", qe.streamConsolidator.FollowersRejected())))
+	}
+}
+
+// consolidationsByFingerprint returns the number of consolidations of the cached plans,
+// from the most consolidated.
+func (qe *QueryEngine) consolidationsByFingerprint() []sync2.ConsolidatorCacheItem {
+	var items []sync2.ConsolidatorCacheItem
+	qe.plans.ForEach(func(value interface{}) bool {
+		plan := value.(*TabletPlan)
+		if count := plan.Consolidations(); count > 0 {
+			items = append(items, sync2.ConsolidatorCacheItem{Query: plan.Original, Count: int64(count)})
+		}
+		return true
+	})
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Count > items[j].Count
+	})
+	return items
 }
 
 // unicoded returns a valid UTF-8 string that json won't reject
@@ -17,6 +17,7 @@ limitations under the License.
 package tabletserver
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -37,6 +38,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/audit"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	p "vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
@@ -59,6 +61,13 @@ type QueryExecutor struct {
 	logStats       *tabletenv.LogStats
 	tsv            *TabletServer
 	tabletType     topodatapb.TabletType
+
+	// restrictedQuery replaces the FullQuery of the plan if tableacl
+	// row filters or column acls restrict the caller.
+	restrictedQuery *sqlparser.ParsedQuery
+	// columnsRestricted is set if column acls restrict the caller,
+	// in which case the cached fields of the plan don't apply.
+	columnsRestricted bool
 }
 
 const streamRowsSize = 256
@@ -91,11 +100,98 @@ var sequenceFields = []*querypb.Field{
 	},
 }
 
+// errConsolidatedResultTooLarge is set as the error of a consolidated
+// result that is too large to be shared with the queries waiting for it.
+var errConsolidatedResultTooLarge = errors.New("consolidated result is too large to be shared")
+
 func (qre *QueryExecutor) shouldConsolidate() bool {
 	cm := qre.tsv.qe.consolidatorMode.Get()
+	if qre.plan.Rules != nil {
+		remoteAddr, username := qre.remoteAddrAndUsername()
+		if qre.plan.Rules.GetConsolidationAction(remoteAddr, username, qre.bindVars) == rules.QRConsolidateNotOnMaster {
+			cm = tabletenv.NotOnMaster
+		}
+	}
 	return cm == tabletenv.Enable || (cm == tabletenv.NotOnMaster && qre.tabletType != topodatapb.TabletType_MASTER)
 }
 
+// remoteAddrAndUsername returns the address and the user name of the caller.
+func (qre *QueryExecutor) remoteAddrAndUsername() (remoteAddr, username string) {
+	if ci, ok := callinfo.FromContext(qre.ctx); ok {
+		return ci.RemoteAddr(), ci.Username()
+	}
+	return "", ""
+}
+
+// auditClass returns the audit class of the statements of a plan,
+// or an empty string if they are not audited.
+func auditClass(planID p.PlanType) string {
+	switch planID {
+	case p.PlanInsert, p.PlanInsertMessage, p.PlanUpdate, p.PlanUpdateLimit, p.PlanDelete, p.PlanDeleteLimit, p.PlanLoad:
+		return tabletenv.AuditClassDML
+	case p.PlanDDL, p.PlanAlterMigration, p.PlanRevertMigration:
+		return tabletenv.AuditClassDDL
+	}
+	return ""
+}
+
+// audited returns the audit class and the tables of the query,
+// and whether it's audited.
+func (qre *QueryExecutor) audited() (class string, tables []string, ok bool) {
+	class = auditClass(qre.plan.PlanID)
+	if class == "" {
+		return "", nil, false
+	}
+	seen := make(map[string]bool)
+	for _, perm := range qre.plan.Permissions {
+		if !seen[perm.TableName] {
+			seen[perm.TableName] = true
+			tables = append(tables, perm.TableName)
+		}
+	}
+	return class, tables, qre.tsv.qe.auditor.Audits(class, tables)
+}
+
+// checkAudit rejects an audited query while the audit log can't be written.
+func (qre *QueryExecutor) checkAudit() error {
+	if _, _, ok := qre.audited(); !ok {
+		return nil
+	}
+	if err := qre.tsv.qe.auditor.Err(); err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "%v, the query cannot be audited", err)
+	}
+	return nil
+}
+
+// audit appends the execution of the query to the audit log,
+// if the class of its statement and its tables are audited.
+func (qre *QueryExecutor) audit(reply *sqltypes.Result, err error) {
+	class, tables, ok := qre.audited()
+	if !ok {
+		return
+	}
+	record := &audit.Record{
+		Keyspace:        qre.logStats.Target.GetKeyspace(),
+		Shard:           qre.logStats.Target.GetShard(),
+		Class:           class,
+		Plan:            qre.plan.PlanID.String(),
+		Tables:          tables,
+		SQL:             qre.query,
+		BindVars:        audit.BindVars(qre.bindVars),
+		EffectiveCaller: callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(qre.ctx)),
+		ImmediateCaller: callerid.GetUsername(callerid.ImmediateCallerIDFromContext(qre.ctx)),
+		TransactionID:   qre.connID,
+	}
+	record.ClientAddr, _ = qre.remoteAddrAndUsername()
+	if reply != nil {
+		record.RowsAffected = reply.RowsAffected
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	qre.tsv.qe.auditor.Record(record)
+}
+
 // Execute performs a non-streaming query execution.
 func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	planName := qre.plan.PlanID.String()
@@ -104,6 +200,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		duration := time.Since(start)
 		qre.tsv.stats.QueryTimings.Add(planName, duration)
 		qre.recordUserQuery("Execute", int64(duration))
+		qre.audit(reply, err)
 
 		mysqlTime := qre.logStats.MysqlResponseTime
 		tableName := qre.plan.TableName().String()
@@ -126,6 +223,9 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
+	if err := qre.checkAudit(); err != nil {
+		return nil, err
+	}
 
 	switch qre.plan.PlanID {
 	case p.PlanNextval:
@@ -133,9 +233,9 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	case p.PlanSelectImpossible:
 		// If the fields did not get cached, we have send the query
 		// to mysql, which you can see below.
-		if qre.plan.Fields != nil {
+		if fields := qre.planFields(); fields != nil {
 			return &sqltypes.Result{
-				Fields: qre.plan.Fields,
+				Fields: fields,
 			}, nil
 		}
 	}
@@ -289,7 +389,7 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 		return err
 	}
 
-	sql, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	sql, sqlWithoutComments, err := qre.generateFinalSQL(qre.fullQuery(), qre.bindVars)
 	if err != nil {
 		return err
 	}
@@ -301,6 +401,11 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 
 	if consolidator := qre.tsv.qe.streamConsolidator; consolidator != nil {
 		if qre.connID == 0 && qre.plan.PlanID == p.PlanSelectStream && qre.shouldConsolidate() {
+			defer func() {
+				if qre.logStats.QuerySources&tabletenv.QuerySourceConsolidator != 0 {
+					qre.plan.AddConsolidation()
+				}
+			}()
 			return consolidator.Consolidate(qre.logStats, sqlWithoutComments, callback,
 				func(callback StreamCallback) error {
 					dbConn, err := qre.getStreamConn()
@@ -390,13 +495,7 @@ func (qre *QueryExecutor) checkPermissions() error {
 	}
 
 	// Check if the query is blacklisted.
-	remoteAddr := ""
-	username := ""
-	ci, ok := callinfo.FromContext(qre.ctx)
-	if ok {
-		remoteAddr = ci.RemoteAddr()
-		username = ci.Username()
-	}
+	remoteAddr, username := qre.remoteAddrAndUsername()
 	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars)
 	switch action {
 	case rules.QRFail:
@@ -437,9 +536,72 @@ func (qre *QueryExecutor) checkPermissions() error {
 		}
 	}
 
+	return qre.applyRestrictions(callerID)
+}
+
+// applyRestrictions restricts the query to the rows that the tableacl
+// row filters of the caller allow it to access, and to the columns that
+// its column acls allow it to read.
+func (qre *QueryExecutor) applyRestrictions(callerID *querypb.VTGateCallerID) error {
+	var filters []*TableRowFilter
+	for _, rf := range qre.plan.RowFilters {
+		if rf.IsMember(callerID) {
+			filters = append(filters, rf)
+		}
+	}
+	var columnACLs []*TableColumnACL
+	for _, ca := range qre.plan.ColumnACLs {
+		if !ca.IsMember(callerID) {
+			columnACLs = append(columnACLs, ca)
+		}
+	}
+	if len(filters) == 0 && len(columnACLs) == 0 {
+		return nil
+	}
+	query, err := qre.plan.restrictedQuery(filters, columnACLs)
+	if err != nil {
+		var denied *p.ColumnAccessDeniedError
+		if errors.As(err, &denied) {
+			errStr := fmt.Sprintf("table acl error: %q %v cannot run %v: %v", callerID.Username, callerID.Groups, qre.plan.PlanID, denied)
+			qre.tsv.Stats().TableaclDenied.Add([]string{denied.Table, denied.Restriction, qre.plan.PlanID.String(), callerID.Username}, 1)
+			qre.tsv.qe.accessCheckerLogger.Infof("%s", errStr)
+			return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "%s", errStr)
+		}
+		return err
+	}
+	effectiveCallerID := callerid.EffectiveCallerIDFromContext(qre.ctx)
+	for _, rf := range filters {
+		bindVars, err := rf.BindVariables(effectiveCallerID, callerID)
+		if err != nil {
+			return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "row filter %s: %v", rf.Name, err)
+		}
+		for name, bv := range bindVars {
+			qre.bindVars[name] = bv
+		}
+		qre.tsv.Stats().TableaclRowFiltered.Add([]string{rf.Table, rf.Name, qre.plan.PlanID.String(), callerID.Username}, 1)
+	}
+	qre.restrictedQuery = query
+	qre.columnsRestricted = len(columnACLs) != 0
 	return nil
 }
 
+// fullQuery returns the query to execute for the plan.
+func (qre *QueryExecutor) fullQuery() *sqlparser.ParsedQuery {
+	if qre.restrictedQuery != nil {
+		return qre.restrictedQuery
+	}
+	return qre.plan.FullQuery
+}
+
+// planFields returns the cached fields of the plan, unless
+// they don't apply to the query that gets executed.
+func (qre *QueryExecutor) planFields() []*querypb.Field {
+	if qre.columnsRestricted {
+		return nil
+	}
+	return qre.plan.Fields
+}
+
 func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName string, callerID *querypb.VTGateCallerID) error {
 	statsKey := []string{tableName, authorized.GroupName, qre.plan.PlanID.String(), callerID.Username}
 	if !authorized.IsMember(callerID) {
@@ -604,14 +766,14 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 // execSelect sends a query to mysql only if another identical query is not running. Otherwise, it waits and
 // reuses the result. If the plan is missing field info, it sends the query to mysql requesting full info.
 func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
-	if qre.tsv.qe.enableQueryPlanFieldCaching && qre.plan.Fields != nil {
-		result, err := qre.qFetch(qre.logStats, qre.plan.FullQuery, qre.bindVars)
+	if fields := qre.planFields(); qre.tsv.qe.enableQueryPlanFieldCaching && fields != nil {
+		result, err := qre.qFetch(qre.logStats, qre.fullQuery(), qre.bindVars)
 		if err != nil {
 			return nil, err
 		}
 		// result is read-only. So, let's copy it before modifying.
 		newResult := *result
-		newResult.Fields = qre.plan.Fields
+		newResult.Fields = fields
 		return &newResult, nil
 	}
 	conn, err := qre.getConn()
@@ -620,7 +782,7 @@ func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 	}
 	defer conn.Recycle()
 
-	sql, _, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	sql, _, err := qre.generateFinalSQL(qre.fullQuery(), qre.bindVars)
 	if err != nil {
 		return nil, err
 	}
@@ -651,7 +813,7 @@ func (qre *QueryExecutor) verifyRowCount(count, maxrows int64) error {
 	if warnThreshold > 0 && count > warnThreshold {
 		callerID := callerid.ImmediateCallerIDFromContext(qre.ctx)
 		qre.tsv.Stats().Warnings.Add("ResultsExceeded", 1)
-		log.Warningf("caller id: %s row count %v exceeds warning threshold %v: %q", callerID.Username, count, warnThreshold, queryAsString(qre.plan.FullQuery.Query, qre.bindVars))
+		log.Warningf("caller id: %s row count %v exceeds warning threshold %v: %q", callerID.Username, count, warnThreshold, queryAsString(qre.fullQuery().Query, qre.bindVars))
 	}
 	return nil
 }
@@ -715,16 +877,32 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 				defer conn.Recycle()
 				q.Result, q.Err = qre.execDBConn(conn, sql, false)
 			}
-		} else {
-			logStats.QuerySources |= tabletenv.QuerySourceConsolidator
+			if q.Err != nil {
+				return nil, q.Err
+			}
+			result := q.Result.(*sqltypes.Result)
+			if maxSize := qre.tsv.qe.consolidatorMaxResultSize.Get(); maxSize > 0 && q.Waiters() > 0 && result.CachedSize(true) > maxSize {
+				// The waiters will execute the query on their own.
+				q.Result, q.Err = nil, errConsolidatedResultTooLarge
+			}
+			return result, nil
+		}
+		if q.AddWaiter(qre.tsv.qe.consolidatorMaxWaiters.Get()) {
 			startTime := time.Now()
 			q.Wait()
 			qre.tsv.stats.WaitTimings.Record("Consolidations", startTime)
+			if q.Err != errConsolidatedResultTooLarge {
+				logStats.QuerySources |= tabletenv.QuerySourceConsolidator
+				qre.plan.AddConsolidation()
+				if q.Err != nil {
+					return nil, q.Err
+				}
+				return q.Result.(*sqltypes.Result), nil
+			}
+			qre.tsv.qe.consolidatorRejections.Add("ResultTooLarge", 1)
+		} else {
+			qre.tsv.qe.consolidatorRejections.Add("MaxWaiters", 1)
 		}
-		if q.Err != nil {
-			return nil, q.Err
-		}
-		return q.Result.(*sqltypes.Result), nil
 	}
 	conn, err := qre.getConn()
 	if err != nil {
@@ -740,7 +918,7 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 
 // txFetch fetches from a TxConnection.
 func (qre *QueryExecutor) txFetch(conn *StatefulConnection, record bool) (*sqltypes.Result, error) {
-	sql, _, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	sql, _, err := qre.generateFinalSQL(qre.fullQuery(), qre.bindVars)
 	if err != nil {
 		return nil, err
 	}
@@ -846,7 +1024,7 @@ func (qre *QueryExecutor) execAlterMigration() (*sqltypes.Result, error) {
 	case sqlparser.RetryMigrationType:
 		return qre.tsv.onlineDDLExecutor.RetryMigration(qre.ctx, alterMigration.UUID)
 	case sqlparser.CompleteMigrationType:
-		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "ALTER VITESS_MIGRATION COMPLETE is not implemented yet")
+		return qre.tsv.onlineDDLExecutor.CompleteMigration(qre.ctx, alterMigration.UUID)
 	case sqlparser.CancelMigrationType:
 		return qre.tsv.onlineDDLExecutor.CancelMigration(qre.ctx, alterMigration.UUID, true, "CANCEL issued by user")
 	case sqlparser.CancelAllMigrationType:
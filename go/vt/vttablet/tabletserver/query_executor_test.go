@@ -17,12 +17,18 @@ limitations under the License.
 package tabletserver
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math/rand"
+	"os"
+	"path"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -41,6 +47,7 @@ import (
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/audit"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -326,6 +333,120 @@ func TestQueryExecutorPlans(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorAudit(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("insert into test_table(pk, addr) values (1, 2)", &sqltypes.Result{RowsAffected: 1})
+	db.AddQuery("select * from test_table limit 10001", &sqltypes.Result{Fields: getTestTableFields()})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{Fields: getTestTableFields()})
+	ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("principal", "", ""), &querypb.VTGateCallerID{Username: "app"})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	config := tsv.config.Clone()
+	config.Audit.File = path.Join(dir, "audit.jsonl")
+	tsv.qe.auditor.Close()
+	tsv.qe.auditor = audit.NewLogger(tabletenv.NewEnv(config, "AuditTest"))
+	require.NoError(t, tsv.qe.auditor.Open())
+
+	target := tsv.sm.Target()
+	txid, _, err := tsv.Begin(ctx, target, nil)
+	require.NoError(t, err)
+	qre := newTestQueryExecutor(ctx, tsv, "insert into test_table(pk, addr) values (:pk, 2)", txid)
+	qre.bindVars["pk"] = sqltypes.Int64BindVariable(1)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	_, err = tsv.Commit(ctx, target, txid)
+	require.NoError(t, err)
+	// Selects are not audited.
+	qre = newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	tsv.qe.auditor.Close()
+
+	data, err := ioutil.ReadFile(config.Audit.File)
+	require.NoError(t, err)
+	n, err := audit.Verify(bytes.NewBuffer(data))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+	record := &audit.Record{}
+	require.NoError(t, json.Unmarshal(data, record))
+	assert.Equal(t, tabletenv.AuditClassDML, record.Class)
+	assert.Equal(t, "Insert", record.Plan)
+	assert.Equal(t, []string{"test_table"}, record.Tables)
+	assert.Equal(t, "insert into test_table(pk, addr) values (:pk, 2)", record.SQL)
+	assert.Equal(t, map[string]string{"pk": "1"}, record.BindVars)
+	assert.Equal(t, "principal", record.EffectiveCaller)
+	assert.Equal(t, "app", record.ImmediateCaller)
+	assert.Equal(t, txid, record.TransactionID)
+	assert.Equal(t, uint64(1), record.RowsAffected)
+}
+
+func TestQueryExecutorAuditFailsClosed(t *testing.T) {
+	if _, err := os.Stat("/dev/full"); err != nil {
+		t.Skip("/dev/full is required to fail the writes of the audit log")
+	}
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("insert into test_table(pk, addr) values (1, 2)", &sqltypes.Result{RowsAffected: 1})
+	db.AddQuery("select * from test_table limit 10001", &sqltypes.Result{Fields: getTestTableFields()})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	config := tsv.config.Clone()
+	config.Audit.File = "/dev/full"
+	tsv.qe.auditor.Close()
+	tsv.qe.auditor = audit.NewLogger(tabletenv.NewEnv(config, "AuditTest"))
+	require.NoError(t, tsv.qe.auditor.Open())
+	defer tsv.qe.auditor.Close()
+
+	// The first audited query fails the log, and the next ones are rejected.
+	_, err := newTestQueryExecutor(ctx, tsv, "insert into test_table(pk, addr) values (1, 2)", 0).Execute()
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return tsv.qe.auditor.Err() != nil }, 5*time.Second, time.Millisecond)
+	_, err = newTestQueryExecutor(ctx, tsv, "insert into test_table(pk, addr) values (1, 2)", 0).Execute()
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "cannot be audited")
+	// Queries that are not audited are executed.
+	_, err = newTestQueryExecutor(ctx, tsv, "select * from test_table", 0).Execute()
+	require.NoError(t, err)
+}
+
+func TestMessagerDMLAudit(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	db.AddQueryPattern("update msg set time_next = .*", &sqltypes.Result{RowsAffected: 1})
+
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	config := tsv.config.Clone()
+	config.Audit.File = path.Join(dir, "audit.jsonl")
+	tsv.qe.auditor.Close()
+	tsv.qe.auditor = audit.NewLogger(tabletenv.NewEnv(config, "AuditTest"))
+	require.NoError(t, tsv.qe.auditor.Open())
+
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	_, err = tsv.PostponeMessages(ctx, &target, "msg", []string{"1", "2"})
+	require.NoError(t, err)
+	tsv.qe.auditor.Close()
+
+	data, err := ioutil.ReadFile(config.Audit.File)
+	require.NoError(t, err)
+	record := &audit.Record{}
+	require.NoError(t, json.Unmarshal(data, record))
+	assert.Equal(t, tabletenv.AuditClassDML, record.Class)
+	assert.Equal(t, []string{"msg"}, record.Tables)
+	assert.Contains(t, record.SQL, "update msg set time_next")
+	assert.NotEmpty(t, record.BindVars)
+}
+
 // TestQueryExecutorSelectImpossible is separate because it's a special case
 // because the "in transaction" case is a no-op.
 func TestQueryExecutorSelectImpossible(t *testing.T) {
@@ -787,6 +908,154 @@ func TestQueryExecutorTableAcl(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorTableAclRowFilters(t *testing.T) {
+	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
+	tableacl.Register(aclName, &simpleacl.Factory{})
+	tableacl.SetDefaultACL(aclName)
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields(), Rows: [][]sqltypes.Value{{sqltypes.NewInt32(1), sqltypes.NewInt32(2), sqltypes.NewInt32(3)}}})
+	db.AddQuery("select * from test_table where test_table.tenant_id = 'acme' limit 1000", want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group01",
+			TableNamesOrPrefixes: []string{"test_table"},
+			Readers:              []string{"tenants", "admin"},
+			Writers:              []string{"tenants", "admin"},
+		}},
+	}
+	rowFilters := []*tableacl.RowFilter{{
+		Name:                 "tenants",
+		TableNamesOrPrefixes: []string{"test_table"},
+		Members:              []string{"tenants"},
+		Predicate:            "tenant_id = :tenant",
+		BindVars:             map[string]string{"tenant": "group:tenant="},
+	}}
+	require.NoError(t, tableacl.InitWithRestrictions(config, &tableacl.Restrictions{RowFilters: rowFilters}))
+	defer tableacl.InitFromProto(&tableaclpb.Config{})
+
+	tenantCtx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{
+		Username: "app",
+		Groups:   []string{"tenants", "tenant=acme"},
+	})
+	tsv := newTestTabletServer(tenantCtx, noFlags, db)
+	defer tsv.StopService()
+	startingFiltered := tsv.Stats().TableaclRowFiltered.Counts()["test_table.tenants.Select.app"]
+
+	// The query of a tenant is restricted to its rows.
+	qre := newTestQueryExecutor(tenantCtx, tsv, query, 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Equal(t, int64(1), tsv.Stats().TableaclRowFiltered.Counts()["test_table.tenants.Select.app"]-startingFiltered)
+
+	// Other callers are not.
+	adminCtx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "admin"})
+	qre = newTestQueryExecutor(adminCtx, tsv, query, 0)
+	got, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(got.Rows))
+
+	// Tenants without a tenant group are denied.
+	noTenantCtx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{
+		Username: "app",
+		Groups:   []string{"tenants"},
+	})
+	qre = newTestQueryExecutor(noTenantCtx, tsv, query, 0)
+	_, err = qre.Execute()
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_PERMISSION_DENIED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "row filter tenants: caller has no group:tenant=")
+
+	// DMLs that could escape the filter are denied.
+	qre = newTestQueryExecutor(tenantCtx, tsv, "insert into test_table(pk, name) values(1, 'a')", 0)
+	_, err = qre.Execute()
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_PERMISSION_DENIED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "row filters do not allow Insert")
+}
+
+func TestQueryExecutorTableAclColumnACLs(t *testing.T) {
+	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
+	tableacl.Register(aclName, &simpleacl.Factory{})
+	tableacl.SetDefaultACL(aclName)
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields(), Rows: [][]sqltypes.Value{{sqltypes.NewInt32(1), sqltypes.NewInt32(2), sqltypes.NewInt32(3)}}})
+	maskedFields := []*querypb.Field{
+		{Name: "pk", Type: sqltypes.Int32},
+		{Name: "addr", Type: sqltypes.VarChar},
+	}
+	want := &sqltypes.Result{
+		Fields: maskedFields,
+		Rows:   [][]sqltypes.Value{{sqltypes.NewInt32(1), sqltypes.NewVarChar("4e07408562bedb8b60ce05c1decfe3ad16b72230967de01f640b7e4729b49fce")}},
+	}
+	db.AddQuery("select test_table.pk, sha2(test_table.addr, 256) as addr from test_table limit 1000", want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	db.AddQuery("select `name` from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields()[1:2],
+	})
+
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group01",
+			TableNamesOrPrefixes: []string{"test_table"},
+			Readers:              []string{"app", "admin"},
+		}},
+	}
+	columnACLs := []*tableacl.ColumnACL{{
+		Name:                 "pii",
+		TableNamesOrPrefixes: []string{"test_table"},
+		Columns:              []string{"addr"},
+		Readers:              []string{"admin"},
+		Mask:                 tableacl.MaskHash,
+	}, {
+		Name:                 "secrets",
+		TableNamesOrPrefixes: []string{"test_table"},
+		Columns:              []string{"name"},
+		Readers:              []string{"admin"},
+	}}
+	require.NoError(t, tableacl.InitWithRestrictions(config, &tableacl.Restrictions{ColumnACLs: columnACLs}))
+	defer tableacl.InitFromProto(&tableaclpb.Config{})
+
+	appCtx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "app"})
+	tsv := newTestTabletServer(appCtx, noFlags, db)
+	defer tsv.StopService()
+	startingDenied := tsv.Stats().TableaclDenied.Counts()["test_table.secrets.Select.app"]
+
+	// The '*' of the caller is expanded without its denied columns, and with its masked ones.
+	qre := newTestQueryExecutor(appCtx, tsv, query, 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	// Queries of the caller that reference a denied column are denied.
+	qre = newTestQueryExecutor(appCtx, tsv, "select name from test_table", 0)
+	_, err = qre.Execute()
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_PERMISSION_DENIED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "column acl secrets denies access to test_table.name")
+	assert.Equal(t, int64(1), tsv.Stats().TableaclDenied.Counts()["test_table.secrets.Select.app"]-startingDenied)
+
+	// Readers are not restricted.
+	adminCtx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "admin"})
+	qre = newTestQueryExecutor(adminCtx, tsv, query, 0)
+	got, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, getTestTableFields(), got.Fields)
+}
+
 func TestQueryExecutorTableAclNoPermission(t *testing.T) {
 	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
 	tableacl.Register(aclName, &simpleacl.Factory{})
@@ -1132,6 +1401,91 @@ func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorConsolidationRule(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	consolidateRule := rules.NewQueryRule("consolidate on replicas", "consolidate_test_table", rules.QRConsolidateNotOnMaster)
+	consolidateRule.AddTableCond("test_table")
+	qrs := rules.New()
+	qrs.Add(consolidateRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.consolidatorMode.Set(tabletenv.Disable)
+	rulesName := "consolidationRules"
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, qrs))
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.tabletType = topodatapb.TabletType_REPLICA
+	assert.True(t, qre.shouldConsolidate())
+	qre.tabletType = topodatapb.TabletType_MASTER
+	assert.False(t, qre.shouldConsolidate())
+}
+
+func TestQueryExecutorConsolidationLimits(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows:   [][]sqltypes.Value{{sqltypes.NewInt32(1), sqltypes.NewInt32(2), sqltypes.NewInt32(3)}},
+	})
+	// Fields are not fetched by qFetch.
+	want := &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{sqltypes.NewInt32(1), sqltypes.NewInt32(2), sqltypes.NewInt32(3)}},
+	}
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.consolidatorMaxWaiters.Set(1)
+	rejections := tsv.qe.consolidatorRejections.Counts()
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	require.NoError(t, err)
+	q, original := tsv.qe.consolidator.Create(sqlWithoutComments)
+	require.True(t, original)
+
+	// The waiter gives up on the consolidated result if it is too large
+	// and executes the query on its own.
+	done := make(chan *sqltypes.Result)
+	go func() {
+		result, err := qre.qFetch(qre.logStats, qre.plan.FullQuery, qre.bindVars)
+		assert.NoError(t, err)
+		done <- result
+	}()
+	for q.Waiters() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The consolidated query already has the maximum number of waiters.
+	qre2 := newTestQueryExecutor(ctx, tsv, query, 0)
+	got, err := qre2.qFetch(qre2.logStats, qre2.plan.FullQuery, qre2.bindVars)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Zero(t, qre2.logStats.QuerySources&tabletenv.QuerySourceConsolidator)
+
+	q.Err = errConsolidatedResultTooLarge
+	q.Broadcast()
+	assert.Equal(t, want, <-done)
+	assert.Zero(t, qre.logStats.QuerySources&tabletenv.QuerySourceConsolidator)
+	assert.Zero(t, qre.plan.Consolidations())
+	assert.Equal(t, rejections["MaxWaiters"]+1, tsv.qe.consolidatorRejections.Counts()["MaxWaiters"])
+	assert.Equal(t, rejections["ResultTooLarge"]+1, tsv.qe.consolidatorRejections.Counts()["ResultTooLarge"])
+}
+
 type executorFlags int64
 
 const (
@@ -1330,6 +1684,9 @@ func getQueryExecutorSupportedQueries() map[string]*sqltypes.Result {
 				mysql.ShowPrimaryRow("msg", "id"),
 			},
 		},
+		mysql.BaseShowUniqueKeys: {
+			Fields: mysql.ShowUniqueKeysFields,
+		},
 		"select * from test_table where 1 != 1": {
 			Fields: []*querypb.Field{{
 				Name: "pk",
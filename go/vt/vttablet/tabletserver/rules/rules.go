@@ -166,8 +166,12 @@ func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableN
 }
 
 // GetAction runs the input against the rules engine and returns the action to be performed.
+// The rules with a consolidation action are skipped: see GetConsolidationAction.
 func (qrs *Rules) GetAction(ip, user string, bindVars map[string]*querypb.BindVariable) (action Action, desc string) {
 	for _, qr := range qrs.rules {
+		if qr.act.isConsolidation() {
+			continue
+		}
 		if act := qr.GetAction(ip, user, bindVars); act != QRContinue {
 			return act, qr.Description
 		}
@@ -175,6 +179,20 @@ func (qrs *Rules) GetAction(ip, user string, bindVars map[string]*querypb.BindVa
 	return QRContinue, ""
 }
 
+// GetConsolidationAction runs the input against the rules with a consolidation
+// action, and returns the action of the first one that matches, or QRContinue.
+func (qrs *Rules) GetConsolidationAction(ip, user string, bindVars map[string]*querypb.BindVariable) Action {
+	for _, qr := range qrs.rules {
+		if !qr.act.isConsolidation() {
+			continue
+		}
+		if act := qr.GetAction(ip, user, bindVars); act != QRContinue {
+			return act
+		}
+	}
+	return QRContinue
+}
+
 //-----------------------------------------------
 
 // Rule represents one rule (conditions-action).
@@ -490,8 +508,15 @@ const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	// QRConsolidateNotOnMaster consolidates the matching queries
+	// only on replicas, whatever the consolidator mode.
+	QRConsolidateNotOnMaster
 )
 
+func (act Action) isConsolidation() bool {
+	return act == QRConsolidateNotOnMaster
+}
+
 // MarshalJSON marshals to JSON.
 func (act Action) MarshalJSON() ([]byte, error) {
 	// If we add more actions, we'll need to use a map.
@@ -501,6 +526,8 @@ func (act Action) MarshalJSON() ([]byte, error) {
 		str = "FAIL"
 	case QRFailRetry:
 		str = "FAIL_RETRY"
+	case QRConsolidateNotOnMaster:
+		str = "CONSOLIDATE_NOT_ON_MASTER"
 	default:
 		str = "INVALID"
 	}
@@ -861,6 +888,8 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				qr.act = QRFail
 			case "FAIL_RETRY":
 				qr.act = QRFailRetry
+			case "CONSOLIDATE_NOT_ON_MASTER":
+				qr.act = QRConsolidateNotOnMaster
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
@@ -24,6 +24,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
@@ -524,6 +527,31 @@ func TestAction(t *testing.T) {
 	}
 }
 
+func TestConsolidationAction(t *testing.T) {
+	qrs := New()
+
+	qr1 := NewQueryRule("consolidate on replicas", "r1", QRConsolidateNotOnMaster)
+	qr1.AddTableCond("t1")
+	qr2 := NewQueryRule("rule 2", "r2", QRFail)
+	qr2.SetUserCond("user")
+	qrs.Add(qr1)
+	qrs.Add(qr2)
+
+	// The consolidation rule does not hide the rules that come after it.
+	action, desc := qrs.GetAction("123", "user", nil)
+	assert.Equal(t, QRFail, action)
+	assert.Equal(t, "rule 2", desc)
+	assert.Equal(t, QRConsolidateNotOnMaster, qrs.GetConsolidationAction("123", "user", nil))
+
+	filtered := qrs.FilterByPlan("select * from t2", planbuilder.PlanSelect, "t2")
+	assert.Equal(t, QRContinue, filtered.GetConsolidationAction("123", "user", nil))
+
+	var imported = New()
+	err := imported.UnmarshalJSON([]byte(`[{"Name": "r1", "TableNames": ["t1"], "Action": "CONSOLIDATE_NOT_ON_MASTER"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, `[{"Description":"","Name":"r1","TableNames":["t1"],"Action":"CONSOLIDATE_NOT_ON_MASTER"}]`, marshalled(imported))
+}
+
 func TestImport(t *testing.T) {
 	var qrs = New()
 	jsondata := `[{
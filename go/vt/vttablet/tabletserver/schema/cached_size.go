@@ -23,7 +23,7 @@ func (cached *MessageInfo) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(128)
 	}
 	// field Fields []*vitess.io/vitess/go/vt/proto/query.Field
 	{
@@ -32,6 +32,10 @@ func (cached *MessageInfo) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field DeadLetterTable string
+	size += int64(len(cached.DeadLetterTable))
+	// field RecurrenceCatchup string
+	size += int64(len(cached.RecurrenceCatchup))
 	return size
 }
 func (cached *SequenceInfo) CachedSize(alloc bool) int64 {
@@ -50,7 +54,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(104)
+		size += int64(128)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.TableIdent
 	size += cached.Name.CachedSize(false)
@@ -65,6 +69,15 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	{
 		size += int64(cap(cached.PKColumns)) * int64(8)
 	}
+	// field UniqueKeys [][]int
+	{
+		size += int64(cap(cached.UniqueKeys)) * int64(24)
+		for _, elem := range cached.UniqueKeys {
+			{
+				size += int64(cap(elem)) * int64(8)
+			}
+		}
+	}
 	// field SequenceInfo *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.SequenceInfo
 	size += cached.SequenceInfo.CachedSize(true)
 	// field MessageInfo *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.MessageInfo
@@ -380,6 +380,9 @@ func (se *Engine) reload(ctx context.Context) error {
 	if err := se.populatePrimaryKeys(ctx, conn, changedTables); err != nil {
 		return err
 	}
+	if err := se.populateUniqueKeys(ctx, conn, changedTables); err != nil {
+		return err
+	}
 
 	// Update se.tables and se.lastChange
 	for k, t := range changedTables {
@@ -450,6 +453,45 @@ func (se *Engine) populatePrimaryKeys(ctx context.Context, conn *connpool.DBConn
 	return nil
 }
 
+// populateUniqueKeys populates the UniqueKeys for the specified tables.
+// Keys on expressions rather than columns are skipped.
+func (se *Engine) populateUniqueKeys(ctx context.Context, conn *connpool.DBConn, tables map[string]*Table) error {
+	ukData, err := conn.Exec(ctx, mysql.BaseShowUniqueKeys, maxTableCount, false)
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "could not get table unique key info: %v", err)
+	}
+	var (
+		table          *Table
+		keyName        string
+		key            []int
+		hasExpressions bool
+	)
+	flush := func() {
+		if table != nil && len(key) != 0 && !hasExpressions {
+			table.UniqueKeys = append(table.UniqueKeys, key)
+		}
+		key, hasExpressions = nil, false
+	}
+	for _, row := range ukData.Rows {
+		tableName, rowKeyName := row[0].ToString(), row[1].ToString()
+		if table == nil || tableName != table.Name.String() || rowKeyName != keyName {
+			flush()
+			table, keyName = tables[tableName], rowKeyName
+		}
+		if table == nil {
+			continue
+		}
+		index := table.FindColumn(sqlparser.NewColIdent(row[2].ToString()))
+		if index < 0 {
+			hasExpressions = true
+			continue
+		}
+		key = append(key, index)
+	}
+	flush()
+	return nil
+}
+
 // RegisterVersionEvent is called by the vstream when it encounters a version event (an insert into _vt.schema_tracking)
 // It triggers the historian to load the newer rows from the database to update its cache
 func (se *Engine) RegisterVersionEvent() error {
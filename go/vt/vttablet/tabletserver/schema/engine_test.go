@@ -143,6 +143,15 @@ func TestOpenAndReload(t *testing.T) {
 			mysql.ShowPrimaryRow("seq", "id"),
 		},
 	})
+	db.AddQuery(mysql.BaseShowUniqueKeys, &sqltypes.Result{
+		Fields: mysql.ShowUniqueKeysFields,
+		Rows: [][]sqltypes.Value{
+			mysql.ShowUniqueKeyRow("test_table_03", "expr_uk", "pk1"),
+			mysql.ShowUniqueKeyRow("test_table_03", "expr_uk", ""),
+			mysql.ShowUniqueKeyRow("test_table_03", "val_uk", "val"),
+			mysql.ShowUniqueKeyRow("test_table_03", "val_uk", "pk2"),
+		},
+	})
 	secondReadRowsValue := 123
 	AddFakeInnoDBReadRowsResult(db, secondReadRowsValue)
 
@@ -180,6 +189,7 @@ func TestOpenAndReload(t *testing.T) {
 			Type: sqltypes.Int32,
 		}},
 		PKColumns:     []int{0, 1},
+		UniqueKeys:    [][]int{{2, 1}},
 		FileSize:      128,
 		AllocatedSize: 256,
 	}
@@ -62,6 +62,9 @@ func loadMessageInfo(ta *Table, comment string) error {
 		"time_next":  {},
 		"epoch":      {},
 		"time_acked": {},
+		// Optional columns of recurring messages.
+		"recurrence":     {},
+		"time_scheduled": {},
 	}
 
 	requiredCols := []string{
@@ -110,6 +113,38 @@ func loadMessageInfo(ta *Table, comment string) error {
 
 	ta.MessageInfo.MaxBackoff, _ = getDuration(keyvals, "vt_max_backoff")
 
+	ta.MessageInfo.MaxRetries, _ = getNum(keyvals, "vt_max_retries")
+	ta.MessageInfo.DeadLetterTable = keyvals["vt_dead_letter_table"]
+	if ta.MessageInfo.MaxRetries < 0 {
+		return fmt.Errorf("vt_max_retries must not be negative for message table: %s", ta.Name.String())
+	}
+	if (ta.MessageInfo.MaxRetries == 0) != (ta.MessageInfo.DeadLetterTable == "") {
+		return fmt.Errorf("vt_max_retries and vt_dead_letter_table must be specified together for message table: %s", ta.Name.String())
+	}
+	if strings.EqualFold(ta.MessageInfo.DeadLetterTable, ta.Name.String()) {
+		return fmt.Errorf("message table %s cannot be its own dead letter table", ta.Name.String())
+	}
+
+	hasRecurrence := ta.FindColumn(sqlparser.NewColIdent("recurrence")) != -1
+	hasTimeScheduled := ta.FindColumn(sqlparser.NewColIdent("time_scheduled")) != -1
+	if hasRecurrence != hasTimeScheduled {
+		return fmt.Errorf("recurrence and time_scheduled must be both present or absent in message table: %s", ta.Name.String())
+	}
+	ta.MessageInfo.Recurring = hasRecurrence
+	switch catchup := keyvals["vt_recurrence_catchup"]; catchup {
+	case "":
+		if ta.MessageInfo.Recurring {
+			ta.MessageInfo.RecurrenceCatchup = RecurrenceCatchupSkip
+		}
+	case RecurrenceCatchupSkip, RecurrenceCatchupOnce, RecurrenceCatchupAll:
+		if !ta.MessageInfo.Recurring {
+			return fmt.Errorf("vt_recurrence_catchup requires the recurrence and time_scheduled columns in message table: %s", ta.Name.String())
+		}
+		ta.MessageInfo.RecurrenceCatchup = catchup
+	default:
+		return fmt.Errorf("invalid vt_recurrence_catchup %s for message table: %s", catchup, ta.Name.String())
+	}
+
 	for _, col := range requiredCols {
 		num := ta.FindColumn(sqlparser.NewColIdent(col))
 		if num == -1 {
@@ -141,6 +141,18 @@ func TestLoadTableMessage(t *testing.T) {
 	want.MessageInfo.MaxBackoff = 100 * time.Second
 	assert.Equal(t, want, table)
 
+	// Test loading max retries and dead letter table
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_max_retries=5,vt_dead_letter_table=test_table_dlq", db)
+	require.NoError(t, err)
+	want.MessageInfo.MaxRetries = 5
+	want.MessageInfo.DeadLetterTable = "test_table_dlq"
+	assert.Equal(t, want, table)
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_retries=5", db)
+	assert.EqualError(t, err, "vt_max_retries and vt_dead_letter_table must be specified together for message table: test_table")
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_retries=5,vt_dead_letter_table=test_table", db)
+	assert.EqualError(t, err, "message table test_table cannot be its own dead letter table")
+
 	// Missing property
 	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30", db)
 	wanterr := "not specified for message table"
@@ -158,6 +170,38 @@ func TestLoadTableMessage(t *testing.T) {
 	}
 }
 
+func TestLoadTableRecurringMessage(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	result := getMessageTableQueries()["select * from test_table where 1 != 1"]
+	result.Fields = append(result.Fields, &querypb.Field{
+		Name: "recurrence",
+		Type: sqltypes.VarChar,
+	})
+	db.AddQuery("select * from test_table where 1 != 1", result)
+	_, err := newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30", db)
+	assert.EqualError(t, err, "recurrence and time_scheduled must be both present or absent in message table: test_table")
+
+	result.Fields = append(result.Fields, &querypb.Field{
+		Name: "time_scheduled",
+		Type: sqltypes.Int64,
+	})
+	db.AddQuery("select * from test_table where 1 != 1", result)
+	table, err := newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30", db)
+	require.NoError(t, err)
+	assert.True(t, table.MessageInfo.Recurring)
+	assert.Equal(t, RecurrenceCatchupSkip, table.MessageInfo.RecurrenceCatchup)
+	// The recurrence columns are not sent to the subscribers.
+	assert.Len(t, table.MessageInfo.Fields, 2)
+
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_recurrence_catchup=all", db)
+	require.NoError(t, err)
+	assert.Equal(t, RecurrenceCatchupAll, table.MessageInfo.RecurrenceCatchup)
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_recurrence_catchup=never", db)
+	assert.EqualError(t, err, "invalid vt_recurrence_catchup never for message table: test_table")
+}
+
 func newTestLoadTable(tableType string, comment string, db *fakesqldb.DB) (*Table, error) {
 	ctx := context.Background()
 	appParams := db.ConnParams()
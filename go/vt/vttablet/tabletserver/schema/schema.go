@@ -32,6 +32,17 @@ const (
 	Message
 )
 
+// Catch-up policies of recurring messages, which define how the firings
+// that were missed while a message was not acked are handled.
+const (
+	// RecurrenceCatchupSkip skips the missed firings. This is the default.
+	RecurrenceCatchupSkip = "skip"
+	// RecurrenceCatchupOnce delivers the missed firings once.
+	RecurrenceCatchupOnce = "once"
+	// RecurrenceCatchupAll delivers every missed firing.
+	RecurrenceCatchupAll = "all"
+)
+
 // TypeNames allows to fetch a the type name for a table.
 // Count must match the number of table types.
 var TypeNames = []string{
@@ -47,6 +58,10 @@ type Table struct {
 	PKColumns []int
 	Type      int
 
+	// UniqueKeys contains the columns of the unique keys other than
+	// the primary key, in key order.
+	UniqueKeys [][]int
+
 	// SequenceInfo contains info for sequence tables.
 	SequenceInfo *SequenceInfo
 
@@ -103,6 +118,27 @@ type MessageInfo struct {
 	// MaxBackoff specifies the longest duration message manager
 	// should wait before rescheduling a message
 	MaxBackoff time.Duration
+
+	// MaxRetries specifies how many times a message is resent
+	// before it is moved to the DeadLetterTable. Zero means
+	// that the message is resent until it is acked.
+	MaxRetries int
+
+	// DeadLetterTable is the table to which messages that
+	// exceeded MaxRetries are moved. It must have the same
+	// columns as the message table. The messages of tables
+	// that have one are streamed with an extra vt_dead_lettered
+	// column, which reports the messages that were moved.
+	DeadLetterTable string
+
+	// Recurring is set if the table has the recurrence and
+	// time_scheduled columns. A message with a recurrence is
+	// rescheduled instead of being acked.
+	Recurring bool
+
+	// RecurrenceCatchup is the catch-up policy of the
+	// recurring messages.
+	RecurrenceCatchup string
 }
 
 // NewTable creates a new Table.
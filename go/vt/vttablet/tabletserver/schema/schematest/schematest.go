@@ -71,6 +71,9 @@ func Queries() map[string]*sqltypes.Result {
 				mysql.ShowPrimaryRow("msg", "id"),
 			},
 		},
+		mysql.BaseShowUniqueKeys: {
+			Fields: mysql.ShowUniqueKeysFields,
+		},
 		"select * from test_table_01 where 1 != 1": {
 			Fields: []*querypb.Field{{
 				Name: "pk",
@@ -20,6 +20,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -35,6 +37,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/withddl"
 )
@@ -51,6 +54,19 @@ const createSchemaTrackingTable = `CREATE TABLE IF NOT EXISTS _vt.schema_version
 const alterSchemaTrackingTableDDLBlob = "alter table _vt.schema_version modify column ddl BLOB NOT NULL"
 const alterSchemaTrackingTableSchemaxBlob = "alter table _vt.schema_version modify column schemax LONGBLOB NOT NULL"
 
+const (
+	selectLatestSchemaVersionBefore = "select max(id) as id from _vt.schema_version where time_updated < %d"
+	deleteSchemaVersionsBefore      = "delete from _vt.schema_version where id < %d"
+	selectSchemaVersionsSchemax     = "select id, schemax from _vt.schema_version where id > %d order by id asc limit %d"
+	deleteSchemaVersionsByID        = "delete from _vt.schema_version where id in (%s)"
+)
+
+// schemaVersionPurgeInterval is the interval between purges of _vt.schema_version, when retention or compaction apply
+const schemaVersionPurgeInterval = time.Hour
+
+// schemaVersionsCompactionPageSize is the number of _vt.schema_version rows read at a time when compacting
+const schemaVersionsCompactionPageSize = 1000
+
 var withDDL = withddl.New([]string{
 	createSidecarDB,
 	createSchemaTrackingTable,
@@ -105,6 +121,11 @@ func (tr *Tracker) Open() {
 	tr.wg.Add(1)
 
 	go tr.process(ctx)
+
+	if tr.env.Config().SchemaVersionMaxAgeSeconds > 0 || tr.env.Config().CompactSchemaVersions {
+		tr.wg.Add(1)
+		go tr.purgeSchemaVersions(ctx)
+	}
 }
 
 // Close disables the tracker functionality
@@ -265,6 +286,126 @@ func (tr *Tracker) saveCurrentSchemaToDb(ctx context.Context, gtid, ddl string,
 	return nil
 }
 
+// purgeSchemaVersions periodically applies the retention and compaction policies to _vt.schema_version
+func (tr *Tracker) purgeSchemaVersions(ctx context.Context) {
+	defer tr.env.LogError()
+	defer tr.wg.Done()
+
+	ticker := time.NewTicker(schemaVersionPurgeInterval)
+	defer ticker.Stop()
+	for {
+		if maxAge := tr.env.Config().SchemaVersionMaxAgeSeconds.Get(); maxAge > 0 {
+			if err := tr.purgeExpiredSchemaVersions(ctx, time.Now().Add(-maxAge)); err != nil {
+				log.Errorf("Error purging expired schema versions: %v", err)
+			}
+		}
+		if tr.env.Config().CompactSchemaVersions {
+			if err := tr.compactSchemaVersions(ctx); err != nil {
+				log.Errorf("Error compacting schema versions: %v", err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeExpiredSchemaVersions deletes schema versions which were replaced by a newer version before the given time.
+// The latest version before that time is retained, as it is still in effect at that time.
+func (tr *Tracker) purgeExpiredSchemaVersions(ctx context.Context, before time.Time) error {
+	conn, err := tr.engine.GetConnection(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+
+	result, err := withDDL.Exec(ctx, fmt.Sprintf(selectLatestSchemaVersionBefore, before.Unix()), conn.Exec)
+	if err != nil {
+		return err
+	}
+	if len(result.Rows) == 0 || result.Rows[0][0].IsNull() {
+		return nil
+	}
+	id, err := evalengine.ToInt64(result.Rows[0][0])
+	if err != nil {
+		return err
+	}
+	result, err = withDDL.Exec(ctx, fmt.Sprintf(deleteSchemaVersionsBefore, id), conn.Exec)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected > 0 {
+		log.Infof("Purged %d expired schema versions", result.RowsAffected)
+	}
+	return nil
+}
+
+// compactSchemaVersions deletes schema versions which are identical to their preceding version. Such versions
+// are the result of DDLs which do not affect the tracked schema, e.g. index changes. The table is read in pages,
+// as it may hold more rows than a single query returns.
+func (tr *Tracker) compactSchemaVersions(ctx context.Context) error {
+	conn, err := tr.engine.GetConnection(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+
+	var lastID int64
+	var previous []byte
+	compacted := 0
+	for {
+		result, err := withDDL.Exec(ctx, fmt.Sprintf(selectSchemaVersionsSchemax, lastID, schemaVersionsCompactionPageSize), conn.Exec)
+		if err != nil {
+			return err
+		}
+		if len(result.Rows) == 0 {
+			break
+		}
+		var ids []int64
+		ids, lastID, previous, err = redundantSchemaVersionIDs(result, previous)
+		if err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			idStrings := make([]string, 0, len(ids))
+			for _, id := range ids {
+				idStrings = append(idStrings, strconv.FormatInt(id, 10))
+			}
+			if _, err := withDDL.Exec(ctx, fmt.Sprintf(deleteSchemaVersionsByID, strings.Join(idStrings, ", ")), conn.Exec); err != nil {
+				return err
+			}
+			compacted += len(ids)
+		}
+		if len(result.Rows) < schemaVersionsCompactionPageSize {
+			break
+		}
+	}
+	if compacted > 0 {
+		log.Infof("Compacted %d schema versions", compacted)
+	}
+	return nil
+}
+
+// redundantSchemaVersionIDs returns the ids of schema versions whose schema is identical to that of the
+// preceding version, given a page of (id, schemax) rows ordered by id and the schema preceding the page.
+// It also returns the last id and schema of the page, which precede the next page.
+func redundantSchemaVersionIDs(result *sqltypes.Result, previous []byte) (ids []int64, lastID int64, last []byte, err error) {
+	for _, row := range result.Rows {
+		id, err := evalengine.ToInt64(row[0])
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		schemax := row[1].ToBytes()
+		if previous != nil && bytes.Equal(schemax, previous) {
+			ids = append(ids, id)
+		}
+		lastID, previous = id, schemax
+	}
+	return ids, lastID, previous, nil
+}
+
 func newMinimalTable(st *Table) *binlogdatapb.MinimalTable {
 	table := &binlogdatapb.MinimalTable{
 		Name:   st.Name.String(),
@@ -172,3 +172,30 @@ func TestMustReloadSchemaOnDDL(t *testing.T) {
 		})
 	}
 }
+
+func TestRedundantSchemaVersionIDs(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|schemax", "int64|blob")
+	page1 := sqltypes.MakeTestResult(fields,
+		"1|a",
+		"2|a",
+		"3|b",
+	)
+	page2 := sqltypes.MakeTestResult(fields,
+		"4|b",
+		"5|b",
+		"6|a",
+	)
+	ids, lastID, last, err := redundantSchemaVersionIDs(page1, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2}, ids)
+	require.Equal(t, int64(3), lastID)
+
+	ids, lastID, _, err = redundantSchemaVersionIDs(page2, last)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 5}, ids)
+	require.Equal(t, int64(6), lastID)
+
+	ids, _, _, err = redundantSchemaVersionIDs(&sqltypes.Result{}, nil)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
@@ -28,6 +28,7 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
@@ -51,6 +52,10 @@ type StatefulConnection struct {
 	reservedProps  *Properties
 	tainted        bool
 	enforceTimeout bool
+
+	// killReason is set when the transaction resource checker kills the
+	// connection, which it may do while the connection is in use.
+	killReason sync2.AtomicString
 }
 
 // Properties contains meta information about the connection
@@ -82,6 +87,9 @@ func (sc *StatefulConnection) IsInTransaction() bool {
 func (sc *StatefulConnection) Exec(ctx context.Context, query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
 	if sc.IsClosed() {
 		if sc.IsInTransaction() {
+			if reason := sc.killReason.Get(); reason != "" {
+				return nil, vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction was aborted: %v", reason)
+			}
 			return nil, vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction was aborted: %v", sc.txProps.Conclusion)
 		}
 		return nil, vterrors.New(vtrpcpb.Code_ABORTED, "connection was aborted")
@@ -213,6 +221,12 @@ func (sc *StatefulConnection) UnderlyingDBConn() *connpool.DBConn {
 	return sc.dbConn
 }
 
+// KillReason returns why the connection was killed by a transaction
+// resource policy, or "" if it wasn't.
+func (sc *StatefulConnection) KillReason() string {
+	return sc.killReason.Get()
+}
+
 // CleanTxState cleans out the current transaction state
 func (sc *StatefulConnection) CleanTxState() {
 	sc.txProps = nil
@@ -21,6 +21,7 @@ import (
 	"sync/atomic"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -37,6 +38,13 @@ type StreamConsolidator struct {
 	maxMemoryTotal, maxMemoryQuery int64
 	blocking                       bool
 	cleanup                        StreamCallback
+
+	// maxFollowers is the maximum number of followers of a stream.
+	// Zero means no limit.
+	maxFollowers sync2.AtomicInt64
+	// followersRejected counts the queries that could not follow a
+	// stream because it had too many followers.
+	followersRejected sync2.AtomicInt64
 }
 
 // NewStreamConsolidator allocates a stream consolidator. The consolidator will use up to maxMemoryTotal
@@ -62,6 +70,18 @@ func (sc *StreamConsolidator) SetBlocking(block bool) {
 	sc.blocking = block
 }
 
+// SetMaxFollowers sets the maximum number of clients that can follow a stream. Additional
+// identical queries are executed on their own. Zero means no limit.
+func (sc *StreamConsolidator) SetMaxFollowers(maxFollowers int64) {
+	sc.maxFollowers.Set(maxFollowers)
+}
+
+// FollowersRejected returns the number of queries that were executed on their own
+// because the identical stream already had the maximum number of followers.
+func (sc *StreamConsolidator) FollowersRejected() int64 {
+	return sc.followersRejected.Get()
+}
+
 // Consolidate wraps the execution of a streaming query so that any other queries being executed
 // simultaneously will wait for the results of the original query, instead of being executed from
 // scratch in MySQL.
@@ -75,6 +95,7 @@ func (sc *StreamConsolidator) Consolidate(logStats *tabletenv.LogStats, sql stri
 		inflight        *streamInFlight
 		catchup         []*sqltypes.Result
 		followChan      chan *sqltypes.Result
+		full            bool
 		err             error
 		leaderClientErr error
 	)
@@ -85,7 +106,18 @@ func (sc *StreamConsolidator) Consolidate(logStats *tabletenv.LogStats, sql stri
 
 	// if there's an existing stream for our query, try to follow it
 	if inflight != nil {
-		catchup, followChan = inflight.follow()
+		catchup, followChan, full = inflight.follow(sc.maxFollowers.Get())
+	}
+
+	// if the existing stream has too many followers, we run the query on our own,
+	// without replacing the existing stream as the leader for this query
+	if full {
+		sc.mu.Unlock()
+		sc.followersRejected.Add(1)
+		return leaderCallback(func(result *sqltypes.Result) error {
+			defer sc.cleanup(result)
+			return callback(result)
+		})
 	}
 
 	// if there isn't an existing stream; OR if there is an existing stream but
@@ -189,20 +221,24 @@ type streamInFlight struct {
 // the Results that have been sent so far (so the client can catch up) and a channel
 // that will receive all the Results in the future.
 // If this stream has been running for too long and we cannot catch up to it, follow
-// returns a nil channel.
-func (s *streamInFlight) follow() ([]*sqltypes.Result, chan *sqltypes.Result) {
+// returns a nil channel. If this stream already has maxFollowers followers, follow
+// returns a nil channel and full is true.
+func (s *streamInFlight) follow(maxFollowers int64) (catchup []*sqltypes.Result, follow chan *sqltypes.Result, full bool) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	if !s.catchupAllowed {
-		return nil, nil
+		return nil, nil, false
+	}
+	if maxFollowers > 0 && int64(len(s.fanout)) >= maxFollowers {
+		return nil, nil, true
 	}
 	if s.fanout == nil {
 		s.fanout = make(map[chan *sqltypes.Result]bool)
 	}
-	follow := make(chan *sqltypes.Result, streamBufferSize)
+	follow = make(chan *sqltypes.Result, streamBufferSize)
 	s.fanout[follow] = true
-	return s.catchup, follow
+	return s.catchup, follow, false
 }
 
 // unfollow unsubscribes the given follower from receiving more results from the stream.
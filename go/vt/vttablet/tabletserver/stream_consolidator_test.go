@@ -401,3 +401,29 @@ func TestConsolidatorMemoryLimits(t *testing.T) {
 		}
 	})
 }
+
+func TestConsolidatorMaxFollowers(t *testing.T) {
+	ct := consolidationTest{
+		cc:              NewStreamConsolidator(128*1024, 2*1024, nocleanup),
+		streamItemDelay: 10 * time.Millisecond,
+		streamItemCount: 10,
+	}
+	ct.cc.SetMaxFollowers(2)
+
+	ct.run(5, func(worker int) (string, StreamCallback) {
+		if worker > 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+		return "select 1", func(result *sqltypes.Result) error {
+			return nil
+		}
+	})
+
+	// the leader is followed by two workers; the other two run the query on their own
+	require.Equal(t, uint64(3), ct.leaderCalls)
+	require.Equal(t, int64(2), ct.cc.FollowersRejected())
+	for _, results := range ct.results {
+		require.NoError(t, results.err)
+		require.Len(t, results.items, 10)
+	}
+}
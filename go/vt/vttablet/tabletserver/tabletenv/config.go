@@ -20,6 +20,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/encoding/prototext"
@@ -30,6 +31,8 @@ import (
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/throttler"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 // These constants represent values for various config parameters.
@@ -124,6 +127,8 @@ func init() {
 	flag.StringVar(&deprecatedPoolNamePrefix, "pool-name-prefix", "", "Deprecated")
 	flag.BoolVar(&currentConfig.WatchReplication, "watch_replication_stream", false, "When enabled, vttablet will stream the MySQL replication stream from the local server, and use it to update schema when it sees a DDL.")
 	flag.BoolVar(&currentConfig.TrackSchemaVersions, "track_schema_versions", false, "When enabled, vttablet will store versions of schemas at each position that a DDL is applied and allow retrieval of the schema corresponding to a position")
+	SecondsVar(&currentConfig.SchemaVersionMaxAgeSeconds, "schema_version_max_age_seconds", 0, "When set, vttablet purges schema versions older than this many seconds from _vt.schema_version, retaining the oldest version still in effect. Only applies with -track_schema_versions")
+	flag.BoolVar(&currentConfig.CompactSchemaVersions, "compact_schema_versions", false, "When enabled, vttablet removes schema versions which are identical to their preceding version from _vt.schema_version. This keeps the table small, but the DDLs of the removed versions, e.g. index changes, are no longer listed in the schema history. Only applies with -track_schema_versions")
 	flag.BoolVar(&deprecatedAutocommit, "enable-autocommit", true, "This flag is deprecated. Autocommit is always allowed.")
 	flag.BoolVar(&currentConfig.TwoPCEnable, "twopc_enable", defaultConfig.TwoPCEnable, "if the flag is on, 2pc is enabled. Other 2pc flags must be supplied.")
 	flag.StringVar(&currentConfig.TwoPCCoordinatorAddress, "twopc_coordinator_address", defaultConfig.TwoPCCoordinatorAddress, "address of the (VTGate) process(es) that will be used to notify of abandoned transactions.")
@@ -137,6 +142,12 @@ func init() {
 	flag.IntVar(&currentConfig.HotRowProtection.MaxQueueSize, "hot_row_protection_max_queue_size", defaultConfig.HotRowProtection.MaxQueueSize, "Maximum number of BeginExecute RPCs which will be queued for the same row (range).")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxGlobalQueueSize, "hot_row_protection_max_global_queue_size", defaultConfig.HotRowProtection.MaxGlobalQueueSize, "Global queue limit across all row (ranges). Useful to prevent that the queue can grow unbounded.")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxConcurrency, "hot_row_protection_concurrent_transactions", defaultConfig.HotRowProtection.MaxConcurrency, "Number of concurrent transactions let through to the txpool/MySQL for the same hot row. Should be > 1 to have enough 'ready' transactions in MySQL and benefit from a pipelining effect.")
+	flag.StringVar(&currentConfig.Audit.File, "audit_log_file", defaultConfig.Audit.File, "The file to which the audit log of DMLs and DDLs is appended. The audit log is disabled if empty. Audited statements are rejected while the file cannot be written.")
+	flagutil.DualFormatStringListVar(&currentConfig.Audit.Tables, "audit_log_tables", defaultConfig.Audit.Tables, "A comma-separated list of tables or table prefixes ending with '%' whose statements are audited. All tables are audited if empty.")
+	flagutil.DualFormatStringListVar(&currentConfig.Audit.Classes, "audit_log_classes", defaultConfig.Audit.Classes, "A comma-separated list of the classes of statements that are audited: dml and/or ddl. All classes are audited if empty.")
+	flag.IntVar(&currentConfig.Audit.BufferSize, "audit_log_buffer_size", defaultConfig.Audit.BufferSize, "The number of audit records that can be buffered before they are written to the audit log.")
+	flag.StringVar(&currentConfig.Audit.OverflowPolicy, "audit_log_overflow_policy", defaultConfig.Audit.OverflowPolicy, "What happens to queries when the audit buffer is full: block waits for space, drop drops their records. The number of dropped records is recorded in the audit log.")
+	SecondsVar(&currentConfig.TxResourcePolicies.CheckIntervalSeconds, "tx_resource_check_interval", defaultConfig.TxResourcePolicies.CheckIntervalSeconds, "how often (in seconds) the resources used by open transactions are read from MySQL and checked against the transaction resource policies of the tablet config. 0 disables the policies.")
 
 	flag.BoolVar(&currentConfig.EnableTransactionLimit, "enable_transaction_limit", defaultConfig.EnableTransactionLimit, "If true, limit on number of transactions open at the same time will be enforced for all users. User trying to open a new transaction after exhausting their limit will receive an error immediately, regardless of whether there are available slots or not.")
 	flag.BoolVar(&currentConfig.EnableTransactionLimitDryRun, "enable_transaction_limit_dry_run", defaultConfig.EnableTransactionLimitDryRun, "If true, limit on number of transactions open at the same time will be tracked for all users, but not enforced.")
@@ -153,6 +164,8 @@ func init() {
 	flag.BoolVar(&currentConfig.EnforceStrictTransTables, "enforce_strict_trans_tables", defaultConfig.EnforceStrictTransTables, "If true, vttablet requires MySQL to run with STRICT_TRANS_TABLES or STRICT_ALL_TABLES on. It is recommended to not turn this flag off. Otherwise MySQL may alter your supplied values before saving them to the database.")
 	flagutil.DualFormatBoolVar(&enableConsolidator, "enable_consolidator", true, "This option enables the query consolidator.")
 	flagutil.DualFormatBoolVar(&enableConsolidatorReplicas, "enable_consolidator_replicas", false, "This option enables the query consolidator only on replicas.")
+	flag.Int64Var(&currentConfig.ConsolidatorMaxWaiters, "consolidator_max_waiters", defaultConfig.ConsolidatorMaxWaiters, "Maximum number of identical queries that wait for the result of a query being executed. Additional identical queries are executed on their own. 0 means no limit.")
+	flag.Int64Var(&currentConfig.ConsolidatorMaxResultSize, "consolidator_max_result_size", defaultConfig.ConsolidatorMaxResultSize, "Maximum size in bytes of a result shared by the query consolidator. If a result is larger, the queries that waited for it are executed on their own. 0 means no limit.")
 	flagutil.DualFormatBoolVar(&currentConfig.CacheResultFields, "enable_query_plan_field_caching", defaultConfig.CacheResultFields, "This option fetches & caches fields (columns) when storing query plans")
 
 	flag.DurationVar(&healthCheckInterval, "health_check_interval", 20*time.Second, "Interval between health checks")
@@ -239,6 +252,10 @@ type TabletConfig struct {
 	Oltp             OltpConfig             `json:"oltp,omitempty"`
 	HotRowProtection HotRowProtectionConfig `json:"hotRowProtection,omitempty"`
 
+	TxResourcePolicies TxResourcePoliciesConfig `json:"txResourcePolicies,omitempty"`
+
+	Audit AuditConfig `json:"audit,omitempty"`
+
 	Healthcheck  HealthcheckConfig  `json:"healthcheck,omitempty"`
 	GracePeriods GracePeriodsConfig `json:"gracePeriods,omitempty"`
 
@@ -250,6 +267,8 @@ type TabletConfig struct {
 	StreamBufferSize                        int     `json:"streamBufferSize,omitempty"`
 	ConsolidatorStreamTotalSize             int64   `json:"consolidatorStreamTotalSize,omitempty"`
 	ConsolidatorStreamQuerySize             int64   `json:"consolidatorStreamQuerySize,omitempty"`
+	ConsolidatorMaxWaiters                  int64   `json:"consolidatorMaxWaiters,omitempty"`
+	ConsolidatorMaxResultSize               int64   `json:"consolidatorMaxResultSize,omitempty"`
 	QueryCacheSize                          int     `json:"queryCacheSize,omitempty"`
 	QueryCacheMemory                        int64   `json:"queryCacheMemory,omitempty"`
 	QueryCacheLFU                           bool    `json:"queryCacheLFU,omitempty"`
@@ -257,6 +276,8 @@ type TabletConfig struct {
 	SignalSchemaChangeReloadIntervalSeconds Seconds `json:"signalSchemaChangeReloadIntervalSeconds,omitempty"`
 	WatchReplication                        bool    `json:"watchReplication,omitempty"`
 	TrackSchemaVersions                     bool    `json:"trackSchemaVersions,omitempty"`
+	SchemaVersionMaxAgeSeconds              Seconds `json:"schemaVersionMaxAgeSeconds,omitempty"`
+	CompactSchemaVersions                   bool    `json:"compactSchemaVersions,omitempty"`
 	TerseErrors                             bool    `json:"terseErrors,omitempty"`
 	MessagePostponeParallelism              int     `json:"messagePostponeParallelism,omitempty"`
 	CacheResultFields                       bool    `json:"cacheResultFields,omitempty"`
@@ -309,6 +330,64 @@ type HotRowProtectionConfig struct {
 	MaxConcurrency     int    `json:"maxConcurrency,omitempty"`
 }
 
+// Actions that can be taken on transactions that exceed the limits of a TxResourcePolicy.
+const (
+	TxResourceActionKill = "kill"
+	TxResourceActionWarn = "warn"
+)
+
+// TxResourcePoliciesConfig contains the config for the transaction resource policies.
+// The resources used by open transactions are read from information_schema.innodb_trx
+// every CheckIntervalSeconds. The policies are not enforced if the interval is 0.
+type TxResourcePoliciesConfig struct {
+	CheckIntervalSeconds Seconds            `json:"checkIntervalSeconds,omitempty"`
+	Policies             []TxResourcePolicy `json:"policies,omitempty"`
+}
+
+// TxResourcePolicy limits the resources used by the transactions of a workload
+// and/or a caller. Workload is one of oltp, olap or dba, and Caller matches the
+// principal of the effective caller or the username of the immediate caller.
+// An empty Workload or Caller matches all transactions. Limits that are 0 are
+// not enforced. MaxRowsLocked limits trx_rows_locked, the approximate number of
+// rows locked by the transaction. Action can be kill or warn. Default is kill.
+type TxResourcePolicy struct {
+	Name            string `json:"name,omitempty"`
+	Workload        string `json:"workload,omitempty"`
+	Caller          string `json:"caller,omitempty"`
+	MaxRowsLocked   int64  `json:"maxRowsLocked,omitempty"`
+	MaxRowsModified int64  `json:"maxRowsModified,omitempty"`
+	MaxUndoSize     int64  `json:"maxUndoSize,omitempty"`
+	Action          string `json:"action,omitempty"`
+}
+
+// Classes of the statements of the audit log.
+const (
+	AuditClassDML = "dml"
+	AuditClassDDL = "ddl"
+)
+
+// Overflow policies of the audit log.
+const (
+	AuditOverflowBlock = "block"
+	AuditOverflowDrop  = "drop"
+)
+
+// AuditConfig contains the config for the audit log. The statements of the
+// query service, of the tabletmanager RPCs that execute SQL and of
+// vreplication are audited, except for those that only access the _vt
+// database. The records of the audited statements are buffered, and appended to File by a single writer.
+// When BufferSize records are buffered, OverflowPolicy decides whether the
+// statements block until there is space, or drop their records. If File
+// can't be written, audited statements are rejected until it can be
+// written again, starting with a record of the number of lost records.
+type AuditConfig struct {
+	File           string   `json:"file,omitempty"`
+	Tables         []string `json:"tables,omitempty"`
+	Classes        []string `json:"classes,omitempty"`
+	BufferSize     int      `json:"bufferSize,omitempty"`
+	OverflowPolicy string   `json:"overflowPolicy,omitempty"`
+}
+
 // HealthcheckConfig contains the config for healthcheck.
 type HealthcheckConfig struct {
 	IntervalSeconds           Seconds `json:"intervalSeconds,omitempty"`
@@ -378,6 +457,66 @@ func (c *TabletConfig) Verify() error {
 	if v := c.HotRowProtection.MaxConcurrency; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	if err := c.verifyTxResourcePolicies(); err != nil {
+		return err
+	}
+	if err := c.verifyAudit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// verifyAudit checks the audit config for sanity
+func (c *TabletConfig) verifyAudit() error {
+	if v := c.Audit.BufferSize; v <= 0 {
+		return fmt.Errorf("-audit_log_buffer_size must be > 0 (specified value: %v)", v)
+	}
+	switch c.Audit.OverflowPolicy {
+	case AuditOverflowBlock, AuditOverflowDrop:
+	default:
+		return fmt.Errorf("-audit_log_overflow_policy must be %s or %s (specified value: %v)", AuditOverflowBlock, AuditOverflowDrop, c.Audit.OverflowPolicy)
+	}
+	for _, class := range c.Audit.Classes {
+		switch class {
+		case AuditClassDML, AuditClassDDL:
+		default:
+			return fmt.Errorf("-audit_log_classes: invalid class: %s", class)
+		}
+	}
+	return nil
+}
+
+// verifyTxResourcePolicies checks the transaction resource policies for sanity
+func (c *TabletConfig) verifyTxResourcePolicies() error {
+	if v := c.TxResourcePolicies.CheckIntervalSeconds; v < 0 {
+		return fmt.Errorf("-tx_resource_check_interval must be >= 0 (specified value: %v)", v.Get())
+	}
+	names := make(map[string]bool)
+	for _, policy := range c.TxResourcePolicies.Policies {
+		if policy.Name == "" {
+			return errors.New("transaction resource policies must have a name")
+		}
+		if names[policy.Name] {
+			return fmt.Errorf("duplicate transaction resource policy: %s", policy.Name)
+		}
+		names[policy.Name] = true
+		if policy.Workload != "" {
+			if _, ok := querypb.ExecuteOptions_Workload_value[strings.ToUpper(policy.Workload)]; !ok {
+				return fmt.Errorf("transaction resource policy %s: invalid workload: %s", policy.Name, policy.Workload)
+			}
+		}
+		switch policy.Action {
+		case "", TxResourceActionKill, TxResourceActionWarn:
+		default:
+			return fmt.Errorf("transaction resource policy %s: invalid action: %s", policy.Name, policy.Action)
+		}
+		if policy.MaxRowsLocked < 0 || policy.MaxRowsModified < 0 || policy.MaxUndoSize < 0 {
+			return fmt.Errorf("transaction resource policy %s: limits must be >= 0", policy.Name)
+		}
+		if policy.MaxRowsLocked == 0 && policy.MaxRowsModified == 0 && policy.MaxUndoSize == 0 {
+			return fmt.Errorf("transaction resource policy %s: at least one limit must be set", policy.Name)
+		}
+	}
 	return nil
 }
 
@@ -452,6 +591,10 @@ var defaultConfig = TabletConfig{
 		// of them ready in MySQL and profit from a pipelining effect.
 		MaxConcurrency: 5,
 	},
+	Audit: AuditConfig{
+		BufferSize:     10000,
+		OverflowPolicy: AuditOverflowBlock,
+	},
 	Consolidator:                Enable,
 	ConsolidatorStreamTotalSize: 128 * 1024 * 1024,
 	ConsolidatorStreamQuerySize: 2 * 1024 * 1024,
@@ -51,7 +51,8 @@ func TestConfigParse(t *testing.T) {
 	}
 	gotBytes, err := yaml2.Marshal(&cfg)
 	require.NoError(t, err)
-	wantBytes := `db:
+	wantBytes := `audit: {}
+db:
   allprivs:
     password: '****'
   app:
@@ -80,6 +81,7 @@ oltpReadPool:
   timeoutSeconds: 10
 replicationTracker: {}
 txPool: {}
+txResourcePolicies: {}
 `
 	assert.Equal(t, wantBytes, string(gotBytes))
 
@@ -109,7 +111,10 @@ oltpReadPool:
 func TestDefaultConfig(t *testing.T) {
 	gotBytes, err := yaml2.Marshal(NewDefaultConfig())
 	require.NoError(t, err)
-	want := `cacheResultFields: true
+	want := `audit:
+  bufferSize: 10000
+  overflowPolicy: block
+cacheResultFields: true
 consolidator: enable
 consolidatorStreamQuerySize: 2097152
 consolidatorStreamTotalSize: 134217728
@@ -149,6 +154,7 @@ txPool:
   maxWaiters: 5000
   size: 20
   timeoutSeconds: 1
+txResourcePolicies: {}
 `
 	utils.MustMatch(t, want, string(gotBytes))
 }
@@ -197,6 +203,10 @@ func TestFlags(t *testing.T) {
 			MaxGlobalQueueSize: 1000,
 			MaxConcurrency:     5,
 		},
+		Audit: AuditConfig{
+			BufferSize:     10000,
+			OverflowPolicy: AuditOverflowBlock,
+		},
 		StreamBufferSize:                        32768,
 		QueryCacheSize:                          int(cache.DefaultConfig.MaxEntries),
 		QueryCacheMemory:                        cache.DefaultConfig.MaxMemoryUsage,
@@ -333,3 +343,68 @@ func TestFlags(t *testing.T) {
 	want.GracePeriods.TransitionSeconds = 4
 	assert.Equal(t, want, currentConfig)
 }
+
+func TestVerifyTxResourcePolicies(t *testing.T) {
+	testcases := []struct {
+		policies []TxResourcePolicy
+		err      string
+	}{{
+		policies: []TxResourcePolicy{{Name: "big", Workload: "oltp", MaxRowsModified: 1000}, {Name: "dba", Caller: "dba", MaxUndoSize: 10, Action: TxResourceActionWarn}},
+	}, {
+		policies: []TxResourcePolicy{{MaxRowsModified: 1000}},
+		err:      "transaction resource policies must have a name",
+	}, {
+		policies: []TxResourcePolicy{{Name: "big", MaxRowsModified: 1000}, {Name: "big", MaxRowsLocked: 1000}},
+		err:      "duplicate transaction resource policy: big",
+	}, {
+		policies: []TxResourcePolicy{{Name: "big", Workload: "batch", MaxRowsModified: 1000}},
+		err:      "transaction resource policy big: invalid workload: batch",
+	}, {
+		policies: []TxResourcePolicy{{Name: "big", MaxRowsModified: 1000, Action: "throttle"}},
+		err:      "transaction resource policy big: invalid action: throttle",
+	}, {
+		policies: []TxResourcePolicy{{Name: "big", MaxRowsModified: -1}},
+		err:      "transaction resource policy big: limits must be >= 0",
+	}, {
+		policies: []TxResourcePolicy{{Name: "big"}},
+		err:      "transaction resource policy big: at least one limit must be set",
+	}}
+	for _, tcase := range testcases {
+		config := NewDefaultConfig()
+		config.TxResourcePolicies.Policies = tcase.policies
+		err := config.Verify()
+		if tcase.err == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		assert.EqualError(t, err, tcase.err)
+	}
+}
+
+func TestVerifyAudit(t *testing.T) {
+	testcases := []struct {
+		audit AuditConfig
+		err   string
+	}{{
+		audit: AuditConfig{BufferSize: 10, OverflowPolicy: AuditOverflowDrop, Classes: []string{AuditClassDML, AuditClassDDL}},
+	}, {
+		audit: AuditConfig{BufferSize: 0, OverflowPolicy: AuditOverflowBlock},
+		err:   "-audit_log_buffer_size must be > 0 (specified value: 0)",
+	}, {
+		audit: AuditConfig{BufferSize: 10, OverflowPolicy: "wait"},
+		err:   "-audit_log_overflow_policy must be block or drop (specified value: wait)",
+	}, {
+		audit: AuditConfig{BufferSize: 10, OverflowPolicy: AuditOverflowBlock, Classes: []string{"select"}},
+		err:   "-audit_log_classes: invalid class: select",
+	}}
+	for _, tcase := range testcases {
+		config := NewDefaultConfig()
+		config.Audit = tcase.audit
+		err := config.Verify()
+		if tcase.err == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		assert.EqualError(t, err, tcase.err)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"os"
 	"os/signal"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -39,6 +40,7 @@ import (
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/tb"
+	"vitess.io/vitess/go/textutil"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/dbconfigs"
@@ -57,6 +59,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/onlineddl"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/audit"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/gc"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/messager"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
@@ -182,7 +185,7 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.te = NewTxEngine(tsv)
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer)
 
-	tsv.onlineDDLExecutor = onlineddl.NewExecutor(tsv, alias, topoServer, tabletTypeFunc)
+	tsv.onlineDDLExecutor = onlineddl.NewExecutor(tsv, alias, topoServer, tabletTypeFunc, tsv.lagThrottler)
 	tsv.tableGC = gc.NewTableGC(tsv, topoServer, tabletTypeFunc, tsv.lagThrottler)
 
 	tsv.sm = &stateManager{
@@ -222,6 +225,7 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.registerMigrationStatusHandler()
 	tsv.registerThrottlerHandlers()
 	tsv.registerDebugEnvHandler()
+	tsv.registerTableGCStatusHandler()
 
 	return tsv
 }
@@ -465,6 +469,11 @@ func (tsv *TabletServer) SchemaEngine() *schema.Engine {
 	return tsv.se
 }
 
+// AuditLogger returns the audit log of TabletServer.
+func (tsv *TabletServer) AuditLogger() *audit.Logger {
+	return tsv.qe.auditor
+}
+
 // Begin starts a new transaction. This is allowed only if the state is StateServing.
 func (tsv *TabletServer) Begin(ctx context.Context, target *querypb.Target, options *querypb.ExecuteOptions) (transactionID int64, tablet *topodatapb.TabletAlias, err error) {
 	return tsv.begin(ctx, target, nil, 0, options)
@@ -998,7 +1007,13 @@ func (tsv *TabletServer) computeTxSerializerKey(ctx context.Context, logStats *t
 		return "", ""
 	}
 
-	where, err := plan.WhereClause.GenerateQuery(bindVariables, nil)
+	// Prefer the predicates on the primary key or a unique key, if any, so
+	// that DMLs going to the same row share the key.
+	whereClause := plan.WhereClause
+	if plan.RowKey != nil {
+		whereClause = plan.RowKey
+	}
+	where, err := whereClause.GenerateQuery(bindVariables, nil)
 	if err != nil {
 		logComputeRowSerializerKey.Errorf("failed to substitute bind vars in where clause: %v query: %v bind vars: %v", err, sql, bindVariables)
 		return "", ""
@@ -1052,8 +1067,8 @@ func (tsv *TabletServer) MessageAck(ctx context.Context, target *querypb.Target,
 	for _, val := range ids {
 		sids = append(sids, sqltypes.ProtoToValue(val).ToString())
 	}
-	count, err = tsv.execDML(ctx, target, func() (string, map[string]*querypb.BindVariable, error) {
-		return tsv.messager.GenerateAckQuery(name, sids)
+	count, err = tsv.execInTransaction(ctx, target, func(execute func(string, map[string]*querypb.BindVariable) (*sqltypes.Result, error)) (int64, error) {
+		return tsv.messager.AckMessages(name, sids, execute)
 	})
 	if err != nil {
 		return 0, err
@@ -1078,18 +1093,43 @@ func (tsv *TabletServer) PurgeMessages(ctx context.Context, target *querypb.Targ
 	})
 }
 
+// DeadLetterMessages moves the list of messages for a given message table
+// to its dead letter table, if they exceeded the table's max retries.
+// It returns the ids of the messages successfully moved.
+func (tsv *TabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (movedIDs []string, err error) {
+	_, err = tsv.execInTransaction(ctx, target, func(execute func(string, map[string]*querypb.BindVariable) (*sqltypes.Result, error)) (int64, error) {
+		movedIDs, err = tsv.messager.DeadLetterMessages(name, ids, execute)
+		return int64(len(movedIDs)), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return movedIDs, nil
+}
+
 func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, queryGenerator func() (string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
+	return tsv.execInTransaction(ctx, target, func(execute func(string, map[string]*querypb.BindVariable) (*sqltypes.Result, error)) (int64, error) {
+		query, bv, err := queryGenerator()
+		if err != nil {
+			return 0, err
+		}
+		qr, err := execute(query, bv)
+		if err != nil {
+			return 0, err
+		}
+		return int64(qr.RowsAffected), nil
+	})
+}
+
+// execInTransaction calls exec with a function that executes queries in a
+// transaction, and commits the transaction if exec succeeds.
+func (tsv *TabletServer) execInTransaction(ctx context.Context, target *querypb.Target, exec func(execute func(string, map[string]*querypb.BindVariable) (*sqltypes.Result, error)) (int64, error)) (count int64, err error) {
 	if err = tsv.sm.StartRequest(ctx, target, false /* allowOnShutdown */); err != nil {
 		return 0, err
 	}
 	defer tsv.sm.EndRequest()
 	defer tsv.handlePanicAndSendLogStats("ack", nil, nil)
 
-	query, bv, err := queryGenerator()
-	if err != nil {
-		return 0, err
-	}
-
 	transactionID, _, err := tsv.Begin(ctx, target, nil)
 	if err != nil {
 		return 0, err
@@ -1101,7 +1141,9 @@ func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, qu
 			tsv.Rollback(ctx, target, transactionID)
 		}
 	}()
-	qr, err := tsv.Execute(ctx, target, query, bv, transactionID, 0, nil)
+	count, err = exec(func(query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+		return tsv.Execute(ctx, target, query, bindVars, transactionID, 0, nil)
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -1110,7 +1152,7 @@ func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, qu
 		return 0, err
 	}
 	transactionID = 0
-	return int64(qr.RowsAffected), nil
+	return count, nil
 }
 
 // VStream streams VReplication events.
@@ -1623,6 +1665,11 @@ func (tsv *TabletServer) registerThrottlerCheckHandlers() {
 			}
 			flags := &throttle.CheckFlags{
 				LowPriority: (r.URL.Query().Get("p") == "low"),
+				Metrics:     textutil.SplitDelimitedList(r.URL.Query().Get("m")),
+			}
+			// p is either "low", or a numeric priority, which can only lower the configured priority of the app
+			if priority, err := strconv.Atoi(r.URL.Query().Get("p")); err == nil {
+				flags.Priority = priority
 			}
 			checkResult := tsv.lagThrottler.CheckByType(ctx, appName, remoteAddr, flags, checkType)
 			if checkResult.StatusCode == http.StatusNotFound && flags.OKIfNotExists {
@@ -1688,6 +1735,20 @@ func (tsv *TabletServer) registerDebugEnvHandler() {
 	})
 }
 
+// registerTableGCStatusHandler registers a table GC status page, which includes the progress of purging tables
+func (tsv *TabletServer) registerTableGCStatusHandler() {
+	tsv.exporter.HandleFunc("/debug/tablegc", func(w http.ResponseWriter, r *http.Request) {
+		if err := acl.CheckAccessHTTP(r, acl.MONITORING); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		status := tsv.tableGC.Status()
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(status)
+	})
+}
+
 // EnableHeartbeat forces heartbeat to be on or off.
 // Only to be used for testing.
 func (tsv *TabletServer) EnableHeartbeat(enabled bool) {
@@ -981,7 +981,7 @@ func TestSerializeTransactionsSameRow(t *testing.T) {
 	db.SetBeforeFunc("update test_table set name_string = 'tx1' where pk = 1 and `name` = 1 limit 10001",
 		func() {
 			close(tx1Started)
-			if err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1 and `name` = 1", 2); err != nil {
+			if err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1", 2); err != nil {
 				t.Fatal(err)
 			}
 		})
@@ -1068,6 +1068,45 @@ func TestDMLQueryWithoutWhereClause(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestComputeTxSerializerKey(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	bindVars := map[string]*querypb.BindVariable{
+		"name": sqltypes.Int64BindVariable(1),
+		"addr": sqltypes.Int64BindVariable(2),
+	}
+	tcases := []struct {
+		sql string
+		key string
+	}{{
+		// Primary key.
+		sql: "update test_table set name_string = 'a' where pk = 1 and name_string = 'b'",
+		key: "test_table where pk = 1",
+	}, {
+		// Unique key, in key order.
+		sql: "update test_table set name_string = 'a' where addr = :addr and name_string = 'b' and `name` = :name",
+		key: "test_table where `name` = 1 and addr = 2",
+	}, {
+		// The unique key is not fully restricted.
+		sql: "delete from test_table where `name` = :name",
+		key: "test_table where `name` = 1",
+	}, {
+		sql: "select * from test_table where pk = 1",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.sql, func(t *testing.T) {
+			logStats := tabletenv.NewLogStats(ctx, "TestComputeTxSerializerKey")
+			key, table := tsv.computeTxSerializerKey(ctx, logStats, tcase.sql, bindVars)
+			assert.Equal(t, tcase.key, key)
+			if tcase.key != "" {
+				assert.Equal(t, "test_table", table)
+			}
+		})
+	}
+}
+
 // TestSerializeTransactionsSameRow_ExecuteBatchAsTransaction tests the same as
 // TestSerializeTransactionsSameRow but for the ExecuteBatch method with
 // asTransaction=true (i.e. vttablet wraps the query in a BEGIN/Query/COMMIT
@@ -1120,7 +1159,7 @@ func TestSerializeTransactionsSameRow_ExecuteBatchAsTransaction(t *testing.T) {
 	db.SetBeforeFunc("update test_table set name_string = 'tx1' where pk = 1 and `name` = 1 limit 10001",
 		func() {
 			close(tx1Started)
-			if err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1 and `name` = 1", 2); err != nil {
+			if err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1", 2); err != nil {
 				t.Fatal(err)
 			}
 		})
@@ -1297,7 +1336,7 @@ func TestSerializeTransactionsSameRow_ConcurrentTransactions(t *testing.T) {
 	// transactions via db.SetBeforeFunc() for the same reason as mentioned
 	// in TestSerializeTransactionsSameRow: The MySQL C client does not seem
 	// to allow more than connection attempt at a time.
-	err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1 and `name` = 1", 3)
+	err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1", 3)
 	require.NoError(t, err)
 	close(allQueriesPending)
 
@@ -1391,7 +1430,7 @@ func TestSerializeTransactionsSameRow_TooManyPendingRequests(t *testing.T) {
 
 		<-tx1Started
 		_, _, _, err := tsv.BeginExecute(ctx, &target, nil, q2, bvTx2, 0, nil)
-		if err == nil || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED || err.Error() != "hot row protection: too many queued transactions (1 >= 1) for the same row (table + WHERE clause: 'test_table where pk = 1 and `name` = 1')" {
+		if err == nil || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED || err.Error() != "hot row protection: too many queued transactions (1 >= 1) for the same row (table + WHERE clause: 'test_table where pk = 1')" {
 			t.Errorf("tx2 should have failed because there are too many pending requests: %v", err)
 		}
 		// No commit necessary because the Begin failed.
@@ -1480,7 +1519,7 @@ func TestSerializeTransactionsSameRow_TooManyPendingRequests_ExecuteBatchAsTrans
 			Sql:           q2,
 			BindVariables: bvTx2,
 		}}, true /*asTransaction*/, 0 /*connID*/, nil /*options*/)
-		if err == nil || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED || err.Error() != "hot row protection: too many queued transactions (1 >= 1) for the same row (table + WHERE clause: 'test_table where pk = 1 and `name` = 1')" {
+		if err == nil || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED || err.Error() != "hot row protection: too many queued transactions (1 >= 1) for the same row (table + WHERE clause: 'test_table where pk = 1')" {
 			t.Errorf("tx2 should have failed because there are too many pending requests: %v results: %+v", err, results)
 		}
 	}()
@@ -1582,7 +1621,7 @@ func TestSerializeTransactionsSameRow_RequestCanceled(t *testing.T) {
 		defer wg.Done()
 
 		// Wait until tx1 and tx2 are pending to make the test deterministic.
-		if err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1 and `name` = 1", 2); err != nil {
+		if err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1", 2); err != nil {
 			t.Error(err)
 		}
 
@@ -1597,7 +1636,7 @@ func TestSerializeTransactionsSameRow_RequestCanceled(t *testing.T) {
 	}()
 
 	// Wait until tx1, 2 and 3 are pending.
-	err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1 and `name` = 1", 3)
+	err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1", 3)
 	require.NoError(t, err)
 	// Now unblock tx2 and cancel it.
 	cancelTx2()
@@ -1709,6 +1748,21 @@ func TestPurgeMessages(t *testing.T) {
 	require.EqualValues(t, 1, count)
 }
 
+func TestDeadLetterMessages(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	_, err := tsv.DeadLetterMessages(ctx, &target, "nonmsg", []string{"1"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "message table nonmsg not found in schema")
+
+	_, err = tsv.DeadLetterMessages(ctx, &target, "msg", []string{"1"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "message table msg has no dead letter table")
+}
+
 func TestHandleExecUnknownError(t *testing.T) {
 	logStats := tabletenv.NewLogStats(ctx, "TestHandleExecError")
 	config := tabletenv.NewDefaultConfig()
@@ -2593,6 +2647,13 @@ func getSupportedQueries() map[string]*sqltypes.Result {
 				mysql.ShowPrimaryRow("msg", "id"),
 			},
 		},
+		mysql.BaseShowUniqueKeys: {
+			Fields: mysql.ShowUniqueKeysFields,
+			Rows: [][]sqltypes.Value{
+				mysql.ShowUniqueKeyRow("test_table", "name_addr", "name"),
+				mysql.ShowUniqueKeyRow("test_table", "name_addr", "addr"),
+			},
+		},
 		// queries for TestReserve*
 		"select 42 from dual where 1 != 1": {
 			Fields: []*querypb.Field{{
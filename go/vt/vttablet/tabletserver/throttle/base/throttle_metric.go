@@ -22,6 +22,9 @@ type MetricResultFunc func() (metricResult MetricResult, threshold float64)
 
 // ErrThresholdExceeded is the common error one may get checking on metric result
 var ErrThresholdExceeded = errors.New("Threshold exceeded")
+
+// ErrQuotaExceeded is the error an app gets when it exceeds its quota of checks
+var ErrQuotaExceeded = errors.New("Quota exceeded")
 var errNoResultYet = errors.New("Metric not collected yet")
 
 // ErrNoSuchMetric is for when a user requests a metric by an unknown metric name
@@ -33,6 +33,8 @@ type CheckFlags struct {
 	OverrideThreshold float64
 	LowPriority       bool
 	OKIfNotExists     bool
+	Metrics           []string // metrics to check; when empty, the metrics configured for the app are checked
+	Priority          int      // priority requested by the app, capped by the configured one; 0 when unspecified
 }
 
 // StandardCheckFlags have no special hints
@@ -67,6 +69,11 @@ func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName s
 	if flags.OverrideThreshold > 0 {
 		threshold = flags.OverrideThreshold
 	}
+	fullThreshold := threshold
+	if flags.Priority > 0 {
+		// the lower the priority of the app, the lower the fraction of the threshold it may reach
+		threshold = threshold * check.throttler.getMetricsConfig().ThresholdRatio(flags.Priority)
+	}
 	value, err := metricResult.Get()
 	if appName == "" {
 		return NewCheckResult(http.StatusExpectationFailed, value, threshold, fmt.Errorf("no app indicated"))
@@ -88,7 +95,7 @@ func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName s
 		statusCode = http.StatusTooManyRequests // 429
 		err = base.ErrThresholdExceeded
 
-		if !flags.LowPriority && !flags.ReadCheck && appName != frenoAppName {
+		if !flags.LowPriority && !flags.ReadCheck && appName != frenoAppName && value > fullThreshold {
 			// low priority requests will henceforth be denied
 			go check.throttler.nonLowPriorityAppRequestsThrottled.SetDefault(metricName, true)
 		}
@@ -139,7 +146,8 @@ func (check *ThrottlerCheck) Check(ctx context.Context, appName string, storeTyp
 }
 
 func (check *ThrottlerCheck) splitMetricTokens(metricName string) (storeType string, storeName string, err error) {
-	metricTokens := strings.Split(metricName, "/")
+	// store names of custom metrics contain a "/", e.g. "mysql/self/threads_running"
+	metricTokens := strings.SplitN(metricName, "/", 2)
 	if len(metricTokens) != 2 {
 		return storeType, storeName, base.ErrNoSuchMetric
 	}
@@ -19,6 +19,7 @@ type CheckResult struct {
 	Threshold  float64 `json:"Threshold"`
 	Error      error   `json:"-"`
 	Message    string  `json:"Message"`
+	MetricName string  `json:"MetricName,omitempty"`
 }
 
 // NewCheckResult returns a CheckResult
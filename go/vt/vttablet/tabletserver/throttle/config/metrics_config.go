@@ -0,0 +1,296 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+const (
+	// LagMetricName is the name of the default metric: the replication lag,
+	// as measured by the heartbeat, or as overridden by -throttle_metrics_query.
+	LagMetricName = "lag"
+	// LoadAvgMetricName is the name of the 1 minute load average per CPU of the
+	// tablet's host. It is only measured on the tablet itself: a check of the
+	// shard evaluates the load of the primary tablet.
+	LoadAvgMetricName = "loadavg"
+
+	// MinAppPriority is the lowest priority of an app
+	MinAppPriority = 1
+	// MaxAppPriority is the highest priority of an app, and the default one
+	MaxAppPriority = 100
+	// DefaultLowestPriorityThresholdRatio is the fraction of the metrics' thresholds
+	// which apps of the lowest priority may reach, unless configured otherwise
+	DefaultLowestPriorityThresholdRatio = 0.5
+)
+
+// BuiltinMetricQueries are the queries of the metrics that can be configured
+// without a query.
+var BuiltinMetricQueries = map[string]string{
+	"threads_running":     "show global status like 'threads_running'",
+	"history_list_length": "select `count` from information_schema.innodb_metrics where name = 'trx_rseg_history_len'",
+}
+
+// MetricConfig is the definition of a named throttler metric. The query is
+// either a read-only SELECT returning a single row with a single value, or a
+// SHOW GLOBAL STATUS/VARIABLES LIKE query returning a single variable.
+// The primary probes the metric on the replicas as the throttler account,
+// which is granted SELECT on the tables the query reads.
+type MetricConfig struct {
+	Name      string  `json:"name"`
+	Query     string  `json:"query,omitempty"`
+	Threshold float64 `json:"threshold"`
+}
+
+// MetricsConfig is the tablet throttler configuration of a keyspace: the metrics, and
+// the metrics, priorities and quotas of the apps. It is stored in the topo, and picked up by the tablets without a restart.
+type MetricsConfig struct {
+	Metrics []*MetricConfig `json:"metrics,omitempty"`
+	// AppMetrics lists the metrics checked for an app that does not ask for
+	// specific metrics. Apps that are not listed are checked against the lag metric.
+	AppMetrics map[string][]string `json:"app_metrics,omitempty"`
+	// AppPriorities are the priorities of the apps, from MinAppPriority to MaxAppPriority,
+	// which is the default. The lower the priority of an app, the lower the fraction of the
+	// metrics' thresholds it is allowed to reach: low priority apps are throttled first.
+	AppPriorities map[string]int `json:"app_priorities,omitempty"`
+	// AppQuotas limit the number of successful checks per second of the apps.
+	AppQuotas map[string]float64 `json:"app_quotas,omitempty"`
+	// LowestPriorityThresholdRatio is the fraction of the metrics' thresholds which apps
+	// of the lowest priority may reach. Apps of higher priorities get proportionally more.
+	LowestPriorityThresholdRatio float64 `json:"lowest_priority_threshold_ratio,omitempty"`
+}
+
+// ParseMetricsConfig parses and validates a JSON metrics configuration.
+func ParseMetricsConfig(data []byte) (*MetricsConfig, error) {
+	metricsConfig := &MetricsConfig{}
+	if len(data) == 0 {
+		return metricsConfig, nil
+	}
+	if err := json.Unmarshal(data, metricsConfig); err != nil {
+		return nil, fmt.Errorf("cannot parse throttler metrics config: %v", err)
+	}
+	if err := metricsConfig.Validate(); err != nil {
+		return nil, err
+	}
+	return metricsConfig, nil
+}
+
+// Validate checks that the metrics are well defined, that the apps only
+// refer to known metrics, and that priorities and quotas are in range.
+func (c *MetricsConfig) Validate() error {
+	names := map[string]bool{}
+	for _, metric := range c.Metrics {
+		if metric.Name == "" || strings.ContainsAny(metric.Name, "/:,") {
+			return fmt.Errorf("invalid throttler metric name: %q", metric.Name)
+		}
+		if names[metric.Name] {
+			return fmt.Errorf("duplicate throttler metric: %s", metric.Name)
+		}
+		names[metric.Name] = true
+		if metric.Threshold <= 0 {
+			return fmt.Errorf("throttler metric %s must have a positive threshold", metric.Name)
+		}
+		switch metric.Name {
+		case LagMetricName, LoadAvgMetricName:
+			if metric.Query != "" {
+				return fmt.Errorf("throttler metric %s is builtin and does not accept a query", metric.Name)
+			}
+			continue
+		}
+		if metric.Query == "" && BuiltinMetricQueries[metric.Name] == "" {
+			return fmt.Errorf("throttler metric %s has no query", metric.Name)
+		}
+		if _, err := MetricQueryTables(metric.MetricQuery()); err != nil {
+			return fmt.Errorf("throttler metric %s: %v: %s", metric.Name, err, metric.Query)
+		}
+	}
+	for appName, metricNames := range c.AppMetrics {
+		for _, name := range metricNames {
+			if name != LagMetricName && !names[name] {
+				return fmt.Errorf("app %s refers to unknown throttler metric %s", appName, name)
+			}
+		}
+	}
+	for appName, priority := range c.AppPriorities {
+		if priority < MinAppPriority || priority > MaxAppPriority {
+			return fmt.Errorf("priority of app %s must be between %d and %d: %d", appName, MinAppPriority, MaxAppPriority, priority)
+		}
+	}
+	for appName, quota := range c.AppQuotas {
+		if quota <= 0 {
+			return fmt.Errorf("quota of app %s must be positive: %v", appName, quota)
+		}
+	}
+	if c.LowestPriorityThresholdRatio < 0 || c.LowestPriorityThresholdRatio > 1 {
+		return fmt.Errorf("lowest_priority_threshold_ratio must be between 0 and 1: %v", c.LowestPriorityThresholdRatio)
+	}
+	return nil
+}
+
+// IsEmpty returns true if nothing is configured.
+func (c *MetricsConfig) IsEmpty() bool {
+	return len(c.Metrics) == 0 && len(c.AppMetrics) == 0 && len(c.AppPriorities) == 0 && len(c.AppQuotas) == 0 && c.LowestPriorityThresholdRatio == 0
+}
+
+// Metric returns the named metric, or nil if it is not configured.
+func (c *MetricsConfig) Metric(name string) *MetricConfig {
+	for _, metric := range c.Metrics {
+		if metric.Name == name {
+			return metric
+		}
+	}
+	return nil
+}
+
+// MetricQuery returns the query that measures the metric.
+func (metric *MetricConfig) MetricQuery() string {
+	if metric.Query != "" {
+		return metric.Query
+	}
+	return BuiltinMetricQueries[metric.Name]
+}
+
+// MetricQueryTables returns the tables read by the query of a metric, which
+// must be a single SELECT that neither locks rows, writes its result nor
+// reads the mysql schema, or a SHOW GLOBAL STATUS/VARIABLES statement.
+func MetricQueryTables(query string) ([]sqlparser.TableName, error) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, fmt.Errorf("query must be a single statement: %v", err)
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+	case *sqlparser.Show:
+		if show, ok := stmt.Internal.(*sqlparser.ShowBasic); ok && (show.Command == sqlparser.StatusGlobal || show.Command == sqlparser.VariableGlobal) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("query must be a SHOW GLOBAL STATUS or SHOW GLOBAL VARIABLES statement")
+	default:
+		return nil, fmt.Errorf("query must be a SELECT or a SHOW GLOBAL statement")
+	}
+	var tables []sqlparser.TableName
+	err = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			if node.Lock != sqlparser.NoLock {
+				return false, fmt.Errorf("query must not lock rows")
+			}
+			if node.Into != nil {
+				return false, fmt.Errorf("query must not select into")
+			}
+		case *sqlparser.Nextval:
+			return false, fmt.Errorf("query must not read sequences")
+		case *sqlparser.AliasedTableExpr:
+			if table, ok := node.Expr.(sqlparser.TableName); ok {
+				if strings.EqualFold(table.Qualifier.String(), "mysql") {
+					return false, fmt.Errorf("query must not read the mysql schema")
+				}
+				tables = append(tables, table)
+			}
+		}
+		return true, nil
+	}, stmt)
+	if err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+// SetMetric adds the metric, or replaces the metric of the same name.
+func (c *MetricsConfig) SetMetric(metric *MetricConfig) {
+	for i, existing := range c.Metrics {
+		if existing.Name == metric.Name {
+			c.Metrics[i] = metric
+			return
+		}
+	}
+	c.Metrics = append(c.Metrics, metric)
+	sort.Slice(c.Metrics, func(i, j int) bool {
+		return c.Metrics[i].Name < c.Metrics[j].Name
+	})
+}
+
+// RemoveMetric removes the metric. It returns false if the metric does not exist.
+func (c *MetricsConfig) RemoveMetric(name string) bool {
+	for i, existing := range c.Metrics {
+		if existing.Name == name {
+			c.Metrics = append(c.Metrics[:i], c.Metrics[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// appConfigKeys returns the keys under which the configuration of an app is looked up,
+// in order. An app name may be composed of several tokens separated by ':',
+// e.g. "online-ddl:gh-ost:<uuid>": the full name comes first, then each token.
+func appConfigKeys(appName string) []string {
+	return append([]string{appName}, strings.Split(appName, ":")...)
+}
+
+// AppMetricNames returns the metrics to check for an app.
+func (c *MetricsConfig) AppMetricNames(appName string) []string {
+	for _, key := range appConfigKeys(appName) {
+		if metricNames, ok := c.AppMetrics[key]; ok {
+			return metricNames
+		}
+	}
+	return []string{LagMetricName}
+}
+
+// AppPriority returns the configured priority of an app.
+func (c *MetricsConfig) AppPriority(appName string) int {
+	for _, key := range appConfigKeys(appName) {
+		if priority, ok := c.AppPriorities[key]; ok {
+			return priority
+		}
+	}
+	return MaxAppPriority
+}
+
+// AppQuota returns the quota of an app, and the key under which it is configured,
+// which is shared by all the apps the quota applies to. It returns a zero quota if
+// the app has no quota.
+func (c *MetricsConfig) AppQuota(appName string) (key string, quota float64) {
+	for _, key := range appConfigKeys(appName) {
+		if quota, ok := c.AppQuotas[key]; ok {
+			return key, quota
+		}
+	}
+	return "", 0
+}
+
+// ThresholdRatio returns the fraction of the metrics' thresholds which an app of
+// the given priority may reach.
+func (c *MetricsConfig) ThresholdRatio(priority int) float64 {
+	if priority <= MinAppPriority {
+		priority = MinAppPriority
+	}
+	if priority >= MaxAppPriority {
+		return 1
+	}
+	lowest := c.LowestPriorityThresholdRatio
+	if lowest == 0 {
+		lowest = DefaultLowestPriorityThresholdRatio
+	}
+	return lowest + (1-lowest)*float64(priority-MinAppPriority)/float64(MaxAppPriority-MinAppPriority)
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestParseMetricsConfig(t *testing.T) {
+	tcases := []struct {
+		data string
+		err  string
+	}{
+		{
+			data: ``,
+		},
+		{
+			data: `{"metrics": [{"name": "threads_running", "threshold": 50}, {"name": "hll", "query": "select count from information_schema.innodb_metrics", "threshold": 1000000}], "app_metrics": {"online-ddl": ["lag", "hll"]}}`,
+		},
+		{
+			data: `{"metrics": [{"name": "lag", "threshold": 5}, {"name": "loadavg", "threshold": 1.5}]}`,
+		},
+		{
+			data: `{"metrics": [`,
+			err:  "cannot parse throttler metrics config",
+		},
+		{
+			data: `{"metrics": [{"name": "self/x", "query": "select 1", "threshold": 1}]}`,
+			err:  `invalid throttler metric name: "self/x"`,
+		},
+		{
+			data: `{"metrics": [{"name": "x", "query": "select 1", "threshold": 1}, {"name": "x", "query": "select 2", "threshold": 1}]}`,
+			err:  "duplicate throttler metric: x",
+		},
+		{
+			data: `{"metrics": [{"name": "x", "query": "select 1"}]}`,
+			err:  "throttler metric x must have a positive threshold",
+		},
+		{
+			data: `{"metrics": [{"name": "x", "threshold": 1}]}`,
+			err:  "throttler metric x has no query",
+		},
+		{
+			data: `{"metrics": [{"name": "loadavg", "query": "select 1", "threshold": 1}]}`,
+			err:  "throttler metric loadavg is builtin and does not accept a query",
+		},
+		{
+			data: `{"metrics": [{"name": "x", "query": "delete from t", "threshold": 1}]}`,
+			err:  "throttler metric x: query must be a SELECT or a SHOW GLOBAL statement: delete from t",
+		},
+		{
+			data: `{"metrics": [{"name": "x", "query": "select 1; delete from t", "threshold": 1}]}`,
+			err:  "throttler metric x: query must be a single statement",
+		},
+		{
+			data: `{"metrics": [{"name": "x", "query": "select count(*) from t for update", "threshold": 1}]}`,
+			err:  "throttler metric x: query must not lock rows",
+		},
+		{
+			data: `{"metrics": [{"name": "x", "query": "select count(*) from mysql.user", "threshold": 1}]}`,
+			err:  "throttler metric x: query must not read the mysql schema",
+		},
+		{
+			data: `{"metrics": [{"name": "x", "query": "show processlist", "threshold": 1}]}`,
+			err:  "throttler metric x: query must be a SHOW GLOBAL STATUS or SHOW GLOBAL VARIABLES statement",
+		},
+		{
+			data: `{"app_metrics": {"vreplication": ["threads_running"]}}`,
+			err:  "app vreplication refers to unknown throttler metric threads_running",
+		},
+		{
+			data: `{"app_priorities": {"tablegc": 10, "vreplication": 100}, "app_quotas": {"batch": 0.5}, "lowest_priority_threshold_ratio": 0.2}`,
+		},
+		{
+			data: `{"app_priorities": {"tablegc": 0}}`,
+			err:  "priority of app tablegc must be between 1 and 100: 0",
+		},
+		{
+			data: `{"app_quotas": {"batch": -1}}`,
+			err:  "quota of app batch must be positive: -1",
+		},
+		{
+			data: `{"lowest_priority_threshold_ratio": 1.5}`,
+			err:  "lowest_priority_threshold_ratio must be between 0 and 1: 1.5",
+		},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.data, func(t *testing.T) {
+			_, err := ParseMetricsConfig([]byte(tcase.data))
+			if tcase.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tcase.err)
+		})
+	}
+}
+
+func TestMetricQueryTables(t *testing.T) {
+	tables, err := MetricQueryTables("select count(*) from jobs j join _vt.queue on j.id = queue.id where j.state in (select state from states)")
+	require.NoError(t, err)
+	var names []string
+	for _, table := range tables {
+		names = append(names, sqlparser.String(table))
+	}
+	assert.Equal(t, []string{"jobs", "_vt.queue", "states"}, names)
+
+	tables, err = MetricQueryTables(BuiltinMetricQueries["threads_running"])
+	require.NoError(t, err)
+	assert.Empty(t, tables)
+
+	_, err = MetricQueryTables("select * from t into outfile '/tmp/t'")
+	assert.EqualError(t, err, "query must not select into")
+}
+
+func TestMetricsConfigAppMetricNames(t *testing.T) {
+	metricsConfig := &MetricsConfig{
+		AppMetrics: map[string][]string{
+			"online-ddl":             {"lag", "threads_running"},
+			"online-ddl:gh-ost:1234": {"history_list_length"},
+			"tablegc":                {"loadavg"},
+		},
+	}
+	assert.Equal(t, []string{"history_list_length"}, metricsConfig.AppMetricNames("online-ddl:gh-ost:1234"))
+	assert.Equal(t, []string{"lag", "threads_running"}, metricsConfig.AppMetricNames("online-ddl:vrepl:5678"))
+	assert.Equal(t, []string{"loadavg"}, metricsConfig.AppMetricNames("tablegc"))
+	assert.Equal(t, []string{LagMetricName}, metricsConfig.AppMetricNames("vreplication"))
+}
+
+func TestMetricsConfigSetRemoveMetric(t *testing.T) {
+	metricsConfig := &MetricsConfig{}
+	metricsConfig.SetMetric(&MetricConfig{Name: "threads_running", Threshold: 10})
+	metricsConfig.SetMetric(&MetricConfig{Name: "custom", Query: "select 1", Threshold: 1})
+	metricsConfig.SetMetric(&MetricConfig{Name: "threads_running", Threshold: 20})
+	require.Len(t, metricsConfig.Metrics, 2)
+	assert.Equal(t, "custom", metricsConfig.Metrics[0].Name)
+	assert.Equal(t, 20.0, metricsConfig.Metric("threads_running").Threshold)
+	assert.Equal(t, BuiltinMetricQueries["threads_running"], metricsConfig.Metric("threads_running").MetricQuery())
+	assert.Equal(t, "select 1", metricsConfig.Metric("custom").MetricQuery())
+
+	assert.True(t, metricsConfig.RemoveMetric("custom"))
+	assert.False(t, metricsConfig.RemoveMetric("custom"))
+	assert.Nil(t, metricsConfig.Metric("custom"))
+}
+
+func TestMetricsConfigAppPriorities(t *testing.T) {
+	metricsConfig := &MetricsConfig{
+		AppPriorities: map[string]int{
+			"online-ddl": 50,
+			"tablegc":    1,
+		},
+		AppQuotas: map[string]float64{
+			"online-ddl": 10,
+		},
+	}
+	assert.Equal(t, 50, metricsConfig.AppPriority("online-ddl:gh-ost:1234"))
+	assert.Equal(t, 1, metricsConfig.AppPriority("tablegc"))
+	assert.Equal(t, MaxAppPriority, metricsConfig.AppPriority("vreplication"))
+
+	assert.Equal(t, 0.5, metricsConfig.ThresholdRatio(1))
+	assert.InDelta(t, 0.6667, metricsConfig.ThresholdRatio(34), 0.0001)
+	assert.Equal(t, 1.0, metricsConfig.ThresholdRatio(100))
+	metricsConfig.LowestPriorityThresholdRatio = 0.2
+	assert.Equal(t, 0.2, metricsConfig.ThresholdRatio(-3))
+
+	key, quota := metricsConfig.AppQuota("online-ddl:vrepl:1234")
+	assert.Equal(t, "online-ddl", key)
+	assert.Equal(t, 10.0, quota)
+	_, quota = metricsConfig.AppQuota("tablegc")
+	assert.Zero(t, quota)
+}
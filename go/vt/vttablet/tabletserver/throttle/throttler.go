@@ -10,15 +10,18 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"math"
 	"math/rand"
 	"net/http"
+	"runtime"
 	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/textutil"
 	"vitess.io/vitess/go/timer"
@@ -34,6 +37,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/mysql"
 
 	"github.com/patrickmn/go-cache"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -57,6 +61,8 @@ const (
 
 	maxPasswordLength = 32
 
+	loadAvgFile = "/proc/loadavg"
+
 	shardStoreName = "shard"
 	selfStoreName  = "self"
 )
@@ -67,6 +73,7 @@ var (
 	throttleMetricQuery       = flag.String("throttle_metrics_query", "", "Override default heartbeat/lag metric. Use either `SELECT` (must return single row, single value) or `SHOW GLOBAL ... LIKE ...` queries. Set -throttle_metrics_threshold respectively.")
 	throttleMetricThreshold   = flag.Float64("throttle_metrics_threshold", math.MaxFloat64, "Override default throttle threshold, respective to -throttle_metrics_query")
 	throttlerCheckAsCheckSelf = flag.Bool("throttle_check_as_check_self", false, "Should throttler/check return a throttler/check-self result (changes throttler behavior for writes)")
+	throttleGrantProcess      = flag.Bool("throttle_grant_process", false, "Grant the global PROCESS privilege to the throttler account, so that the primary can read the history_list_length metric of the replicas. Without it, history_list_length is only measured on the primary itself")
 )
 var (
 	throttlerUser  = "vt_tablet_throttler"
@@ -79,7 +86,11 @@ var (
 	sqlGrantThrottlerUser = []string{
 		`GRANT SELECT ON _vt.heartbeat TO %s`,
 	}
-	replicationLagQuery = `select unix_timestamp(now(6))-max(ts/1000000000) as replication_lag from _vt.heartbeat`
+	// information_schema.innodb_metrics, used by the history_list_length metric, requires PROCESS
+	sqlGrantThrottlerProcess = `GRANT PROCESS ON *.* TO %s`
+	// the queries of the custom metrics may only read the tables they are granted
+	sqlGrantThrottlerMetricTable = `GRANT SELECT ON %s TO %s`
+	replicationLagQuery          = `select unix_timestamp(now(6))-max(ts/1000000000) as replication_lag from _vt.heartbeat`
 )
 
 // ThrottleCheckType allows a client to indicate what type of check it wants to issue. See available types below.
@@ -123,6 +134,11 @@ type Throttler struct {
 	MetricsThreshold sync2.AtomicFloat64
 	metricsQueryType mysql.MetricsQueryType
 
+	// metricsConfig is the keyspace's custom metrics configuration, read from the topo
+	metricsConfig     atomic.Value
+	metricsConfigData []byte
+	password          string
+
 	mysqlClusterThresholds *cache.Cache
 	aggregatedMetrics      *cache.Cache
 	throttledApps          *cache.Cache
@@ -137,6 +153,11 @@ type Throttler struct {
 
 	nonLowPriorityAppRequestsThrottled *cache.Cache
 	httpClient                         *http.Client
+
+	appQuotaMutex    sync.Mutex
+	appQuotaLimiters map[string]*rate.Limiter
+
+	appChecks *stats.CountersWithMultiLabels
 }
 
 // ThrottlerStatus published some status values from the throttler
@@ -150,6 +171,11 @@ type ThrottlerStatus struct {
 
 	AggregatedMetrics map[string]base.MetricResult
 	MetricsHealth     base.MetricHealthMap
+
+	ThrottledApps map[string]*base.AppThrottle
+	RecentApps    map[string]*base.RecentApp
+	AppPriorities map[string]int
+	AppQuotas     map[string]float64
 }
 
 // NewThrottler creates a Throttler
@@ -186,7 +212,18 @@ func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topo
 		nonLowPriorityAppRequestsThrottled: cache.New(nonDeprioritizedAppMapExpiration, nonDeprioritizedAppMapInterval),
 
 		httpClient: base.SetupHTTPClient(0),
+
+		appQuotaLimiters: make(map[string]*rate.Limiter),
 	}
+	throttler.appChecks = env.Exporter().NewCountersWithMultiLabels("ThrottlerAppChecks", "Tablet throttler checks by app and result", []string{"App", "Result"})
+	env.Exporter().NewGaugesFuncWithMultiLabels("ThrottlerThrottledApps", "Throttle ratio, in percent, of the apps throttled by the tablet throttler", []string{"App"}, func() map[string]int64 {
+		result := make(map[string]int64)
+		for appName, appThrottle := range throttler.ThrottledAppsMap() {
+			result[appName] = int64(appThrottle.Ratio * 100)
+		}
+		return result
+	})
+	throttler.metricsConfig.Store(&config.MetricsConfig{})
 	throttler.initThrottleTabletTypes()
 	throttler.ThrottleApp("abusing-app", time.Now().Add(time.Hour*24*365*10), defaultThrottleRatio)
 	throttler.check = NewThrottlerCheck(throttler)
@@ -222,9 +259,11 @@ func (throttler *Throttler) InitDBConfig(keyspace, shard string) {
 }
 
 // initThrottler initializes config
+// The settings are built aside and then swapped in, so that readers of
+// mysqlSettings() never see a partially populated map. Callers hold initMutex.
 func (throttler *Throttler) initConfig(password string) {
 	log.Infof("Throttler: initializing config")
-	config.Instance = &config.ConfigurationSettings{
+	settings := &config.ConfigurationSettings{
 		Stores: config.StoresSettings{
 			MySQL: config.MySQLConfigurationSettings{
 				IgnoreDialTCPErrors: true,
@@ -239,23 +278,117 @@ func (throttler *Throttler) initConfig(password string) {
 		throttler.MetricsThreshold = sync2.NewAtomicFloat64(*throttleMetricThreshold)
 	}
 	throttler.metricsQueryType = mysql.GetMetricsQueryType(throttler.metricsQuery)
+	throttler.password = password
 
-	config.Instance.Stores.MySQL.Clusters[selfStoreName] = &config.MySQLClusterConfigurationSettings{
-		User:              "", // running on local tablet server, will use vttablet DBA user
-		Password:          "", // running on local tablet server, will use vttablet DBA user
-		MetricQuery:       throttler.metricsQuery,
-		ThrottleThreshold: throttler.MetricsThreshold.Get(),
-		IgnoreHostsCount:  0,
-	}
-	if password != "" {
-		config.Instance.Stores.MySQL.Clusters[shardStoreName] = &config.MySQLClusterConfigurationSettings{
-			User:              throttlerUser,
-			Password:          password,
-			MetricQuery:       throttler.metricsQuery,
-			ThrottleThreshold: throttler.MetricsThreshold.Get(),
+	metricsConfig := throttler.getMetricsConfig()
+	lagThreshold := throttler.MetricsThreshold.Get()
+	if lagMetric := metricsConfig.Metric(config.LagMetricName); lagMetric != nil {
+		lagThreshold = lagMetric.Threshold
+	}
+	addClusters := func(metricName string, metricQuery string, threshold float64) {
+		settings.Stores.MySQL.Clusters[metricClusterName(selfStoreName, metricName)] = &config.MySQLClusterConfigurationSettings{
+			User:              "", // running on local tablet server, will use vttablet app user
+			Password:          "", // running on local tablet server, will use vttablet app user
+			MetricQuery:       metricQuery,
+			ThrottleThreshold: threshold,
 			IgnoreHostsCount:  0,
 		}
+		if password != "" && !isLocalMetric(metricName) {
+			settings.Stores.MySQL.Clusters[metricClusterName(shardStoreName, metricName)] = &config.MySQLClusterConfigurationSettings{
+				User:              throttlerUser,
+				Password:          password,
+				MetricQuery:       metricQuery,
+				ThrottleThreshold: threshold,
+				IgnoreHostsCount:  0,
+			}
+		}
 	}
+	addClusters(config.LagMetricName, throttler.metricsQuery, lagThreshold)
+	for _, metric := range metricsConfig.Metrics {
+		if metric.Name != config.LagMetricName {
+			addClusters(metric.Name, metric.MetricQuery(), metric.Threshold)
+		}
+	}
+	config.Instance = settings
+}
+
+// mysqlSettings returns the current MySQL store settings. The returned settings
+// are never modified, as initConfig replaces them altogether.
+func (throttler *Throttler) mysqlSettings() *config.MySQLConfigurationSettings {
+	throttler.initMutex.Lock()
+	defer throttler.initMutex.Unlock()
+	return &config.Settings().Stores.MySQL
+}
+
+// getMetricsConfig returns the current custom metrics configuration
+func (throttler *Throttler) getMetricsConfig() *config.MetricsConfig {
+	return throttler.metricsConfig.Load().(*config.MetricsConfig)
+}
+
+// refreshMetricsConfig reads the keyspace's metrics configuration from the topo, and
+// reconfigures the throttler if it has changed.
+func (throttler *Throttler) refreshMetricsConfig(ctx context.Context) error {
+	if throttler.ts == nil || throttler.keyspace == "" {
+		return nil
+	}
+	data, err := throttler.ts.GetThrottlerConfig(ctx, throttler.keyspace)
+	if err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return err
+	}
+
+	throttler.initMutex.Lock()
+	if string(data) == string(throttler.metricsConfigData) {
+		throttler.initMutex.Unlock()
+		return nil
+	}
+	metricsConfig, err := config.ParseMetricsConfig(data)
+	if err != nil {
+		throttler.initMutex.Unlock()
+		return err
+	}
+	log.Infof("Throttler: metrics config changed: %s", data)
+	throttler.metricsConfigData = data
+	throttler.metricsConfig.Store(metricsConfig)
+	throttler.initConfig(throttler.password)
+	// the account is created, with the grants of the current config, when the tablet becomes the leader
+	isLeaderWithAccount := atomic.LoadInt64(&throttler.isLeader) > 0 && throttler.password != ""
+	throttler.initMutex.Unlock()
+
+	if isLeaderWithAccount {
+		conn, err := dbconnpool.NewDBConnection(ctx, throttler.env.Config().DB.DbaWithDB())
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		throttler.grantMetricTables(conn)
+	}
+	return nil
+}
+
+// metricClusterName returns the name of the cluster that collects the given metric in the given store.
+// The lag metric is collected by the store's own cluster.
+func metricClusterName(storeName string, metricName string) string {
+	if metricName == config.LagMetricName || metricName == "" {
+		return storeName
+	}
+	return fmt.Sprintf("%s/%s", storeName, metricName)
+}
+
+// isLocalMetric returns true when the metric is only measured on the tablet itself, in which
+// case a check of the shard evaluates the metric of the primary tablet.
+func isLocalMetric(metricName string) bool {
+	switch metricName {
+	case config.LoadAvgMetricName:
+		return true
+	case "history_list_length":
+		return !*throttleGrantProcess
+	}
+	return false
+}
+
+// isSelfCluster returns true when the cluster only probes this tablet's backend mysql
+func isSelfCluster(clusterName string) bool {
+	return clusterName == selfStoreName || strings.HasPrefix(clusterName, selfStoreName+"/")
 }
 
 // Open opens database pool and initializes the schema
@@ -349,14 +482,80 @@ func (throttler *Throttler) createThrottlerUser(ctx context.Context) (password s
 			return password, err
 		}
 	}
+	if *throttleGrantProcess {
+		parsed := sqlparser.BuildParsedQuery(sqlGrantThrottlerProcess, throttlerGrant)
+		if _, err := conn.ExecuteFetch(parsed.Query, 0, false); err != nil {
+			return password, err
+		}
+	}
+	throttler.grantMetricTables(conn)
 	log.Infof("Throttler: user created/updated")
 	return password, nil
 }
 
+// metricTableGrants returns the statements that grant the throttler account SELECT on the tables
+// read by the queries of the custom metrics which the primary probes on the replicas. The tables
+// of information_schema need no grant.
+func (throttler *Throttler) metricTableGrants() (grants []string) {
+	for _, metric := range throttler.getMetricsConfig().Metrics {
+		if isLocalMetric(metric.Name) || metric.MetricQuery() == "" {
+			continue
+		}
+		// the queries were validated when the config was parsed
+		tables, _ := config.MetricQueryTables(metric.MetricQuery())
+		for _, table := range tables {
+			if table.Qualifier.IsEmpty() && throttler.env.Config().DB.DBName != "" {
+				table.Qualifier = sqlparser.NewTableIdent(throttler.env.Config().DB.DBName)
+			}
+			if strings.EqualFold(table.Qualifier.String(), "information_schema") {
+				continue
+			}
+			grants = append(grants, sqlparser.BuildParsedQuery(sqlGrantThrottlerMetricTable, sqlparser.String(table), throttlerGrant).Query)
+		}
+	}
+	return grants
+}
+
+// grantMetricTables grants the throttler account SELECT on the tables of the custom metrics.
+// The grants replicate to the replicas. A grant that fails, e.g. because the table does not
+// exist yet, leaves the metric unreadable on the replicas until the next attempt.
+func (throttler *Throttler) grantMetricTables(conn *dbconnpool.DBConnection) {
+	for _, grant := range throttler.metricTableGrants() {
+		if _, err := conn.ExecuteFetch(grant, 0, false); err != nil {
+			log.Errorf("Throttler: cannot grant access to a metric table: %s: %v", grant, err)
+		}
+	}
+}
+
+// readSelfLoadAvg reads the 1 minute load average of this very tablet's host, per CPU.
+func (throttler *Throttler) readSelfLoadAvg(clusterName string) *mysql.MySQLThrottleMetric {
+	metric := &mysql.MySQLThrottleMetric{
+		ClusterName: clusterName,
+		Key:         *mysql.SelfInstanceKey,
+	}
+	data, err := ioutil.ReadFile(loadAvgFile)
+	if err != nil {
+		metric.Err = err
+		return metric
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		metric.Err = fmt.Errorf("unexpected content in %s: %s", loadAvgFile, data)
+		return metric
+	}
+	loadAvg, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		metric.Err = err
+		return metric
+	}
+	metric.Value = loadAvg / float64(runtime.NumCPU())
+	return metric
+}
+
 // readSelfMySQLThrottleMetric reads the mysql metric from thi very tablet's backend mysql.
-func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMetric {
+func (throttler *Throttler) readSelfMySQLThrottleMetric(clusterName string, metricsQuery string) *mysql.MySQLThrottleMetric {
 	metric := &mysql.MySQLThrottleMetric{
-		ClusterName: selfStoreName,
+		ClusterName: clusterName,
 		Key:         *mysql.SelfInstanceKey,
 		Value:       0,
 		Err:         nil,
@@ -369,7 +568,7 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 	}
 	defer conn.Recycle()
 
-	tm, err := conn.Exec(ctx, throttler.metricsQuery, 1, true)
+	tm, err := conn.Exec(ctx, metricsQuery, 1, true)
 	if err != nil {
 		metric.Err = err
 		return metric
@@ -380,7 +579,7 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 		return metric
 	}
 
-	switch throttler.metricsQueryType {
+	switch mysql.GetMetricsQueryType(metricsQuery) {
 	case mysql.MetricsQueryTypeSelect:
 		// We expect a single row, single column result.
 		// The "for" iteration below is just a way to get first result without knowning column name
@@ -390,7 +589,7 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 	case mysql.MetricsQueryTypeShowGlobal:
 		metric.Value, metric.Err = strconv.ParseFloat(row["Value"].ToString(), 64)
 	default:
-		metric.Err = fmt.Errorf("Unsupported metrics query type for query %s", throttler.metricsQuery)
+		metric.Err = fmt.Errorf("Unsupported metrics query type for query %s", metricsQuery)
 	}
 
 	return metric
@@ -494,7 +693,13 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 			{
 				// sparse
 				if atomic.LoadInt64(&throttler.isOpen) > 0 {
-					go throttler.refreshMySQLInventory(ctx)
+					throttler.pruneMySQLInventory()
+					go func() {
+						if err := throttler.refreshMetricsConfig(ctx); err != nil {
+							log.Errorf("Throttler: error refreshing metrics config: %+v", err)
+						}
+						throttler.refreshMySQLInventory(ctx)
+					}()
 				}
 			}
 		case probes := <-throttler.mysqlClusterProbesChan:
@@ -536,11 +741,16 @@ func (throttler *Throttler) collectMySQLMetrics(ctx context.Context) error {
 					}
 					defer atomic.StoreInt64(&probe.QueryInProgress, 0)
 
-					// Apply an override to metrics read, if this is the special "self" cluster
+					// Apply an override to metrics read, if this is one of the special "self" clusters
 					// (where we incidentally know there's a single probe)
-					overrideGetMySQLThrottleMetricFunc := throttler.readSelfMySQLThrottleMetric
-					if clusterName != selfStoreName {
-						overrideGetMySQLThrottleMetricFunc = nil
+					var overrideGetMySQLThrottleMetricFunc func() *mysql.MySQLThrottleMetric
+					if isSelfCluster(clusterName) {
+						overrideGetMySQLThrottleMetricFunc = func() *mysql.MySQLThrottleMetric {
+							if clusterName == metricClusterName(selfStoreName, config.LoadAvgMetricName) {
+								return throttler.readSelfLoadAvg(clusterName)
+							}
+							return throttler.readSelfMySQLThrottleMetric(clusterName, probe.MetricQuery)
+						}
 					}
 					throttleMetrics := mysql.ReadThrottleMetric(probe, clusterName, overrideGetMySQLThrottleMetricFunc)
 					throttler.mysqlThrottleMetricChan <- throttleMetrics
@@ -576,7 +786,7 @@ func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
 		(*probes)[*key] = probe
 	}
 
-	for clusterName, clusterSettings := range config.Settings().Stores.MySQL.Clusters {
+	for clusterName, clusterSettings := range throttler.mysqlSettings().Clusters {
 		clusterName := clusterName
 		clusterSettings := clusterSettings
 		// config may dynamically change, but internal structure (config.Settings().Stores.MySQL.Clusters in our case)
@@ -589,7 +799,7 @@ func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
 				InstanceProbes:   mysql.NewProbes(),
 			}
 
-			if clusterName == selfStoreName {
+			if isSelfCluster(clusterName) {
 				// special case: just looking at this tablet's MySQL server
 				// We will probe this "cluster" (of one server) is a special way.
 				addInstanceKey(mysql.SelfInstanceKey, clusterName, clusterSettings, clusterProbes.InstanceProbes)
@@ -627,6 +837,21 @@ func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
 	return nil
 }
 
+// pruneMySQLInventory removes from the inventory the clusters which are no longer configured,
+// e.g. after a metric was removed from the metrics config
+func (throttler *Throttler) pruneMySQLInventory() {
+	clusters := throttler.mysqlSettings().Clusters
+	for clusterName := range throttler.mysqlInventory.ClustersProbes {
+		if _, ok := clusters[clusterName]; !ok {
+			delete(throttler.mysqlInventory.ClustersProbes, clusterName)
+			delete(throttler.mysqlInventory.IgnoreHostsCount, clusterName)
+			delete(throttler.mysqlInventory.IgnoreHostsThreshold, clusterName)
+			throttler.mysqlClusterThresholds.Delete(clusterName)
+			throttler.aggregatedMetrics.Delete(fmt.Sprintf("mysql/%s", clusterName))
+		}
+	}
+}
+
 // synchronous update of inventory
 func (throttler *Throttler) updateMySQLClusterProbes(ctx context.Context, clusterProbes *mysql.ClusterProbes) error {
 	throttler.mysqlInventory.ClustersProbes[clusterProbes.ClusterName] = clusterProbes.InstanceProbes
@@ -637,11 +862,12 @@ func (throttler *Throttler) updateMySQLClusterProbes(ctx context.Context, cluste
 
 // synchronous aggregation of collected data
 func (throttler *Throttler) aggregateMySQLMetrics(ctx context.Context) error {
+	ignoreDialTCPErrors := throttler.mysqlSettings().IgnoreDialTCPErrors
 	for clusterName, probes := range throttler.mysqlInventory.ClustersProbes {
 		metricName := fmt.Sprintf("mysql/%s", clusterName)
 		ignoreHostsCount := throttler.mysqlInventory.IgnoreHostsCount[clusterName]
 		ignoreHostsThreshold := throttler.mysqlInventory.IgnoreHostsThreshold[clusterName]
-		aggregatedMetric := aggregateMySQLProbes(ctx, probes, clusterName, throttler.mysqlInventory.InstanceKeyMetrics, ignoreHostsCount, config.Settings().Stores.MySQL.IgnoreDialTCPErrors, ignoreHostsThreshold)
+		aggregatedMetric := aggregateMySQLProbes(ctx, probes, clusterName, throttler.mysqlInventory.InstanceKeyMetrics, ignoreHostsCount, ignoreDialTCPErrors, ignoreHostsThreshold)
 		throttler.aggregatedMetrics.Set(metricName, aggregatedMetric, cache.DefaultExpiration)
 	}
 	return nil
@@ -813,12 +1039,83 @@ func (throttler *Throttler) AppRequestMetricResult(ctx context.Context, appName
 	return metricResultFunc()
 }
 
-// checkStore checks the aggregated value of given MySQL store
+// allowAppQuota returns false if the app has exceeded its quota of successful checks.
+// All the apps which share a quota configuration key share the quota.
+func (throttler *Throttler) allowAppQuota(appName string) bool {
+	key, quota := throttler.getMetricsConfig().AppQuota(appName)
+	if quota == 0 {
+		return true
+	}
+	throttler.appQuotaMutex.Lock()
+	defer throttler.appQuotaMutex.Unlock()
+
+	limiter, ok := throttler.appQuotaLimiters[key]
+	if !ok || limiter.Limit() != rate.Limit(quota) {
+		burst := int(math.Ceil(quota))
+		limiter = rate.NewLimiter(rate.Limit(quota), burst)
+		throttler.appQuotaLimiters[key] = limiter
+	}
+	return limiter.Allow()
+}
+
+// recordAppCheck counts the result of a check in the ThrottlerAppChecks stats.
+// Apps are counted by the first token of their name, e.g. "online-ddl" for "online-ddl:gh-ost:<uuid>".
+func (throttler *Throttler) recordAppCheck(appName string, checkResult *CheckResult) {
+	result := "OK"
+	switch {
+	case checkResult.Error == base.ErrQuotaExceeded:
+		result = "QuotaExceeded"
+	case checkResult.Error == base.ErrThresholdExceeded:
+		result = "Throttled"
+	case checkResult.Error == base.ErrAppDenied:
+		result = "Denied"
+	case checkResult.StatusCode != http.StatusOK:
+		result = "Error"
+	}
+	throttler.appChecks.Add([]string{strings.Split(appName, ":")[0], result}, 1)
+}
+
+// checkStore checks the aggregated values of the given MySQL store. The metrics checked are
+// the ones requested in the flags, or else the ones configured for the app. The check
+// succeeds if all the metrics are within the app's thresholds, which depend on its priority,
+// and if the app is within its quota. Otherwise, it returns the first failed metric.
 func (throttler *Throttler) checkStore(ctx context.Context, appName string, storeName string, remoteAddr string, flags *CheckFlags) (checkResult *CheckResult) {
 	if !throttler.env.Config().EnableLagThrottler {
 		return okMetricCheckResult
 	}
-	return throttler.check.Check(ctx, appName, "mysql", storeName, remoteAddr, flags)
+	metricsConfig := throttler.getMetricsConfig()
+	metricNames := flags.Metrics
+	if len(metricNames) == 0 {
+		metricNames = metricsConfig.AppMetricNames(appName)
+	}
+	if appName != frenoAppName {
+		// an app may lower its own priority, but never raise it above the configured one
+		if priority := metricsConfig.AppPriority(appName); flags.Priority == 0 || flags.Priority > priority {
+			appFlags := *flags
+			appFlags.Priority = priority
+			flags = &appFlags
+		}
+	}
+	if len(metricNames) == 0 {
+		metricNames = []string{config.LagMetricName}
+	}
+	for _, metricName := range metricNames {
+		metricStoreName := storeName
+		if isLocalMetric(metricName) {
+			metricStoreName = selfStoreName
+		}
+		// copy the result, which may be a shared one
+		metricCheckResult := *throttler.check.Check(ctx, appName, "mysql", metricClusterName(metricStoreName, metricName), remoteAddr, flags)
+		metricCheckResult.MetricName = metricName
+		if checkResult == nil || (checkResult.StatusCode == http.StatusOK && metricCheckResult.StatusCode != http.StatusOK) {
+			checkResult = &metricCheckResult
+		}
+	}
+	if checkResult.StatusCode == http.StatusOK && !throttler.allowAppQuota(appName) {
+		checkResult = NewCheckResult(http.StatusTooManyRequests, checkResult.Value, checkResult.Threshold, base.ErrQuotaExceeded)
+	}
+	throttler.recordAppCheck(appName, checkResult)
+	return checkResult
 }
 
 // checkShard checks the health of the shard, and runs on the primary tablet only
@@ -848,6 +1145,7 @@ func (throttler *Throttler) CheckByType(ctx context.Context, appName string, rem
 
 // Status exports a status breakdown
 func (throttler *Throttler) Status() *ThrottlerStatus {
+	metricsConfig := throttler.getMetricsConfig()
 	return &ThrottlerStatus{
 		Keyspace: throttler.keyspace,
 		Shard:    throttler.shard,
@@ -858,5 +1156,10 @@ func (throttler *Throttler) Status() *ThrottlerStatus {
 
 		AggregatedMetrics: throttler.aggregatedMetricsSnapshot(),
 		MetricsHealth:     throttler.metricsHealthSnapshot(),
+
+		ThrottledApps: throttler.ThrottledAppsMap(),
+		RecentApps:    throttler.RecentAppsMap(),
+		AppPriorities: metricsConfig.AppPriorities,
+		AppQuotas:     metricsConfig.AppQuotas,
 	}
 }
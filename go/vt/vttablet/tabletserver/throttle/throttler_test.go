@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/config"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func newTestThrottler(t *testing.T) *Throttler {
+	ts := memorytopo.NewServer("zone1")
+	cfg := tabletenv.NewDefaultConfig()
+	cfg.EnableLagThrottler = true
+	env := tabletenv.NewEnv(cfg, t.Name())
+	throttler := NewThrottler(env, ts, func() topodatapb.TabletType { return topodatapb.TabletType_MASTER })
+	throttler.keyspace = "ks"
+	throttler.shard = "0"
+	return throttler
+}
+
+func configuredClusters() []string {
+	var clusters []string
+	for clusterName := range config.Settings().Stores.MySQL.Clusters {
+		clusters = append(clusters, clusterName)
+	}
+	sort.Strings(clusters)
+	return clusters
+}
+
+func TestRefreshMetricsConfig(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestThrottler(t)
+	assert.Equal(t, []string{"self"}, configuredClusters())
+
+	data := []byte(`{"metrics": [{"name": "threads_running", "threshold": 50}, {"name": "loadavg", "threshold": 2}, {"name": "lag", "threshold": 3}]}`)
+	require.NoError(t, throttler.ts.SaveThrottlerConfig(ctx, "ks", data))
+	require.NoError(t, throttler.refreshMetricsConfig(ctx))
+	assert.Equal(t, []string{"self", "self/loadavg", "self/threads_running"}, configuredClusters())
+	assert.Equal(t, 3.0, config.Settings().Stores.MySQL.Clusters["self"].ThrottleThreshold)
+	assert.Equal(t, "show global status like 'threads_running'", config.Settings().Stores.MySQL.Clusters["self/threads_running"].MetricQuery)
+
+	// the leader also aggregates the metrics of the shard, except for the load average
+	throttler.initConfig("password")
+	assert.Equal(t, []string{"self", "self/loadavg", "self/threads_running", "shard", "shard/threads_running"}, configuredClusters())
+
+	require.NoError(t, throttler.ts.SaveThrottlerConfig(ctx, "ks", nil))
+	require.NoError(t, throttler.refreshMetricsConfig(ctx))
+	assert.Equal(t, []string{"self", "shard"}, configuredClusters())
+
+	require.NoError(t, throttler.ts.SaveThrottlerConfig(ctx, "ks", []byte(`{"metrics": [{"name": "x", "threshold": 1}]}`)))
+	assert.EqualError(t, throttler.refreshMetricsConfig(ctx), "throttler metric x has no query")
+	assert.Equal(t, []string{"self", "shard"}, configuredClusters())
+}
+
+func TestHistoryListLengthNeedsProcessGrant(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestThrottler(t)
+	require.NoError(t, throttler.ts.SaveThrottlerConfig(ctx, "ks", []byte(`{"metrics": [{"name": "history_list_length", "threshold": 1000}]}`)))
+	require.NoError(t, throttler.refreshMetricsConfig(ctx))
+
+	// without the PROCESS privilege, the replicas can't be probed for the metric
+	throttler.initConfig("password")
+	assert.Equal(t, []string{"self", "self/history_list_length", "shard"}, configuredClusters())
+
+	defer func(grant bool) { *throttleGrantProcess = grant }(*throttleGrantProcess)
+	*throttleGrantProcess = true
+	throttler.initConfig("password")
+	assert.Equal(t, []string{"self", "self/history_list_length", "shard", "shard/history_list_length"}, configuredClusters())
+}
+
+func TestMetricTableGrants(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestThrottler(t)
+	throttler.env.Config().DB = &dbconfigs.DBConfigs{DBName: "vt_ks"}
+	data := []byte(`{"metrics": [` +
+		`{"name": "threads_running", "threshold": 50}, ` +
+		`{"name": "history_list_length", "threshold": 1000}, ` +
+		`{"name": "queued", "query": "select count(*) from jobs j join _vt.queue q on j.id = q.id", "threshold": 10}]}`)
+	require.NoError(t, throttler.ts.SaveThrottlerConfig(ctx, "ks", data))
+	require.NoError(t, throttler.refreshMetricsConfig(ctx))
+
+	assert.Equal(t, []string{
+		"GRANT SELECT ON vt_ks.jobs TO 'vt_tablet_throttler'@'%'",
+		"GRANT SELECT ON _vt.queue TO 'vt_tablet_throttler'@'%'",
+	}, throttler.metricTableGrants())
+}
+
+func TestRefreshMetricsConfigConcurrently(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestThrottler(t)
+	configs := [][]byte{
+		[]byte(`{"metrics": [{"name": "threads_running", "threshold": 50}]}`),
+		[]byte(`{"metrics": [{"name": "loadavg", "threshold": 2}]}`),
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			require.NoError(t, throttler.ts.SaveThrottlerConfig(ctx, "ks", configs[i%len(configs)]))
+			require.NoError(t, throttler.refreshMetricsConfig(ctx))
+		}
+	}()
+	// the inventory is pruned by the operation loop while the config is refreshed
+	for {
+		select {
+		case <-done:
+			return
+		default:
+			throttler.pruneMySQLInventory()
+			assert.Contains(t, throttler.mysqlSettings().Clusters, selfStoreName)
+		}
+	}
+}
+
+func TestCheckMetrics(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestThrottler(t)
+	data := []byte(`{"metrics": [{"name": "threads_running", "threshold": 50}, {"name": "loadavg", "threshold": 2}], "app_metrics": {"online-ddl": ["lag", "threads_running"]}}`)
+	require.NoError(t, throttler.ts.SaveThrottlerConfig(ctx, "ks", data))
+	require.NoError(t, throttler.refreshMetricsConfig(ctx))
+
+	setMetric := func(clusterName string, value float64, threshold float64) {
+		throttler.mysqlClusterThresholds.Set(clusterName, threshold, cache.DefaultExpiration)
+		throttler.aggregatedMetrics.Set("mysql/"+clusterName, base.NewSimpleMetricResult(value), cache.DefaultExpiration)
+	}
+	setMetric("shard", 0.5, 1)
+	setMetric("shard/threads_running", 70, 50)
+	setMetric("self/loadavg", 1.5, 2)
+
+	// vreplication is not configured, and only checks the lag
+	checkResult := throttler.CheckByType(ctx, "vreplication", "", StandardCheckFlags, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+	assert.Equal(t, "lag", checkResult.MetricName)
+
+	checkResult = throttler.CheckByType(ctx, "online-ddl:vrepl:1234", "", StandardCheckFlags, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.StatusCode)
+	assert.Equal(t, "threads_running", checkResult.MetricName)
+	assert.Equal(t, 70.0, checkResult.Value)
+
+	// explicitly requested metrics override the app's metrics; the load average is read locally
+	checkResult = throttler.CheckByType(ctx, "online-ddl", "", &CheckFlags{Metrics: []string{"loadavg"}}, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+	assert.Equal(t, 1.5, checkResult.Value)
+
+	checkResult = throttler.CheckByType(ctx, "online-ddl", "", &CheckFlags{Metrics: []string{"unknown"}}, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusNotFound, checkResult.StatusCode)
+}
+
+func TestCheckAppPriorityAndQuota(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestThrottler(t)
+	data := []byte(`{"app_priorities": {"tablegc": 1, "online-ddl": 50}, "app_quotas": {"batch": 2}}`)
+	require.NoError(t, throttler.ts.SaveThrottlerConfig(ctx, "ks", data))
+	require.NoError(t, throttler.refreshMetricsConfig(ctx))
+
+	throttler.mysqlClusterThresholds.Set("shard", 1.0, cache.DefaultExpiration)
+	throttler.aggregatedMetrics.Set("mysql/shard", base.NewSimpleMetricResult(0.6), cache.DefaultExpiration)
+
+	// the lowest priority app may only reach half the threshold
+	checkResult := throttler.CheckByType(ctx, "tablegc", "", StandardCheckFlags, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.StatusCode)
+	assert.Equal(t, 0.5, checkResult.Threshold)
+
+	checkResult = throttler.CheckByType(ctx, "online-ddl:vrepl:1234", "", StandardCheckFlags, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+	assert.InDelta(t, 0.7475, checkResult.Threshold, 0.0001)
+
+	// an app may request a lower priority than the configured one
+	checkResult = throttler.CheckByType(ctx, "online-ddl", "", &CheckFlags{Priority: 1}, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.StatusCode)
+
+	// but not a higher one
+	checkResult = throttler.CheckByType(ctx, "tablegc", "", &CheckFlags{Priority: config.MaxAppPriority}, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.StatusCode)
+	assert.Equal(t, 0.5, checkResult.Threshold)
+
+	// throttling a low priority app does not deny the low priority requests of other apps
+	_, denied := throttler.nonLowPriorityAppRequestsThrottled.Get("mysql/shard")
+	assert.False(t, denied)
+
+	for i := 0; i < 2; i++ {
+		checkResult = throttler.CheckByType(ctx, "batch:job1", "", StandardCheckFlags, ThrottleCheckPrimaryWrite)
+		assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+	}
+	checkResult = throttler.CheckByType(ctx, "batch:job2", "", StandardCheckFlags, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.StatusCode)
+	assert.Equal(t, base.ErrQuotaExceeded, checkResult.Error)
+
+	assert.Equal(t, map[string]int64{
+		"tablegc.Throttled":    2,
+		"online-ddl.OK":        1,
+		"online-ddl.Throttled": 1,
+		"batch.OK":             2,
+		"batch.QuotaExceeded":  1,
+	}, throttler.appChecks.Counts())
+
+	status := throttler.Status()
+	assert.Equal(t, map[string]int{"tablegc": 1, "online-ddl": 50}, status.AppPriorities)
+	assert.Equal(t, map[string]float64{"batch": 2}, status.AppQuotas)
+	assert.Contains(t, status.ThrottledApps, "abusing-app")
+}
@@ -19,6 +19,7 @@ package tx
 import (
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -56,8 +57,27 @@ type (
 		Autocommit      bool
 		Conclusion      string
 		LogToFile       bool
+		Workload        string
 
 		Stats *servenv.TimingsWrapper
+
+		// mu protects the fields below. They are updated by the
+		// transaction resource checker while the transaction runs.
+		mu               sync.Mutex
+		resources        Resources
+		policyViolations []string
+	}
+
+	// Resources contains the resources used by a transaction in MySQL,
+	// as last reported by information_schema.innodb_trx.
+	Resources struct {
+		// RowsLocked is trx_rows_locked: the approximate number of rows locked.
+		RowsLocked int64
+		// RowsModified is trx_rows_modified.
+		RowsModified int64
+		// UndoSize is trx_weight, which InnoDB computes from the number of
+		// undo log records and locks held by the transaction.
+		UndoSize int64
 	}
 )
 
@@ -124,14 +144,52 @@ func (p *Properties) RecordQuery(query string) {
 // InTransaction returns true as soon as this struct is not nil
 func (p *Properties) InTransaction() bool { return p != nil }
 
+// SetResources records the resources currently used by the transaction.
+func (p *Properties) SetResources(resources Resources) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.resources = resources
+}
+
+// Resources returns the resources used by the transaction, as last recorded.
+func (p *Properties) Resources() Resources {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.resources
+}
+
+// AddPolicyViolation records a violation of a transaction resource policy.
+// It returns false if a violation was already recorded for the policy.
+func (p *Properties) AddPolicyViolation(policy, violation string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	prefix := policy + ":"
+	for _, v := range p.policyViolations {
+		if strings.HasPrefix(v, prefix) {
+			return false
+		}
+	}
+	p.policyViolations = append(p.policyViolations, prefix+" "+violation)
+	return true
+}
+
+// PolicyViolations returns the transaction resource policies violated by the
+// transaction, in the order they were detected.
+func (p *Properties) PolicyViolations() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.policyViolations...)
+}
+
 // String returns a printable version of the transaction
 func (p *Properties) String() string {
 	if p == nil {
 		return ""
 	}
 
+	resources := p.Resources()
 	return fmt.Sprintf(
-		"'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%v\t\n",
+		"'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%v\t%v\t%v\t%v\t%v\t\n",
 		p.EffectiveCaller,
 		p.ImmediateCaller,
 		p.StartTime.Format(time.StampMicro),
@@ -139,5 +197,9 @@ func (p *Properties) String() string {
 		p.EndTime.Sub(p.StartTime).Seconds(),
 		p.Conclusion,
 		strings.Join(p.Queries, ";"),
+		resources.RowsLocked,
+		resources.RowsModified,
+		resources.UndoSize,
+		strings.Join(p.PolicyViolations(), ";"),
 	)
 }
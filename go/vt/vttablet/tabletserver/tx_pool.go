@@ -63,6 +63,7 @@ type (
 		transactionTimeout sync2.AtomicDuration
 		ticks              *timer.Timer
 		limiter            txlimiter.TxLimiter
+		resources          *txResourceChecker
 
 		logMu   sync.Mutex
 		lastLog time.Time
@@ -86,6 +87,7 @@ func NewTxPool(env tabletenv.Env, limiter txlimiter.TxLimiter) *TxPool {
 		limiter:            limiter,
 		txStats:            env.Exporter().NewTimings("Transactions", "Transaction stats", "operation"),
 	}
+	axp.resources = newTxResourceChecker(env, axp)
 	// Careful: conns also exports name+"xxx" vars,
 	// but we know it doesn't export Timeout.
 	env.Exporter().NewGaugeDurationFunc("TransactionTimeout", "Transaction timeout", axp.transactionTimeout.Get)
@@ -97,10 +99,12 @@ func NewTxPool(env tabletenv.Env, limiter txlimiter.TxLimiter) *TxPool {
 func (tp *TxPool) Open(appParams, dbaParams, appDebugParams dbconfigs.Connector) {
 	tp.scp.Open(appParams, dbaParams, appDebugParams)
 	tp.ticks.Start(func() { tp.transactionKiller() })
+	tp.resources.Open(dbaParams, appDebugParams)
 }
 
 // Close closes the TxPool. A closed pool can be reopened.
 func (tp *TxPool) Close() {
+	tp.resources.Close()
 	tp.ticks.Stop()
 	tp.scp.Close()
 }
@@ -205,6 +209,9 @@ func (tp *TxPool) Rollback(ctx context.Context, txConn *StatefulConnection) erro
 	span, ctx := trace.NewSpan(ctx, "TxPool.Rollback")
 	defer span.Finish()
 	if txConn.IsClosed() || !txConn.IsInTransaction() {
+		// The transaction of a closed connection, e.g. killed by a resource
+		// policy, is over: it's no longer checked.
+		tp.resources.untrack(txConn)
 		return nil
 	}
 	if txConn.TxProperties().Autocommit {
@@ -270,6 +277,12 @@ func (tp *TxPool) begin(ctx context.Context, options *querypb.ExecuteOptions, re
 	}
 
 	conn.txProps = tp.NewTxProps(immediateCaller, effectiveCaller, autocommit)
+	workload := options.GetWorkload()
+	if workload == querypb.ExecuteOptions_UNSPECIFIED {
+		workload = querypb.ExecuteOptions_OLTP
+	}
+	conn.txProps.Workload = workload.String()
+	tp.resources.track(conn)
 
 	return beginQueries, nil
 }
@@ -351,6 +364,7 @@ func (tp *TxPool) SetTimeout(timeout time.Duration) {
 }
 
 func (tp *TxPool) txComplete(conn *StatefulConnection, reason tx.ReleaseReason) {
+	tp.resources.untrack(conn)
 	conn.LogTransaction(reason)
 	tp.limiter.Release(conn.TxProperties().ImmediateCaller, conn.TxProperties().EffectiveCaller)
 	conn.CleanTxState()
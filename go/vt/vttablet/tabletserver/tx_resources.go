@@ -0,0 +1,298 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
+)
+
+// txResourcesQuery reads the resources used by the open transactions.
+// See tx.Resources for how the columns map to the tracked resources.
+const txResourcesQuery = "select trx_mysql_thread_id, trx_rows_locked, trx_rows_modified, trx_weight from information_schema.innodb_trx"
+
+// txResourceChecker periodically reads the resources used by the
+// transactions of the TxPool from MySQL, and enforces the transaction
+// resource policies on them.
+type txResourceChecker struct {
+	tp       *TxPool
+	policies []tabletenv.TxResourcePolicy
+	conns    *connpool.Pool
+	ticks    *timer.Timer
+
+	violations *stats.CountersWithMultiLabels
+
+	// mu protects txs, which are the open transactions of the pool,
+	// and whether they are being killed.
+	// A transaction is tracked when it begins and untracked when it
+	// completes, while its connection is locked, so that the checker
+	// never reads the state of connections that are in use.
+	mu  sync.Mutex
+	txs map[tx.ConnID]*trackedTx
+}
+
+// trackedTx is the state of a transaction that the checker needs,
+// captured when the transaction begins.
+type trackedTx struct {
+	connID   tx.ConnID
+	conn     *StatefulConnection
+	dbConn   *connpool.DBConn
+	threadID int64
+	props    *tx.Properties
+	// killed is set, under mu, when the checker starts killing the
+	// MySQL connection of the transaction, and closed once it's killed.
+	killed chan struct{}
+}
+
+func newTxResourceChecker(env tabletenv.Env, tp *TxPool) *txResourceChecker {
+	config := env.Config()
+	return &txResourceChecker{
+		tp:       tp,
+		policies: config.TxResourcePolicies.Policies,
+		conns: connpool.NewPool(env, "", tabletenv.ConnPoolConfig{
+			Size:               1,
+			IdleTimeoutSeconds: config.OltpReadPool.IdleTimeoutSeconds,
+		}),
+		ticks:      timer.NewTimer(config.TxResourcePolicies.CheckIntervalSeconds.Get()),
+		violations: env.Exporter().NewCountersWithMultiLabels("TxResourcePolicyViolations", "Transactions that exceeded the limits of a resource policy", []string{"Policy", "Action"}),
+		txs:        make(map[tx.ConnID]*trackedTx),
+	}
+}
+
+func (rc *txResourceChecker) enabled() bool {
+	return rc.ticks.Interval() > 0 && len(rc.policies) > 0
+}
+
+// Open starts the periodic checks if there are policies to enforce.
+func (rc *txResourceChecker) Open(dbaParams, appDebugParams dbconfigs.Connector) {
+	if !rc.enabled() {
+		return
+	}
+	rc.conns.Open(dbaParams, dbaParams, appDebugParams)
+	rc.ticks.Start(func() { rc.check() })
+}
+
+// Close stops the periodic checks.
+func (rc *txResourceChecker) Close() {
+	if !rc.enabled() {
+		return
+	}
+	rc.ticks.Stop()
+	rc.conns.Close()
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.txs = make(map[tx.ConnID]*trackedTx)
+}
+
+// track starts tracking the transaction of conn. It must be called
+// while conn is locked, after the transaction began.
+func (rc *txResourceChecker) track(conn *StatefulConnection) {
+	if !rc.enabled() || conn.dbConn == nil {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.txs[conn.ConnID] = &trackedTx{
+		connID:   conn.ConnID,
+		conn:     conn,
+		dbConn:   conn.dbConn,
+		threadID: conn.dbConn.ID(),
+		props:    conn.txProps,
+	}
+}
+
+// untrack stops tracking the transaction of conn. It must be called
+// while conn is locked, before the transaction state is cleaned.
+// If the checker is killing the MySQL connection of the transaction,
+// it waits until it's killed, so that the connection isn't reused.
+func (rc *txResourceChecker) untrack(conn *StatefulConnection) {
+	if !rc.enabled() {
+		return
+	}
+	rc.mu.Lock()
+	t, ok := rc.txs[conn.ConnID]
+	if !ok || t.props != conn.txProps {
+		rc.mu.Unlock()
+		return
+	}
+	delete(rc.txs, conn.ConnID)
+	killed := t.killed
+	rc.mu.Unlock()
+	if killed != nil {
+		<-killed
+	}
+}
+
+// isTracked returns true if t is still the tracked transaction of its connection.
+// It must be called with mu held.
+func (rc *txResourceChecker) isTracked(t *trackedTx) bool {
+	return rc.txs[t.connID] == t
+}
+
+func (rc *txResourceChecker) check() {
+	defer rc.tp.env.LogError()
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), rc.ticks.Interval())
+	defer cancel()
+	conn, err := rc.conns.Get(ctx)
+	if err != nil {
+		log.Warningf("Could not get connection to check transaction resources: %v", err)
+		return
+	}
+	qr, err := conn.Exec(ctx, txResourcesQuery, 100000, false)
+	conn.Recycle()
+	if err != nil {
+		log.Warningf("Could not read transaction resources: %v", err)
+		return
+	}
+
+	resources := make(map[int64]tx.Resources, len(qr.Rows))
+	for _, row := range qr.Rows {
+		var values [4]int64
+		for i := range values {
+			if values[i], err = row[i].ToInt64(); err != nil {
+				log.Warningf("Unexpected row in %s: %v", txResourcesQuery, row)
+				return
+			}
+		}
+		resources[values[0]] = tx.Resources{
+			RowsLocked:   values[1],
+			RowsModified: values[2],
+			UndoSize:     values[3],
+		}
+	}
+
+	rc.mu.Lock()
+	txs := make([]*trackedTx, 0, len(rc.txs))
+	for _, t := range rc.txs {
+		txs = append(txs, t)
+	}
+	rc.mu.Unlock()
+
+	for _, t := range txs {
+		if r, ok := resources[t.threadID]; ok {
+			rc.enforce(t, r)
+		}
+	}
+}
+
+// enforce records the resources used by the transaction t
+// and applies the policies it violates.
+func (rc *txResourceChecker) enforce(t *trackedTx, resources tx.Resources) {
+	props := t.props
+	props.SetResources(resources)
+	for i := range rc.policies {
+		policy := &rc.policies[i]
+		if !txResourcePolicyMatches(policy, props) {
+			continue
+		}
+		violation := txResourceViolation(policy, resources)
+		if violation == "" || !props.AddPolicyViolation(policy.Name, violation) {
+			continue
+		}
+		action := policy.Action
+		if action == "" {
+			action = tabletenv.TxResourceActionKill
+		}
+		rc.violations.Add([]string{policy.Name, action}, 1)
+		reason := fmt.Sprintf("transaction resource policy %s: %s", policy.Name, violation)
+		if action == tabletenv.TxResourceActionWarn {
+			log.Warningf("%s: %v\t%s", reason, t.connID, props.String())
+			continue
+		}
+		rc.kill(t, reason)
+		return
+	}
+}
+
+// kill rolls back the transaction if it's idle. Otherwise, it kills
+// the MySQL connection, which fails the running query with the reason.
+// The reason is recorded on the connection, and returned to the client
+// by the next requests of the transaction.
+func (rc *txResourceChecker) kill(t *trackedTx, reason string) {
+	log.Warningf("killing transaction (%s): %v\t%s", reason, t.connID, t.props.String())
+	rc.tp.env.Stats().KillCounters.Add("TxResourcePolicy", 1)
+
+	if locked, err := rc.tp.scp.GetAndLock(t.connID, "for tx resource policy"); err == nil {
+		// A reserved connection may have moved on to another transaction.
+		if locked.txProps != t.props {
+			locked.Unlock()
+			return
+		}
+		locked.killReason.Set(reason)
+		if _, err := locked.Exec(context.Background(), "rollback", 1, false); err != nil {
+			locked.Close()
+		}
+		rc.tp.txComplete(locked, tx.TxKill)
+		locked.Releasef("%s", reason)
+		return
+	}
+
+	// The connection is in use. Marking the transaction as killed keeps
+	// it from completing, and its MySQL connection from being reused,
+	// until the connection is killed, without holding mu while killing.
+	rc.mu.Lock()
+	if !rc.isTracked(t) || t.killed != nil {
+		rc.mu.Unlock()
+		return
+	}
+	t.killed = make(chan struct{})
+	t.conn.killReason.Set(reason)
+	rc.mu.Unlock()
+
+	t.dbConn.Kill(reason, time.Since(t.props.StartTime))
+	close(t.killed)
+}
+
+// txResourcePolicyMatches returns true if the policy applies to the transaction.
+func txResourcePolicyMatches(policy *tabletenv.TxResourcePolicy, props *tx.Properties) bool {
+	if policy.Workload != "" && !strings.EqualFold(policy.Workload, props.Workload) {
+		return false
+	}
+	if policy.Caller != "" &&
+		policy.Caller != callerid.GetPrincipal(props.EffectiveCaller) &&
+		policy.Caller != callerid.GetUsername(props.ImmediateCaller) {
+		return false
+	}
+	return true
+}
+
+// txResourceViolation returns a description of the first limit of the
+// policy exceeded by resources, or "" if they are within the limits.
+func txResourceViolation(policy *tabletenv.TxResourcePolicy, resources tx.Resources) string {
+	switch {
+	case policy.MaxRowsLocked > 0 && resources.RowsLocked > policy.MaxRowsLocked:
+		return fmt.Sprintf("rows locked %d exceeds %d", resources.RowsLocked, policy.MaxRowsLocked)
+	case policy.MaxRowsModified > 0 && resources.RowsModified > policy.MaxRowsModified:
+		return fmt.Sprintf("rows modified %d exceeds %d", resources.RowsModified, policy.MaxRowsModified)
+	case policy.MaxUndoSize > 0 && resources.UndoSize > policy.MaxUndoSize:
+		return fmt.Sprintf("undo size %d exceeds %d", resources.UndoSize, policy.MaxUndoSize)
+	}
+	return ""
+}
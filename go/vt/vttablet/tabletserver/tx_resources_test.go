@@ -0,0 +1,252 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestTxResourcePolicyMatches(t *testing.T) {
+	props := &tx.Properties{
+		EffectiveCaller: callerid.NewEffectiveCallerID("batch-job", "", ""),
+		ImmediateCaller: callerid.NewImmediateCallerID("app"),
+		Workload:        "OLTP",
+	}
+	testcases := []struct {
+		policy tabletenv.TxResourcePolicy
+		want   bool
+	}{
+		{policy: tabletenv.TxResourcePolicy{}, want: true},
+		{policy: tabletenv.TxResourcePolicy{Workload: "oltp"}, want: true},
+		{policy: tabletenv.TxResourcePolicy{Workload: "olap"}, want: false},
+		{policy: tabletenv.TxResourcePolicy{Caller: "batch-job"}, want: true},
+		{policy: tabletenv.TxResourcePolicy{Caller: "app"}, want: true},
+		{policy: tabletenv.TxResourcePolicy{Caller: "other"}, want: false},
+		{policy: tabletenv.TxResourcePolicy{Workload: "oltp", Caller: "other"}, want: false},
+	}
+	for _, tcase := range testcases {
+		assert.Equal(t, tcase.want, txResourcePolicyMatches(&tcase.policy, props), "%+v", tcase.policy)
+	}
+}
+
+func TestTxResourceViolation(t *testing.T) {
+	resources := tx.Resources{RowsLocked: 100, RowsModified: 10, UndoSize: 20}
+	testcases := []struct {
+		policy tabletenv.TxResourcePolicy
+		want   string
+	}{
+		{policy: tabletenv.TxResourcePolicy{MaxRowsLocked: 100, MaxRowsModified: 10, MaxUndoSize: 20}, want: ""},
+		{policy: tabletenv.TxResourcePolicy{MaxRowsLocked: 99}, want: "rows locked 100 exceeds 99"},
+		{policy: tabletenv.TxResourcePolicy{MaxRowsModified: 9}, want: "rows modified 10 exceeds 9"},
+		{policy: tabletenv.TxResourcePolicy{MaxUndoSize: 19}, want: "undo size 20 exceeds 19"},
+		{policy: tabletenv.TxResourcePolicy{MaxRowsLocked: 99, MaxUndoSize: 19}, want: "rows locked 100 exceeds 99"},
+	}
+	for _, tcase := range testcases {
+		assert.Equal(t, tcase.want, txResourceViolation(&tcase.policy, resources), "%+v", tcase.policy)
+	}
+}
+
+func setupTxResources(t *testing.T, policies ...tabletenv.TxResourcePolicy) (*fakesqldb.DB, *TxPool, func()) {
+	env := newEnv("TabletServerTest")
+	env.Config().TxResourcePolicies.CheckIntervalSeconds = 3600
+	env.Config().TxResourcePolicies.Policies = policies
+	db, txPool, _, closer := setupWithEnv(t, env)
+	return db, txPool, closer
+}
+
+func addTxResources(db *fakesqldb.DB, conn *StatefulConnection, rowsLocked, rowsModified, undoSize int64) {
+	db.AddQuery(txResourcesQuery, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"trx_mysql_thread_id|trx_rows_locked|trx_rows_modified|trx_weight",
+			"int64|int64|int64|int64",
+		),
+		fmt.Sprintf("%d|%d|%d|%d", conn.dbConn.ID(), rowsLocked, rowsModified, undoSize),
+	))
+}
+
+func TestTxResourcePolicyWarn(t *testing.T) {
+	db, txPool, closer := setupTxResources(t, tabletenv.TxResourcePolicy{
+		Name:            "warn_modified",
+		MaxRowsModified: 100,
+		Action:          tabletenv.TxResourceActionWarn,
+	})
+	defer closer()
+	startingWarnings := txPool.resources.violations.Counts()["warn_modified.warn"]
+
+	conn, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+	defer txPool.RollbackAndRelease(ctx, conn)
+	conn.Unlock()
+
+	addTxResources(db, conn, 500, 200, 210)
+	txPool.resources.check()
+	txPool.resources.check()
+
+	props := conn.TxProperties()
+	assert.Equal(t, "OLTP", props.Workload)
+	assert.Equal(t, tx.Resources{RowsLocked: 500, RowsModified: 200, UndoSize: 210}, props.Resources())
+	assert.Equal(t, []string{"warn_modified: rows modified 200 exceeds 100"}, props.PolicyViolations())
+	assert.Equal(t, int64(1), txPool.resources.violations.Counts()["warn_modified.warn"]-startingWarnings)
+	assert.Empty(t, conn.KillReason())
+
+	// The transaction is still usable.
+	_, err = conn.Exec(ctx, "select 1", 1, false)
+	require.NoError(t, err)
+}
+
+func TestTxResourcePolicyKillsIdleTransaction(t *testing.T) {
+	db, txPool, closer := setupTxResources(t, tabletenv.TxResourcePolicy{
+		Name:          "olap_locked",
+		Workload:      "olap",
+		MaxRowsLocked: 100,
+	}, tabletenv.TxResourcePolicy{
+		Name:        "oltp_undo",
+		Workload:    "oltp",
+		MaxUndoSize: 1000,
+	})
+	defer closer()
+	startingKills := txPool.env.Stats().KillCounters.Counts()["TxResourcePolicy"]
+	startingViolations := txPool.resources.violations.Counts()["oltp_undo.kill"]
+
+	conn, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+	conn.Unlock()
+
+	addTxResources(db, conn, 500, 900, 1010)
+	txPool.resources.check()
+
+	assert.Equal(t, int64(1), txPool.env.Stats().KillCounters.Counts()["TxResourcePolicy"]-startingKills)
+	assert.Equal(t, int64(1), txPool.resources.violations.Counts()["oltp_undo.kill"]-startingViolations)
+	_, err = txPool.GetAndLock(conn.ConnID, "for query")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transaction resource policy oltp_undo: undo size 1010 exceeds 1000")
+}
+
+func TestTxResourcePolicyKillsBusyTransaction(t *testing.T) {
+	db, txPool, closer := setupTxResources(t, tabletenv.TxResourcePolicy{
+		Name:            "modified",
+		MaxRowsModified: 100,
+	})
+	defer closer()
+
+	conn, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+	defer txPool.RollbackAndRelease(ctx, conn)
+
+	// The connection is still locked by Begin, so the transaction
+	// cannot be rolled back: its connection is killed instead.
+	addTxResources(db, conn, 500, 200, 210)
+	txPool.resources.check()
+
+	assert.Equal(t, "transaction resource policy modified: rows modified 200 exceeds 100", conn.KillReason())
+	_, err = conn.Exec(context.Background(), "select 1", 1, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transaction was aborted: transaction resource policy modified: rows modified 200 exceeds 100")
+}
+
+func TestTxResourcePolicyKillDoesNotBlockTracking(t *testing.T) {
+	db, txPool, closer := setupTxResources(t, tabletenv.TxResourcePolicy{
+		Name:            "modified",
+		MaxRowsModified: 100,
+	})
+	defer closer()
+
+	conn, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+	addTxResources(db, conn, 500, 200, 210)
+	killing := make(chan struct{})
+	release := make(chan struct{})
+	killQuery := fmt.Sprintf("kill %d", conn.dbConn.ID())
+	db.AddQuery(killQuery, &sqltypes.Result{})
+	db.SetBeforeFunc(killQuery, func() {
+		close(killing)
+		<-release
+	})
+	checked := make(chan struct{})
+	go func() {
+		defer close(checked)
+		txPool.resources.check()
+	}()
+	<-killing
+
+	// The checker doesn't keep other transactions from being tracked
+	// while the connection is killed.
+	unlocked := make(chan struct{})
+	go func() {
+		defer close(unlocked)
+		txPool.resources.mu.Lock()
+		defer txPool.resources.mu.Unlock()
+	}()
+	select {
+	case <-unlocked:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the checker holds its lock while killing the connection")
+	}
+
+	// The killed transaction only completes once its connection is killed.
+	rolledBack := make(chan struct{})
+	go func() {
+		defer close(rolledBack)
+		txPool.RollbackAndRelease(ctx, conn)
+	}()
+	select {
+	case <-rolledBack:
+		t.Fatal("the transaction completed before its connection was killed")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	<-checked
+	<-rolledBack
+	txPool.resources.mu.Lock()
+	assert.Empty(t, txPool.resources.txs)
+	txPool.resources.mu.Unlock()
+}
+
+func TestTxResourceCheckerTracksTransactions(t *testing.T) {
+	_, txPool, closer := setupTxResources(t, tabletenv.TxResourcePolicy{
+		Name:            "modified",
+		MaxRowsModified: 100,
+	})
+	defer closer()
+
+	conn, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+	txPool.resources.mu.Lock()
+	assert.Len(t, txPool.resources.txs, 1)
+	txPool.resources.mu.Unlock()
+
+	_, err = txPool.Commit(ctx, conn)
+	require.NoError(t, err)
+	conn.Release(tx.TxCommit)
+	txPool.resources.mu.Lock()
+	assert.Empty(t, txPool.resources.txs)
+	txPool.resources.mu.Unlock()
+}
@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logz"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -46,6 +47,10 @@ var (
 				<th>Duration</th>
 				<th>Decision</th>
 				<th>Statements</th>
+				<th>Rows locked</th>
+				<th>Rows modified</th>
+				<th>Undo size</th>
+				<th>Resource policies</th>
 			</tr>
 		</thead>
 	`)
@@ -68,6 +73,14 @@ var (
 					{{.}}<br>
 				{{ end}}
 			</td>
+			<td>{{.Resources.RowsLocked}}</td>
+			<td>{{.Resources.RowsModified}}</td>
+			<td>{{.Resources.UndoSize}}</td>
+			<td>
+				{{ range .PolicyViolations }}
+					{{.}}<br>
+				{{ end}}
+			</td>
 		</tr>`))
 )
 
@@ -143,9 +156,11 @@ func writeTransactionData(w http.ResponseWriter, txc *StatefulConnection) {
 	}
 	tmplData := struct {
 		*StatefulConnection
-		Duration   float64
-		ColorLevel string
-	}{txc, duration, level}
+		Duration         float64
+		ColorLevel       string
+		Resources        tx.Resources
+		PolicyViolations []string
+	}{txc, duration, level, props.Resources(), props.PolicyViolations()}
 	if err := txlogzTmpl.Execute(w, tmplData); err != nil {
 		log.Errorf("txlogz: couldn't execute template: %v", err)
 	}
@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
@@ -77,8 +78,15 @@ type TxSerializer struct {
 	// been rejected due to exceeding the max queue size per row (range).
 	//
 	// globalQueueExceeded is the same as queueExceeded but for the global queue.
-	waits, waitsDryRun, queueExceeded, queueExceededDryRun *stats.CountersWithSingleLabel
-	globalQueueExceeded, globalQueueExceededDryRun         *stats.Counter
+	//
+	// transactions counts per table how many transactions went through the
+	// hot row protection, whether they were queued or not.
+	waits, waitsDryRun, queueExceeded, queueExceededDryRun, transactions *stats.CountersWithSingleLabel
+	globalQueueExceeded, globalQueueExceededDryRun                       *stats.Counter
+
+	// queueWaits is the histogram of the time spent waiting in the queue,
+	// per table.
+	queueWaits *servenv.TimingsWrapper
 
 	log                          *logutil.ThrottledLogger
 	logDryRun                    *logutil.ThrottledLogger
@@ -94,7 +102,7 @@ type TxSerializer struct {
 // New returns a TxSerializer object.
 func New(env tabletenv.Env) *TxSerializer {
 	config := env.Config()
-	return &TxSerializer{
+	txs := &TxSerializer{
 		env:                    env,
 		ConsolidatorCache:      sync2.NewConsolidatorCache(1000),
 		dryRun:                 config.HotRowProtection.Mode == tabletenv.Dryrun,
@@ -123,6 +131,14 @@ func New(env tabletenv.Env) *TxSerializer {
 		globalQueueExceededDryRun: env.Exporter().NewCounter(
 			"TxSerializerGlobalQueueExceededDryRun",
 			"Dry-run stats for TxSerializerGlobalQueueExceeded"),
+		transactions: env.Exporter().NewCountersWithSingleLabel(
+			"TxSerializerTransactions",
+			"Number of transactions that went through the hot row protection",
+			"table_name"),
+		queueWaits: env.Exporter().NewTimings(
+			"TxSerializerQueueWait",
+			"Time transactions spent queued because another transaction was already in flight for the same row range",
+			"table_name"),
 		log:                          logutil.NewThrottledLogger("HotRowProtection", 5*time.Second),
 		logDryRun:                    logutil.NewThrottledLogger("HotRowProtection DryRun", 5*time.Second),
 		logWaitsDryRun:               logutil.NewThrottledLogger("HotRowProtection Waits DryRun", 5*time.Second),
@@ -130,7 +146,12 @@ func New(env tabletenv.Env) *TxSerializer {
 		logGlobalQueueExceededDryRun: logutil.NewThrottledLogger("HotRowProtection GlobalQueueExceeded DryRun", 5*time.Second),
 		queues:                       make(map[string]*queue),
 	}
-
+	env.Exporter().NewGaugesFuncWithMultiLabels(
+		"TxSerializerPending",
+		"Number of transactions that are currently queued or in flight for a hot row range",
+		[]string{"table_name"},
+		txs.pendingByTable)
+	return txs
 }
 
 // DoneFunc is returned by Wait() and must be called by the caller.
@@ -143,10 +164,15 @@ type DoneFunc func()
 // "waited" is true if Wait() had to wait for other transactions.
 // "err" is not nil if a) the context is done or b) a queue limit was reached.
 func (txs *TxSerializer) Wait(ctx context.Context, key, table string) (done DoneFunc, waited bool, err error) {
+	txs.transactions.Add(table, 1)
+	startTime := time.Now()
 	txs.mu.Lock()
 	defer txs.mu.Unlock()
 
 	waited, err = txs.lockLocked(ctx, key, table)
+	if waited {
+		txs.queueWaits.Record(table, startTime)
+	}
 	if err != nil {
 		if waited {
 			// Waiting failed early e.g. due a canceled context and we did NOT get the
@@ -165,7 +191,7 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 	q, ok := txs.queues[key]
 	if !ok {
 		// First transaction in the queue i.e. we don't wait and return immediately.
-		txs.queues[key] = newQueueForFirstTransaction(txs.concurrentTransactions)
+		txs.queues[key] = newQueueForFirstTransaction(table, txs.concurrentTransactions)
 		txs.globalSize++
 		return false, nil
 	}
@@ -304,6 +330,19 @@ func (txs *TxSerializer) Pending(key string) int {
 	return q.size
 }
 
+// pendingByTable returns the number of queued transactions (including the
+// ones which are currently in flight) per table.
+func (txs *TxSerializer) pendingByTable() map[string]int64 {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+
+	pending := make(map[string]int64)
+	for _, q := range txs.queues {
+		pending[q.table] += int64(q.size)
+	}
+	return pending
+}
+
 // ServeHTTP lists the most recent, cached queries and their count.
 func (txs *TxSerializer) ServeHTTP(response http.ResponseWriter, request *http.Request) {
 	if *streamlog.RedactDebugUIQueries {
@@ -344,6 +383,9 @@ func (txs *TxSerializer) ServeHTTP(response http.ResponseWriter, request *http.R
 // transactions which can access the tx pool). All queued transactions are
 // competing for these slots and try to add themselves to the channel.
 type queue struct {
+	// table is the table name of the row range. It is immutable.
+	table string
+
 	// NOTE: The following fields are guarded by TxSerializer.mu.
 	// size counts how many transactions are currently queued/in flight (includes
 	// the transactions which are not waiting.)
@@ -364,8 +406,9 @@ type queue struct {
 	availableSlots chan struct{}
 }
 
-func newQueueForFirstTransaction(concurrentTransactions int) *queue {
+func newQueueForFirstTransaction(table string, concurrentTransactions int) *queue {
 	return &queue{
+		table: table,
 		size:  1,
 		count: 1,
 		max:   1,
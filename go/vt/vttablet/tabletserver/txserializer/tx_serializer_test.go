@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -41,6 +42,8 @@ func resetVariables(txs *TxSerializer) {
 	txs.queueExceededDryRun.ResetAll()
 	txs.globalQueueExceeded.Reset()
 	txs.globalQueueExceededDryRun.Reset()
+	txs.transactions.ResetAll()
+	txs.queueWaits.Reset()
 }
 
 func TestTxSerializer_NoHotRow(t *testing.T) {
@@ -142,6 +145,9 @@ func TestTxSerializer(t *testing.T) {
 	if err := waitForPending(txs, "t1 where1", 2); err != nil {
 		t.Error(err)
 	}
+	if got, want := txs.pendingByTable(), map[string]int64{"t1": 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong pending transactions per table: got = %v, want = %v", got, want)
+	}
 
 	// tx3 (gets rejected because it would exceed the local queue).
 	_, _, err3 := txs.Wait(context.Background(), "t1 where1", "t1")
@@ -172,6 +178,17 @@ func TestTxSerializer(t *testing.T) {
 	if got, want := txs.queueExceeded.Counts()["t1"], int64(1); got != want {
 		t.Errorf("variable not incremented: got = %v, want = %v", got, want)
 	}
+	// All 3 went through the hot row protection.
+	if got, want := txs.transactions.Counts()["t1"], int64(3); got != want {
+		t.Errorf("variable not incremented: got = %v, want = %v", got, want)
+	}
+	// The wait of tx2 was recorded.
+	if got, want := txs.queueWaits.Counts()["TxSerializerTest.t1"], int64(1); got != want {
+		t.Errorf("queue wait not recorded: got = %v, want = %v", got, want)
+	}
+	if got := txs.pendingByTable(); len(got) != 0 {
+		t.Errorf("there should be no pending transactions: got = %v", got)
+	}
 }
 
 func TestTxSerializer_ConcurrentTransactions(t *testing.T) {
@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/audit"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -82,6 +83,9 @@ type Controller struct {
 	// TS is the return value for TopoServer.
 	TS *topo.Server
 
+	// Auditor is the return value for AuditLogger.
+	Auditor *audit.Logger
+
 	// mu protects the next fields in this structure. They are
 	// accessed by both the methods in this interface, and the
 	// background health check.
@@ -211,6 +215,11 @@ func (tqsc *Controller) SchemaEngine() *schema.Engine {
 	return nil
 }
 
+// AuditLogger is part of the tabletserver.Controller interface
+func (tqsc *Controller) AuditLogger() *audit.Logger {
+	return tqsc.Auditor
+}
+
 // BroadcastHealth is part of the tabletserver.Controller interface
 func (tqsc *Controller) BroadcastHealth() {
 	tqsc.mu.Lock()
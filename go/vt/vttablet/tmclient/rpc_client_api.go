@@ -52,6 +52,9 @@ type TabletManagerClient interface {
 	// GetPermissions asks the remote tablet for its permissions list
 	GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error)
 
+	// GetSchemaVersions asks the remote tablet for the schema versions it tracks in _vt.schema_version
+	GetSchemaVersions(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GetSchemaVersionsRequest) ([]*tabletmanagerdatapb.SchemaVersion, error)
+
 	//
 	// Various read-write methods
 	//
@@ -335,6 +335,42 @@ func tmRPCTestGetPermissionsPanic(ctx context.Context, t *testing.T, client tmcl
 	expectHandleRPCPanic(t, "GetPermissions", false /*verbose*/, err)
 }
 
+var testGetSchemaVersionsRequest = &tabletmanagerdatapb.GetSchemaVersionsRequest{
+	Ids:           []int64{1, 2},
+	IncludeSchema: true,
+}
+
+var testGetSchemaVersionsReply = []*tabletmanagerdatapb.SchemaVersion{
+	{
+		Id:       1,
+		Position: "MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-10",
+		Ddl:      "create table t1 (id int)",
+	},
+	{
+		Id:       2,
+		Position: "MySQL56/7b04699f-f5e9-11e9-bf88-9cb6d089e1c3:1-20",
+		Ddl:      "create table t2 (id int)",
+	},
+}
+
+func (fra *fakeRPCTM) GetSchemaVersions(ctx context.Context, req *tabletmanagerdatapb.GetSchemaVersionsRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetSchemaVersions request", req, testGetSchemaVersionsRequest)
+	return testGetSchemaVersionsReply, nil
+}
+
+func tmRPCTestGetSchemaVersions(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.GetSchemaVersions(ctx, tablet, testGetSchemaVersionsRequest)
+	compareError(t, "GetSchemaVersions", err, result, testGetSchemaVersionsReply)
+}
+
+func tmRPCTestGetSchemaVersionsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetSchemaVersions(ctx, tablet, testGetSchemaVersionsRequest)
+	expectHandleRPCPanic(t, "GetSchemaVersions", false /*verbose*/, err)
+}
+
 //
 // Various read-write methods
 //
@@ -1309,6 +1345,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestPing(ctx, t, client, tablet)
 	tmRPCTestGetSchema(ctx, t, client, tablet)
 	tmRPCTestGetPermissions(ctx, t, client, tablet)
+	tmRPCTestGetSchemaVersions(ctx, t, client, tablet)
 
 	// Various read-write methods
 	tmRPCTestSetReadOnly(ctx, t, client, tablet)
@@ -1365,6 +1402,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestPingPanic(ctx, t, client, tablet)
 	tmRPCTestGetSchemaPanic(ctx, t, client, tablet)
 	tmRPCTestGetPermissionsPanic(ctx, t, client, tablet)
+	tmRPCTestGetSchemaVersionsPanic(ctx, t, client, tablet)
 
 	// Various read-write methods
 	tmRPCTestSetReadOnlyPanic(ctx, t, client, tablet)
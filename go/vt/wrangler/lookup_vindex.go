@@ -0,0 +1,45 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/vtctl/workflow"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// VerifyLookupVindex compares the rows of the owner table of a lookup vindex
+// with the rows of its lookup table, and reports the differences for each
+// shard of the lookup table. If repair is true, entries missing from the
+// lookup table are inserted and orphaned entries are deleted.
+// See workflow.Server.VerifyLookupVindex.
+func (wr *Wrangler) VerifyLookupVindex(ctx context.Context, qualifiedVindexName string, repair bool, chunkSize int64) (*vtctldatapb.VerifyLookupVindexResponse, error) {
+	splits := strings.Split(qualifiedVindexName, ".")
+	if len(splits) != 2 {
+		return nil, fmt.Errorf("vindex name should be of the form keyspace.vindex: %s", qualifiedVindexName)
+	}
+	return workflow.NewServer(wr.ts, wr.tmc).VerifyLookupVindex(ctx, &vtctldatapb.VerifyLookupVindexRequest{
+		Keyspace:  splits[0],
+		Vindex:    splits[1],
+		Repair:    repair,
+		ChunkSize: chunkSize,
+	})
+}
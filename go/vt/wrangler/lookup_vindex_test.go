@@ -0,0 +1,206 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestVerifyLookupVindex(t *testing.T) {
+	env := newTestVDiffEnv([]string{"-80", "80-"}, []string{"0"}, "", nil)
+	defer env.close()
+	ctx := context.Background()
+
+	sourceVSchema := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+			"v": {
+				Type: "lookup_unique",
+				Params: map[string]string{
+					"table": "target.lkp",
+					"from":  "c1",
+					"to":    "keyspace_id",
+				},
+				Owner: "t1",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Name:   "hash",
+					Column: "id",
+				}, {
+					Name:   "v",
+					Column: "c1",
+				}},
+			},
+		},
+	}
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "source", sourceVSchema))
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "target", &vschemapb.Keyspace{
+		Tables: map[string]*vschemapb.Table{
+			"lkp": {},
+		},
+	}))
+
+	env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"id", "c1"},
+			PrimaryKeyColumns: []string{"id"},
+		}, {
+			Name:              "lkp",
+			Columns:           []string{"c1", "keyspace_id"},
+			PrimaryKeyColumns: []string{"c1"},
+		}},
+	}
+
+	// The tables are read in chunks of 2 rows.
+	ownerFields := sqltypes.MakeTestFields("c1|id", "int64|int64")
+	env.tablets[100].setResults("select c1, id from t1 order by id limit 2", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(ownerFields,
+		"10|1",
+		"null|4",
+	))
+	env.tablets[100].setResults("select c1, id from t1 where id > 4 order by id limit 2", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(ownerFields))
+	env.tablets[110].setResults("select c1, id from t1 order by id limit 2", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(ownerFields,
+		"20|2",
+	))
+	// Owner rows looked up from the lookup table.
+	env.tablets[100].setResults("select c1, id from t1 where c1 in (10, 30)", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(ownerFields,
+		"10|1",
+	))
+	env.tablets[100].setResults("select c1, id from t1 where c1 in (30)", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(ownerFields))
+
+	lookupFields := sqltypes.MakeTestFields("c1|keyspace_id", "int64|varbinary")
+	env.tablets[200].setResults("select c1, keyspace_id from lkp order by c1 limit 2", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(lookupFields,
+		"10|\x16k@\xb4J\xbaK\xd6",
+		"30|\x16k@\xb4J\xbaK\xd6",
+	))
+	env.tablets[200].setResults("select c1, keyspace_id from lkp where c1 > 30 order by c1 limit 2", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(lookupFields))
+	// Lookup entries looked up from the owner table.
+	env.tablets[200].setResults("select c1, keyspace_id from lkp where c1 in (10)", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(lookupFields,
+		"10|\x16k@\xb4J\xbaK\xd6",
+	))
+	env.tablets[200].setResults("select c1, keyspace_id from lkp where c1 in (20)", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(lookupFields))
+
+	resp, err := env.wr.VerifyLookupVindex(ctx, "source.v", false, 2)
+	require.NoError(t, err)
+	want := map[string]*vtctldatapb.LookupVindexReport{
+		"0": {
+			Shard:         "0",
+			ProcessedRows: 2,
+			MatchingRows:  1,
+			MissingRows:   1,
+			MissingRowsSample: []*vtctldatapb.LookupVindexRowDiff{{
+				Row: map[string]*querypb.Value{
+					"c1":          sqltypes.ValueToProto(sqltypes.NewInt64(20)),
+					"keyspace_id": sqltypes.ValueToProto(sqltypes.NewVarBinary("\x06\xe7\xea\"Βp\x8f")),
+				},
+				Query: "insert ignore into lkp(c1, keyspace_id) values (20, '\x06\xe7\xea\\\"Βp\x8f')",
+			}},
+			OrphanedRows: 1,
+			OrphanedRowsSample: []*vtctldatapb.LookupVindexRowDiff{{
+				Row: map[string]*querypb.Value{
+					"c1":          sqltypes.ValueToProto(sqltypes.NewInt64(30)),
+					"keyspace_id": sqltypes.ValueToProto(sqltypes.NewVarBinary("\x16k@\xb4J\xbaK\xd6")),
+				},
+				Query: "delete from lkp where c1 = 30 and keyspace_id = '\x16k@\xb4J\xbaK\xd6'",
+			}},
+		},
+	}
+	utils.MustMatch(t, want, resp.Reports)
+	assert.Empty(t, env.tmc.dbaQueries[200])
+
+	resp, err = env.wr.VerifyLookupVindex(ctx, "source.v", true, 2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, resp.Reports["0"].RepairedRows)
+	assert.Equal(t, []string{
+		resp.Reports["0"].MissingRowsSample[0].Query,
+		resp.Reports["0"].OrphanedRowsSample[0].Query,
+	}, env.tmc.dbaQueries[200])
+}
+
+func TestVerifyLookupVindexErrors(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+	defer env.close()
+	ctx := context.Background()
+
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "source", &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+			"hashed": {
+				Type: "lookup_hash",
+				Params: map[string]string{
+					"table": "target.lkp",
+					"from":  "c1",
+					"to":    "id",
+				},
+				Owner: "t1",
+			},
+			"unowned": {
+				Type: "lookup_unique",
+				Params: map[string]string{
+					"table": "target.lkp",
+					"from":  "c1",
+					"to":    "keyspace_id",
+				},
+			},
+		},
+	}))
+
+	testcases := []struct {
+		name string
+		err  string
+	}{{
+		name: "v",
+		err:  "vindex name should be of the form keyspace.vindex: v",
+	}, {
+		name: "source.absent",
+		err:  "vindex source.absent not found in vschema",
+	}, {
+		name: "source.hash",
+		err:  "vindex hash is not a lookup type",
+	}, {
+		name: "source.hashed",
+		err:  "vindex source.hashed of type lookup_hash does not store keyspace ids, and cannot be verified",
+	}, {
+		name: "source.unowned",
+		err:  "vindex source.unowned has no owner",
+	}}
+	for _, tcase := range testcases {
+		_, err := env.wr.VerifyLookupVindex(ctx, tcase.name, false, 0)
+		assert.EqualError(t, err, tcase.err, tcase.name)
+	}
+}
@@ -42,6 +42,7 @@ import (
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/key"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -71,6 +72,7 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 	//FIXME validate tableSpecs, allTables, excludeTables
 	var tables []string
 	var externalTopo *topo.Server
+	var sourceSchema *tabletmanagerdatapb.SchemaDefinition
 	var err error
 
 	if externalCluster != "" {
@@ -106,10 +108,11 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 		if len(strings.TrimSpace(tableSpecs)) > 0 {
 			tables = strings.Split(tableSpecs, ",")
 		}
-		ksTables, err := wr.getKeyspaceTables(ctx, sourceKeyspace, wr.sourceTs)
+		sourceSchema, err = wr.getKeyspaceSchema(ctx, sourceKeyspace, wr.sourceTs)
 		if err != nil {
 			return err
 		}
+		ksTables := schemaTableNames(sourceSchema)
 		if len(tables) > 0 {
 			err = wr.validateSourceTablesExist(ctx, sourceKeyspace, ksTables, tables)
 			if err != nil {
@@ -157,6 +160,15 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 			}
 		}
 	}
+	if sourceSchema == nil {
+		sourceSchema, err = wr.getKeyspaceSchema(ctx, sourceKeyspace, wr.sourceTs)
+		if err != nil {
+			return err
+		}
+	}
+	if err := validateForeignKeysMovedTogether(sourceSchema, tables); err != nil {
+		return err
+	}
 	if externalTopo == nil {
 		// Save routing rules before vschema. If we save vschema first, and routing rules
 		// fails to save, we may generate duplicate table errors.
@@ -264,7 +276,46 @@ func (wr *Wrangler) validateSourceTablesExist(ctx context.Context, sourceKeyspac
 	return nil
 }
 
-func (wr *Wrangler) getKeyspaceTables(ctx context.Context, ks string, ts *topo.Server) ([]string, error) {
+// validateForeignKeysMovedTogether returns an error if one of the tables
+// has a foreign key relationship with a table that is not being moved.
+// Such a relationship cannot be maintained across keyspaces.
+func validateForeignKeysMovedTogether(schema *tabletmanagerdatapb.SchemaDefinition, tables []string) error {
+	moved := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		moved[table] = true
+	}
+	for _, td := range schema.TableDefinitions {
+		stmt, err := sqlparser.Parse(td.Schema)
+		if err != nil {
+			continue
+		}
+		createTable, ok := stmt.(*sqlparser.CreateTable)
+		if !ok || createTable.TableSpec == nil {
+			continue
+		}
+		for _, constraint := range createTable.TableSpec.Constraints {
+			fk, ok := constraint.Details.(*sqlparser.ForeignKeyDefinition)
+			if !ok {
+				continue
+			}
+			parent := fk.ReferenceDefinition.ReferencedTable.Name.String()
+			if moved[td.Name] != moved[parent] {
+				return fmt.Errorf("table %s has a foreign key %s to table %s: tables linked by foreign keys must be moved together", td.Name, constraint.Name.String(), parent)
+			}
+		}
+	}
+	return nil
+}
+
+func schemaTableNames(schema *tabletmanagerdatapb.SchemaDefinition) []string {
+	var tables []string
+	for _, td := range schema.TableDefinitions {
+		tables = append(tables, td.Name)
+	}
+	return tables
+}
+
+func (wr *Wrangler) getKeyspaceSchema(ctx context.Context, ks string, ts *topo.Server) (*tabletmanagerdatapb.SchemaDefinition, error) {
 	shards, err := ts.GetServingShards(ctx, ks)
 	if err != nil {
 		return nil, err
@@ -287,12 +338,7 @@ func (wr *Wrangler) getKeyspaceTables(ctx context.Context, ks string, ts *topo.S
 		return nil, err
 	}
 	log.Infof("got table schemas from source master %v.", master)
-
-	var sourceTables []string
-	for _, td := range schema.TableDefinitions {
-		sourceTables = append(sourceTables, td.Name)
-	}
-	return sourceTables, nil
+	return schema, nil
 }
 
 func (wr *Wrangler) checkIfPreviousJournalExists(ctx context.Context, mz *materializer, migrationID int64) (bool, []string, error) {
@@ -750,7 +796,6 @@ func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName s
 	return wr.ts.SaveVSchema(ctx, sourceKeyspace, sourceVSchema)
 }
 
-//
 func (wr *Wrangler) collectTargetStreams(ctx context.Context, mz *materializer) ([]string, error) {
 	var shardTablets []string
 	var mu sync.Mutex
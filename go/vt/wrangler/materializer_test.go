@@ -238,6 +238,31 @@ func TestMigrateVSchema(t *testing.T) {
 	}
 }
 
+func TestMoveTablesForeignKeys(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+		}, {
+			TargetTable:      "t2",
+			SourceExpression: "select * from t2",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.schema["sourceks.t2"].TableDefinitions[0].Schema = "create table t2(id int, t1_id int, primary key(id), constraint t2_fk foreign key (t1_id) references t1 (id))"
+
+	ctx := context.Background()
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", false, "", true, false, "")
+	require.EqualError(t, err, "table t2 has a foreign key t2_fk to table t1: tables linked by foreign keys must be moved together")
+	err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", `{"t2":{}}`, "", "", false, "", true, false, "")
+	require.EqualError(t, err, "table t2 has a foreign key t2_fk to table t1: tables linked by foreign keys must be moved together")
+}
+
 func TestCreateLookupVindexFull(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "lkp_vdx",
@@ -17,8 +17,10 @@ limitations under the License.
 package wrangler
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -34,6 +36,7 @@ import (
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/key"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topotools"
@@ -101,6 +104,89 @@ func (wr *Wrangler) Reshard(ctx context.Context, keyspace, workflow string, sour
 	return nil
 }
 
+// PlanTimeBucketReshard computes the Reshard needed for a keyspace sharded
+// by a time_bucket vindex to hold each of the next periods in its own shard.
+// It returns the serving shard that holds the most recent bucket, and the
+// shards it should be split into. All the split points are at the start of
+// future buckets, so no bucket is split across shards. Resharding still
+// copies the whole source: the first target gets all the rows of the source
+// up to the first future bucket, including its historic rows. The copy is
+// cheap when the source only holds recent buckets, i.e. when the previous
+// time based reshard was recent.
+func (wr *Wrangler) PlanTimeBucketReshard(ctx context.Context, keyspace, vindexName string, periods int, now time.Time) (string, []string, error) {
+	if periods < 1 {
+		return "", nil, fmt.Errorf("periods must be at least 1: %d", periods)
+	}
+	vschema, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		return "", nil, vterrors.Wrap(err, "GetVSchema")
+	}
+	vdef, ok := vschema.Vindexes[vindexName]
+	if !ok {
+		return "", nil, fmt.Errorf("vindex %s not found in keyspace %s", vindexName, keyspace)
+	}
+	vindex, err := vindexes.CreateVindex(vdef.Type, vindexName, vdef.Params)
+	if err != nil {
+		return "", nil, err
+	}
+	tb, ok := vindex.(*vindexes.TimeBucket)
+	if !ok {
+		return "", nil, fmt.Errorf("vindex %s is not a time_bucket vindex", vindexName)
+	}
+
+	shards, err := wr.ts.FindAllShardsInKeyspace(ctx, keyspace)
+	if err != nil {
+		return "", nil, vterrors.Wrap(err, "FindAllShardsInKeyspace")
+	}
+	var source *topo.ShardInfo
+	for _, si := range shards {
+		if si.IsMasterServing && len(si.GetKeyRange().GetEnd()) == 0 {
+			source = si
+			break
+		}
+	}
+	if source == nil {
+		return "", nil, fmt.Errorf("no serving shard holds the end of the key range in keyspace %s", keyspace)
+	}
+
+	start := source.GetKeyRange().GetStart()
+	var boundaries [][]byte
+	next := tb.Bucket(now) + 1
+	for i := 0; i < periods; i++ {
+		prefix, err := tb.BucketPrefix(tb.BucketStart(next + int64(i)))
+		if err != nil {
+			return "", nil, err
+		}
+		if bytes.Compare(prefix, start) <= 0 {
+			continue
+		}
+		boundaries = append(boundaries, prefix)
+	}
+	if len(boundaries) == 0 {
+		return "", nil, fmt.Errorf("shard %s already starts after the next %d periods", source.ShardName(), periods)
+	}
+
+	targets := make([]string, 0, len(boundaries)+1)
+	for _, end := range boundaries {
+		targets = append(targets, key.KeyRangeString(&topodatapb.KeyRange{Start: start, End: end}))
+		start = end
+	}
+	targets = append(targets, key.KeyRangeString(&topodatapb.KeyRange{Start: start}))
+	return source.ShardName(), targets, nil
+}
+
+// TimeBucketReshard starts the Reshard computed by PlanTimeBucketReshard.
+// The target shards and their tablets must already exist.
+func (wr *Wrangler) TimeBucketReshard(ctx context.Context, keyspace, workflow, vindexName string, periods int, now time.Time,
+	skipSchemaCopy bool, cell, tabletTypes string, autoStart, stopAfterCopy bool) error {
+	source, targets, err := wr.PlanTimeBucketReshard(ctx, keyspace, vindexName, periods, now)
+	if err != nil {
+		return err
+	}
+	wr.Logger().Infof("Resharding %v/%v into %v", keyspace, source, strings.Join(targets, ","))
+	return wr.Reshard(ctx, keyspace, workflow, []string{source}, targets, skipSchemaCopy, cell, tabletTypes, autoStart, stopAfterCopy)
+}
+
 func (wr *Wrangler) buildResharder(ctx context.Context, keyspace, workflow string, sources, targets []string, cell, tabletTypes string) (*resharder, error) {
 	rs := &resharder{
 		wr:            wr,
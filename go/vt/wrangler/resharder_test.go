@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -30,6 +31,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
@@ -872,3 +874,90 @@ func TestResharderMixedTablesOrder2(t *testing.T) {
 	}
 	env.tmc.verifyQueries(t)
 }
+
+func TestPlanTimeBucketReshard(t *testing.T) {
+	env := newTestResharderEnv(t, []string{"-495c", "495c-"}, []string{"495c-495d", "495d-495e", "495e-"})
+	defer env.close()
+
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"events_by_day": {
+				Type:   "time_bucket",
+				Params: map[string]string{"period": "day"},
+			},
+			"hash": {
+				Type: "hash",
+			},
+		},
+	}
+	if err := env.wr.ts.SaveVSchema(context.Background(), env.keyspace, vs); err != nil {
+		t.Fatal(err)
+	}
+
+	// 2021-06-01 falls in the bucket 0x495b, and the source already starts at the next bucket.
+	now := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	source, targets, err := env.wr.PlanTimeBucketReshard(context.Background(), env.keyspace, "events_by_day", 3, now)
+	require.NoError(t, err)
+	assert.Equal(t, "495c-", source)
+	assert.Equal(t, []string{"495c-495d", "495d-495e", "495e-"}, targets)
+
+	_, _, err = env.wr.PlanTimeBucketReshard(context.Background(), env.keyspace, "events_by_day", 1, now)
+	assert.EqualError(t, err, "shard 495c- already starts after the next 1 periods")
+
+	_, _, err = env.wr.PlanTimeBucketReshard(context.Background(), env.keyspace, "hash", 3, now)
+	assert.EqualError(t, err, "vindex hash is not a time_bucket vindex")
+
+	_, _, err = env.wr.PlanTimeBucketReshard(context.Background(), env.keyspace, "events_by_day", 0, now)
+	assert.EqualError(t, err, "periods must be at least 1: 0")
+}
+
+func TestTimeBucketReshard(t *testing.T) {
+	env := newTestResharderEnv(t, []string{"-495c", "495c-"}, []string{"495c-495d", "495d-495e", "495e-"})
+	defer env.close()
+
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"events_by_day": {
+				Type:   "time_bucket",
+				Params: map[string]string{"period": "day"},
+			},
+		},
+	}
+	if err := env.wr.ts.SaveVSchema(context.Background(), env.keyspace, vs); err != nil {
+		t.Fatal(err)
+	}
+	// Only the sources are serving: the targets don't cover the whole key range.
+	srvKeyspace := &topodatapb.SrvKeyspace{
+		Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{getPartition(t, env.sources)},
+	}
+	if err := env.wr.ts.UpdateSrvKeyspace(context.Background(), env.cell, env.keyspace, srvKeyspace); err != nil {
+		t.Fatal(err)
+	}
+	env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+
+	env.expectValidation()
+	env.tmc.expectVRQuery(110, fmt.Sprintf("select workflow, source, cell, tablet_types from _vt.vreplication where db_name='vt_%s' and message != 'FROZEN'", env.keyspace), &sqltypes.Result{})
+	for i, target := range env.targets {
+		env.tmc.expectVRQuery(
+			200+i*10,
+			insertPrefix+
+				`\('resharderTest', 'keyspace:\\"ks\\" shard:\\"495c-\\" filter:{rules:{match:\\"/.*\\" filter:\\"`+target+`\\"}}', '', [0-9]*, [0-9]*, '', '', [0-9]*, 0, 'Stopped', 'vt_ks'\)`+eol,
+			&sqltypes.Result{},
+		)
+		env.tmc.expectVRQuery(200+i*10, "update _vt.vreplication set state='Running' where db_name='vt_ks'", &sqltypes.Result{})
+	}
+
+	now := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	err := env.wr.TimeBucketReshard(context.Background(), env.keyspace, env.workflow, "events_by_day", 3, now, true, "", "", true, false)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
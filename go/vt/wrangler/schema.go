@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"html/template"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -31,6 +32,9 @@ import (
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemadiff"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
@@ -53,6 +57,115 @@ func (wr *Wrangler) GetSchema(ctx context.Context, tabletAlias *topodatapb.Table
 	return wr.tmc.GetSchema(ctx, ti.Tablet, tables, excludeTables, includeViews)
 }
 
+// DiffSchemaKeyspace compares the schema of a keyspace, as read from the
+// master of its first shard, with a desired schema made of CREATE TABLE and
+// CREATE VIEW statements. It returns the statements that turn the former
+// into the latter, in the order in which they must be applied, and whether
+// each of them depends on an earlier statement, in which case it may only
+// be applied once the earlier statements are.
+// Internal tables, such as Online DDL and table GC artifacts, are ignored.
+func (wr *Wrangler) DiffSchemaKeyspace(ctx context.Context, keyspace string, desired []string) (diff []sqlparser.Statement, dependent []bool, err error) {
+	desiredSchema, err := schemadiff.NewSchemaFromQueries(desired)
+	if err != nil {
+		return nil, nil, err
+	}
+	currentSchema, err := wr.keyspaceSchema(ctx, keyspace)
+	if err != nil {
+		return nil, nil, err
+	}
+	diff, err = currentSchema.Diff(desiredSchema, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	dependent = make([]bool, len(diff))
+	for i, stmt := range diff {
+		for _, earlier := range diff[:i] {
+			if currentSchema.DependsOn(stmt, earlier) {
+				dependent[i] = true
+				break
+			}
+		}
+	}
+	return diff, dependent, nil
+}
+
+// DryRunApplySchema returns the statements that ApplySchema applies to a
+// keyspace for the given queries, without applying them. With declarative,
+// the CREATE TABLE and CREATE VIEW statements are compared with the schema
+// of the keyspace, as read from the master of its first shard, and are
+// replaced by the statements that apply them, if any. Each of them is
+// compared with the schema that the previous ones declare.
+func (wr *Wrangler) DryRunApplySchema(ctx context.Context, keyspace string, queries []string, declarative bool) ([]string, error) {
+	var current *schemadiff.Schema
+	var result []string
+	for _, query := range queries {
+		stmt, err := sqlparser.Parse(query)
+		if err != nil {
+			return nil, err
+		}
+		isCreate := false
+		switch stmt.(type) {
+		case *sqlparser.CreateTable, *sqlparser.CreateView:
+			isCreate = true
+		}
+		if !declarative || !isCreate {
+			result = append(result, sqlparser.String(stmt))
+			continue
+		}
+		if current == nil {
+			if current, err = wr.keyspaceSchema(ctx, keyspace); err != nil {
+				return nil, err
+			}
+		}
+		declared, err := current.Declare(stmt)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := current.Diff(declared, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, stmt := range diff {
+			result = append(result, sqlparser.String(stmt))
+		}
+		current = declared
+	}
+	return result, nil
+}
+
+// keyspaceSchema returns the tables and views of a keyspace, as read from
+// the master of its first shard. Internal tables are ignored.
+func (wr *Wrangler) keyspaceSchema(ctx context.Context, keyspace string) (*schemadiff.Schema, error) {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, fmt.Errorf("GetShardNames(%v) failed: %v", keyspace, err)
+	}
+	if len(shards) == 0 {
+		return nil, fmt.Errorf("no shards in keyspace %v", keyspace)
+	}
+	sort.Strings(shards)
+	si, err := wr.ts.GetShard(ctx, keyspace, shards[0])
+	if err != nil {
+		return nil, fmt.Errorf("GetShard(%v, %v) failed: %v", keyspace, shards[0], err)
+	}
+	if !si.HasMaster() {
+		return nil, fmt.Errorf("no master in shard %v/%v", keyspace, shards[0])
+	}
+	sd, err := wr.GetSchema(ctx, si.MasterAlias, nil, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	var current []string
+	for _, td := range sd.TableDefinitions {
+		if schema.IsInternalOperationTableName(td.Name) {
+			continue
+		}
+		// Views are qualified with a placeholder for the database name.
+		current = append(current, strings.ReplaceAll(td.Schema, "{{.DatabaseName}}.", ""))
+	}
+	return schemadiff.NewSchemaFromQueries(current)
+}
+
 // ReloadSchema forces the remote tablet to reload its schema.
 func (wr *Wrangler) ReloadSchema(ctx context.Context, tabletAlias *topodatapb.TabletAlias) error {
 	ti, err := wr.ts.GetTablet(ctx, tabletAlias)
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func (wr *Wrangler) getSchemaVersions(ctx context.Context, tabletAlias *topodatapb.TabletAlias, req *tabletmanagerdatapb.GetSchemaVersionsRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	ti, err := wr.ts.GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return nil, fmt.Errorf("GetTablet(%v) failed: %v", tabletAlias, err)
+	}
+	return wr.tmc.GetSchemaVersions(ctx, ti.Tablet, req)
+}
+
+// GetSchemaVersions lists the schema versions which a tablet tracks in _vt.schema_version, without their schemas
+func (wr *Wrangler) GetSchemaVersions(ctx context.Context, tabletAlias *topodatapb.TabletAlias) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	return wr.getSchemaVersions(ctx, tabletAlias, &tabletmanagerdatapb.GetSchemaVersionsRequest{})
+}
+
+// GetSchemaVersionAtPosition returns the schema version of a tablet which was in effect at the given GTID position
+func (wr *Wrangler) GetSchemaVersionAtPosition(ctx context.Context, tabletAlias *topodatapb.TabletAlias, position string) (*tabletmanagerdatapb.SchemaVersion, error) {
+	versions, err := wr.getSchemaVersions(ctx, tabletAlias, &tabletmanagerdatapb.GetSchemaVersionsRequest{
+		Position:      position,
+		IncludeSchema: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("no schema version found at or before position %s", position)
+	}
+	return versions[0], nil
+}
+
+// GetSchemaVersionAtTime returns the schema version of a tablet which was in effect at the given time
+func (wr *Wrangler) GetSchemaVersionAtTime(ctx context.Context, tabletAlias *topodatapb.TabletAlias, t time.Time) (*tabletmanagerdatapb.SchemaVersion, error) {
+	versions, err := wr.getSchemaVersions(ctx, tabletAlias, &tabletmanagerdatapb.GetSchemaVersionsRequest{
+		Time:          protoutil.TimeToProto(t),
+		IncludeSchema: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("no schema version found at or before %s", t.Format(time.RFC3339))
+	}
+	return versions[0], nil
+}
+
+// DiffSchemaVersions compares two schema versions of a tablet, given by their ids
+func (wr *Wrangler) DiffSchemaVersions(ctx context.Context, tabletAlias *topodatapb.TabletAlias, fromID, toID int64) ([]*tabletmanagerdatapb.SchemaVersionTableDiff, error) {
+	versions, err := wr.getSchemaVersions(ctx, tabletAlias, &tabletmanagerdatapb.GetSchemaVersionsRequest{
+		Ids:           []int64{fromID, toID},
+		IncludeSchema: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var from, to *tabletmanagerdatapb.SchemaVersion
+	for _, version := range versions {
+		if version.Id == fromID {
+			from = version
+		}
+		if version.Id == toID {
+			to = version
+		}
+	}
+	if from == nil {
+		return nil, fmt.Errorf("schema version %d not found", fromID)
+	}
+	if to == nil {
+		return nil, fmt.Errorf("schema version %d not found", toID)
+	}
+	return tmutils.DiffMinimalSchemas(from.Schema, to.Schema), nil
+}
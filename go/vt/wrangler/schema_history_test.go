@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+type schemaVersionsTMClient struct {
+	tmclient.TabletManagerClient
+	versions []*tabletmanagerdatapb.SchemaVersion
+	requests []*tabletmanagerdatapb.GetSchemaVersionsRequest
+}
+
+func (tmc *schemaVersionsTMClient) GetSchemaVersions(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.GetSchemaVersionsRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	tmc.requests = append(tmc.requests, req)
+	var versions []*tabletmanagerdatapb.SchemaVersion
+	for _, version := range tmc.versions {
+		for _, id := range req.Ids {
+			if version.Id == id {
+				versions = append(versions, version)
+			}
+		}
+	}
+	return versions, nil
+}
+
+func TestDiffSchemaVersions(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	alias := &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}
+	require.NoError(t, ts.CreateTablet(ctx, &topodatapb.Tablet{Alias: alias}))
+
+	tmc := &schemaVersionsTMClient{
+		versions: []*tabletmanagerdatapb.SchemaVersion{
+			{Id: 1, Schema: &binlogdatapb.MinimalSchema{Tables: []*binlogdatapb.MinimalTable{{Name: "t1"}}}},
+			{Id: 2, Schema: &binlogdatapb.MinimalSchema{Tables: []*binlogdatapb.MinimalTable{{Name: "t1"}, {Name: "t2"}}}},
+			{Id: 3, Schema: &binlogdatapb.MinimalSchema{Tables: []*binlogdatapb.MinimalTable{{Name: "t2"}}}},
+		},
+	}
+	wr := New(logutil.NewConsoleLogger(), ts, tmc)
+
+	diffs, err := wr.DiffSchemaVersions(ctx, alias, 1, 3)
+	require.NoError(t, err)
+	expected := []*tabletmanagerdatapb.SchemaVersionTableDiff{
+		{Table: "t1", Change: "dropped"},
+		{Table: "t2", Change: "added"},
+	}
+	require.Len(t, diffs, len(expected))
+	for i := range expected {
+		assert.True(t, proto.Equal(expected[i], diffs[i]), "got %v, want %v", diffs[i], expected[i])
+	}
+	// only the two compared versions are read from the tablet
+	require.Len(t, tmc.requests, 1)
+	assert.Equal(t, []int64{1, 3}, tmc.requests[0].Ids)
+	assert.True(t, tmc.requests[0].IncludeSchema)
+
+	_, err = wr.DiffSchemaVersions(ctx, alias, 1, 4)
+	assert.EqualError(t, err, "schema version 4 not found")
+}
@@ -23,6 +23,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/sqlparser"
+
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
@@ -132,3 +135,83 @@ func TestValidateSchemaKeyspace(t *testing.T) {
 	shouldErr := tmeDiffs.wr.ValidateSchemaKeyspace(ctx, "ks", nil /*excludeTables*/, true /*includeViews*/, true /*skipNoMaster*/, true /*includeVSchema*/)
 	require.Error(t, shouldErr)
 }
+
+func TestDiffSchemaKeyspace(t *testing.T) {
+	ctx := context.Background()
+	sourceShards := []string{"-80", "80-"}
+	targetShards := []string{"-40", "40-80", "80-c0", "c0-"}
+
+	tme := newTestShardMigrater(ctx, t, sourceShards, targetShards)
+
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "t1",
+			Schema: "CREATE TABLE `t1` (\n  `c1` int NOT NULL,\n  PRIMARY KEY (`c1`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+			Type:   tmutils.TableBaseTable,
+		}, {
+			Name:   "t2",
+			Schema: "CREATE TABLE `t2` (\n  `c1` int NOT NULL,\n  PRIMARY KEY (`c1`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+			Type:   tmutils.TableBaseTable,
+		}, {
+			Name:   "v1",
+			Schema: "CREATE ALGORITHM=UNDEFINED DEFINER=`root`@`localhost` SQL SECURITY DEFINER VIEW {{.DatabaseName}}.`v1` AS select {{.DatabaseName}}.`t1`.`c1` AS `c1` from {{.DatabaseName}}.`t1`",
+			Type:   tmutils.TableView,
+		}, {
+			Name:   "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+			Schema: "CREATE TABLE `_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410` (\n  `c1` int NOT NULL\n) ENGINE=InnoDB",
+			Type:   tmutils.TableBaseTable,
+		}},
+	}
+	for _, primary := range append(tme.sourceMasters, tme.targetMasters...) {
+		primary.FakeMysqlDaemon.Schema = schm
+	}
+
+	diff, dependent, err := tme.wr.DiffSchemaKeyspace(ctx, "ks", []string{
+		"create view v1 as select c1 from t1",
+		"create table t3 (c1 int primary key, foreign key (c1) references t1 (c1))",
+		"create table t1 (c1 int primary key, c2 int)",
+	})
+	require.NoError(t, err)
+	var result []string
+	for _, stmt := range diff {
+		result = append(result, sqlparser.String(stmt))
+	}
+	require.Equal(t, []string{
+		"create table t3 (\n\tc1 int primary key,\n\tforeign key (c1) references t1 (c1)\n)",
+		"alter table t1 add column c2 int",
+		"drop table t2",
+	}, result)
+	require.Equal(t, []bool{false, true, false}, dependent)
+
+	// The schema that the keyspace already has yields no diff, whatever its form
+	diff, _, err = tme.wr.DiffSchemaKeyspace(ctx, "ks", []string{
+		"create table t1 (c1 int not null primary key) engine=innodb default charset=utf8mb4",
+		"create table t2 (c1 int not null, primary key (c1)) engine InnoDB charset utf8mb4",
+		"create view v1 as select * from t1",
+	})
+	require.NoError(t, err)
+	require.Empty(t, diff)
+
+	queries := []string{
+		"create view v1 as select * from t1",
+		"create table t1 (c1 int primary key, c2 int)",
+		"create view v2 as select * from t1",
+		"drop table t2",
+	}
+	result, err = tme.wr.DryRunApplySchema(ctx, "ks", queries, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"alter table t1 add column c2 int",
+		"create view v2 as select * from t1",
+		"drop table t2",
+	}, result)
+
+	result, err = tme.wr.DryRunApplySchema(ctx, "ks", queries, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"create view v1 as select * from t1",
+		"create table t1 (\n\tc1 int primary key,\n\tc2 int\n)",
+		"create view v2 as select * from t1",
+		"drop table t2",
+	}, result)
+}
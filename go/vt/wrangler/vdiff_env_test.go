@@ -57,6 +57,8 @@ type testVDiffEnv struct {
 	cell       string
 	tabletType topodatapb.TabletType
 	tmc        *testVDiffTMClient
+	// protocol is the tablet protocol to restore on close.
+	protocol string
 
 	mu      sync.Mutex
 	tablets map[int]*testVDiffTablet
@@ -80,8 +82,8 @@ func init() {
 // testVDiffEnv
 
 func newTestVDiffEnv(sourceShards, targetShards []string, query string, positions map[string]string) *testVDiffEnv {
-	flag.Set("tablet_protocol", "VDiffTest")
 	env := &testVDiffEnv{
+		protocol:   *tabletconn.TabletProtocol,
 		workflow:   "vdiffTest",
 		tablets:    make(map[int]*testVDiffTablet),
 		topoServ:   memorytopo.NewServer("cell"),
@@ -90,6 +92,7 @@ func newTestVDiffEnv(sourceShards, targetShards []string, query string, position
 		tmc:        newTestVDiffTMClient(),
 	}
 	env.wr = New(logutil.NewConsoleLogger(), env.topoServ, env.tmc)
+	flag.Set("tablet_protocol", "VDiffTest")
 
 	tabletID := 100
 	for _, shard := range sourceShards {
@@ -178,6 +181,7 @@ func (env *testVDiffEnv) close() {
 		env.topoServ.DeleteTablet(context.Background(), t.tablet.Alias)
 	}
 	env.tablets = nil
+	flag.Set("tablet_protocol", env.protocol)
 }
 
 func (env *testVDiffEnv) addTablet(id int, keyspace, shard string, tabletType topodatapb.TabletType) *testVDiffTablet {
@@ -276,17 +280,20 @@ type testVDiffTMClient struct {
 	tmclient.TabletManagerClient
 	schema    *tabletmanagerdatapb.SchemaDefinition
 	vrQueries map[int]map[string]*querypb.QueryResult
-	waitpos   map[int]string
-	vrpos     map[int]string
-	pos       map[int]string
+	// dbaQueries records the queries sent through ExecuteFetchAsDba.
+	dbaQueries map[int][]string
+	waitpos    map[int]string
+	vrpos      map[int]string
+	pos        map[int]string
 }
 
 func newTestVDiffTMClient() *testVDiffTMClient {
 	return &testVDiffTMClient{
-		vrQueries: make(map[int]map[string]*querypb.QueryResult),
-		waitpos:   make(map[int]string),
-		vrpos:     make(map[int]string),
-		pos:       make(map[int]string),
+		vrQueries:  make(map[int]map[string]*querypb.QueryResult),
+		dbaQueries: make(map[int][]string),
+		waitpos:    make(map[int]string),
+		vrpos:      make(map[int]string),
+		pos:        make(map[int]string),
 	}
 }
 
@@ -311,6 +318,11 @@ func (tmc *testVDiffTMClient) VReplicationExec(ctx context.Context, tablet *topo
 	return result, nil
 }
 
+func (tmc *testVDiffTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	tmc.dbaQueries[int(tablet.Alias.Uid)] = append(tmc.dbaQueries[int(tablet.Alias.Uid)], string(query))
+	return &querypb.QueryResult{RowsAffected: 1}, nil
+}
+
 func (tmc *testVDiffTMClient) WaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, pos string) error {
 	select {
 	case <-ctx.Done():
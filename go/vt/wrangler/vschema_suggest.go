@@ -0,0 +1,349 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// VSchemaSuggestion is a vschema proposed by SuggestVSchema.
+type VSchemaSuggestion struct {
+	// VSchema can be applied with ApplyVSchema.
+	VSchema *vschemapb.Keyspace
+	// SequenceTables are the sequence tables used by the suggested
+	// auto increment columns. They must be created in an unsharded keyspace.
+	SequenceTables []string
+	// Diff lists the differences between the current vschema of the
+	// keyspace and the suggested one.
+	Diff []string
+	// Warnings lists the tables for which no vschema could be suggested,
+	// and the lookup tables that the suggested vindexes need.
+	Warnings []string
+}
+
+// suggestTable is the information SuggestVSchema extracts from the schema of a table.
+type suggestTable struct {
+	name       string
+	columns    map[string]querypb.Type
+	primaryKey []string
+	autoInc    string
+	unique     map[string]bool
+	sequence   bool
+}
+
+// SuggestVSchema proposes a vschema for the keyspace, based on the schema of
+// its tables and on a sample of the queries sent to it.
+// In a sharded keyspace, each table gets a primary vindex on the first
+// column of its primary key, and columns that are compared for equality in
+// at least minLookupQueries of the sample queries get a lookup vindex, which
+// is unique if the column has a unique index. The lookup table of such a
+// vindex gets a primary vindex on its from column, unless it's already one
+// of the tables of the keyspace. Auto increment columns are
+// backed by sequences. The keyspace is considered sharded if sharded is true,
+// if its current vschema is sharded or if it has more than one shard.
+func (wr *Wrangler) SuggestVSchema(ctx context.Context, keyspace string, sharded bool, queries []string, minLookupQueries int) (*VSchemaSuggestion, error) {
+	current, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		if !topo.IsErrType(err, topo.NoNode) {
+			return nil, err
+		}
+		current = &vschemapb.Keyspace{}
+	}
+	shards, err := wr.ts.GetServingShards(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	sharded = sharded || current.Sharded || len(shards) > 1
+
+	schema, err := wr.getKeyspaceSchema(ctx, keyspace, wr.ts)
+	if err != nil {
+		return nil, err
+	}
+	var tables []*suggestTable
+	for _, td := range schema.TableDefinitions {
+		st, err := parseSuggestTable(td.Name, td.Schema)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, st)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].name < tables[j].name
+	})
+	lookups := countEqualityFilters(queries)
+
+	suggestion := &VSchemaSuggestion{
+		VSchema: &vschemapb.Keyspace{
+			Sharded:  sharded,
+			Vindexes: make(map[string]*vschemapb.Vindex),
+			Tables:   make(map[string]*vschemapb.Table),
+		},
+	}
+	vs := suggestion.VSchema
+	lookupTables := make(map[string]*vschemapb.Table)
+	for _, st := range tables {
+		if !sharded {
+			if st.sequence {
+				vs.Tables[st.name] = &vschemapb.Table{Type: "sequence"}
+				continue
+			}
+			vs.Tables[st.name] = &vschemapb.Table{}
+			continue
+		}
+		if st.sequence {
+			suggestion.Warnings = append(suggestion.Warnings, fmt.Sprintf("table %s is a sequence table, which must live in an unsharded keyspace", st.name))
+			continue
+		}
+		if len(st.primaryKey) == 0 {
+			suggestion.Warnings = append(suggestion.Warnings, fmt.Sprintf("table %s has no primary key to shard on", st.name))
+			continue
+		}
+		primary := st.primaryKey[0]
+		vindexType := suggestVindexType(st.columns[primary])
+		vs.Vindexes[vindexType] = &vschemapb.Vindex{Type: vindexType}
+		table := &vschemapb.Table{
+			ColumnVindexes: []*vschemapb.ColumnVindex{{
+				Column: primary,
+				Name:   vindexType,
+			}},
+		}
+		if st.autoInc != "" {
+			sequence := st.name + "_seq"
+			table.AutoIncrement = &vschemapb.AutoIncrement{
+				Column:   st.autoInc,
+				Sequence: sequence,
+			}
+			suggestion.SequenceTables = append(suggestion.SequenceTables, sequence)
+		}
+
+		var lookupCols []string
+		for col, count := range lookups[st.name] {
+			if _, ok := st.columns[col]; !ok || col == primary || count < minLookupQueries {
+				continue
+			}
+			lookupCols = append(lookupCols, col)
+		}
+		sort.Strings(lookupCols)
+		for _, col := range lookupCols {
+			name := fmt.Sprintf("%s_%s_lookup", st.name, col)
+			lookupType := "consistent_lookup"
+			if st.unique[col] {
+				lookupType = "consistent_lookup_unique"
+			}
+			vs.Vindexes[name] = &vschemapb.Vindex{
+				Type: lookupType,
+				Params: map[string]string{
+					"table": keyspace + "." + name,
+					"from":  col,
+					"to":    "keyspace_id",
+				},
+				Owner: st.name,
+			}
+			table.ColumnVindexes = append(table.ColumnVindexes, &vschemapb.ColumnVindex{
+				Column: col,
+				Name:   name,
+			})
+			lookupVindexType := suggestVindexType(st.columns[col])
+			vs.Vindexes[lookupVindexType] = &vschemapb.Vindex{Type: lookupVindexType}
+			lookupTables[name] = &vschemapb.Table{
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Column: col,
+					Name:   lookupVindexType,
+				}},
+			}
+			suggestion.Warnings = append(suggestion.Warnings, fmt.Sprintf("lookup table %s of vindex %s must be created and backfilled before the vindex is used", name, name))
+		}
+		vs.Tables[st.name] = table
+	}
+	for name, table := range lookupTables {
+		if _, ok := vs.Tables[name]; !ok {
+			vs.Tables[name] = table
+		}
+	}
+	suggestion.Diff = diffVSchemas(current, vs)
+	return suggestion, nil
+}
+
+func parseSuggestTable(name, createSQL string) (*suggestTable, error) {
+	stmt, err := sqlparser.Parse(createSQL)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse schema of table %s: %v", name, err)
+	}
+	createTable, ok := stmt.(*sqlparser.CreateTable)
+	if !ok || createTable.TableSpec == nil {
+		return nil, fmt.Errorf("schema of table %s is not a CREATE TABLE statement: %s", name, createSQL)
+	}
+	st := &suggestTable{
+		name:    name,
+		columns: make(map[string]querypb.Type),
+		unique:  make(map[string]bool),
+	}
+	spec := createTable.TableSpec
+	for _, col := range spec.Columns {
+		colName := col.Name.Lowered()
+		st.columns[colName] = col.Type.SQLType()
+		if col.Type.Options != nil && col.Type.Options.Autoincrement {
+			st.autoInc = colName
+		}
+	}
+	// The schema comes from SHOW CREATE TABLE, which always lists
+	// the primary key and unique keys as separate indexes.
+	for _, idx := range spec.Indexes {
+		if idx.Info.Primary {
+			st.primaryKey = nil
+			for _, col := range idx.Columns {
+				st.primaryKey = append(st.primaryKey, col.Column.Lowered())
+			}
+		}
+		if (idx.Info.Primary || idx.Info.Unique) && len(idx.Columns) == 1 {
+			st.unique[idx.Columns[0].Column.Lowered()] = true
+		}
+	}
+	for _, option := range spec.Options {
+		if strings.EqualFold(option.Name, "comment") && option.Value != nil && string(option.Value.Val) == "vitess_sequence" {
+			st.sequence = true
+		}
+	}
+	return st, nil
+}
+
+// suggestVindexType returns the type of the primary vindex suggested for a column type.
+func suggestVindexType(typ querypb.Type) string {
+	switch {
+	case sqltypes.IsIntegral(typ):
+		return "hash"
+	case sqltypes.IsText(typ):
+		return "unicode_loose_md5"
+	}
+	return "binary_md5"
+}
+
+// countEqualityFilters returns, for each table and column, the number of
+// queries that compare the column for equality in their WHERE clause.
+// Queries that cannot be parsed or that reference multiple tables are ignored.
+func countEqualityFilters(queries []string) map[string]map[string]int {
+	counts := make(map[string]map[string]int)
+	for _, query := range queries {
+		stmt, err := sqlparser.Parse(query)
+		if err != nil {
+			continue
+		}
+		var tableExprs sqlparser.TableExprs
+		var where *sqlparser.Where
+		switch stmt := stmt.(type) {
+		case *sqlparser.Select:
+			tableExprs, where = stmt.From, stmt.Where
+		case *sqlparser.Update:
+			tableExprs, where = stmt.TableExprs, stmt.Where
+		case *sqlparser.Delete:
+			tableExprs, where = stmt.TableExprs, stmt.Where
+		default:
+			continue
+		}
+		if len(tableExprs) != 1 || where == nil {
+			continue
+		}
+		aliased, ok := tableExprs[0].(*sqlparser.AliasedTableExpr)
+		if !ok {
+			continue
+		}
+		tableName, err := aliased.TableName()
+		if err != nil {
+			continue
+		}
+		table := tableName.Name.String()
+		seen := make(map[string]bool)
+		for _, expr := range sqlparser.SplitAndExpression(nil, where.Expr) {
+			cmp, ok := expr.(*sqlparser.ComparisonExpr)
+			if !ok || (cmp.Operator != sqlparser.EqualOp && cmp.Operator != sqlparser.InOp) {
+				continue
+			}
+			col, ok := cmp.Left.(*sqlparser.ColName)
+			if !ok || seen[col.Name.Lowered()] {
+				continue
+			}
+			seen[col.Name.Lowered()] = true
+			if counts[table] == nil {
+				counts[table] = make(map[string]int)
+			}
+			counts[table][col.Name.Lowered()]++
+		}
+	}
+	return counts
+}
+
+// diffVSchemas describes the changes from the current vschema to the
+// suggested one, one line per vindex or table.
+func diffVSchemas(current, suggested *vschemapb.Keyspace) []string {
+	var diff []string
+	if current.Sharded != suggested.Sharded {
+		diff = append(diff, fmt.Sprintf("~ sharded: %v -> %v", current.Sharded, suggested.Sharded))
+	}
+	diffKeys := func(kind string, current, suggested map[string]proto.Message) {
+		var names []string
+		for name := range current {
+			names = append(names, name)
+		}
+		for name := range suggested {
+			if _, ok := current[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			cur, inCurrent := current[name]
+			sug, inSuggested := suggested[name]
+			switch {
+			case !inCurrent:
+				diff = append(diff, fmt.Sprintf("+ %s %s", kind, name))
+			case !inSuggested:
+				diff = append(diff, fmt.Sprintf("- %s %s", kind, name))
+			case !proto.Equal(cur, sug):
+				diff = append(diff, fmt.Sprintf("~ %s %s", kind, name))
+			}
+		}
+	}
+	vindexes := func(vs *vschemapb.Keyspace) map[string]proto.Message {
+		m := make(map[string]proto.Message, len(vs.Vindexes))
+		for name, vindex := range vs.Vindexes {
+			m[name] = vindex
+		}
+		return m
+	}
+	tables := func(vs *vschemapb.Keyspace) map[string]proto.Message {
+		m := make(map[string]proto.Message, len(vs.Tables))
+		for name, table := range vs.Tables {
+			m[name] = table
+		}
+		return m
+	}
+	diffKeys("vindex", vindexes(current), vindexes(suggested))
+	diffKeys("table", tables(current), tables(suggested))
+	return diff
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/test/utils"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestSuggestVSchema(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "ks",
+		TargetKeyspace: "ks",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"-80", "80-"}, nil)
+	defer env.close()
+
+	schemas := map[string]string{
+		"customer": "CREATE TABLE `customer` (\n" +
+			"  `customer_id` bigint NOT NULL AUTO_INCREMENT,\n" +
+			"  `email` varchar(128) NOT NULL,\n" +
+			"  `name` varchar(128),\n" +
+			"  PRIMARY KEY (`customer_id`),\n" +
+			"  UNIQUE KEY `email` (`email`)\n" +
+			") ENGINE=InnoDB",
+		"corder": "CREATE TABLE `corder` (\n" +
+			"  `sku` varbinary(64) NOT NULL,\n" +
+			"  `customer_id` bigint NOT NULL,\n" +
+			"  PRIMARY KEY (`sku`, `customer_id`),\n" +
+			"  KEY `customer_id` (`customer_id`)\n" +
+			") ENGINE=InnoDB",
+		"nopk": "CREATE TABLE `nopk` (\n" +
+			"  `id` bigint\n" +
+			") ENGINE=InnoDB",
+	}
+	for name, schema := range schemas {
+		env.tmc.schema["ks."+name] = &tabletmanagerdatapb.SchemaDefinition{
+			TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+				Name:   name,
+				Schema: schema,
+			}},
+		}
+	}
+	ctx := context.Background()
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "ks", &vschemapb.Keyspace{
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+			"old":  {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"customer": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "customer_id", Name: "hash"}},
+			},
+		},
+	}))
+
+	queries := []string{
+		"select * from customer where email = 'a@b.c'",
+		"select * from customer where email = 'd@e.f' and email = 'd@e.f'",
+		"update customer set name = 'a' where email = 'a@b.c'",
+		"select * from customer where name = 'a'",
+		"select * from customer join corder where corder.customer_id = 1",
+		"select * from corder where customer_id in (1, 2)",
+		"select * from corder where customer_id = 3",
+		"not a query",
+	}
+	suggestion, err := env.wr.SuggestVSchema(ctx, "ks", false, queries, 2)
+	require.NoError(t, err)
+
+	want := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash":              {Type: "hash"},
+			"binary_md5":        {Type: "binary_md5"},
+			"unicode_loose_md5": {Type: "unicode_loose_md5"},
+			"corder_customer_id_lookup": {
+				Type:   "consistent_lookup",
+				Params: map[string]string{"table": "ks.corder_customer_id_lookup", "from": "customer_id", "to": "keyspace_id"},
+				Owner:  "corder",
+			},
+			"customer_email_lookup": {
+				Type:   "consistent_lookup_unique",
+				Params: map[string]string{"table": "ks.customer_email_lookup", "from": "email", "to": "keyspace_id"},
+				Owner:  "customer",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"corder": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{
+					{Column: "sku", Name: "binary_md5"},
+					{Column: "customer_id", Name: "corder_customer_id_lookup"},
+				},
+			},
+			"customer": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{
+					{Column: "customer_id", Name: "hash"},
+					{Column: "email", Name: "customer_email_lookup"},
+				},
+				AutoIncrement: &vschemapb.AutoIncrement{Column: "customer_id", Sequence: "customer_seq"},
+			},
+			"corder_customer_id_lookup": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "customer_id", Name: "hash"}},
+			},
+			"customer_email_lookup": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "email", Name: "unicode_loose_md5"}},
+			},
+		},
+	}
+	utils.MustMatch(t, want, suggestion.VSchema)
+	assert.Equal(t, []string{"customer_seq"}, suggestion.SequenceTables)
+	assert.Equal(t, []string{
+		"lookup table corder_customer_id_lookup of vindex corder_customer_id_lookup must be created and backfilled before the vindex is used",
+		"lookup table customer_email_lookup of vindex customer_email_lookup must be created and backfilled before the vindex is used",
+		"table nopk has no primary key to shard on",
+	}, suggestion.Warnings)
+	assert.Equal(t, []string{
+		"~ sharded: false -> true",
+		"+ vindex binary_md5",
+		"+ vindex corder_customer_id_lookup",
+		"+ vindex customer_email_lookup",
+		"- vindex old",
+		"+ vindex unicode_loose_md5",
+		"+ table corder",
+		"+ table corder_customer_id_lookup",
+		"~ table customer",
+		"+ table customer_email_lookup",
+	}, suggestion.Diff)
+}
+
+func TestSuggestVSchemaUnsharded(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "ks",
+		TargetKeyspace: "ks",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, nil)
+	defer env.close()
+
+	env.tmc.schema["ks.t1"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "t1",
+			Schema: "CREATE TABLE `t1` (`id` bigint, PRIMARY KEY (`id`)) ENGINE=InnoDB",
+		}, {
+			Name:   "t1_seq",
+			Schema: "CREATE TABLE `t1_seq` (`id` int, `next_id` bigint, `cache` bigint, PRIMARY KEY (`id`)) COMMENT 'vitess_sequence'",
+		}},
+	}
+	suggestion, err := env.wr.SuggestVSchema(context.Background(), "ks", false, nil, 1)
+	require.NoError(t, err)
+	want := &vschemapb.Keyspace{
+		Vindexes: map[string]*vschemapb.Vindex{},
+		Tables: map[string]*vschemapb.Table{
+			"t1":     {},
+			"t1_seq": {Type: "sequence"},
+		},
+	}
+	utils.MustMatch(t, want, suggestion.VSchema)
+	assert.Equal(t, []string{"+ table t1", "+ table t1_seq"}, suggestion.Diff)
+}